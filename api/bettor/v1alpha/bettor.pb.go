@@ -10,6 +10,7 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -77,6 +78,217 @@ func (Market_Status) EnumDescriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type Event_Type int32
+
+const (
+	Event_TYPE_UNSPECIFIED Event_Type = 0
+	Event_TYPE_CREATED     Event_Type = 1
+	Event_TYPE_UPDATED     Event_Type = 2
+	// the current state of a resource when a watch starts
+	Event_TYPE_SNAPSHOT Event_Type = 3
+)
+
+// Enum value maps for Event_Type.
+var (
+	Event_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_CREATED",
+		2: "TYPE_UPDATED",
+		3: "TYPE_SNAPSHOT",
+	}
+	Event_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_CREATED":     1,
+		"TYPE_UPDATED":     2,
+		"TYPE_SNAPSHOT":    3,
+	}
+)
+
+func (x Event_Type) Enum() *Event_Type {
+	p := new(Event_Type)
+	*p = x
+	return p
+}
+
+func (x Event_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Event_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_bettor_v1alpha_bettor_proto_enumTypes[1].Descriptor()
+}
+
+func (Event_Type) Type() protoreflect.EnumType {
+	return &file_bettor_v1alpha_bettor_proto_enumTypes[1]
+}
+
+func (x Event_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_Type.Descriptor instead.
+func (Event_Type) EnumDescriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type WebhookEvent_Type int32
+
+const (
+	WebhookEvent_TYPE_UNSPECIFIED     WebhookEvent_Type = 0
+	WebhookEvent_TYPE_MARKET_CREATED  WebhookEvent_Type = 1
+	WebhookEvent_TYPE_MARKET_LOCKED   WebhookEvent_Type = 2
+	WebhookEvent_TYPE_MARKET_SETTLED  WebhookEvent_Type = 3
+	WebhookEvent_TYPE_MARKET_CANCELED WebhookEvent_Type = 4
+	WebhookEvent_TYPE_BET_PLACED      WebhookEvent_Type = 5
+)
+
+// Enum value maps for WebhookEvent_Type.
+var (
+	WebhookEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_MARKET_CREATED",
+		2: "TYPE_MARKET_LOCKED",
+		3: "TYPE_MARKET_SETTLED",
+		4: "TYPE_MARKET_CANCELED",
+		5: "TYPE_BET_PLACED",
+	}
+	WebhookEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":     0,
+		"TYPE_MARKET_CREATED":  1,
+		"TYPE_MARKET_LOCKED":   2,
+		"TYPE_MARKET_SETTLED":  3,
+		"TYPE_MARKET_CANCELED": 4,
+		"TYPE_BET_PLACED":      5,
+	}
+)
+
+func (x WebhookEvent_Type) Enum() *WebhookEvent_Type {
+	p := new(WebhookEvent_Type)
+	*p = x
+	return p
+}
+
+func (x WebhookEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_bettor_v1alpha_bettor_proto_enumTypes[2].Descriptor()
+}
+
+func (WebhookEvent_Type) Type() protoreflect.EnumType {
+	return &file_bettor_v1alpha_bettor_proto_enumTypes[2]
+}
+
+func (x WebhookEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEvent_Type.Descriptor instead.
+func (WebhookEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type Grant_Reason int32
+
+const (
+	Grant_REASON_UNSPECIFIED Grant_Reason = 0
+	Grant_REASON_ALLOWANCE   Grant_Reason = 1
+	Grant_REASON_BAILOUT     Grant_Reason = 2
+)
+
+// Enum value maps for Grant_Reason.
+var (
+	Grant_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "REASON_ALLOWANCE",
+		2: "REASON_BAILOUT",
+	}
+	Grant_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED": 0,
+		"REASON_ALLOWANCE":   1,
+		"REASON_BAILOUT":     2,
+	}
+)
+
+func (x Grant_Reason) Enum() *Grant_Reason {
+	p := new(Grant_Reason)
+	*p = x
+	return p
+}
+
+func (x Grant_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Grant_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_bettor_v1alpha_bettor_proto_enumTypes[3].Descriptor()
+}
+
+func (Grant_Reason) Type() protoreflect.EnumType {
+	return &file_bettor_v1alpha_bettor_proto_enumTypes[3]
+}
+
+func (x Grant_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Grant_Reason.Descriptor instead.
+func (Grant_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type Season_OpenBetPolicy int32
+
+const (
+	Season_OPEN_BET_POLICY_UNSPECIFIED Season_OpenBetPolicy = 0
+	// unsettled markets are canceled and their bets refunded
+	Season_OPEN_BET_POLICY_REFUND Season_OpenBetPolicy = 1
+	// unsettled markets and their bets move to the new season. unsettled bets count towards users' starting balances
+	Season_OPEN_BET_POLICY_CARRY Season_OpenBetPolicy = 2
+)
+
+// Enum value maps for Season_OpenBetPolicy.
+var (
+	Season_OpenBetPolicy_name = map[int32]string{
+		0: "OPEN_BET_POLICY_UNSPECIFIED",
+		1: "OPEN_BET_POLICY_REFUND",
+		2: "OPEN_BET_POLICY_CARRY",
+	}
+	Season_OpenBetPolicy_value = map[string]int32{
+		"OPEN_BET_POLICY_UNSPECIFIED": 0,
+		"OPEN_BET_POLICY_REFUND":      1,
+		"OPEN_BET_POLICY_CARRY":       2,
+	}
+)
+
+func (x Season_OpenBetPolicy) Enum() *Season_OpenBetPolicy {
+	p := new(Season_OpenBetPolicy)
+	*p = x
+	return p
+}
+
+func (x Season_OpenBetPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Season_OpenBetPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_bettor_v1alpha_bettor_proto_enumTypes[4].Descriptor()
+}
+
+func (Season_OpenBetPolicy) Type() protoreflect.EnumType {
+	return &file_bettor_v1alpha_bettor_proto_enumTypes[4]
+}
+
+func (x Season_OpenBetPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Season_OpenBetPolicy.Descriptor instead.
+func (Season_OpenBetPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{34, 0}
+}
+
 // User information.
 type User struct {
 	state         protoimpl.MessageState
@@ -89,6 +301,11 @@ type User struct {
 	Username             string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
 	Centipoints          uint64                 `protobuf:"varint,5,opt,name=centipoints,proto3" json:"centipoints,omitempty"`
 	UnsettledCentipoints uint64                 `protobuf:"varint,6,opt,name=unsettled_centipoints,json=unsettledCentipoints,proto3" json:"unsettled_centipoints,omitempty"` // virtual field hydrated on read
+	Etag                 string                 `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`                                                              // set on every write. updates are rejected if stale
+	AllowanceClaimedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=allowance_claimed_at,json=allowanceClaimedAt,proto3" json:"allowance_claimed_at,omitempty"`      // last ClaimAllowance
+	// points owed from clawbacks of resettled markets that exceeded the balance. repaid from future credits before
+	// they are added to centipoints
+	DebtCentipoints uint64 `protobuf:"varint,9,opt,name=debt_centipoints,json=debtCentipoints,proto3" json:"debt_centipoints,omitempty"`
 }
 
 func (x *User) Reset() {
@@ -165,6 +382,27 @@ func (x *User) GetUnsettledCentipoints() uint64 {
 	return 0
 }
 
+func (x *User) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *User) GetAllowanceClaimedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AllowanceClaimedAt
+	}
+	return nil
+}
+
+func (x *User) GetDebtCentipoints() uint64 {
+	if x != nil {
+		return x.DebtCentipoints
+	}
+	return 0
+}
+
 // A betting market.
 type Market struct {
 	state         protoimpl.MessageState
@@ -182,6 +420,12 @@ type Market struct {
 	//
 	//	*Market_Pool
 	Type isMarket_Type `protobuf_oneof:"type"`
+	Etag string        `protobuf:"bytes,9,opt,name=etag,proto3" json:"etag,omitempty"` // set on every write. updates are rejected if stale
+	// the season the market was created in, or the season it was carried into if it was unsettled when that season
+	// started. set by the server
+	Season string `protobuf:"bytes,10,opt,name=season,proto3" json:"season,omitempty"`
+	// settlements of the market, oldest first. there is more than one if the market was resettled. set by the server
+	Settlements []*Settlement `protobuf:"bytes,11,rep,name=settlements,proto3" json:"settlements,omitempty"`
 }
 
 func (x *Market) Reset() {
@@ -279,6 +523,27 @@ func (x *Market) GetPool() *Pool {
 	return nil
 }
 
+func (x *Market) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Market) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+func (x *Market) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
+}
+
 type isMarket_Type interface {
 	isMarket_Type()
 }
@@ -345,6 +610,145 @@ func (x *Pool) GetWinner() string {
 	return ""
 }
 
+// A settlement of a market.
+type Settlement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winner    string                 `protobuf:"bytes,1,opt,name=winner,proto3" json:"winner,omitempty"`
+	SettledAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	// the user who resettled the market. unset for the original settlement
+	Actor  string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// points that could not be clawed back from users when resettling because their balance was too low. they are
+	// added to the users' debt_centipoints
+	Debts []*Debt `protobuf:"bytes,5,rep,name=debts,proto3" json:"debts,omitempty"`
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Settlement) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Settlement) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+func (x *Settlement) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Settlement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Settlement) GetDebts() []*Debt {
+	if x != nil {
+		return x.Debts
+	}
+	return nil
+}
+
+// Points owed by a user.
+type Debt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User        string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Centipoints uint64 `protobuf:"varint,2,opt,name=centipoints,proto3" json:"centipoints,omitempty"`
+}
+
+func (x *Debt) Reset() {
+	*x = Debt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Debt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Debt) ProtoMessage() {}
+
+func (x *Debt) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Debt.ProtoReflect.Descriptor instead.
+func (*Debt) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Debt) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Debt) GetCentipoints() uint64 {
+	if x != nil {
+		return x.Centipoints
+	}
+	return 0
+}
+
 // An outcome in a pool betting market.
 type Outcome struct {
 	state         protoimpl.MessageState
@@ -359,7 +763,7 @@ type Outcome struct {
 func (x *Outcome) Reset() {
 	*x = Outcome{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[3]
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -372,7 +776,7 @@ func (x *Outcome) String() string {
 func (*Outcome) ProtoMessage() {}
 
 func (x *Outcome) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[3]
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +789,7 @@ func (x *Outcome) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Outcome.ProtoReflect.Descriptor instead.
 func (*Outcome) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{3}
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Outcome) GetName() string {
@@ -427,12 +831,17 @@ type Bet struct {
 	//
 	//	*Bet_Outcome
 	Type isBet_Type `protobuf_oneof:"type"`
+	Etag string     `protobuf:"bytes,10,opt,name=etag,proto3" json:"etag,omitempty"` // set on every write. updates are rejected if stale
+	// the share of the pool on the bet's outcome just before the bet was placed, or 1 / number of outcomes if the pool was
+	// empty. set by the server
+	ImpliedProbability float64 `protobuf:"fixed64,11,opt,name=implied_probability,json=impliedProbability,proto3" json:"implied_probability,omitempty"`
+	Season             string  `protobuf:"bytes,12,opt,name=season,proto3" json:"season,omitempty"` // the season of the bet's market. set by the server
 }
 
 func (x *Bet) Reset() {
 	*x = Bet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[4]
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -445,7 +854,7 @@ func (x *Bet) String() string {
 func (*Bet) ProtoMessage() {}
 
 func (x *Bet) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[4]
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +867,7 @@ func (x *Bet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bet.ProtoReflect.Descriptor instead.
 func (*Bet) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{4}
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Bet) GetName() string {
@@ -531,6 +940,27 @@ func (x *Bet) GetOutcome() string {
 	return ""
 }
 
+func (x *Bet) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Bet) GetImpliedProbability() float64 {
+	if x != nil {
+		return x.ImpliedProbability
+	}
+	return 0
+}
+
+func (x *Bet) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
 type isBet_Type interface {
 	isBet_Type()
 }
@@ -541,32 +971,38 @@ type Bet_Outcome struct {
 
 func (*Bet_Outcome) isBet_Type() {}
 
-type CreateUserRequest struct {
+// A change to a user, market, or bet. Events are emitted after the change is committed.
+type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Book string `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
-	User *User  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Type Event_Type `protobuf:"varint,1,opt,name=type,proto3,enum=bettor.v1alpha.Event_Type" json:"type,omitempty"`
+	// Types that are assignable to Resource:
+	//
+	//	*Event_User
+	//	*Event_Market
+	//	*Event_Bet
+	Resource isEvent_Resource `protobuf_oneof:"resource"`
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+func (x *Event) Reset() {
+	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[5]
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[5]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,97 +1013,114 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateUserRequest) GetBook() string {
+func (x *Event) GetType() Event_Type {
 	if x != nil {
-		return x.Book
+		return x.Type
 	}
-	return ""
+	return Event_TYPE_UNSPECIFIED
 }
 
-func (x *CreateUserRequest) GetUser() *User {
-	if x != nil {
-		return x.User
+func (m *Event) GetResource() isEvent_Resource {
+	if m != nil {
+		return m.Resource
 	}
 	return nil
 }
 
-type CreateUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+func (x *Event) GetUser() *User {
+	if x, ok := x.GetResource().(*Event_User); ok {
+		return x.User
+	}
+	return nil
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Event) GetMarket() *Market {
+	if x, ok := x.GetResource().(*Event_Market); ok {
+		return x.Market
 	}
+	return nil
 }
 
-func (x *CreateUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Event) GetBet() *Bet {
+	if x, ok := x.GetResource().(*Event_Bet); ok {
+		return x.Bet
+	}
+	return nil
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+type isEvent_Resource interface {
+	isEvent_Resource()
+}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type Event_User struct {
+	User *User `protobuf:"bytes,2,opt,name=user,proto3,oneof"`
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{6}
+type Event_Market struct {
+	Market *Market `protobuf:"bytes,3,opt,name=market,proto3,oneof"`
 }
 
-func (x *CreateUserResponse) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+type Event_Bet struct {
+	Bet *Bet `protobuf:"bytes,4,opt,name=bet,proto3,oneof"`
 }
 
-type GetUserRequest struct {
+func (*Event_User) isEvent_Resource() {}
+
+func (*Event_Market) isEvent_Resource() {}
+
+func (*Event_Bet) isEvent_Resource() {}
+
+// A typed record of a state change. Events are persisted atomically with the change they describe and are delivered
+// to consumers in sequence order.
+type DomainEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
+	// assigned by the repo on write. sequences increase in commit order
+	Sequence  uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Id        string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// the book of the changed resource
+	Book string `protobuf:"bytes,4,opt,name=book,proto3" json:"book,omitempty"`
+	// resources are as written by the change
+	//
+	// Types that are assignable to Payload:
+	//
+	//	*DomainEvent_UserCreated
+	//	*DomainEvent_UserBalanceChanged
+	//	*DomainEvent_MarketCreated
+	//	*DomainEvent_MarketLocked
+	//	*DomainEvent_MarketSettled
+	//	*DomainEvent_MarketCanceled
+	//	*DomainEvent_MarketPoolChanged
+	//	*DomainEvent_BetPlaced
+	//	*DomainEvent_BetSettled
+	Payload isDomainEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *DomainEvent) Reset() {
+	*x = DomainEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[7]
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserRequest) String() string {
+func (x *DomainEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*DomainEvent) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[7]
+func (x *DomainEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,121 +1131,168 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DomainEvent.ProtoReflect.Descriptor instead.
+func (*DomainEvent) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetUserRequest) GetName() string {
+func (x *DomainEvent) GetSequence() uint64 {
 	if x != nil {
-		return x.Name
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DomainEvent) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type GetUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *DomainEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+func (x *DomainEvent) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *DomainEvent) GetPayload() isDomainEvent_Payload {
+	if m != nil {
+		return m.Payload
 	}
+	return nil
 }
 
-func (x *GetUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DomainEvent) GetUserCreated() *UserCreated {
+	if x, ok := x.GetPayload().(*DomainEvent_UserCreated); ok {
+		return x.UserCreated
+	}
+	return nil
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (x *DomainEvent) GetUserBalanceChanged() *UserBalanceChanged {
+	if x, ok := x.GetPayload().(*DomainEvent_UserBalanceChanged); ok {
+		return x.UserBalanceChanged
+	}
+	return nil
+}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DomainEvent) GetMarketCreated() *MarketCreated {
+	if x, ok := x.GetPayload().(*DomainEvent_MarketCreated); ok {
+		return x.MarketCreated
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{8}
+func (x *DomainEvent) GetMarketLocked() *MarketLocked {
+	if x, ok := x.GetPayload().(*DomainEvent_MarketLocked); ok {
+		return x.MarketLocked
+	}
+	return nil
 }
 
-func (x *GetUserResponse) GetUser() *User {
-	if x != nil {
-		return x.User
+func (x *DomainEvent) GetMarketSettled() *MarketSettled {
+	if x, ok := x.GetPayload().(*DomainEvent_MarketSettled); ok {
+		return x.MarketSettled
 	}
 	return nil
 }
 
-type GetUserByUsernameRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *DomainEvent) GetMarketCanceled() *MarketCanceled {
+	if x, ok := x.GetPayload().(*DomainEvent_MarketCanceled); ok {
+		return x.MarketCanceled
+	}
+	return nil
+}
 
-	Book     string `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+func (x *DomainEvent) GetMarketPoolChanged() *MarketPoolChanged {
+	if x, ok := x.GetPayload().(*DomainEvent_MarketPoolChanged); ok {
+		return x.MarketPoolChanged
+	}
+	return nil
 }
 
-func (x *GetUserByUsernameRequest) Reset() {
-	*x = GetUserByUsernameRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DomainEvent) GetBetPlaced() *BetPlaced {
+	if x, ok := x.GetPayload().(*DomainEvent_BetPlaced); ok {
+		return x.BetPlaced
 	}
+	return nil
 }
 
-func (x *GetUserByUsernameRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DomainEvent) GetBetSettled() *BetSettled {
+	if x, ok := x.GetPayload().(*DomainEvent_BetSettled); ok {
+		return x.BetSettled
+	}
+	return nil
 }
 
-func (*GetUserByUsernameRequest) ProtoMessage() {}
+type isDomainEvent_Payload interface {
+	isDomainEvent_Payload()
+}
 
-func (x *GetUserByUsernameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type DomainEvent_UserCreated struct {
+	UserCreated *UserCreated `protobuf:"bytes,5,opt,name=user_created,json=userCreated,proto3,oneof"`
 }
 
-// Deprecated: Use GetUserByUsernameRequest.ProtoReflect.Descriptor instead.
-func (*GetUserByUsernameRequest) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{9}
+type DomainEvent_UserBalanceChanged struct {
+	UserBalanceChanged *UserBalanceChanged `protobuf:"bytes,6,opt,name=user_balance_changed,json=userBalanceChanged,proto3,oneof"`
 }
 
-func (x *GetUserByUsernameRequest) GetBook() string {
-	if x != nil {
-		return x.Book
-	}
-	return ""
+type DomainEvent_MarketCreated struct {
+	MarketCreated *MarketCreated `protobuf:"bytes,7,opt,name=market_created,json=marketCreated,proto3,oneof"`
 }
 
-func (x *GetUserByUsernameRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+type DomainEvent_MarketLocked struct {
+	MarketLocked *MarketLocked `protobuf:"bytes,8,opt,name=market_locked,json=marketLocked,proto3,oneof"`
 }
 
-type GetUserByUsernameResponse struct {
+type DomainEvent_MarketSettled struct {
+	MarketSettled *MarketSettled `protobuf:"bytes,9,opt,name=market_settled,json=marketSettled,proto3,oneof"`
+}
+
+type DomainEvent_MarketCanceled struct {
+	MarketCanceled *MarketCanceled `protobuf:"bytes,10,opt,name=market_canceled,json=marketCanceled,proto3,oneof"`
+}
+
+type DomainEvent_MarketPoolChanged struct {
+	MarketPoolChanged *MarketPoolChanged `protobuf:"bytes,11,opt,name=market_pool_changed,json=marketPoolChanged,proto3,oneof"`
+}
+
+type DomainEvent_BetPlaced struct {
+	BetPlaced *BetPlaced `protobuf:"bytes,12,opt,name=bet_placed,json=betPlaced,proto3,oneof"`
+}
+
+type DomainEvent_BetSettled struct {
+	BetSettled *BetSettled `protobuf:"bytes,13,opt,name=bet_settled,json=betSettled,proto3,oneof"`
+}
+
+func (*DomainEvent_UserCreated) isDomainEvent_Payload() {}
+
+func (*DomainEvent_UserBalanceChanged) isDomainEvent_Payload() {}
+
+func (*DomainEvent_MarketCreated) isDomainEvent_Payload() {}
+
+func (*DomainEvent_MarketLocked) isDomainEvent_Payload() {}
+
+func (*DomainEvent_MarketSettled) isDomainEvent_Payload() {}
+
+func (*DomainEvent_MarketCanceled) isDomainEvent_Payload() {}
+
+func (*DomainEvent_MarketPoolChanged) isDomainEvent_Payload() {}
+
+func (*DomainEvent_BetPlaced) isDomainEvent_Payload() {}
+
+func (*DomainEvent_BetSettled) isDomainEvent_Payload() {}
+
+type UserCreated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -800,23 +1300,23 @@ type GetUserByUsernameResponse struct {
 	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *GetUserByUsernameResponse) Reset() {
-	*x = GetUserByUsernameResponse{}
+func (x *UserCreated) Reset() {
+	*x = UserCreated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[10]
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserByUsernameResponse) String() string {
+func (x *UserCreated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByUsernameResponse) ProtoMessage() {}
+func (*UserCreated) ProtoMessage() {}
 
-func (x *GetUserByUsernameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[10]
+func (x *UserCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,48 +1327,56 @@ func (x *GetUserByUsernameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByUsernameResponse.ProtoReflect.Descriptor instead.
-func (*GetUserByUsernameResponse) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UserCreated.ProtoReflect.Descriptor instead.
+func (*UserCreated) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetUserByUsernameResponse) GetUser() *User {
+func (x *UserCreated) GetUser() *User {
 	if x != nil {
 		return x.User
 	}
 	return nil
 }
 
-type ListUsersRequest struct {
+// A user's balance changed because of a bet being placed, refunded, or paid out, because of a grant, a transfer, or an
+// adjustment, or because a season started.
+type UserBalanceChanged struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageSize  int32    `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Book      string   `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
-	Users     []string `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
-	// valid options: "name" asc (default), "total_centipoints" desc
-	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-}
-
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// the change to the user's balance
+	DeltaCentipoints int64 `protobuf:"varint,2,opt,name=delta_centipoints,json=deltaCentipoints,proto3" json:"delta_centipoints,omitempty"`
+	// the change to the user's debt. negative when a credit repaid it or a season reset forgave it, positive when a
+	// clawback was more than the user's balance
+	DebtDeltaCentipoints int64 `protobuf:"varint,8,opt,name=debt_delta_centipoints,json=debtDeltaCentipoints,proto3" json:"debt_delta_centipoints,omitempty"`
+	// one of bet, grant, season, transfer, or adjustment is set. season is set when the balance is reset at the start of a season
+	Bet        string `protobuf:"bytes,3,opt,name=bet,proto3" json:"bet,omitempty"`
+	Grant      string `protobuf:"bytes,4,opt,name=grant,proto3" json:"grant,omitempty"`
+	Season     string `protobuf:"bytes,5,opt,name=season,proto3" json:"season,omitempty"`
+	Transfer   string `protobuf:"bytes,6,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Adjustment string `protobuf:"bytes,7,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+}
+
+func (x *UserBalanceChanged) Reset() {
+	*x = UserBalanceChanged{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[11]
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUsersRequest) String() string {
+func (x *UserBalanceChanged) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (*UserBalanceChanged) ProtoMessage() {}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[11]
+func (x *UserBalanceChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,72 +1387,92 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UserBalanceChanged.ProtoReflect.Descriptor instead.
+func (*UserBalanceChanged) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListUsersRequest) GetPageSize() int32 {
+func (x *UserBalanceChanged) GetUser() *User {
 	if x != nil {
-		return x.PageSize
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *ListUsersRequest) GetPageToken() string {
+func (x *UserBalanceChanged) GetDeltaCentipoints() int64 {
 	if x != nil {
-		return x.PageToken
+		return x.DeltaCentipoints
 	}
-	return ""
+	return 0
 }
 
-func (x *ListUsersRequest) GetBook() string {
+func (x *UserBalanceChanged) GetDebtDeltaCentipoints() int64 {
 	if x != nil {
-		return x.Book
+		return x.DebtDeltaCentipoints
+	}
+	return 0
+}
+
+func (x *UserBalanceChanged) GetBet() string {
+	if x != nil {
+		return x.Bet
 	}
 	return ""
 }
 
-func (x *ListUsersRequest) GetUsers() []string {
+func (x *UserBalanceChanged) GetGrant() string {
 	if x != nil {
-		return x.Users
+		return x.Grant
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUsersRequest) GetOrderBy() string {
+func (x *UserBalanceChanged) GetSeason() string {
 	if x != nil {
-		return x.OrderBy
+		return x.Season
 	}
 	return ""
 }
 
-type ListUsersResponse struct {
+func (x *UserBalanceChanged) GetTransfer() string {
+	if x != nil {
+		return x.Transfer
+	}
+	return ""
+}
+
+func (x *UserBalanceChanged) GetAdjustment() string {
+	if x != nil {
+		return x.Adjustment
+	}
+	return ""
+}
+
+type MarketCreated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users         []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
+func (x *MarketCreated) Reset() {
+	*x = MarketCreated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[12]
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *MarketCreated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*MarketCreated) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[12]
+func (x *MarketCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,36 +1483,75 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MarketCreated.ProtoReflect.Descriptor instead.
+func (*MarketCreated) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListUsersResponse) GetUsers() []*User {
+func (x *MarketCreated) GetMarket() *Market {
 	if x != nil {
-		return x.Users
+		return x.Market
 	}
 	return nil
 }
 
-func (x *ListUsersResponse) GetNextPageToken() string {
+type MarketLocked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *MarketLocked) Reset() {
+	*x = MarketLocked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketLocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketLocked) ProtoMessage() {}
+
+func (x *MarketLocked) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketLocked.ProtoReflect.Descriptor instead.
+func (*MarketLocked) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MarketLocked) GetMarket() *Market {
 	if x != nil {
-		return x.NextPageToken
+		return x.Market
 	}
-	return ""
+	return nil
 }
 
-type CreateMarketRequest struct {
+type MarketSettled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Book   string  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
-	Market *Market `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
 }
 
-func (x *CreateMarketRequest) Reset() {
-	*x = CreateMarketRequest{}
+func (x *MarketSettled) Reset() {
+	*x = MarketSettled{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -992,13 +1559,13 @@ func (x *CreateMarketRequest) Reset() {
 	}
 }
 
-func (x *CreateMarketRequest) String() string {
+func (x *MarketSettled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMarketRequest) ProtoMessage() {}
+func (*MarketSettled) ProtoMessage() {}
 
-func (x *CreateMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *MarketSettled) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1010,26 +1577,19 @@ func (x *CreateMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMarketRequest.ProtoReflect.Descriptor instead.
-func (*CreateMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarketSettled.ProtoReflect.Descriptor instead.
+func (*MarketSettled) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateMarketRequest) GetBook() string {
-	if x != nil {
-		return x.Book
-	}
-	return ""
-}
-
-func (x *CreateMarketRequest) GetMarket() *Market {
+func (x *MarketSettled) GetMarket() *Market {
 	if x != nil {
 		return x.Market
 	}
 	return nil
 }
 
-type CreateMarketResponse struct {
+type MarketCanceled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1037,8 +1597,8 @@ type CreateMarketResponse struct {
 	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
 }
 
-func (x *CreateMarketResponse) Reset() {
-	*x = CreateMarketResponse{}
+func (x *MarketCanceled) Reset() {
+	*x = MarketCanceled{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1046,13 +1606,13 @@ func (x *CreateMarketResponse) Reset() {
 	}
 }
 
-func (x *CreateMarketResponse) String() string {
+func (x *MarketCanceled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMarketResponse) ProtoMessage() {}
+func (*MarketCanceled) ProtoMessage() {}
 
-func (x *CreateMarketResponse) ProtoReflect() protoreflect.Message {
+func (x *MarketCanceled) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1064,28 +1624,30 @@ func (x *CreateMarketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMarketResponse.ProtoReflect.Descriptor instead.
-func (*CreateMarketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarketCanceled.ProtoReflect.Descriptor instead.
+func (*MarketCanceled) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateMarketResponse) GetMarket() *Market {
+func (x *MarketCanceled) GetMarket() *Market {
 	if x != nil {
 		return x.Market
 	}
 	return nil
 }
 
-type GetMarketRequest struct {
+// A market's pool changed because of a bet being placed.
+type MarketPoolChanged struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Bet    string  `protobuf:"bytes,2,opt,name=bet,proto3" json:"bet,omitempty"`
 }
 
-func (x *GetMarketRequest) Reset() {
-	*x = GetMarketRequest{}
+func (x *MarketPoolChanged) Reset() {
+	*x = MarketPoolChanged{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1093,13 +1655,13 @@ func (x *GetMarketRequest) Reset() {
 	}
 }
 
-func (x *GetMarketRequest) String() string {
+func (x *MarketPoolChanged) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarketRequest) ProtoMessage() {}
+func (*MarketPoolChanged) ProtoMessage() {}
 
-func (x *GetMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *MarketPoolChanged) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1111,28 +1673,35 @@ func (x *GetMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarketRequest.ProtoReflect.Descriptor instead.
-func (*GetMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarketPoolChanged.ProtoReflect.Descriptor instead.
+func (*MarketPoolChanged) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetMarketRequest) GetName() string {
+func (x *MarketPoolChanged) GetMarket() *Market {
 	if x != nil {
-		return x.Name
+		return x.Market
+	}
+	return nil
+}
+
+func (x *MarketPoolChanged) GetBet() string {
+	if x != nil {
+		return x.Bet
 	}
 	return ""
 }
 
-type GetMarketResponse struct {
+type BetPlaced struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Bet *Bet `protobuf:"bytes,1,opt,name=bet,proto3" json:"bet,omitempty"`
 }
 
-func (x *GetMarketResponse) Reset() {
-	*x = GetMarketResponse{}
+func (x *BetPlaced) Reset() {
+	*x = BetPlaced{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1140,13 +1709,13 @@ func (x *GetMarketResponse) Reset() {
 	}
 }
 
-func (x *GetMarketResponse) String() string {
+func (x *BetPlaced) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarketResponse) ProtoMessage() {}
+func (*BetPlaced) ProtoMessage() {}
 
-func (x *GetMarketResponse) ProtoReflect() protoreflect.Message {
+func (x *BetPlaced) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1158,31 +1727,29 @@ func (x *GetMarketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarketResponse.ProtoReflect.Descriptor instead.
-func (*GetMarketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BetPlaced.ProtoReflect.Descriptor instead.
+func (*BetPlaced) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetMarketResponse) GetMarket() *Market {
+func (x *BetPlaced) GetBet() *Bet {
 	if x != nil {
-		return x.Market
+		return x.Bet
 	}
 	return nil
 }
 
-type ListMarketsRequest struct {
+// A bet was paid out or refunded.
+type BetSettled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageSize  int32         `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string        `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Book      string        `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
-	Status    Market_Status `protobuf:"varint,4,opt,name=status,proto3,enum=bettor.v1alpha.Market_Status" json:"status,omitempty"`
+	Bet *Bet `protobuf:"bytes,1,opt,name=bet,proto3" json:"bet,omitempty"`
 }
 
-func (x *ListMarketsRequest) Reset() {
-	*x = ListMarketsRequest{}
+func (x *BetSettled) Reset() {
+	*x = BetSettled{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1190,13 +1757,13 @@ func (x *ListMarketsRequest) Reset() {
 	}
 }
 
-func (x *ListMarketsRequest) String() string {
+func (x *BetSettled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMarketsRequest) ProtoMessage() {}
+func (*BetSettled) ProtoMessage() {}
 
-func (x *ListMarketsRequest) ProtoReflect() protoreflect.Message {
+func (x *BetSettled) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1208,50 +1775,30 @@ func (x *ListMarketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMarketsRequest.ProtoReflect.Descriptor instead.
-func (*ListMarketsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BetSettled.ProtoReflect.Descriptor instead.
+func (*BetSettled) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListMarketsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListMarketsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListMarketsRequest) GetBook() string {
+func (x *BetSettled) GetBet() *Bet {
 	if x != nil {
-		return x.Book
-	}
-	return ""
-}
-
-func (x *ListMarketsRequest) GetStatus() Market_Status {
-	if x != nil {
-		return x.Status
+		return x.Bet
 	}
-	return Market_STATUS_UNSPECIFIED
+	return nil
 }
 
-type ListMarketsResponse struct {
+// The sequence of the last domain event a consumer has handled.
+type ConsumerCursor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Markets       []*Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
-	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (x *ListMarketsResponse) Reset() {
-	*x = ListMarketsResponse{}
+func (x *ConsumerCursor) Reset() {
+	*x = ConsumerCursor{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1259,13 +1806,13 @@ func (x *ListMarketsResponse) Reset() {
 	}
 }
 
-func (x *ListMarketsResponse) String() string {
+func (x *ConsumerCursor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMarketsResponse) ProtoMessage() {}
+func (*ConsumerCursor) ProtoMessage() {}
 
-func (x *ListMarketsResponse) ProtoReflect() protoreflect.Message {
+func (x *ConsumerCursor) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1277,35 +1824,40 @@ func (x *ListMarketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMarketsResponse.ProtoReflect.Descriptor instead.
-func (*ListMarketsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsumerCursor.ProtoReflect.Descriptor instead.
+func (*ConsumerCursor) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListMarketsResponse) GetMarkets() []*Market {
+func (x *ConsumerCursor) GetConsumer() string {
 	if x != nil {
-		return x.Markets
+		return x.Consumer
 	}
-	return nil
+	return ""
 }
 
-func (x *ListMarketsResponse) GetNextPageToken() string {
+func (x *ConsumerCursor) GetSequence() uint64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.Sequence
 	}
-	return ""
+	return 0
 }
 
-type LockMarketRequest struct {
+// A subscription that POSTs a book's market and bet events to a URL.
+type Webhook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Url       string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// key for the HMAC-SHA256 signature of payloads. generated by the server and only returned on creation
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *LockMarketRequest) Reset() {
-	*x = LockMarketRequest{}
+func (x *Webhook) Reset() {
+	*x = Webhook{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1313,13 +1865,13 @@ func (x *LockMarketRequest) Reset() {
 	}
 }
 
-func (x *LockMarketRequest) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockMarketRequest) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *LockMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *Webhook) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1331,28 +1883,58 @@ func (x *LockMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockMarketRequest.ProtoReflect.Descriptor instead.
-func (*LockMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *LockMarketRequest) GetName() string {
+func (x *Webhook) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type LockMarketResponse struct {
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// The JSON body POSTed to a webhook.
+type WebhookEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// unique per event. deliveries are at-least-once so receivers should deduplicate by id
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      WebhookEvent_Type      `protobuf:"varint,2,opt,name=type,proto3,enum=bettor.v1alpha.WebhookEvent_Type" json:"type,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Types that are assignable to Resource:
+	//
+	//	*WebhookEvent_Market
+	//	*WebhookEvent_Bet
+	Resource isWebhookEvent_Resource `protobuf_oneof:"resource"`
 }
 
-func (x *LockMarketResponse) Reset() {
-	*x = LockMarketResponse{}
+func (x *WebhookEvent) Reset() {
+	*x = WebhookEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1360,13 +1942,13 @@ func (x *LockMarketResponse) Reset() {
 	}
 }
 
-func (x *LockMarketResponse) String() string {
+func (x *WebhookEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockMarketResponse) ProtoMessage() {}
+func (*WebhookEvent) ProtoMessage() {}
 
-func (x *LockMarketResponse) ProtoReflect() protoreflect.Message {
+func (x *WebhookEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1378,32 +1960,84 @@ func (x *LockMarketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockMarketResponse.ProtoReflect.Descriptor instead.
-func (*LockMarketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebhookEvent.ProtoReflect.Descriptor instead.
+func (*WebhookEvent) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *LockMarketResponse) GetMarket() *Market {
+func (x *WebhookEvent) GetId() string {
 	if x != nil {
-		return x.Market
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type SettleMarketRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *WebhookEvent) GetType() WebhookEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WebhookEvent_TYPE_UNSPECIFIED
+}
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are assignable to Type:
-	//
-	//	*SettleMarketRequest_Winner
-	Type isSettleMarketRequest_Type `protobuf_oneof:"type"`
+func (x *WebhookEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *SettleMarketRequest) Reset() {
-	*x = SettleMarketRequest{}
+func (m *WebhookEvent) GetResource() isWebhookEvent_Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetMarket() *Market {
+	if x, ok := x.GetResource().(*WebhookEvent_Market); ok {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetBet() *Bet {
+	if x, ok := x.GetResource().(*WebhookEvent_Bet); ok {
+		return x.Bet
+	}
+	return nil
+}
+
+type isWebhookEvent_Resource interface {
+	isWebhookEvent_Resource()
+}
+
+type WebhookEvent_Market struct {
+	Market *Market `protobuf:"bytes,4,opt,name=market,proto3,oneof"`
+}
+
+type WebhookEvent_Bet struct {
+	Bet *Bet `protobuf:"bytes,5,opt,name=bet,proto3,oneof"`
+}
+
+func (*WebhookEvent_Market) isWebhookEvent_Resource() {}
+
+func (*WebhookEvent_Bet) isWebhookEvent_Resource() {}
+
+// A pending delivery of an event to a webhook. Deliveries are deleted once the receiver accepts them.
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// books/{book}/webhooks/{webhook}/deliveries/{event id}
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Event         *WebhookEvent          `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Attempts      uint32                 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1411,13 +2045,13 @@ func (x *SettleMarketRequest) Reset() {
 	}
 }
 
-func (x *SettleMarketRequest) String() string {
+func (x *WebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SettleMarketRequest) ProtoMessage() {}
+func (*WebhookDelivery) ProtoMessage() {}
 
-func (x *SettleMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1429,52 +2063,57 @@ func (x *SettleMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SettleMarketRequest.ProtoReflect.Descriptor instead.
-func (*SettleMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SettleMarketRequest) GetName() string {
+func (x *WebhookDelivery) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (m *SettleMarketRequest) GetType() isSettleMarketRequest_Type {
-	if m != nil {
-		return m.Type
+func (x *WebhookDelivery) GetEvent() *WebhookEvent {
+	if x != nil {
+		return x.Event
 	}
 	return nil
 }
 
-func (x *SettleMarketRequest) GetWinner() string {
-	if x, ok := x.GetType().(*SettleMarketRequest_Winner); ok {
-		return x.Winner
+func (x *WebhookDelivery) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
 	}
-	return ""
-}
-
-type isSettleMarketRequest_Type interface {
-	isSettleMarketRequest_Type()
+	return 0
 }
 
-type SettleMarketRequest_Winner struct {
-	Winner string `protobuf:"bytes,2,opt,name=winner,proto3,oneof"`
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
 }
 
-func (*SettleMarketRequest_Winner) isSettleMarketRequest_Type() {}
-
-type SettleMarketResponse struct {
+// Aggregate stats of a market computed from its pool and bets.
+type MarketStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Market           string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	TotalCentipoints uint64 `protobuf:"varint,2,opt,name=total_centipoints,json=totalCentipoints,proto3" json:"total_centipoints,omitempty"`
+	// number of distinct users who have bet
+	BettorCount uint32 `protobuf:"varint,3,opt,name=bettor_count,json=bettorCount,proto3" json:"bettor_count,omitempty"`
+	// in the order of the market's outcomes
+	Outcomes []*OutcomeStats `protobuf:"bytes,4,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	// the largest single bet. unset if there are no bets
+	LargestBet *Bet `protobuf:"bytes,5,opt,name=largest_bet,json=largestBet,proto3" json:"largest_bet,omitempty"`
 }
 
-func (x *SettleMarketResponse) Reset() {
-	*x = SettleMarketResponse{}
+func (x *MarketStats) Reset() {
+	*x = MarketStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1482,13 +2121,13 @@ func (x *SettleMarketResponse) Reset() {
 	}
 }
 
-func (x *SettleMarketResponse) String() string {
+func (x *MarketStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SettleMarketResponse) ProtoMessage() {}
+func (*MarketStats) ProtoMessage() {}
 
-func (x *SettleMarketResponse) ProtoReflect() protoreflect.Message {
+func (x *MarketStats) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1500,28 +2139,64 @@ func (x *SettleMarketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SettleMarketResponse.ProtoReflect.Descriptor instead.
-func (*SettleMarketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarketStats.ProtoReflect.Descriptor instead.
+func (*MarketStats) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SettleMarketResponse) GetMarket() *Market {
+func (x *MarketStats) GetMarket() string {
 	if x != nil {
 		return x.Market
 	}
+	return ""
+}
+
+func (x *MarketStats) GetTotalCentipoints() uint64 {
+	if x != nil {
+		return x.TotalCentipoints
+	}
+	return 0
+}
+
+func (x *MarketStats) GetBettorCount() uint32 {
+	if x != nil {
+		return x.BettorCount
+	}
+	return 0
+}
+
+func (x *MarketStats) GetOutcomes() []*OutcomeStats {
+	if x != nil {
+		return x.Outcomes
+	}
 	return nil
 }
 
-type CancelMarketRequest struct {
+func (x *MarketStats) GetLargestBet() *Bet {
+	if x != nil {
+		return x.LargestBet
+	}
+	return nil
+}
+
+// Aggregate stats of an outcome in a pool betting market.
+type OutcomeStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Outcome     string `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Centipoints uint64 `protobuf:"varint,2,opt,name=centipoints,proto3" json:"centipoints,omitempty"`
+	// number of distinct users who have bet on the outcome
+	BettorCount uint32 `protobuf:"varint,3,opt,name=bettor_count,json=bettorCount,proto3" json:"bettor_count,omitempty"`
+	// share of the pool bet on the outcome. 0 if nothing has been bet
+	ImpliedProbability float64 `protobuf:"fixed64,4,opt,name=implied_probability,json=impliedProbability,proto3" json:"implied_probability,omitempty"`
+	// points paid out per point bet on the outcome if it wins. 0 if nothing has been bet on the outcome
+	PayoutMultiplier float64 `protobuf:"fixed64,5,opt,name=payout_multiplier,json=payoutMultiplier,proto3" json:"payout_multiplier,omitempty"`
 }
 
-func (x *CancelMarketRequest) Reset() {
-	*x = CancelMarketRequest{}
+func (x *OutcomeStats) Reset() {
+	*x = OutcomeStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1529,13 +2204,13 @@ func (x *CancelMarketRequest) Reset() {
 	}
 }
 
-func (x *CancelMarketRequest) String() string {
+func (x *OutcomeStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelMarketRequest) ProtoMessage() {}
+func (*OutcomeStats) ProtoMessage() {}
 
-func (x *CancelMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *OutcomeStats) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1547,28 +2222,76 @@ func (x *CancelMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelMarketRequest.ProtoReflect.Descriptor instead.
-func (*CancelMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutcomeStats.ProtoReflect.Descriptor instead.
+func (*OutcomeStats) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CancelMarketRequest) GetName() string {
+func (x *OutcomeStats) GetOutcome() string {
 	if x != nil {
-		return x.Name
+		return x.Outcome
 	}
 	return ""
 }
 
-type CancelMarketResponse struct {
+func (x *OutcomeStats) GetCentipoints() uint64 {
+	if x != nil {
+		return x.Centipoints
+	}
+	return 0
+}
+
+func (x *OutcomeStats) GetBettorCount() uint32 {
+	if x != nil {
+		return x.BettorCount
+	}
+	return 0
+}
+
+func (x *OutcomeStats) GetImpliedProbability() float64 {
+	if x != nil {
+		return x.ImpliedProbability
+	}
+	return 0
+}
+
+func (x *OutcomeStats) GetPayoutMultiplier() float64 {
+	if x != nil {
+		return x.PayoutMultiplier
+	}
+	return 0
+}
+
+// Betting performance of a user computed from their settled bets. Bets on canceled markets are refunds and are not
+// counted.
+type UserStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
-}
-
-func (x *CancelMarketResponse) Reset() {
-	*x = CancelMarketResponse{}
+	User         string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	MarketsBetOn uint32 `protobuf:"varint,2,opt,name=markets_bet_on,json=marketsBetOn,proto3" json:"markets_bet_on,omitempty"`
+	SettledBets  uint32 `protobuf:"varint,3,opt,name=settled_bets,json=settledBets,proto3" json:"settled_bets,omitempty"`
+	// bets paid out more than was staked
+	Wins uint32 `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	// bets paid out less than was staked
+	Losses uint32 `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
+	// wins / (wins + losses). 0 if there are none
+	WinRate                float64 `protobuf:"fixed64,6,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	TotalStakedCentipoints uint64  `protobuf:"varint,7,opt,name=total_staked_centipoints,json=totalStakedCentipoints,proto3" json:"total_staked_centipoints,omitempty"`
+	NetProfitCentipoints   int64   `protobuf:"varint,8,opt,name=net_profit_centipoints,json=netProfitCentipoints,proto3" json:"net_profit_centipoints,omitempty"`
+	// net profit / total staked. 0 if nothing was staked
+	Roi float64 `protobuf:"fixed64,9,opt,name=roi,proto3" json:"roi,omitempty"`
+	// the bet with the largest profit. unset if there are no wins
+	BiggestWin *Bet `protobuf:"bytes,10,opt,name=biggest_win,json=biggestWin,proto3" json:"biggest_win,omitempty"`
+	// most consecutive wins by settle time. bets that broke even do not break a streak
+	LongestWinStreak uint32 `protobuf:"varint,11,opt,name=longest_win_streak,json=longestWinStreak,proto3" json:"longest_win_streak,omitempty"`
+	// the most bet on outcome titles, most bets first
+	FavoriteOutcomes []*OutcomeCount `protobuf:"bytes,12,rep,name=favorite_outcomes,json=favoriteOutcomes,proto3" json:"favorite_outcomes,omitempty"`
+}
+
+func (x *UserStats) Reset() {
+	*x = UserStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1576,13 +2299,13 @@ func (x *CancelMarketResponse) Reset() {
 	}
 }
 
-func (x *CancelMarketResponse) String() string {
+func (x *UserStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelMarketResponse) ProtoMessage() {}
+func (*UserStats) ProtoMessage() {}
 
-func (x *CancelMarketResponse) ProtoReflect() protoreflect.Message {
+func (x *UserStats) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1594,29 +2317,107 @@ func (x *CancelMarketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelMarketResponse.ProtoReflect.Descriptor instead.
-func (*CancelMarketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
+func (*UserStats) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CancelMarketResponse) GetMarket() *Market {
+func (x *UserStats) GetUser() string {
 	if x != nil {
-		return x.Market
+		return x.User
+	}
+	return ""
+}
+
+func (x *UserStats) GetMarketsBetOn() uint32 {
+	if x != nil {
+		return x.MarketsBetOn
+	}
+	return 0
+}
+
+func (x *UserStats) GetSettledBets() uint32 {
+	if x != nil {
+		return x.SettledBets
+	}
+	return 0
+}
+
+func (x *UserStats) GetWins() uint32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *UserStats) GetLosses() uint32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *UserStats) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *UserStats) GetTotalStakedCentipoints() uint64 {
+	if x != nil {
+		return x.TotalStakedCentipoints
+	}
+	return 0
+}
+
+func (x *UserStats) GetNetProfitCentipoints() int64 {
+	if x != nil {
+		return x.NetProfitCentipoints
+	}
+	return 0
+}
+
+func (x *UserStats) GetRoi() float64 {
+	if x != nil {
+		return x.Roi
+	}
+	return 0
+}
+
+func (x *UserStats) GetBiggestWin() *Bet {
+	if x != nil {
+		return x.BiggestWin
 	}
 	return nil
 }
 
-type CreateBetRequest struct {
+func (x *UserStats) GetLongestWinStreak() uint32 {
+	if x != nil {
+		return x.LongestWinStreak
+	}
+	return 0
+}
+
+func (x *UserStats) GetFavoriteOutcomes() []*OutcomeCount {
+	if x != nil {
+		return x.FavoriteOutcomes
+	}
+	return nil
+}
+
+// The number of bets on outcomes with a title.
+type OutcomeCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Book string `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
-	Bet  *Bet   `protobuf:"bytes,2,opt,name=bet,proto3" json:"bet,omitempty"`
+	Title    string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	BetCount uint32 `protobuf:"varint,2,opt,name=bet_count,json=betCount,proto3" json:"bet_count,omitempty"`
 }
 
-func (x *CreateBetRequest) Reset() {
-	*x = CreateBetRequest{}
+func (x *OutcomeCount) Reset() {
+	*x = OutcomeCount{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1624,13 +2425,13 @@ func (x *CreateBetRequest) Reset() {
 	}
 }
 
-func (x *CreateBetRequest) String() string {
+func (x *OutcomeCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBetRequest) ProtoMessage() {}
+func (*OutcomeCount) ProtoMessage() {}
 
-func (x *CreateBetRequest) ProtoReflect() protoreflect.Message {
+func (x *OutcomeCount) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1642,35 +2443,44 @@ func (x *CreateBetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBetRequest.ProtoReflect.Descriptor instead.
-func (*CreateBetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutcomeCount.ProtoReflect.Descriptor instead.
+func (*OutcomeCount) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateBetRequest) GetBook() string {
+func (x *OutcomeCount) GetTitle() string {
 	if x != nil {
-		return x.Book
+		return x.Title
 	}
 	return ""
 }
 
-func (x *CreateBetRequest) GetBet() *Bet {
+func (x *OutcomeCount) GetBetCount() uint32 {
 	if x != nil {
-		return x.Bet
+		return x.BetCount
 	}
-	return nil
+	return 0
 }
 
-type CreateBetResponse struct {
+// Forecasting accuracy of a user scored from the implied probabilities of their bets on settled markets.
+type ForecastScore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bet *Bet `protobuf:"bytes,1,opt,name=bet,proto3" json:"bet,omitempty"`
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// number of scored bets
+	Forecasts uint32 `protobuf:"varint,2,opt,name=forecasts,proto3" json:"forecasts,omitempty"`
+	// mean squared error of implied probabilities. 0 is perfect, lower is better
+	BrierScore float64 `protobuf:"fixed64,3,opt,name=brier_score,json=brierScore,proto3" json:"brier_score,omitempty"`
+	// mean natural log of the implied probability of what happened. 0 is perfect, higher is better
+	LogScore float64 `protobuf:"fixed64,4,opt,name=log_score,json=logScore,proto3" json:"log_score,omitempty"`
+	// forecasts grouped into 10 equal width bins of implied probability. only non-empty bins are returned
+	Calibration []*CalibrationBin `protobuf:"bytes,5,rep,name=calibration,proto3" json:"calibration,omitempty"`
 }
 
-func (x *CreateBetResponse) Reset() {
-	*x = CreateBetResponse{}
+func (x *ForecastScore) Reset() {
+	*x = ForecastScore{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1678,13 +2488,13 @@ func (x *CreateBetResponse) Reset() {
 	}
 }
 
-func (x *CreateBetResponse) String() string {
+func (x *ForecastScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBetResponse) ProtoMessage() {}
+func (*ForecastScore) ProtoMessage() {}
 
-func (x *CreateBetResponse) ProtoReflect() protoreflect.Message {
+func (x *ForecastScore) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1696,28 +2506,63 @@ func (x *CreateBetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBetResponse.ProtoReflect.Descriptor instead.
-func (*CreateBetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForecastScore.ProtoReflect.Descriptor instead.
+func (*ForecastScore) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateBetResponse) GetBet() *Bet {
+func (x *ForecastScore) GetUser() string {
 	if x != nil {
-		return x.Bet
+		return x.User
+	}
+	return ""
+}
+
+func (x *ForecastScore) GetForecasts() uint32 {
+	if x != nil {
+		return x.Forecasts
+	}
+	return 0
+}
+
+func (x *ForecastScore) GetBrierScore() float64 {
+	if x != nil {
+		return x.BrierScore
+	}
+	return 0
+}
+
+func (x *ForecastScore) GetLogScore() float64 {
+	if x != nil {
+		return x.LogScore
+	}
+	return 0
+}
+
+func (x *ForecastScore) GetCalibration() []*CalibrationBin {
+	if x != nil {
+		return x.Calibration
 	}
 	return nil
 }
 
-type GetBetRequest struct {
+// A bin of a calibration curve.
+type CalibrationBin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bet string `protobuf:"bytes,1,opt,name=bet,proto3" json:"bet,omitempty"`
+	// the bin's implied probabilities are in [lower_bound, upper_bound)
+	LowerBound             float64 `protobuf:"fixed64,1,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound             float64 `protobuf:"fixed64,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	Forecasts              uint32  `protobuf:"varint,3,opt,name=forecasts,proto3" json:"forecasts,omitempty"`
+	MeanImpliedProbability float64 `protobuf:"fixed64,4,opt,name=mean_implied_probability,json=meanImpliedProbability,proto3" json:"mean_implied_probability,omitempty"`
+	// the share of the bin's forecasts that won
+	ObservedFrequency float64 `protobuf:"fixed64,5,opt,name=observed_frequency,json=observedFrequency,proto3" json:"observed_frequency,omitempty"`
 }
 
-func (x *GetBetRequest) Reset() {
-	*x = GetBetRequest{}
+func (x *CalibrationBin) Reset() {
+	*x = CalibrationBin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1725,13 +2570,13 @@ func (x *GetBetRequest) Reset() {
 	}
 }
 
-func (x *GetBetRequest) String() string {
+func (x *CalibrationBin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBetRequest) ProtoMessage() {}
+func (*CalibrationBin) ProtoMessage() {}
 
-func (x *GetBetRequest) ProtoReflect() protoreflect.Message {
+func (x *CalibrationBin) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1743,28 +2588,63 @@ func (x *GetBetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBetRequest.ProtoReflect.Descriptor instead.
-func (*GetBetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CalibrationBin.ProtoReflect.Descriptor instead.
+func (*CalibrationBin) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetBetRequest) GetBet() string {
+func (x *CalibrationBin) GetLowerBound() float64 {
 	if x != nil {
-		return x.Bet
+		return x.LowerBound
 	}
-	return ""
+	return 0
 }
 
-type GetBetResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CalibrationBin) GetUpperBound() float64 {
+	if x != nil {
+		return x.UpperBound
+	}
+	return 0
+}
 
-	Bet *Bet `protobuf:"bytes,1,opt,name=bet,proto3" json:"bet,omitempty"`
+func (x *CalibrationBin) GetForecasts() uint32 {
+	if x != nil {
+		return x.Forecasts
+	}
+	return 0
 }
 
-func (x *GetBetResponse) Reset() {
-	*x = GetBetResponse{}
+func (x *CalibrationBin) GetMeanImpliedProbability() float64 {
+	if x != nil {
+		return x.MeanImpliedProbability
+	}
+	return 0
+}
+
+func (x *CalibrationBin) GetObservedFrequency() float64 {
+	if x != nil {
+		return x.ObservedFrequency
+	}
+	return 0
+}
+
+// The distribution of a pool betting market's pool after a bet changed it.
+type OddsSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// the market revision the snapshot was taken at. a market's snapshots are ordered by revision
+	Revision         uint64                 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TotalCentipoints uint64                 `protobuf:"varint,4,opt,name=total_centipoints,json=totalCentipoints,proto3" json:"total_centipoints,omitempty"`
+	// in the order of the market's outcomes
+	Outcomes []*OutcomeOdds `protobuf:"bytes,5,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+}
+
+func (x *OddsSnapshot) Reset() {
+	*x = OddsSnapshot{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1772,13 +2652,13 @@ func (x *GetBetResponse) Reset() {
 	}
 }
 
-func (x *GetBetResponse) String() string {
+func (x *OddsSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBetResponse) ProtoMessage() {}
+func (*OddsSnapshot) ProtoMessage() {}
 
-func (x *GetBetResponse) ProtoReflect() protoreflect.Message {
+func (x *OddsSnapshot) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1790,33 +2670,60 @@ func (x *GetBetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBetResponse.ProtoReflect.Descriptor instead.
-func (*GetBetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OddsSnapshot.ProtoReflect.Descriptor instead.
+func (*OddsSnapshot) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetBetResponse) GetBet() *Bet {
+func (x *OddsSnapshot) GetMarket() string {
 	if x != nil {
-		return x.Bet
+		return x.Market
+	}
+	return ""
+}
+
+func (x *OddsSnapshot) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *OddsSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ListBetsRequest struct {
+func (x *OddsSnapshot) GetTotalCentipoints() uint64 {
+	if x != nil {
+		return x.TotalCentipoints
+	}
+	return 0
+}
+
+func (x *OddsSnapshot) GetOutcomes() []*OutcomeOdds {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+// The odds of an outcome in an odds snapshot.
+type OutcomeOdds struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageSize       int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken      string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Book           string `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
-	User           string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
-	Market         string `protobuf:"bytes,5,opt,name=market,proto3" json:"market,omitempty"`
-	ExcludeSettled bool   `protobuf:"varint,6,opt,name=exclude_settled,json=excludeSettled,proto3" json:"exclude_settled,omitempty"`
+	Outcome     string `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Centipoints uint64 `protobuf:"varint,2,opt,name=centipoints,proto3" json:"centipoints,omitempty"`
+	// share of the pool bet on the outcome
+	ImpliedProbability float64 `protobuf:"fixed64,3,opt,name=implied_probability,json=impliedProbability,proto3" json:"implied_probability,omitempty"`
 }
 
-func (x *ListBetsRequest) Reset() {
-	*x = ListBetsRequest{}
+func (x *OutcomeOdds) Reset() {
+	*x = OutcomeOdds{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1824,13 +2731,13 @@ func (x *ListBetsRequest) Reset() {
 	}
 }
 
-func (x *ListBetsRequest) String() string {
+func (x *OutcomeOdds) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBetsRequest) ProtoMessage() {}
+func (*OutcomeOdds) ProtoMessage() {}
 
-func (x *ListBetsRequest) ProtoReflect() protoreflect.Message {
+func (x *OutcomeOdds) ProtoReflect() protoreflect.Message {
 	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1842,568 +2749,7751 @@ func (x *ListBetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBetsRequest.ProtoReflect.Descriptor instead.
-func (*ListBetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutcomeOdds.ProtoReflect.Descriptor instead.
+func (*OutcomeOdds) Descriptor() ([]byte, []int) {
 	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListBetsRequest) GetPageSize() int32 {
+func (x *OutcomeOdds) GetOutcome() string {
 	if x != nil {
-		return x.PageSize
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *OutcomeOdds) GetCentipoints() uint64 {
+	if x != nil {
+		return x.Centipoints
 	}
 	return 0
 }
 
-func (x *ListBetsRequest) GetPageToken() string {
+func (x *OutcomeOdds) GetImpliedProbability() float64 {
 	if x != nil {
-		return x.PageToken
+		return x.ImpliedProbability
+	}
+	return 0
+}
+
+// Settings of a book. Books that have not been configured use default settings.
+type BookSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// points granted by ClaimAllowance. 0 disables the allowance
+	AllowanceCentipoints uint64 `protobuf:"varint,3,opt,name=allowance_centipoints,json=allowanceCentipoints,proto3" json:"allowance_centipoints,omitempty"`
+	// how long a user must wait between claims
+	AllowanceCooldown *durationpb.Duration `protobuf:"bytes,4,opt,name=allowance_cooldown,json=allowanceCooldown,proto3" json:"allowance_cooldown,omitempty"`
+	// a claim by a user with fewer total points than this tops them up to it if that grants more than the allowance.
+	// 0 disables bailouts
+	BailoutCentipoints uint64 `protobuf:"varint,5,opt,name=bailout_centipoints,json=bailoutCentipoints,proto3" json:"bailout_centipoints,omitempty"`
+	// users who may update settings, adjust balances, start seasons, and resettle any market. while there are none, any
+	// member of the book may update settings to bootstrap them
+	Admins []string `protobuf:"bytes,6,rep,name=admins,proto3" json:"admins,omitempty"`
+}
+
+func (x *BookSettings) Reset() {
+	*x = BookSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookSettings) ProtoMessage() {}
+
+func (x *BookSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookSettings.ProtoReflect.Descriptor instead.
+func (*BookSettings) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BookSettings) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListBetsRequest) GetBook() string {
+func (x *BookSettings) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Book
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *BookSettings) GetAllowanceCentipoints() uint64 {
+	if x != nil {
+		return x.AllowanceCentipoints
+	}
+	return 0
+}
+
+func (x *BookSettings) GetAllowanceCooldown() *durationpb.Duration {
+	if x != nil {
+		return x.AllowanceCooldown
+	}
+	return nil
+}
+
+func (x *BookSettings) GetBailoutCentipoints() uint64 {
+	if x != nil {
+		return x.BailoutCentipoints
+	}
+	return 0
+}
+
+func (x *BookSettings) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+// Points granted to a user outside of betting.
+type Grant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	User        string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Centipoints uint64                 `protobuf:"varint,4,opt,name=centipoints,proto3" json:"centipoints,omitempty"`
+	Reason      Grant_Reason           `protobuf:"varint,5,opt,name=reason,proto3,enum=bettor.v1alpha.Grant_Reason" json:"reason,omitempty"`
+	// the part of centipoints that repaid the user's debt. the rest was added to their balance
+	DebtRepaidCentipoints uint64 `protobuf:"varint,6,opt,name=debt_repaid_centipoints,json=debtRepaidCentipoints,proto3" json:"debt_repaid_centipoints,omitempty"`
+}
+
+func (x *Grant) Reset() {
+	*x = Grant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Grant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grant) ProtoMessage() {}
+
+func (x *Grant) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grant.ProtoReflect.Descriptor instead.
+func (*Grant) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Grant) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListBetsRequest) GetUser() string {
+func (x *Grant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Grant) GetUser() string {
 	if x != nil {
 		return x.User
 	}
 	return ""
 }
 
-func (x *ListBetsRequest) GetMarket() string {
+func (x *Grant) GetCentipoints() uint64 {
 	if x != nil {
-		return x.Market
+		return x.Centipoints
 	}
-	return ""
+	return 0
 }
 
-func (x *ListBetsRequest) GetExcludeSettled() bool {
+func (x *Grant) GetReason() Grant_Reason {
 	if x != nil {
-		return x.ExcludeSettled
+		return x.Reason
 	}
-	return false
+	return Grant_REASON_UNSPECIFIED
 }
 
-type ListBetsResponse struct {
+func (x *Grant) GetDebtRepaidCentipoints() uint64 {
+	if x != nil {
+		return x.DebtRepaidCentipoints
+	}
+	return 0
+}
+
+// Points sent from one user to another in the same book.
+type Transfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bets          []*Bet `protobuf:"bytes,1,rep,name=bets,proto3" json:"bets,omitempty"`
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FromUser    string                 `protobuf:"bytes,3,opt,name=from_user,json=fromUser,proto3" json:"from_user,omitempty"`
+	ToUser      string                 `protobuf:"bytes,4,opt,name=to_user,json=toUser,proto3" json:"to_user,omitempty"`
+	Centipoints uint64                 `protobuf:"varint,5,opt,name=centipoints,proto3" json:"centipoints,omitempty"`
+	Memo        string                 `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// the part of centipoints that repaid the recipient's debt. the rest was added to their balance
+	DebtRepaidCentipoints uint64 `protobuf:"varint,7,opt,name=debt_repaid_centipoints,json=debtRepaidCentipoints,proto3" json:"debt_repaid_centipoints,omitempty"`
 }
 
-func (x *ListBetsResponse) Reset() {
-	*x = ListBetsResponse{}
+func (x *Transfer) Reset() {
+	*x = Transfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[30]
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBetsResponse) String() string {
+func (x *Transfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBetsResponse) ProtoMessage() {}
+func (*Transfer) ProtoMessage() {}
 
-func (x *ListBetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[30]
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListBetsResponse.ProtoReflect.Descriptor instead.
-func (*ListBetsResponse) Descriptor() ([]byte, []int) {
-	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *ListBetsResponse) GetBets() []*Bet {
-	if x != nil {
-		return x.Bets
-	}
-	return nil
-}
-
-func (x *ListBetsResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-var File_bettor_v1alpha_bettor_proto protoreflect.FileDescriptor
-
-var file_bettor_v1alpha_bettor_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x2f, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62,
-	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d,
-	0xfa, 0x42, 0x2a, 0x72, 0x28, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32, 0x21, 0x5e, 0x62, 0x6f, 0x6f,
-	0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33, 0x36, 0x7d, 0x2f, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x33, 0x36, 0x7d, 0x24, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02,
-	0x08, 0x01, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1b, 0xfa, 0x42, 0x18, 0x72, 0x16, 0x10, 0x01, 0x18, 0x80, 0x01, 0x32, 0x0f, 0x5e, 0x5b, 0x61,
-	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x65, 0x6e,
-	0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x75, 0x6e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x64, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xc7, 0x04,
-	0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xfa, 0x42, 0x2c, 0x72, 0x2a, 0x10, 0x01, 0x18,
-	0x80, 0x08, 0x32, 0x23, 0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b,
-	0x31, 0x2c, 0x33, 0x36, 0x7d, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x5b, 0x5e,
-	0x2f, 0x5d, 0x7b, 0x33, 0x36, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01,
-	0x20, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x6f,
-	0x6f, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x48, 0x00,
-	0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x72, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x42, 0x45, 0x54, 0x53, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x54,
-	0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x42, 0x0b, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x5f, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12,
-	0x3f, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92,
-	0x01, 0x04, 0x08, 0x02, 0x10, 0x63, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0xa5, 0x01, 0x0a, 0x07, 0x4f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x56, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x42, 0xfa, 0x42, 0x3f, 0x72, 0x3d, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32, 0x36,
-	0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33, 0x36,
-	0x7d, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x33,
-	0x36, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d,
-	0x7b, 0x31, 0x2c, 0x32, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
-	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x22, 0xcf, 0x03, 0x0a, 0x03, 0x42, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xfa, 0x42, 0x29, 0x72, 0x27, 0x10, 0x01, 0x18,
-	0x80, 0x08, 0x32, 0x20, 0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b,
-	0x31, 0x2c, 0x33, 0x36, 0x7d, 0x2f, 0x62, 0x65, 0x74, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b,
-	0x33, 0x36, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2,
-	0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x43, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x06,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x29, 0x0a,
-	0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x63, 0x65, 0x6e,
-	0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x64, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x43, 0x65,
-	0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x07, 0x6f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8,
-	0x42, 0x01, 0x22, 0x51, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x28, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x2d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x22, 0x5c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x45, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x0a,
-	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x52, 0x00, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x65, 0x6e, 0x74,
-	0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
-	0x22, 0x67, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
-	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x59, 0x0a, 0x13, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x2e, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x22, 0x46, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
-	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x2f, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
-	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
-	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x35, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x65,
-	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x6f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
-	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x30, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65,
-	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x59, 0x0a, 0x13, 0x53,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x46, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
-	0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x32,
-	0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x46, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x4d, 0x0a, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
-	0x6f, 0x6b, 0x12, 0x25, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x2e, 0x42, 0x65, 0x74, 0x52, 0x03, 0x62, 0x65, 0x74, 0x22, 0x3a, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65,
-	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x65, 0x74,
-	0x52, 0x03, 0x62, 0x65, 0x74, 0x22, 0x2a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x62, 0x65,
-	0x74, 0x22, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x03, 0x62, 0x65, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f,
-	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x64, 0x22, 0x63, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x65, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x04, 0x62, 0x65,
-	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
-	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x80, 0x09, 0x0a, 0x0d, 0x42,
-	0x65, 0x74, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x6a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62,
-	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x62, 0x65,
-	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x58, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x73, 0x12, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0a,
-	0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x6f, 0x63, 0x6b,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c,
-	0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5b, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
-	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62,
-	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x49, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x62, 0x65,
-	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x08,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xb2, 0x01,
-	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x42, 0x0b, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x65, 0x6c, 0x68, 0x2f, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62,
-	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x3b, 0x62, 0x65,
-	0x74, 0x74, 0x6f, 0x72, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0xa2, 0x02, 0x03, 0x42, 0x58,
-	0x58, 0xaa, 0x02, 0x0e, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0xca, 0x02, 0x0e, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x5c, 0x56, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0xe2, 0x02, 0x1a, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x5c, 0x56, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x0f, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_bettor_v1alpha_bettor_proto_rawDescOnce sync.Once
-	file_bettor_v1alpha_bettor_proto_rawDescData = file_bettor_v1alpha_bettor_proto_rawDesc
-)
-
-func file_bettor_v1alpha_bettor_proto_rawDescGZIP() []byte {
-	file_bettor_v1alpha_bettor_proto_rawDescOnce.Do(func() {
-		file_bettor_v1alpha_bettor_proto_rawDescData = protoimpl.X.CompressGZIP(file_bettor_v1alpha_bettor_proto_rawDescData)
-	})
-	return file_bettor_v1alpha_bettor_proto_rawDescData
-}
-
-var file_bettor_v1alpha_bettor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_bettor_v1alpha_bettor_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
-var file_bettor_v1alpha_bettor_proto_goTypes = []interface{}{
-	(Market_Status)(0),                // 0: bettor.v1alpha.Market.Status
-	(*User)(nil),                      // 1: bettor.v1alpha.User
-	(*Market)(nil),                    // 2: bettor.v1alpha.Market
-	(*Pool)(nil),                      // 3: bettor.v1alpha.Pool
-	(*Outcome)(nil),                   // 4: bettor.v1alpha.Outcome
-	(*Bet)(nil),                       // 5: bettor.v1alpha.Bet
-	(*CreateUserRequest)(nil),         // 6: bettor.v1alpha.CreateUserRequest
-	(*CreateUserResponse)(nil),        // 7: bettor.v1alpha.CreateUserResponse
-	(*GetUserRequest)(nil),            // 8: bettor.v1alpha.GetUserRequest
-	(*GetUserResponse)(nil),           // 9: bettor.v1alpha.GetUserResponse
-	(*GetUserByUsernameRequest)(nil),  // 10: bettor.v1alpha.GetUserByUsernameRequest
-	(*GetUserByUsernameResponse)(nil), // 11: bettor.v1alpha.GetUserByUsernameResponse
-	(*ListUsersRequest)(nil),          // 12: bettor.v1alpha.ListUsersRequest
-	(*ListUsersResponse)(nil),         // 13: bettor.v1alpha.ListUsersResponse
-	(*CreateMarketRequest)(nil),       // 14: bettor.v1alpha.CreateMarketRequest
-	(*CreateMarketResponse)(nil),      // 15: bettor.v1alpha.CreateMarketResponse
-	(*GetMarketRequest)(nil),          // 16: bettor.v1alpha.GetMarketRequest
-	(*GetMarketResponse)(nil),         // 17: bettor.v1alpha.GetMarketResponse
-	(*ListMarketsRequest)(nil),        // 18: bettor.v1alpha.ListMarketsRequest
-	(*ListMarketsResponse)(nil),       // 19: bettor.v1alpha.ListMarketsResponse
-	(*LockMarketRequest)(nil),         // 20: bettor.v1alpha.LockMarketRequest
-	(*LockMarketResponse)(nil),        // 21: bettor.v1alpha.LockMarketResponse
-	(*SettleMarketRequest)(nil),       // 22: bettor.v1alpha.SettleMarketRequest
-	(*SettleMarketResponse)(nil),      // 23: bettor.v1alpha.SettleMarketResponse
-	(*CancelMarketRequest)(nil),       // 24: bettor.v1alpha.CancelMarketRequest
-	(*CancelMarketResponse)(nil),      // 25: bettor.v1alpha.CancelMarketResponse
-	(*CreateBetRequest)(nil),          // 26: bettor.v1alpha.CreateBetRequest
-	(*CreateBetResponse)(nil),         // 27: bettor.v1alpha.CreateBetResponse
-	(*GetBetRequest)(nil),             // 28: bettor.v1alpha.GetBetRequest
-	(*GetBetResponse)(nil),            // 29: bettor.v1alpha.GetBetResponse
-	(*ListBetsRequest)(nil),           // 30: bettor.v1alpha.ListBetsRequest
-	(*ListBetsResponse)(nil),          // 31: bettor.v1alpha.ListBetsResponse
-	(*timestamppb.Timestamp)(nil),     // 32: google.protobuf.Timestamp
-}
-var file_bettor_v1alpha_bettor_proto_depIdxs = []int32{
-	32, // 0: bettor.v1alpha.User.created_at:type_name -> google.protobuf.Timestamp
-	32, // 1: bettor.v1alpha.User.updated_at:type_name -> google.protobuf.Timestamp
-	32, // 2: bettor.v1alpha.Market.created_at:type_name -> google.protobuf.Timestamp
-	32, // 3: bettor.v1alpha.Market.updated_at:type_name -> google.protobuf.Timestamp
-	32, // 4: bettor.v1alpha.Market.settled_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: bettor.v1alpha.Market.status:type_name -> bettor.v1alpha.Market.Status
-	3,  // 6: bettor.v1alpha.Market.pool:type_name -> bettor.v1alpha.Pool
-	4,  // 7: bettor.v1alpha.Pool.outcomes:type_name -> bettor.v1alpha.Outcome
-	32, // 8: bettor.v1alpha.Bet.created_at:type_name -> google.protobuf.Timestamp
-	32, // 9: bettor.v1alpha.Bet.updated_at:type_name -> google.protobuf.Timestamp
-	32, // 10: bettor.v1alpha.Bet.settled_at:type_name -> google.protobuf.Timestamp
-	1,  // 11: bettor.v1alpha.CreateUserRequest.user:type_name -> bettor.v1alpha.User
-	1,  // 12: bettor.v1alpha.CreateUserResponse.user:type_name -> bettor.v1alpha.User
-	1,  // 13: bettor.v1alpha.GetUserResponse.user:type_name -> bettor.v1alpha.User
-	1,  // 14: bettor.v1alpha.GetUserByUsernameResponse.user:type_name -> bettor.v1alpha.User
-	1,  // 15: bettor.v1alpha.ListUsersResponse.users:type_name -> bettor.v1alpha.User
-	2,  // 16: bettor.v1alpha.CreateMarketRequest.market:type_name -> bettor.v1alpha.Market
-	2,  // 17: bettor.v1alpha.CreateMarketResponse.market:type_name -> bettor.v1alpha.Market
-	2,  // 18: bettor.v1alpha.GetMarketResponse.market:type_name -> bettor.v1alpha.Market
-	0,  // 19: bettor.v1alpha.ListMarketsRequest.status:type_name -> bettor.v1alpha.Market.Status
-	2,  // 20: bettor.v1alpha.ListMarketsResponse.markets:type_name -> bettor.v1alpha.Market
-	2,  // 21: bettor.v1alpha.LockMarketResponse.market:type_name -> bettor.v1alpha.Market
-	2,  // 22: bettor.v1alpha.SettleMarketResponse.market:type_name -> bettor.v1alpha.Market
-	2,  // 23: bettor.v1alpha.CancelMarketResponse.market:type_name -> bettor.v1alpha.Market
-	5,  // 24: bettor.v1alpha.CreateBetRequest.bet:type_name -> bettor.v1alpha.Bet
-	5,  // 25: bettor.v1alpha.CreateBetResponse.bet:type_name -> bettor.v1alpha.Bet
-	5,  // 26: bettor.v1alpha.GetBetResponse.bet:type_name -> bettor.v1alpha.Bet
-	5,  // 27: bettor.v1alpha.ListBetsResponse.bets:type_name -> bettor.v1alpha.Bet
-	6,  // 28: bettor.v1alpha.BettorService.CreateUser:input_type -> bettor.v1alpha.CreateUserRequest
-	8,  // 29: bettor.v1alpha.BettorService.GetUser:input_type -> bettor.v1alpha.GetUserRequest
-	10, // 30: bettor.v1alpha.BettorService.GetUserByUsername:input_type -> bettor.v1alpha.GetUserByUsernameRequest
-	12, // 31: bettor.v1alpha.BettorService.ListUsers:input_type -> bettor.v1alpha.ListUsersRequest
-	14, // 32: bettor.v1alpha.BettorService.CreateMarket:input_type -> bettor.v1alpha.CreateMarketRequest
-	16, // 33: bettor.v1alpha.BettorService.GetMarket:input_type -> bettor.v1alpha.GetMarketRequest
-	18, // 34: bettor.v1alpha.BettorService.ListMarkets:input_type -> bettor.v1alpha.ListMarketsRequest
-	20, // 35: bettor.v1alpha.BettorService.LockMarket:input_type -> bettor.v1alpha.LockMarketRequest
-	22, // 36: bettor.v1alpha.BettorService.SettleMarket:input_type -> bettor.v1alpha.SettleMarketRequest
-	24, // 37: bettor.v1alpha.BettorService.CancelMarket:input_type -> bettor.v1alpha.CancelMarketRequest
-	26, // 38: bettor.v1alpha.BettorService.CreateBet:input_type -> bettor.v1alpha.CreateBetRequest
-	28, // 39: bettor.v1alpha.BettorService.GetBet:input_type -> bettor.v1alpha.GetBetRequest
-	30, // 40: bettor.v1alpha.BettorService.ListBets:input_type -> bettor.v1alpha.ListBetsRequest
-	7,  // 41: bettor.v1alpha.BettorService.CreateUser:output_type -> bettor.v1alpha.CreateUserResponse
-	9,  // 42: bettor.v1alpha.BettorService.GetUser:output_type -> bettor.v1alpha.GetUserResponse
-	11, // 43: bettor.v1alpha.BettorService.GetUserByUsername:output_type -> bettor.v1alpha.GetUserByUsernameResponse
-	13, // 44: bettor.v1alpha.BettorService.ListUsers:output_type -> bettor.v1alpha.ListUsersResponse
-	15, // 45: bettor.v1alpha.BettorService.CreateMarket:output_type -> bettor.v1alpha.CreateMarketResponse
-	17, // 46: bettor.v1alpha.BettorService.GetMarket:output_type -> bettor.v1alpha.GetMarketResponse
-	19, // 47: bettor.v1alpha.BettorService.ListMarkets:output_type -> bettor.v1alpha.ListMarketsResponse
-	21, // 48: bettor.v1alpha.BettorService.LockMarket:output_type -> bettor.v1alpha.LockMarketResponse
-	23, // 49: bettor.v1alpha.BettorService.SettleMarket:output_type -> bettor.v1alpha.SettleMarketResponse
-	25, // 50: bettor.v1alpha.BettorService.CancelMarket:output_type -> bettor.v1alpha.CancelMarketResponse
-	27, // 51: bettor.v1alpha.BettorService.CreateBet:output_type -> bettor.v1alpha.CreateBetResponse
-	29, // 52: bettor.v1alpha.BettorService.GetBet:output_type -> bettor.v1alpha.GetBetResponse
-	31, // 53: bettor.v1alpha.BettorService.ListBets:output_type -> bettor.v1alpha.ListBetsResponse
-	41, // [41:54] is the sub-list for method output_type
-	28, // [28:41] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
-}
-
-func init() { file_bettor_v1alpha_bettor_proto_init() }
-func file_bettor_v1alpha_bettor_proto_init() {
-	if File_bettor_v1alpha_bettor_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_bettor_v1alpha_bettor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Transfer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Transfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transfer) GetFromUser() string {
+	if x != nil {
+		return x.FromUser
+	}
+	return ""
+}
+
+func (x *Transfer) GetToUser() string {
+	if x != nil {
+		return x.ToUser
+	}
+	return ""
+}
+
+func (x *Transfer) GetCentipoints() uint64 {
+	if x != nil {
+		return x.Centipoints
+	}
+	return 0
+}
+
+func (x *Transfer) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Transfer) GetDebtRepaidCentipoints() uint64 {
+	if x != nil {
+		return x.DebtRepaidCentipoints
+	}
+	return 0
+}
+
+// A manual correction to a user's balance made by a book admin.
+type Adjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	User      string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// the admin who made the adjustment
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// positive credits the user. negative debits them
+	DeltaCentipoints int64  `protobuf:"varint,5,opt,name=delta_centipoints,json=deltaCentipoints,proto3" json:"delta_centipoints,omitempty"`
+	Reason           string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the change to the user's balance. less than delta_centipoints if part of a credit repaid the user's debt. set by
+	// the server
+	BalanceDeltaCentipoints int64 `protobuf:"varint,7,opt,name=balance_delta_centipoints,json=balanceDeltaCentipoints,proto3" json:"balance_delta_centipoints,omitempty"`
+	// the part of a credit that repaid the user's debt. set by the server
+	DebtRepaidCentipoints uint64 `protobuf:"varint,8,opt,name=debt_repaid_centipoints,json=debtRepaidCentipoints,proto3" json:"debt_repaid_centipoints,omitempty"`
+}
+
+func (x *Adjustment) Reset() {
+	*x = Adjustment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Adjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adjustment) ProtoMessage() {}
+
+func (x *Adjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adjustment.ProtoReflect.Descriptor instead.
+func (*Adjustment) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Adjustment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Adjustment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Adjustment) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Adjustment) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Adjustment) GetDeltaCentipoints() int64 {
+	if x != nil {
+		return x.DeltaCentipoints
+	}
+	return 0
+}
+
+func (x *Adjustment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Adjustment) GetBalanceDeltaCentipoints() int64 {
+	if x != nil {
+		return x.BalanceDeltaCentipoints
+	}
+	return 0
+}
+
+func (x *Adjustment) GetDebtRepaidCentipoints() uint64 {
+	if x != nil {
+		return x.DebtRepaidCentipoints
+	}
+	return 0
+}
+
+// A season of a book. Books start in season 1. Starting a new season archives the final standings of the current one
+// and resets every user's balance.
+type Season struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Number    uint32                 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // unset for a book's first season
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // unset for the current season
+	// the balance users were reset to when the season started. unset for a book's first season
+	StartingCentipoints uint64 `protobuf:"varint,5,opt,name=starting_centipoints,json=startingCentipoints,proto3" json:"starting_centipoints,omitempty"`
+	// how open bets were handled when the season started. unset for a book's first season
+	OpenBetPolicy Season_OpenBetPolicy `protobuf:"varint,6,opt,name=open_bet_policy,json=openBetPolicy,proto3,enum=bettor.v1alpha.Season_OpenBetPolicy" json:"open_bet_policy,omitempty"`
+	// final standings of users by total points, including points in unsettled bets. set when the season ends
+	Standings []*Standing `protobuf:"bytes,7,rep,name=standings,proto3" json:"standings,omitempty"`
+	// the changes to users' balances when they were reset at the start of the season
+	Resets []*SeasonReset `protobuf:"bytes,8,rep,name=resets,proto3" json:"resets,omitempty"`
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Season) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Season) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Season) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Season) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Season) GetStartingCentipoints() uint64 {
+	if x != nil {
+		return x.StartingCentipoints
+	}
+	return 0
+}
+
+func (x *Season) GetOpenBetPolicy() Season_OpenBetPolicy {
+	if x != nil {
+		return x.OpenBetPolicy
+	}
+	return Season_OPEN_BET_POLICY_UNSPECIFIED
+}
+
+func (x *Season) GetStandings() []*Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *Season) GetResets() []*SeasonReset {
+	if x != nil {
+		return x.Resets
+	}
+	return nil
+}
+
+// A user's final placement in a season.
+type Standing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User             string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Username         string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TotalCentipoints uint64 `protobuf:"varint,3,opt,name=total_centipoints,json=totalCentipoints,proto3" json:"total_centipoints,omitempty"`
+	Rank             uint32 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"` // 1-based. users with equal totals share a rank
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Standing) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Standing) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Standing) GetTotalCentipoints() uint64 {
+	if x != nil {
+		return x.TotalCentipoints
+	}
+	return 0
+}
+
+func (x *Standing) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// A change to a user's balance when they were reset at the start of a season.
+type SeasonReset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User             string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	DeltaCentipoints int64  `protobuf:"varint,2,opt,name=delta_centipoints,json=deltaCentipoints,proto3" json:"delta_centipoints,omitempty"`
+}
+
+func (x *SeasonReset) Reset() {
+	*x = SeasonReset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeasonReset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonReset) ProtoMessage() {}
+
+func (x *SeasonReset) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonReset.ProtoReflect.Descriptor instead.
+func (*SeasonReset) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SeasonReset) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SeasonReset) GetDeltaCentipoints() int64 {
+	if x != nil {
+		return x.DeltaCentipoints
+	}
+	return 0
+}
+
+// A user's total points, including points in unsettled bets, at a point in time.
+type BalancePoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// may be negative at points before changes that were not from bets
+	TotalCentipoints int64 `protobuf:"varint,2,opt,name=total_centipoints,json=totalCentipoints,proto3" json:"total_centipoints,omitempty"`
+}
+
+func (x *BalancePoint) Reset() {
+	*x = BalancePoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalancePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalancePoint) ProtoMessage() {}
+
+func (x *BalancePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalancePoint.ProtoReflect.Descriptor instead.
+func (*BalancePoint) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BalancePoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *BalancePoint) GetTotalCentipoints() int64 {
+	if x != nil {
+		return x.TotalCentipoints
+	}
+	return 0
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book string `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	User *User  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateUserRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserByUsernameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book     string `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *GetUserByUsernameRequest) Reset() {
+	*x = GetUserByUsernameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserByUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByUsernameRequest) ProtoMessage() {}
+
+func (x *GetUserByUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByUsernameRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetUserByUsernameRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *GetUserByUsernameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetUserByUsernameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetUserByUsernameResponse) Reset() {
+	*x = GetUserByUsernameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserByUsernameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByUsernameResponse) ProtoMessage() {}
+
+func (x *GetUserByUsernameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByUsernameResponse.ProtoReflect.Descriptor instead.
+func (*GetUserByUsernameResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetUserByUsernameResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32    `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Book      string   `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	Users     []string `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	// valid options: "name" asc (default), "total_centipoints" desc then name asc
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users         []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book   string  `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	Market *Market `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *CreateMarketRequest) Reset() {
+	*x = CreateMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMarketRequest) ProtoMessage() {}
+
+func (x *CreateMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMarketRequest.ProtoReflect.Descriptor instead.
+func (*CreateMarketRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateMarketRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *CreateMarketRequest) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type CreateMarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *CreateMarketResponse) Reset() {
+	*x = CreateMarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMarketResponse) ProtoMessage() {}
+
+func (x *CreateMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMarketResponse.ProtoReflect.Descriptor instead.
+func (*CreateMarketResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateMarketResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type GetMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetMarketRequest) Reset() {
+	*x = GetMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketRequest) ProtoMessage() {}
+
+func (x *GetMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetMarketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetMarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *GetMarketResponse) Reset() {
+	*x = GetMarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketResponse) ProtoMessage() {}
+
+func (x *GetMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetMarketResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type ListMarketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32         `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string        `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Book      string        `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	Status    Market_Status `protobuf:"varint,4,opt,name=status,proto3,enum=bettor.v1alpha.Market_Status" json:"status,omitempty"`
+	// AIP-160 filter over Market fields, e.g. `status = OPEN AND create_time > "2026-01-01"`. timestamp fields may be
+	// named as in order_by (create_time) or by their field names (created_at)
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// valid options: "name" asc (default), "create_time desc", "total_centipoints desc", "settled_time desc". ties are
+	// ordered by name and unsettled markets are last in "settled_time desc"
+	OrderBy string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+}
+
+func (x *ListMarketsRequest) Reset() {
+	*x = ListMarketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketsRequest) ProtoMessage() {}
+
+func (x *ListMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketsRequest.ProtoReflect.Descriptor instead.
+func (*ListMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListMarketsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMarketsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMarketsRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *ListMarketsRequest) GetStatus() Market_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Market_STATUS_UNSPECIFIED
+}
+
+func (x *ListMarketsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListMarketsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListMarketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Markets       []*Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListMarketsResponse) Reset() {
+	*x = ListMarketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMarketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketsResponse) ProtoMessage() {}
+
+func (x *ListMarketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketsResponse.ProtoReflect.Descriptor instead.
+func (*ListMarketsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListMarketsResponse) GetMarkets() []*Market {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *ListMarketsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type LockMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// if set, the request fails with ABORTED if the market has been modified since this etag was read
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *LockMarketRequest) Reset() {
+	*x = LockMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockMarketRequest) ProtoMessage() {}
+
+func (x *LockMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockMarketRequest.ProtoReflect.Descriptor instead.
+func (*LockMarketRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LockMarketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LockMarketRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type LockMarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *LockMarketResponse) Reset() {
+	*x = LockMarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockMarketResponse) ProtoMessage() {}
+
+func (x *LockMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockMarketResponse.ProtoReflect.Descriptor instead.
+func (*LockMarketResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LockMarketResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type SettleMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Type:
+	//
+	//	*SettleMarketRequest_Winner
+	Type isSettleMarketRequest_Type `protobuf_oneof:"type"`
+	// if set, the request fails with ABORTED if the market has been modified since this etag was read
+	Etag string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *SettleMarketRequest) Reset() {
+	*x = SettleMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettleMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleMarketRequest) ProtoMessage() {}
+
+func (x *SettleMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleMarketRequest.ProtoReflect.Descriptor instead.
+func (*SettleMarketRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SettleMarketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *SettleMarketRequest) GetType() isSettleMarketRequest_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *SettleMarketRequest) GetWinner() string {
+	if x, ok := x.GetType().(*SettleMarketRequest_Winner); ok {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *SettleMarketRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type isSettleMarketRequest_Type interface {
+	isSettleMarketRequest_Type()
+}
+
+type SettleMarketRequest_Winner struct {
+	Winner string `protobuf:"bytes,2,opt,name=winner,proto3,oneof"`
+}
+
+func (*SettleMarketRequest_Winner) isSettleMarketRequest_Type() {}
+
+type SettleMarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *SettleMarketResponse) Reset() {
+	*x = SettleMarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettleMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleMarketResponse) ProtoMessage() {}
+
+func (x *SettleMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleMarketResponse.ProtoReflect.Descriptor instead.
+func (*SettleMarketResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SettleMarketResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type ResettleMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Winner string `protobuf:"bytes,2,opt,name=winner,proto3" json:"winner,omitempty"`
+	// the creator or book admin correcting the settlement. must be a member of the market's book
+	Actor  string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// if set, the request fails with ABORTED if the market has been modified since this etag was read
+	Etag string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *ResettleMarketRequest) Reset() {
+	*x = ResettleMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResettleMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResettleMarketRequest) ProtoMessage() {}
+
+func (x *ResettleMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResettleMarketRequest.ProtoReflect.Descriptor instead.
+func (*ResettleMarketRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ResettleMarketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResettleMarketRequest) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *ResettleMarketRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ResettleMarketRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ResettleMarketRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ResettleMarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *ResettleMarketResponse) Reset() {
+	*x = ResettleMarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResettleMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResettleMarketResponse) ProtoMessage() {}
+
+func (x *ResettleMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResettleMarketResponse.ProtoReflect.Descriptor instead.
+func (*ResettleMarketResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ResettleMarketResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type CancelMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// if set, the request fails with ABORTED if the market has been modified since this etag was read
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *CancelMarketRequest) Reset() {
+	*x = CancelMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMarketRequest) ProtoMessage() {}
+
+func (x *CancelMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMarketRequest.ProtoReflect.Descriptor instead.
+func (*CancelMarketRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CancelMarketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CancelMarketRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CancelMarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *CancelMarketResponse) Reset() {
+	*x = CancelMarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMarketResponse) ProtoMessage() {}
+
+func (x *CancelMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMarketResponse.ProtoReflect.Descriptor instead.
+func (*CancelMarketResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CancelMarketResponse) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type CreateBetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book string `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	Bet  *Bet   `protobuf:"bytes,2,opt,name=bet,proto3" json:"bet,omitempty"`
+}
+
+func (x *CreateBetRequest) Reset() {
+	*x = CreateBetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBetRequest) ProtoMessage() {}
+
+func (x *CreateBetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBetRequest.ProtoReflect.Descriptor instead.
+func (*CreateBetRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateBetRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *CreateBetRequest) GetBet() *Bet {
+	if x != nil {
+		return x.Bet
+	}
+	return nil
+}
+
+type CreateBetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bet *Bet `protobuf:"bytes,1,opt,name=bet,proto3" json:"bet,omitempty"`
+}
+
+func (x *CreateBetResponse) Reset() {
+	*x = CreateBetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBetResponse) ProtoMessage() {}
+
+func (x *CreateBetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBetResponse.ProtoReflect.Descriptor instead.
+func (*CreateBetResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateBetResponse) GetBet() *Bet {
+	if x != nil {
+		return x.Bet
+	}
+	return nil
+}
+
+type GetBetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bet string `protobuf:"bytes,1,opt,name=bet,proto3" json:"bet,omitempty"`
+}
+
+func (x *GetBetRequest) Reset() {
+	*x = GetBetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBetRequest) ProtoMessage() {}
+
+func (x *GetBetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBetRequest.ProtoReflect.Descriptor instead.
+func (*GetBetRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetBetRequest) GetBet() string {
+	if x != nil {
+		return x.Bet
+	}
+	return ""
+}
+
+type GetBetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bet *Bet `protobuf:"bytes,1,opt,name=bet,proto3" json:"bet,omitempty"`
+}
+
+func (x *GetBetResponse) Reset() {
+	*x = GetBetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBetResponse) ProtoMessage() {}
+
+func (x *GetBetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBetResponse.ProtoReflect.Descriptor instead.
+func (*GetBetResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetBetResponse) GetBet() *Bet {
+	if x != nil {
+		return x.Bet
+	}
+	return nil
+}
+
+type ListBetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize       int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Book           string `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	User           string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Market         string `protobuf:"bytes,5,opt,name=market,proto3" json:"market,omitempty"`
+	ExcludeSettled bool   `protobuf:"varint,6,opt,name=exclude_settled,json=excludeSettled,proto3" json:"exclude_settled,omitempty"`
+	// AIP-160 filter over Bet fields, e.g. `centipoints >= 1000 AND NOT settled_time:*`. timestamp fields may be named
+	// as in order_by (settled_time) or by their field names (settled_at)
+	Filter string `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	// valid options: "name" asc (default), "create_time desc", "total_centipoints desc", "settled_time desc". ties are
+	// ordered by name and unsettled bets are last in "settled_time desc"
+	OrderBy string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+}
+
+func (x *ListBetsRequest) Reset() {
+	*x = ListBetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBetsRequest) ProtoMessage() {}
+
+func (x *ListBetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBetsRequest.ProtoReflect.Descriptor instead.
+func (*ListBetsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListBetsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBetsRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *ListBetsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ListBetsRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *ListBetsRequest) GetExcludeSettled() bool {
+	if x != nil {
+		return x.ExcludeSettled
+	}
+	return false
+}
+
+func (x *ListBetsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListBetsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListBetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bets          []*Bet `protobuf:"bytes,1,rep,name=bets,proto3" json:"bets,omitempty"`
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListBetsResponse) Reset() {
+	*x = ListBetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBetsResponse) ProtoMessage() {}
+
+func (x *ListBetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBetsResponse.ProtoReflect.Descriptor instead.
+func (*ListBetsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListBetsResponse) GetBets() []*Bet {
+	if x != nil {
+		return x.Bets
+	}
+	return nil
+}
+
+func (x *ListBetsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type WatchMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *WatchMarketRequest) Reset() {
+	*x = WatchMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMarketRequest) ProtoMessage() {}
+
+func (x *WatchMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMarketRequest.ProtoReflect.Descriptor instead.
+func (*WatchMarketRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *WatchMarketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WatchMarketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *WatchMarketResponse) Reset() {
+	*x = WatchMarketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMarketResponse) ProtoMessage() {}
+
+func (x *WatchMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMarketResponse.ProtoReflect.Descriptor instead.
+func (*WatchMarketResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *WatchMarketResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type WatchBookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book string `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+}
+
+func (x *WatchBookRequest) Reset() {
+	*x = WatchBookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBookRequest) ProtoMessage() {}
+
+func (x *WatchBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBookRequest.ProtoReflect.Descriptor instead.
+func (*WatchBookRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *WatchBookRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+type WatchBookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *WatchBookResponse) Reset() {
+	*x = WatchBookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBookResponse) ProtoMessage() {}
+
+func (x *WatchBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBookResponse.ProtoReflect.Descriptor instead.
+func (*WatchBookResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *WatchBookResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book    string   `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	Webhook *Webhook `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateWebhookRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Book      string `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListWebhooksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhooksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks      []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{75}
+}
+
+type BatchGetUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results are returned in the order of names
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *BatchGetUsersRequest) Reset() {
+	*x = BatchGetUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsersRequest) ProtoMessage() {}
+
+func (x *BatchGetUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *BatchGetUsersRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type BatchGetUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// users that were found, in the order of the request's names
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// names of users that were not found, in the order of the request's names
+	NotFound []string `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+}
+
+func (x *BatchGetUsersResponse) Reset() {
+	*x = BatchGetUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsersResponse) ProtoMessage() {}
+
+func (x *BatchGetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *BatchGetUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BatchGetUsersResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+type BatchGetMarketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results are returned in the order of names
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *BatchGetMarketsRequest) Reset() {
+	*x = BatchGetMarketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetMarketsRequest) ProtoMessage() {}
+
+func (x *BatchGetMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetMarketsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *BatchGetMarketsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type BatchGetMarketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// markets that were found, in the order of the request's names
+	Markets []*Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	// names of markets that were not found, in the order of the request's names
+	NotFound []string `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+}
+
+func (x *BatchGetMarketsResponse) Reset() {
+	*x = BatchGetMarketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetMarketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetMarketsResponse) ProtoMessage() {}
+
+func (x *BatchGetMarketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetMarketsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetMarketsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BatchGetMarketsResponse) GetMarkets() []*Market {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *BatchGetMarketsResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+type BatchGetBetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results are returned in the order of names
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *BatchGetBetsRequest) Reset() {
+	*x = BatchGetBetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetBetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetBetsRequest) ProtoMessage() {}
+
+func (x *BatchGetBetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetBetsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetBetsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *BatchGetBetsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type BatchGetBetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bets that were found, in the order of the request's names
+	Bets []*Bet `protobuf:"bytes,1,rep,name=bets,proto3" json:"bets,omitempty"`
+	// names of bets that were not found, in the order of the request's names
+	NotFound []string `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+}
+
+func (x *BatchGetBetsResponse) Reset() {
+	*x = BatchGetBetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetBetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetBetsResponse) ProtoMessage() {}
+
+func (x *BatchGetBetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetBetsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetBetsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BatchGetBetsResponse) GetBets() []*Bet {
+	if x != nil {
+		return x.Bets
+	}
+	return nil
+}
+
+func (x *BatchGetBetsResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+type GetMarketStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetMarketStatsRequest) Reset() {
+	*x = GetMarketStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketStatsRequest) ProtoMessage() {}
+
+func (x *GetMarketStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMarketStatsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetMarketStatsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetMarketStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats *MarketStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetMarketStatsResponse) Reset() {
+	*x = GetMarketStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMarketStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarketStatsResponse) ProtoMessage() {}
+
+func (x *GetMarketStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarketStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMarketStatsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetMarketStatsResponse) GetStats() *MarketStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetUserStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// if set, only bets settled at or after start_time are counted
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// if set, only bets settled before end_time are counted
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *GetUserStatsRequest) Reset() {
+	*x = GetUserStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsRequest) ProtoMessage() {}
+
+func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetUserStatsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *GetUserStatsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetUserStatsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type GetUserStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats *UserStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetUserStatsResponse) Reset() {
+	*x = GetUserStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsResponse) ProtoMessage() {}
+
+func (x *GetUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetUserStatsResponse) GetStats() *UserStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetUserForecastScoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetUserForecastScoreRequest) Reset() {
+	*x = GetUserForecastScoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserForecastScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserForecastScoreRequest) ProtoMessage() {}
+
+func (x *GetUserForecastScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserForecastScoreRequest.ProtoReflect.Descriptor instead.
+func (*GetUserForecastScoreRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetUserForecastScoreRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type GetUserForecastScoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score *ForecastScore `protobuf:"bytes,1,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *GetUserForecastScoreResponse) Reset() {
+	*x = GetUserForecastScoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserForecastScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserForecastScoreResponse) ProtoMessage() {}
+
+func (x *GetUserForecastScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserForecastScoreResponse.ProtoReflect.Descriptor instead.
+func (*GetUserForecastScoreResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetUserForecastScoreResponse) GetScore() *ForecastScore {
+	if x != nil {
+		return x.Score
+	}
+	return nil
+}
+
+type GetForecastLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// defaults to 10
+	PageSize int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Book     string `protobuf:"bytes,2,opt,name=book,proto3" json:"book,omitempty"`
+}
+
+func (x *GetForecastLeaderboardRequest) Reset() {
+	*x = GetForecastLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetForecastLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetForecastLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetForecastLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetForecastLeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetForecastLeaderboardRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+type GetForecastLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// users with the best brier scores first
+	Scores []*ForecastScore `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *GetForecastLeaderboardResponse) Reset() {
+	*x = GetForecastLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetForecastLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetForecastLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetForecastLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetForecastLeaderboardResponse) GetScores() []*ForecastScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type ListMarketOddsHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Market    string `protobuf:"bytes,3,opt,name=market,proto3" json:"market,omitempty"`
+	// if set, only snapshots after this market revision are listed
+	AfterRevision uint64 `protobuf:"varint,4,opt,name=after_revision,json=afterRevision,proto3" json:"after_revision,omitempty"`
+}
+
+func (x *ListMarketOddsHistoryRequest) Reset() {
+	*x = ListMarketOddsHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMarketOddsHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketOddsHistoryRequest) ProtoMessage() {}
+
+func (x *ListMarketOddsHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketOddsHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListMarketOddsHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListMarketOddsHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMarketOddsHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMarketOddsHistoryRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *ListMarketOddsHistoryRequest) GetAfterRevision() uint64 {
+	if x != nil {
+		return x.AfterRevision
+	}
+	return 0
+}
+
+type ListMarketOddsHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// oldest first
+	Snapshots     []*OddsSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListMarketOddsHistoryResponse) Reset() {
+	*x = ListMarketOddsHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMarketOddsHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketOddsHistoryResponse) ProtoMessage() {}
+
+func (x *ListMarketOddsHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketOddsHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListMarketOddsHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListMarketOddsHistoryResponse) GetSnapshots() []*OddsSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *ListMarketOddsHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetUserBalanceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetUserBalanceHistoryRequest) Reset() {
+	*x = GetUserBalanceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserBalanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceHistoryRequest) ProtoMessage() {}
+
+func (x *GetUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetUserBalanceHistoryRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type GetUserBalanceHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// oldest first. the first point is the user's creation and the last is now
+	Points []*BalancePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *GetUserBalanceHistoryResponse) Reset() {
+	*x = GetUserBalanceHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserBalanceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBalanceHistoryResponse) ProtoMessage() {}
+
+func (x *GetUserBalanceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBalanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetUserBalanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetUserBalanceHistoryResponse) GetPoints() []*BalancePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type StartSeasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book string `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	// the balance every user is reset to
+	StartingCentipoints uint64               `protobuf:"varint,2,opt,name=starting_centipoints,json=startingCentipoints,proto3" json:"starting_centipoints,omitempty"`
+	OpenBetPolicy       Season_OpenBetPolicy `protobuf:"varint,3,opt,name=open_bet_policy,json=openBetPolicy,proto3,enum=bettor.v1alpha.Season_OpenBetPolicy" json:"open_bet_policy,omitempty"`
+	// the admin starting the season. must be a member of the book
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// if set, the number of the season to start. a retry of a request that started it returns it instead of starting
+	// another season. fails with FAILED_PRECONDITION if it does not follow the current season
+	Number uint32 `protobuf:"varint,5,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *StartSeasonRequest) Reset() {
+	*x = StartSeasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSeasonRequest) ProtoMessage() {}
+
+func (x *StartSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSeasonRequest.ProtoReflect.Descriptor instead.
+func (*StartSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *StartSeasonRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *StartSeasonRequest) GetStartingCentipoints() uint64 {
+	if x != nil {
+		return x.StartingCentipoints
+	}
+	return 0
+}
+
+func (x *StartSeasonRequest) GetOpenBetPolicy() Season_OpenBetPolicy {
+	if x != nil {
+		return x.OpenBetPolicy
+	}
+	return Season_OPEN_BET_POLICY_UNSPECIFIED
+}
+
+func (x *StartSeasonRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StartSeasonRequest) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type StartSeasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndedSeason *Season `protobuf:"bytes,1,opt,name=ended_season,json=endedSeason,proto3" json:"ended_season,omitempty"`
+	Season      *Season `protobuf:"bytes,2,opt,name=season,proto3" json:"season,omitempty"`
+}
+
+func (x *StartSeasonResponse) Reset() {
+	*x = StartSeasonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSeasonResponse) ProtoMessage() {}
+
+func (x *StartSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSeasonResponse.ProtoReflect.Descriptor instead.
+func (*StartSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *StartSeasonResponse) GetEndedSeason() *Season {
+	if x != nil {
+		return x.EndedSeason
+	}
+	return nil
+}
+
+func (x *StartSeasonResponse) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+type GetSeasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetSeasonRequest) Reset() {
+	*x = GetSeasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonRequest) ProtoMessage() {}
+
+func (x *GetSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetSeasonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetSeasonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Season *Season `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+}
+
+func (x *GetSeasonResponse) Reset() {
+	*x = GetSeasonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonResponse) ProtoMessage() {}
+
+func (x *GetSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonResponse.ProtoReflect.Descriptor instead.
+func (*GetSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetSeasonResponse) GetSeason() *Season {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+type ListSeasonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Book      string `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+}
+
+func (x *ListSeasonsRequest) Reset() {
+	*x = ListSeasonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSeasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsRequest) ProtoMessage() {}
+
+func (x *ListSeasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListSeasonsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListSeasonsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSeasonsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSeasonsRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+type ListSeasonsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ordered by number. the last season is the current one
+	Seasons       []*Season `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListSeasonsResponse) Reset() {
+	*x = ListSeasonsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSeasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsResponse) ProtoMessage() {}
+
+func (x *ListSeasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListSeasonsResponse) GetSeasons() []*Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+func (x *ListSeasonsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type TransferPointsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromUser    string `protobuf:"bytes,1,opt,name=from_user,json=fromUser,proto3" json:"from_user,omitempty"`
+	ToUser      string `protobuf:"bytes,2,opt,name=to_user,json=toUser,proto3" json:"to_user,omitempty"`
+	Centipoints uint64 `protobuf:"varint,3,opt,name=centipoints,proto3" json:"centipoints,omitempty"`
+	Memo        string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *TransferPointsRequest) Reset() {
+	*x = TransferPointsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPointsRequest) ProtoMessage() {}
+
+func (x *TransferPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPointsRequest.ProtoReflect.Descriptor instead.
+func (*TransferPointsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *TransferPointsRequest) GetFromUser() string {
+	if x != nil {
+		return x.FromUser
+	}
+	return ""
+}
+
+func (x *TransferPointsRequest) GetToUser() string {
+	if x != nil {
+		return x.ToUser
+	}
+	return ""
+}
+
+func (x *TransferPointsRequest) GetCentipoints() uint64 {
+	if x != nil {
+		return x.Centipoints
+	}
+	return 0
+}
+
+func (x *TransferPointsRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type TransferPointsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfer *Transfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	FromUser *User     `protobuf:"bytes,2,opt,name=from_user,json=fromUser,proto3" json:"from_user,omitempty"`
+	ToUser   *User     `protobuf:"bytes,3,opt,name=to_user,json=toUser,proto3" json:"to_user,omitempty"`
+}
+
+func (x *TransferPointsResponse) Reset() {
+	*x = TransferPointsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPointsResponse) ProtoMessage() {}
+
+func (x *TransferPointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPointsResponse.ProtoReflect.Descriptor instead.
+func (*TransferPointsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *TransferPointsResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *TransferPointsResponse) GetFromUser() *User {
+	if x != nil {
+		return x.FromUser
+	}
+	return nil
+}
+
+func (x *TransferPointsResponse) GetToUser() *User {
+	if x != nil {
+		return x.ToUser
+	}
+	return nil
+}
+
+type ListTransfersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Book      string `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	// if set, only transfers from or to the user are listed
+	User string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ListTransfersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers     []*Transfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ListTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *ListTransfersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AdjustUserBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// the admin making the adjustment. must be a member of the user's book
+	Actor            string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	DeltaCentipoints int64  `protobuf:"varint,3,opt,name=delta_centipoints,json=deltaCentipoints,proto3" json:"delta_centipoints,omitempty"`
+	Reason           string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdjustUserBalanceRequest) Reset() {
+	*x = AdjustUserBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjustUserBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustUserBalanceRequest) ProtoMessage() {}
+
+func (x *AdjustUserBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustUserBalanceRequest.ProtoReflect.Descriptor instead.
+func (*AdjustUserBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AdjustUserBalanceRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AdjustUserBalanceRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AdjustUserBalanceRequest) GetDeltaCentipoints() int64 {
+	if x != nil {
+		return x.DeltaCentipoints
+	}
+	return 0
+}
+
+func (x *AdjustUserBalanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdjustUserBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adjustment *Adjustment `protobuf:"bytes,1,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+	User       *User       `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *AdjustUserBalanceResponse) Reset() {
+	*x = AdjustUserBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjustUserBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustUserBalanceResponse) ProtoMessage() {}
+
+func (x *AdjustUserBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustUserBalanceResponse.ProtoReflect.Descriptor instead.
+func (*AdjustUserBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AdjustUserBalanceResponse) GetAdjustment() *Adjustment {
+	if x != nil {
+		return x.Adjustment
+	}
+	return nil
+}
+
+func (x *AdjustUserBalanceResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ListAdjustmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Book      string `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	// if set, only adjustments to the user are listed
+	User string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ListAdjustmentsRequest) Reset() {
+	*x = ListAdjustmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAdjustmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdjustmentsRequest) ProtoMessage() {}
+
+func (x *ListAdjustmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdjustmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListAdjustmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAdjustmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAdjustmentsRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *ListAdjustmentsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ListAdjustmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adjustments   []*Adjustment `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListAdjustmentsResponse) Reset() {
+	*x = ListAdjustmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAdjustmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdjustmentsResponse) ProtoMessage() {}
+
+func (x *ListAdjustmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdjustmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListAdjustmentsResponse) GetAdjustments() []*Adjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *ListAdjustmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetBookSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book string `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+}
+
+func (x *GetBookSettingsRequest) Reset() {
+	*x = GetBookSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookSettingsRequest) ProtoMessage() {}
+
+func (x *GetBookSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetBookSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetBookSettingsRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+type GetBookSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *BookSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GetBookSettingsResponse) Reset() {
+	*x = GetBookSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBookSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookSettingsResponse) ProtoMessage() {}
+
+func (x *GetBookSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetBookSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetBookSettingsResponse) GetSettings() *BookSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateBookSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *BookSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	// the admin updating the settings. must be a member of the book
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *UpdateBookSettingsRequest) Reset() {
+	*x = UpdateBookSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBookSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateBookSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UpdateBookSettingsRequest) GetSettings() *BookSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateBookSettingsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type UpdateBookSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *BookSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateBookSettingsResponse) Reset() {
+	*x = UpdateBookSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBookSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateBookSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBookSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdateBookSettingsResponse) GetSettings() *BookSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ClaimAllowanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ClaimAllowanceRequest) Reset() {
+	*x = ClaimAllowanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimAllowanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAllowanceRequest) ProtoMessage() {}
+
+func (x *ClaimAllowanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAllowanceRequest.ProtoReflect.Descriptor instead.
+func (*ClaimAllowanceRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ClaimAllowanceRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ClaimAllowanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Grant         *Grant                 `protobuf:"bytes,2,opt,name=grant,proto3" json:"grant,omitempty"`
+	NextClaimTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_claim_time,json=nextClaimTime,proto3" json:"next_claim_time,omitempty"`
+}
+
+func (x *ClaimAllowanceResponse) Reset() {
+	*x = ClaimAllowanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimAllowanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimAllowanceResponse) ProtoMessage() {}
+
+func (x *ClaimAllowanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimAllowanceResponse.ProtoReflect.Descriptor instead.
+func (*ClaimAllowanceResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ClaimAllowanceResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ClaimAllowanceResponse) GetGrant() *Grant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+func (x *ClaimAllowanceResponse) GetNextClaimTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextClaimTime
+	}
+	return nil
+}
+
+type ListGrantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Book      string `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	// if set, only grants to the user are listed
+	User string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ListGrantsRequest) Reset() {
+	*x = ListGrantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGrantsRequest) ProtoMessage() {}
+
+func (x *ListGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListGrantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGrantsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListGrantsRequest) GetBook() string {
+	if x != nil {
+		return x.Book
+	}
+	return ""
+}
+
+func (x *ListGrantsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ListGrantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grants        []*Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	NextPageToken string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListGrantsResponse) Reset() {
+	*x = ListGrantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bettor_v1alpha_bettor_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGrantsResponse) ProtoMessage() {}
+
+func (x *ListGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bettor_v1alpha_bettor_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGrantsResponse.ProtoReflect.Descriptor instead.
+func (*ListGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_bettor_v1alpha_bettor_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListGrantsResponse) GetGrants() []*Grant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+func (x *ListGrantsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_bettor_v1alpha_bettor_proto protoreflect.FileDescriptor
+
+var file_bettor_v1alpha_bettor_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2f, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x03, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d,
+	0xfa, 0x42, 0x2a, 0x72, 0x28, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32, 0x21, 0x5e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33, 0x36, 0x7d, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x33, 0x36, 0x7d, 0x24, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1b, 0xfa, 0x42, 0x18, 0x72, 0x16, 0x10, 0x01, 0x18, 0x80, 0x01, 0x32, 0x0f, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x75, 0x6e, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x64, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61,
+	0x67, 0x12, 0x4c, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x62, 0x74, 0x43,
+	0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xb1, 0x05, 0x0a, 0x06, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2f, 0xfa, 0x42, 0x2c, 0x72, 0x2a, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32,
+	0x23, 0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33,
+	0x36, 0x7d, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b,
+	0x33, 0x36, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2,
+	0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x43, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x20, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x21, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x3c, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x72, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x42, 0x45, 0x54, 0x53, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x42, 0x0b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x5f,
+	0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x3f, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08, 0x02, 0x10, 0x63, 0x52, 0x08, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22,
+	0xb9, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x2a, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x44, 0x65, 0x62, 0x74, 0x52, 0x05, 0x64, 0x65, 0x62, 0x74, 0x73, 0x22, 0x3c, 0x0a, 0x04, 0x44,
+	0x65, 0x62, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x07, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x56, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x42, 0xfa, 0x42, 0x3f, 0x72, 0x3d, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32,
+	0x36, 0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33,
+	0x36, 0x7d, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b,
+	0x33, 0x36, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x2f, 0x5b, 0x5e, 0x2f,
+	0x5d, 0x7b, 0x31, 0x2c, 0x32, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x08, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0xac, 0x04, 0x0a, 0x03, 0x42, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xfa, 0x42, 0x29, 0x72, 0x27, 0x10, 0x01,
+	0x18, 0x80, 0x08, 0x32, 0x20, 0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d,
+	0x7b, 0x31, 0x2c, 0x33, 0x36, 0x7d, 0x2f, 0x62, 0x65, 0x74, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d,
+	0x7b, 0x33, 0x36, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x43, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a,
+	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x29,
+	0x0a, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x43,
+	0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01,
+	0x22, 0x9f, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x65, 0x74, 0x48, 0x00, 0x52, 0x03, 0x62, 0x65,
+	0x74, 0x22, 0x53, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50,
+	0x53, 0x48, 0x4f, 0x54, 0x10, 0x03, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x22, 0x9d, 0x06, 0x0a, 0x0b, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x40, 0x0a,
+	0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x56, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x12, 0x75, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x43, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x0f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x13, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0a,
+	0x62, 0x65, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x2e, 0x42, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x62,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x62, 0x65, 0x74, 0x5f,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42,
+	0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x65, 0x74,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0x37, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x9d, 0x02, 0x0a, 0x12,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x65,
+	0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x62,
+	0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x64, 0x65, 0x62, 0x74, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x0d, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x3e, 0x0a, 0x0c,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x3f, 0x0a, 0x0d,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a,
+	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x40, 0x0a,
+	0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x12,
+	0x2e, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22,
+	0x55, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x62, 0x65, 0x74, 0x22, 0x32, 0x0a, 0x09, 0x42, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x03, 0x62, 0x65, 0x74, 0x22, 0x33, 0x0a, 0x0a, 0x42, 0x65,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x03, 0x62, 0x65, 0x74, 0x22,
+	0x48, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x07, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x44, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xfa, 0x42, 0x2d, 0x72, 0x2b, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32,
+	0x24, 0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33,
+	0x36, 0x7d, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d,
+	0x7b, 0x33, 0x36, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x29, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa,
+	0x42, 0x14, 0x72, 0x12, 0x18, 0x80, 0x10, 0x32, 0x0a, 0x5e, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3f,
+	0x3a, 0x2f, 0x2f, 0x88, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x22, 0x8f, 0x03, 0x0a, 0x0c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x65, 0x74, 0x48, 0x00, 0x52, 0x03, 0x62, 0x65,
+	0x74, 0x22, 0x95, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54,
+	0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x45, 0x54,
+	0x5f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x05, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41,
+	0x74, 0x22, 0xe5, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x65, 0x6e, 0x74, 0x69,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x62,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x0a, 0x6c,
+	0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x42, 0x65, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x0c, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0xe0, 0x03, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x5f, 0x62, 0x65, 0x74, 0x5f, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x65, 0x74, 0x4f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x42, 0x65,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x14, 0x6e, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x43, 0x65,
+	0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x69,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x72, 0x6f, 0x69, 0x12, 0x34, 0x0a, 0x0b, 0x62,
+	0x69, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x0a, 0x62, 0x69, 0x67, 0x67, 0x65, 0x73, 0x74, 0x57, 0x69,
+	0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x77, 0x69, 0x6e,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c,
+	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x57, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12,
+	0x49, 0x0a, 0x11, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x10, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x0c, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc1, 0x01,
+	0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x65, 0x72, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x40, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x69, 0x6e, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x0e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x6d, 0x65, 0x61, 0x6e, 0x49, 0x6d, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2d,
+	0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xe3, 0x01,
+	0x0a, 0x0c, 0x4f, 0x64, 0x64, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a,
+	0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x64, 0x64, 0x73, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x64,
+	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2f,
+	0x0a, 0x13, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22,
+	0xd8, 0x02, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x3b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27,
+	0xfa, 0x42, 0x24, 0x72, 0x22, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32, 0x1b, 0x5e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33, 0x36, 0x7d, 0x2f, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x52, 0x0a,
+	0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6f, 0x6c, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x11,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77,
+	0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x62, 0x61, 0x69, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12,
+	0x62, 0x61, 0x69, 0x6c, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0x9e, 0x03, 0x0a, 0x05, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2e, 0xfa, 0x42, 0x2b, 0x72, 0x29, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32, 0x22,
+	0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33, 0x36,
+	0x7d, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x33, 0x36,
+	0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x0b, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x62, 0x74, 0x5f,
+	0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x64, 0x65, 0x62, 0x74, 0x52, 0x65,
+	0x70, 0x61, 0x69, 0x64, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0x4a, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x4f,
+	0x57, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x42, 0x41, 0x49, 0x4c, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x22, 0xdf, 0x02, 0x0a, 0x08,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xfa, 0x42, 0x2e, 0x72, 0x2c, 0x10, 0x01, 0x18,
+	0x80, 0x08, 0x32, 0x25, 0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b,
+	0x31, 0x2c, 0x33, 0x36, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f,
+	0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x33, 0x36, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x6f,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x0b,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x0b, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x64, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x64, 0x65, 0x62, 0x74, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x64, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xa4, 0x03,
+	0x0a, 0x0a, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0x42, 0x30, 0x72,
+	0x2e, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32, 0x27, 0x5e, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x5b,
+	0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33, 0x36, 0x7d, 0x2f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x33, 0x36, 0x7d, 0x24, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x38, 0x00, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x3a, 0x0a, 0x19, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x17, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17,
+	0x64, 0x65, 0x62, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x64,
+	0x65, 0x62, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x64, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x22, 0xb1, 0x04, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x46, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xfa,
+	0x42, 0x2f, 0x72, 0x2d, 0x10, 0x01, 0x18, 0x80, 0x08, 0x32, 0x26, 0x5e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x73, 0x2f, 0x5b, 0x5e, 0x2f, 0x5d, 0x7b, 0x31, 0x2c, 0x33, 0x36, 0x7d, 0x2f, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2a,
+	0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x13, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x62, 0x65, 0x74,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x42, 0x65, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x42, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x22,
+	0x67, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x42, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x42, 0x45, 0x54, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x42, 0x45, 0x54, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a,
+	0x15, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x42, 0x45, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x43, 0x41, 0x52, 0x52, 0x59, 0x10, 0x02, 0x22, 0x7b, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x4e, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0x51, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x28, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x2d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x22, 0x5c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x45, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xfa, 0x42, 0x1d, 0x72, 0x1b, 0x52, 0x00, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x22, 0x67, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x59, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x2e, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x22, 0x46, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x2f, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x35, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0xfa, 0x42,
+	0x47, 0x72, 0x45, 0x52, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x10, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x64, 0x65, 0x73, 0x63, 0x52, 0x16, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x20, 0x64, 0x65, 0x73, 0x63, 0x52, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x20, 0x64, 0x65, 0x73, 0x63, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x22, 0x6f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x44, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x22, 0x44, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x6b,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x6d,
+	0x0a, 0x13, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x65, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67,
+	0x42, 0x0b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x46, 0x0a,
+	0x14, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74,
+	0x61, 0x67, 0x22, 0x48, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x46, 0x0a, 0x13,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x65, 0x74, 0x61, 0x67, 0x22, 0x46, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x4d, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x25, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x03, 0x62, 0x65, 0x74, 0x22, 0x3a, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42,
+	0x65, 0x74, 0x52, 0x03, 0x62, 0x65, 0x74, 0x22, 0x2a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03,
+	0x62, 0x65, 0x74, 0x22, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x62, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x03, 0x62, 0x65, 0x74, 0x22, 0xc7, 0x02, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x65, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x4a, 0xfa, 0x42, 0x47, 0x72, 0x45, 0x52, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x64, 0x65,
+	0x73, 0x63, 0x52, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x20, 0x64, 0x65, 0x73, 0x63, 0x52, 0x11, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x64, 0x65, 0x73, 0x63, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x63, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x65, 0x74, 0x52, 0x04, 0x62,
+	0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a, 0x12, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x42,
+	0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62,
+	0x6f, 0x6f, 0x6b, 0x22, 0x40, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x6f,
+	0x6b, 0x12, 0x31, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x4a, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x22, 0x77, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x04,
+	0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x22, 0x73, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x33,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x14,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x08, 0x01, 0x10, 0x64, 0x22,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x15,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x40,
+	0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x08,
+	0x01, 0x10, 0x64, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0x68, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x3d, 0x0a, 0x13, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x10, 0xfa, 0x42, 0x0d, 0x92, 0x01, 0x0a, 0x08, 0x01, 0x10, 0x64, 0x22, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x14, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x42, 0x65, 0x74, 0x52, 0x04, 0x62, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f,
+	0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4b, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x47, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x53, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x62, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x22,
+	0x57, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x64, 0x64, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x1a, 0x02, 0x18, 0x64, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x64, 0x64, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4f, 0x64, 0x64,
+	0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x1d, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x22, 0xfe, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x3a, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x13, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x58, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x62, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x42, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0d, 0x6f, 0x70, 0x65,
+	0x6e, 0x42, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x53, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x6b, 0x22, 0x6f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74,
+	0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32,
+	0x02, 0x20, 0x00, 0x52, 0x0b, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0xb0,
+	0x01, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x31, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x2d, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x74, 0x6f, 0x55, 0x73, 0x65,
+	0x72, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0x77, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x34, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x38, 0x00, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x65, 0x6e,
+	0x74, 0x69, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0x80, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a,
+	0x19, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0x8e, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1b, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x22, 0x7f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b,
+	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x35, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04,
+	0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x22, 0x53, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x7e,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x1d, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x56,
+	0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x34, 0x0a, 0x15, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xb3, 0x01, 0x0a,
+	0x16, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x42,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x18, 0x32, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
+	0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x6b,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xa1, 0x1d, 0x0a, 0x0d,
+	0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a,
+	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1e, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a,
+	0x0a, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x61, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x12, 0x20,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x12,
+	0x1d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x12, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a,
+	0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x64, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x42, 0x65, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2b, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x12, 0x2d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x4f, 0x64, 0x64, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x64, 0x64, 0x73, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x64, 0x64, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x29, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x58, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x58, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12,
+	0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x24,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a,
+	0x11, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x28, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0xb2, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x42, 0x0b, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x65, 0x6c, 0x68, 0x2f, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x3b,
+	0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0xa2, 0x02, 0x03,
+	0x42, 0x58, 0x58, 0xaa, 0x02, 0x0e, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0xca, 0x02, 0x0e, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x5c, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0xe2, 0x02, 0x1a, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x5c, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0f, 0x42, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bettor_v1alpha_bettor_proto_rawDescOnce sync.Once
+	file_bettor_v1alpha_bettor_proto_rawDescData = file_bettor_v1alpha_bettor_proto_rawDesc
+)
+
+func file_bettor_v1alpha_bettor_proto_rawDescGZIP() []byte {
+	file_bettor_v1alpha_bettor_proto_rawDescOnce.Do(func() {
+		file_bettor_v1alpha_bettor_proto_rawDescData = protoimpl.X.CompressGZIP(file_bettor_v1alpha_bettor_proto_rawDescData)
+	})
+	return file_bettor_v1alpha_bettor_proto_rawDescData
+}
+
+var file_bettor_v1alpha_bettor_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_bettor_v1alpha_bettor_proto_msgTypes = make([]protoimpl.MessageInfo, 116)
+var file_bettor_v1alpha_bettor_proto_goTypes = []interface{}{
+	(Market_Status)(0),                     // 0: bettor.v1alpha.Market.Status
+	(Event_Type)(0),                        // 1: bettor.v1alpha.Event.Type
+	(WebhookEvent_Type)(0),                 // 2: bettor.v1alpha.WebhookEvent.Type
+	(Grant_Reason)(0),                      // 3: bettor.v1alpha.Grant.Reason
+	(Season_OpenBetPolicy)(0),              // 4: bettor.v1alpha.Season.OpenBetPolicy
+	(*User)(nil),                           // 5: bettor.v1alpha.User
+	(*Market)(nil),                         // 6: bettor.v1alpha.Market
+	(*Pool)(nil),                           // 7: bettor.v1alpha.Pool
+	(*Settlement)(nil),                     // 8: bettor.v1alpha.Settlement
+	(*Debt)(nil),                           // 9: bettor.v1alpha.Debt
+	(*Outcome)(nil),                        // 10: bettor.v1alpha.Outcome
+	(*Bet)(nil),                            // 11: bettor.v1alpha.Bet
+	(*Event)(nil),                          // 12: bettor.v1alpha.Event
+	(*DomainEvent)(nil),                    // 13: bettor.v1alpha.DomainEvent
+	(*UserCreated)(nil),                    // 14: bettor.v1alpha.UserCreated
+	(*UserBalanceChanged)(nil),             // 15: bettor.v1alpha.UserBalanceChanged
+	(*MarketCreated)(nil),                  // 16: bettor.v1alpha.MarketCreated
+	(*MarketLocked)(nil),                   // 17: bettor.v1alpha.MarketLocked
+	(*MarketSettled)(nil),                  // 18: bettor.v1alpha.MarketSettled
+	(*MarketCanceled)(nil),                 // 19: bettor.v1alpha.MarketCanceled
+	(*MarketPoolChanged)(nil),              // 20: bettor.v1alpha.MarketPoolChanged
+	(*BetPlaced)(nil),                      // 21: bettor.v1alpha.BetPlaced
+	(*BetSettled)(nil),                     // 22: bettor.v1alpha.BetSettled
+	(*ConsumerCursor)(nil),                 // 23: bettor.v1alpha.ConsumerCursor
+	(*Webhook)(nil),                        // 24: bettor.v1alpha.Webhook
+	(*WebhookEvent)(nil),                   // 25: bettor.v1alpha.WebhookEvent
+	(*WebhookDelivery)(nil),                // 26: bettor.v1alpha.WebhookDelivery
+	(*MarketStats)(nil),                    // 27: bettor.v1alpha.MarketStats
+	(*OutcomeStats)(nil),                   // 28: bettor.v1alpha.OutcomeStats
+	(*UserStats)(nil),                      // 29: bettor.v1alpha.UserStats
+	(*OutcomeCount)(nil),                   // 30: bettor.v1alpha.OutcomeCount
+	(*ForecastScore)(nil),                  // 31: bettor.v1alpha.ForecastScore
+	(*CalibrationBin)(nil),                 // 32: bettor.v1alpha.CalibrationBin
+	(*OddsSnapshot)(nil),                   // 33: bettor.v1alpha.OddsSnapshot
+	(*OutcomeOdds)(nil),                    // 34: bettor.v1alpha.OutcomeOdds
+	(*BookSettings)(nil),                   // 35: bettor.v1alpha.BookSettings
+	(*Grant)(nil),                          // 36: bettor.v1alpha.Grant
+	(*Transfer)(nil),                       // 37: bettor.v1alpha.Transfer
+	(*Adjustment)(nil),                     // 38: bettor.v1alpha.Adjustment
+	(*Season)(nil),                         // 39: bettor.v1alpha.Season
+	(*Standing)(nil),                       // 40: bettor.v1alpha.Standing
+	(*SeasonReset)(nil),                    // 41: bettor.v1alpha.SeasonReset
+	(*BalancePoint)(nil),                   // 42: bettor.v1alpha.BalancePoint
+	(*CreateUserRequest)(nil),              // 43: bettor.v1alpha.CreateUserRequest
+	(*CreateUserResponse)(nil),             // 44: bettor.v1alpha.CreateUserResponse
+	(*GetUserRequest)(nil),                 // 45: bettor.v1alpha.GetUserRequest
+	(*GetUserResponse)(nil),                // 46: bettor.v1alpha.GetUserResponse
+	(*GetUserByUsernameRequest)(nil),       // 47: bettor.v1alpha.GetUserByUsernameRequest
+	(*GetUserByUsernameResponse)(nil),      // 48: bettor.v1alpha.GetUserByUsernameResponse
+	(*ListUsersRequest)(nil),               // 49: bettor.v1alpha.ListUsersRequest
+	(*ListUsersResponse)(nil),              // 50: bettor.v1alpha.ListUsersResponse
+	(*CreateMarketRequest)(nil),            // 51: bettor.v1alpha.CreateMarketRequest
+	(*CreateMarketResponse)(nil),           // 52: bettor.v1alpha.CreateMarketResponse
+	(*GetMarketRequest)(nil),               // 53: bettor.v1alpha.GetMarketRequest
+	(*GetMarketResponse)(nil),              // 54: bettor.v1alpha.GetMarketResponse
+	(*ListMarketsRequest)(nil),             // 55: bettor.v1alpha.ListMarketsRequest
+	(*ListMarketsResponse)(nil),            // 56: bettor.v1alpha.ListMarketsResponse
+	(*LockMarketRequest)(nil),              // 57: bettor.v1alpha.LockMarketRequest
+	(*LockMarketResponse)(nil),             // 58: bettor.v1alpha.LockMarketResponse
+	(*SettleMarketRequest)(nil),            // 59: bettor.v1alpha.SettleMarketRequest
+	(*SettleMarketResponse)(nil),           // 60: bettor.v1alpha.SettleMarketResponse
+	(*ResettleMarketRequest)(nil),          // 61: bettor.v1alpha.ResettleMarketRequest
+	(*ResettleMarketResponse)(nil),         // 62: bettor.v1alpha.ResettleMarketResponse
+	(*CancelMarketRequest)(nil),            // 63: bettor.v1alpha.CancelMarketRequest
+	(*CancelMarketResponse)(nil),           // 64: bettor.v1alpha.CancelMarketResponse
+	(*CreateBetRequest)(nil),               // 65: bettor.v1alpha.CreateBetRequest
+	(*CreateBetResponse)(nil),              // 66: bettor.v1alpha.CreateBetResponse
+	(*GetBetRequest)(nil),                  // 67: bettor.v1alpha.GetBetRequest
+	(*GetBetResponse)(nil),                 // 68: bettor.v1alpha.GetBetResponse
+	(*ListBetsRequest)(nil),                // 69: bettor.v1alpha.ListBetsRequest
+	(*ListBetsResponse)(nil),               // 70: bettor.v1alpha.ListBetsResponse
+	(*WatchMarketRequest)(nil),             // 71: bettor.v1alpha.WatchMarketRequest
+	(*WatchMarketResponse)(nil),            // 72: bettor.v1alpha.WatchMarketResponse
+	(*WatchBookRequest)(nil),               // 73: bettor.v1alpha.WatchBookRequest
+	(*WatchBookResponse)(nil),              // 74: bettor.v1alpha.WatchBookResponse
+	(*CreateWebhookRequest)(nil),           // 75: bettor.v1alpha.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),          // 76: bettor.v1alpha.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),            // 77: bettor.v1alpha.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),           // 78: bettor.v1alpha.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),           // 79: bettor.v1alpha.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),          // 80: bettor.v1alpha.DeleteWebhookResponse
+	(*BatchGetUsersRequest)(nil),           // 81: bettor.v1alpha.BatchGetUsersRequest
+	(*BatchGetUsersResponse)(nil),          // 82: bettor.v1alpha.BatchGetUsersResponse
+	(*BatchGetMarketsRequest)(nil),         // 83: bettor.v1alpha.BatchGetMarketsRequest
+	(*BatchGetMarketsResponse)(nil),        // 84: bettor.v1alpha.BatchGetMarketsResponse
+	(*BatchGetBetsRequest)(nil),            // 85: bettor.v1alpha.BatchGetBetsRequest
+	(*BatchGetBetsResponse)(nil),           // 86: bettor.v1alpha.BatchGetBetsResponse
+	(*GetMarketStatsRequest)(nil),          // 87: bettor.v1alpha.GetMarketStatsRequest
+	(*GetMarketStatsResponse)(nil),         // 88: bettor.v1alpha.GetMarketStatsResponse
+	(*GetUserStatsRequest)(nil),            // 89: bettor.v1alpha.GetUserStatsRequest
+	(*GetUserStatsResponse)(nil),           // 90: bettor.v1alpha.GetUserStatsResponse
+	(*GetUserForecastScoreRequest)(nil),    // 91: bettor.v1alpha.GetUserForecastScoreRequest
+	(*GetUserForecastScoreResponse)(nil),   // 92: bettor.v1alpha.GetUserForecastScoreResponse
+	(*GetForecastLeaderboardRequest)(nil),  // 93: bettor.v1alpha.GetForecastLeaderboardRequest
+	(*GetForecastLeaderboardResponse)(nil), // 94: bettor.v1alpha.GetForecastLeaderboardResponse
+	(*ListMarketOddsHistoryRequest)(nil),   // 95: bettor.v1alpha.ListMarketOddsHistoryRequest
+	(*ListMarketOddsHistoryResponse)(nil),  // 96: bettor.v1alpha.ListMarketOddsHistoryResponse
+	(*GetUserBalanceHistoryRequest)(nil),   // 97: bettor.v1alpha.GetUserBalanceHistoryRequest
+	(*GetUserBalanceHistoryResponse)(nil),  // 98: bettor.v1alpha.GetUserBalanceHistoryResponse
+	(*StartSeasonRequest)(nil),             // 99: bettor.v1alpha.StartSeasonRequest
+	(*StartSeasonResponse)(nil),            // 100: bettor.v1alpha.StartSeasonResponse
+	(*GetSeasonRequest)(nil),               // 101: bettor.v1alpha.GetSeasonRequest
+	(*GetSeasonResponse)(nil),              // 102: bettor.v1alpha.GetSeasonResponse
+	(*ListSeasonsRequest)(nil),             // 103: bettor.v1alpha.ListSeasonsRequest
+	(*ListSeasonsResponse)(nil),            // 104: bettor.v1alpha.ListSeasonsResponse
+	(*TransferPointsRequest)(nil),          // 105: bettor.v1alpha.TransferPointsRequest
+	(*TransferPointsResponse)(nil),         // 106: bettor.v1alpha.TransferPointsResponse
+	(*ListTransfersRequest)(nil),           // 107: bettor.v1alpha.ListTransfersRequest
+	(*ListTransfersResponse)(nil),          // 108: bettor.v1alpha.ListTransfersResponse
+	(*AdjustUserBalanceRequest)(nil),       // 109: bettor.v1alpha.AdjustUserBalanceRequest
+	(*AdjustUserBalanceResponse)(nil),      // 110: bettor.v1alpha.AdjustUserBalanceResponse
+	(*ListAdjustmentsRequest)(nil),         // 111: bettor.v1alpha.ListAdjustmentsRequest
+	(*ListAdjustmentsResponse)(nil),        // 112: bettor.v1alpha.ListAdjustmentsResponse
+	(*GetBookSettingsRequest)(nil),         // 113: bettor.v1alpha.GetBookSettingsRequest
+	(*GetBookSettingsResponse)(nil),        // 114: bettor.v1alpha.GetBookSettingsResponse
+	(*UpdateBookSettingsRequest)(nil),      // 115: bettor.v1alpha.UpdateBookSettingsRequest
+	(*UpdateBookSettingsResponse)(nil),     // 116: bettor.v1alpha.UpdateBookSettingsResponse
+	(*ClaimAllowanceRequest)(nil),          // 117: bettor.v1alpha.ClaimAllowanceRequest
+	(*ClaimAllowanceResponse)(nil),         // 118: bettor.v1alpha.ClaimAllowanceResponse
+	(*ListGrantsRequest)(nil),              // 119: bettor.v1alpha.ListGrantsRequest
+	(*ListGrantsResponse)(nil),             // 120: bettor.v1alpha.ListGrantsResponse
+	(*timestamppb.Timestamp)(nil),          // 121: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 122: google.protobuf.Duration
+}
+var file_bettor_v1alpha_bettor_proto_depIdxs = []int32{
+	121, // 0: bettor.v1alpha.User.created_at:type_name -> google.protobuf.Timestamp
+	121, // 1: bettor.v1alpha.User.updated_at:type_name -> google.protobuf.Timestamp
+	121, // 2: bettor.v1alpha.User.allowance_claimed_at:type_name -> google.protobuf.Timestamp
+	121, // 3: bettor.v1alpha.Market.created_at:type_name -> google.protobuf.Timestamp
+	121, // 4: bettor.v1alpha.Market.updated_at:type_name -> google.protobuf.Timestamp
+	121, // 5: bettor.v1alpha.Market.settled_at:type_name -> google.protobuf.Timestamp
+	0,   // 6: bettor.v1alpha.Market.status:type_name -> bettor.v1alpha.Market.Status
+	7,   // 7: bettor.v1alpha.Market.pool:type_name -> bettor.v1alpha.Pool
+	8,   // 8: bettor.v1alpha.Market.settlements:type_name -> bettor.v1alpha.Settlement
+	10,  // 9: bettor.v1alpha.Pool.outcomes:type_name -> bettor.v1alpha.Outcome
+	121, // 10: bettor.v1alpha.Settlement.settled_at:type_name -> google.protobuf.Timestamp
+	9,   // 11: bettor.v1alpha.Settlement.debts:type_name -> bettor.v1alpha.Debt
+	121, // 12: bettor.v1alpha.Bet.created_at:type_name -> google.protobuf.Timestamp
+	121, // 13: bettor.v1alpha.Bet.updated_at:type_name -> google.protobuf.Timestamp
+	121, // 14: bettor.v1alpha.Bet.settled_at:type_name -> google.protobuf.Timestamp
+	1,   // 15: bettor.v1alpha.Event.type:type_name -> bettor.v1alpha.Event.Type
+	5,   // 16: bettor.v1alpha.Event.user:type_name -> bettor.v1alpha.User
+	6,   // 17: bettor.v1alpha.Event.market:type_name -> bettor.v1alpha.Market
+	11,  // 18: bettor.v1alpha.Event.bet:type_name -> bettor.v1alpha.Bet
+	121, // 19: bettor.v1alpha.DomainEvent.created_at:type_name -> google.protobuf.Timestamp
+	14,  // 20: bettor.v1alpha.DomainEvent.user_created:type_name -> bettor.v1alpha.UserCreated
+	15,  // 21: bettor.v1alpha.DomainEvent.user_balance_changed:type_name -> bettor.v1alpha.UserBalanceChanged
+	16,  // 22: bettor.v1alpha.DomainEvent.market_created:type_name -> bettor.v1alpha.MarketCreated
+	17,  // 23: bettor.v1alpha.DomainEvent.market_locked:type_name -> bettor.v1alpha.MarketLocked
+	18,  // 24: bettor.v1alpha.DomainEvent.market_settled:type_name -> bettor.v1alpha.MarketSettled
+	19,  // 25: bettor.v1alpha.DomainEvent.market_canceled:type_name -> bettor.v1alpha.MarketCanceled
+	20,  // 26: bettor.v1alpha.DomainEvent.market_pool_changed:type_name -> bettor.v1alpha.MarketPoolChanged
+	21,  // 27: bettor.v1alpha.DomainEvent.bet_placed:type_name -> bettor.v1alpha.BetPlaced
+	22,  // 28: bettor.v1alpha.DomainEvent.bet_settled:type_name -> bettor.v1alpha.BetSettled
+	5,   // 29: bettor.v1alpha.UserCreated.user:type_name -> bettor.v1alpha.User
+	5,   // 30: bettor.v1alpha.UserBalanceChanged.user:type_name -> bettor.v1alpha.User
+	6,   // 31: bettor.v1alpha.MarketCreated.market:type_name -> bettor.v1alpha.Market
+	6,   // 32: bettor.v1alpha.MarketLocked.market:type_name -> bettor.v1alpha.Market
+	6,   // 33: bettor.v1alpha.MarketSettled.market:type_name -> bettor.v1alpha.Market
+	6,   // 34: bettor.v1alpha.MarketCanceled.market:type_name -> bettor.v1alpha.Market
+	6,   // 35: bettor.v1alpha.MarketPoolChanged.market:type_name -> bettor.v1alpha.Market
+	11,  // 36: bettor.v1alpha.BetPlaced.bet:type_name -> bettor.v1alpha.Bet
+	11,  // 37: bettor.v1alpha.BetSettled.bet:type_name -> bettor.v1alpha.Bet
+	121, // 38: bettor.v1alpha.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 39: bettor.v1alpha.WebhookEvent.type:type_name -> bettor.v1alpha.WebhookEvent.Type
+	121, // 40: bettor.v1alpha.WebhookEvent.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 41: bettor.v1alpha.WebhookEvent.market:type_name -> bettor.v1alpha.Market
+	11,  // 42: bettor.v1alpha.WebhookEvent.bet:type_name -> bettor.v1alpha.Bet
+	25,  // 43: bettor.v1alpha.WebhookDelivery.event:type_name -> bettor.v1alpha.WebhookEvent
+	121, // 44: bettor.v1alpha.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	28,  // 45: bettor.v1alpha.MarketStats.outcomes:type_name -> bettor.v1alpha.OutcomeStats
+	11,  // 46: bettor.v1alpha.MarketStats.largest_bet:type_name -> bettor.v1alpha.Bet
+	11,  // 47: bettor.v1alpha.UserStats.biggest_win:type_name -> bettor.v1alpha.Bet
+	30,  // 48: bettor.v1alpha.UserStats.favorite_outcomes:type_name -> bettor.v1alpha.OutcomeCount
+	32,  // 49: bettor.v1alpha.ForecastScore.calibration:type_name -> bettor.v1alpha.CalibrationBin
+	121, // 50: bettor.v1alpha.OddsSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	34,  // 51: bettor.v1alpha.OddsSnapshot.outcomes:type_name -> bettor.v1alpha.OutcomeOdds
+	121, // 52: bettor.v1alpha.BookSettings.updated_at:type_name -> google.protobuf.Timestamp
+	122, // 53: bettor.v1alpha.BookSettings.allowance_cooldown:type_name -> google.protobuf.Duration
+	121, // 54: bettor.v1alpha.Grant.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 55: bettor.v1alpha.Grant.reason:type_name -> bettor.v1alpha.Grant.Reason
+	121, // 56: bettor.v1alpha.Transfer.created_at:type_name -> google.protobuf.Timestamp
+	121, // 57: bettor.v1alpha.Adjustment.created_at:type_name -> google.protobuf.Timestamp
+	121, // 58: bettor.v1alpha.Season.start_time:type_name -> google.protobuf.Timestamp
+	121, // 59: bettor.v1alpha.Season.end_time:type_name -> google.protobuf.Timestamp
+	4,   // 60: bettor.v1alpha.Season.open_bet_policy:type_name -> bettor.v1alpha.Season.OpenBetPolicy
+	40,  // 61: bettor.v1alpha.Season.standings:type_name -> bettor.v1alpha.Standing
+	41,  // 62: bettor.v1alpha.Season.resets:type_name -> bettor.v1alpha.SeasonReset
+	121, // 63: bettor.v1alpha.BalancePoint.time:type_name -> google.protobuf.Timestamp
+	5,   // 64: bettor.v1alpha.CreateUserRequest.user:type_name -> bettor.v1alpha.User
+	5,   // 65: bettor.v1alpha.CreateUserResponse.user:type_name -> bettor.v1alpha.User
+	5,   // 66: bettor.v1alpha.GetUserResponse.user:type_name -> bettor.v1alpha.User
+	5,   // 67: bettor.v1alpha.GetUserByUsernameResponse.user:type_name -> bettor.v1alpha.User
+	5,   // 68: bettor.v1alpha.ListUsersResponse.users:type_name -> bettor.v1alpha.User
+	6,   // 69: bettor.v1alpha.CreateMarketRequest.market:type_name -> bettor.v1alpha.Market
+	6,   // 70: bettor.v1alpha.CreateMarketResponse.market:type_name -> bettor.v1alpha.Market
+	6,   // 71: bettor.v1alpha.GetMarketResponse.market:type_name -> bettor.v1alpha.Market
+	0,   // 72: bettor.v1alpha.ListMarketsRequest.status:type_name -> bettor.v1alpha.Market.Status
+	6,   // 73: bettor.v1alpha.ListMarketsResponse.markets:type_name -> bettor.v1alpha.Market
+	6,   // 74: bettor.v1alpha.LockMarketResponse.market:type_name -> bettor.v1alpha.Market
+	6,   // 75: bettor.v1alpha.SettleMarketResponse.market:type_name -> bettor.v1alpha.Market
+	6,   // 76: bettor.v1alpha.ResettleMarketResponse.market:type_name -> bettor.v1alpha.Market
+	6,   // 77: bettor.v1alpha.CancelMarketResponse.market:type_name -> bettor.v1alpha.Market
+	11,  // 78: bettor.v1alpha.CreateBetRequest.bet:type_name -> bettor.v1alpha.Bet
+	11,  // 79: bettor.v1alpha.CreateBetResponse.bet:type_name -> bettor.v1alpha.Bet
+	11,  // 80: bettor.v1alpha.GetBetResponse.bet:type_name -> bettor.v1alpha.Bet
+	11,  // 81: bettor.v1alpha.ListBetsResponse.bets:type_name -> bettor.v1alpha.Bet
+	12,  // 82: bettor.v1alpha.WatchMarketResponse.event:type_name -> bettor.v1alpha.Event
+	12,  // 83: bettor.v1alpha.WatchBookResponse.event:type_name -> bettor.v1alpha.Event
+	24,  // 84: bettor.v1alpha.CreateWebhookRequest.webhook:type_name -> bettor.v1alpha.Webhook
+	24,  // 85: bettor.v1alpha.CreateWebhookResponse.webhook:type_name -> bettor.v1alpha.Webhook
+	24,  // 86: bettor.v1alpha.ListWebhooksResponse.webhooks:type_name -> bettor.v1alpha.Webhook
+	5,   // 87: bettor.v1alpha.BatchGetUsersResponse.users:type_name -> bettor.v1alpha.User
+	6,   // 88: bettor.v1alpha.BatchGetMarketsResponse.markets:type_name -> bettor.v1alpha.Market
+	11,  // 89: bettor.v1alpha.BatchGetBetsResponse.bets:type_name -> bettor.v1alpha.Bet
+	27,  // 90: bettor.v1alpha.GetMarketStatsResponse.stats:type_name -> bettor.v1alpha.MarketStats
+	121, // 91: bettor.v1alpha.GetUserStatsRequest.start_time:type_name -> google.protobuf.Timestamp
+	121, // 92: bettor.v1alpha.GetUserStatsRequest.end_time:type_name -> google.protobuf.Timestamp
+	29,  // 93: bettor.v1alpha.GetUserStatsResponse.stats:type_name -> bettor.v1alpha.UserStats
+	31,  // 94: bettor.v1alpha.GetUserForecastScoreResponse.score:type_name -> bettor.v1alpha.ForecastScore
+	31,  // 95: bettor.v1alpha.GetForecastLeaderboardResponse.scores:type_name -> bettor.v1alpha.ForecastScore
+	33,  // 96: bettor.v1alpha.ListMarketOddsHistoryResponse.snapshots:type_name -> bettor.v1alpha.OddsSnapshot
+	42,  // 97: bettor.v1alpha.GetUserBalanceHistoryResponse.points:type_name -> bettor.v1alpha.BalancePoint
+	4,   // 98: bettor.v1alpha.StartSeasonRequest.open_bet_policy:type_name -> bettor.v1alpha.Season.OpenBetPolicy
+	39,  // 99: bettor.v1alpha.StartSeasonResponse.ended_season:type_name -> bettor.v1alpha.Season
+	39,  // 100: bettor.v1alpha.StartSeasonResponse.season:type_name -> bettor.v1alpha.Season
+	39,  // 101: bettor.v1alpha.GetSeasonResponse.season:type_name -> bettor.v1alpha.Season
+	39,  // 102: bettor.v1alpha.ListSeasonsResponse.seasons:type_name -> bettor.v1alpha.Season
+	37,  // 103: bettor.v1alpha.TransferPointsResponse.transfer:type_name -> bettor.v1alpha.Transfer
+	5,   // 104: bettor.v1alpha.TransferPointsResponse.from_user:type_name -> bettor.v1alpha.User
+	5,   // 105: bettor.v1alpha.TransferPointsResponse.to_user:type_name -> bettor.v1alpha.User
+	37,  // 106: bettor.v1alpha.ListTransfersResponse.transfers:type_name -> bettor.v1alpha.Transfer
+	38,  // 107: bettor.v1alpha.AdjustUserBalanceResponse.adjustment:type_name -> bettor.v1alpha.Adjustment
+	5,   // 108: bettor.v1alpha.AdjustUserBalanceResponse.user:type_name -> bettor.v1alpha.User
+	38,  // 109: bettor.v1alpha.ListAdjustmentsResponse.adjustments:type_name -> bettor.v1alpha.Adjustment
+	35,  // 110: bettor.v1alpha.GetBookSettingsResponse.settings:type_name -> bettor.v1alpha.BookSettings
+	35,  // 111: bettor.v1alpha.UpdateBookSettingsRequest.settings:type_name -> bettor.v1alpha.BookSettings
+	35,  // 112: bettor.v1alpha.UpdateBookSettingsResponse.settings:type_name -> bettor.v1alpha.BookSettings
+	5,   // 113: bettor.v1alpha.ClaimAllowanceResponse.user:type_name -> bettor.v1alpha.User
+	36,  // 114: bettor.v1alpha.ClaimAllowanceResponse.grant:type_name -> bettor.v1alpha.Grant
+	121, // 115: bettor.v1alpha.ClaimAllowanceResponse.next_claim_time:type_name -> google.protobuf.Timestamp
+	36,  // 116: bettor.v1alpha.ListGrantsResponse.grants:type_name -> bettor.v1alpha.Grant
+	43,  // 117: bettor.v1alpha.BettorService.CreateUser:input_type -> bettor.v1alpha.CreateUserRequest
+	45,  // 118: bettor.v1alpha.BettorService.GetUser:input_type -> bettor.v1alpha.GetUserRequest
+	47,  // 119: bettor.v1alpha.BettorService.GetUserByUsername:input_type -> bettor.v1alpha.GetUserByUsernameRequest
+	49,  // 120: bettor.v1alpha.BettorService.ListUsers:input_type -> bettor.v1alpha.ListUsersRequest
+	51,  // 121: bettor.v1alpha.BettorService.CreateMarket:input_type -> bettor.v1alpha.CreateMarketRequest
+	53,  // 122: bettor.v1alpha.BettorService.GetMarket:input_type -> bettor.v1alpha.GetMarketRequest
+	55,  // 123: bettor.v1alpha.BettorService.ListMarkets:input_type -> bettor.v1alpha.ListMarketsRequest
+	57,  // 124: bettor.v1alpha.BettorService.LockMarket:input_type -> bettor.v1alpha.LockMarketRequest
+	59,  // 125: bettor.v1alpha.BettorService.SettleMarket:input_type -> bettor.v1alpha.SettleMarketRequest
+	61,  // 126: bettor.v1alpha.BettorService.ResettleMarket:input_type -> bettor.v1alpha.ResettleMarketRequest
+	63,  // 127: bettor.v1alpha.BettorService.CancelMarket:input_type -> bettor.v1alpha.CancelMarketRequest
+	65,  // 128: bettor.v1alpha.BettorService.CreateBet:input_type -> bettor.v1alpha.CreateBetRequest
+	67,  // 129: bettor.v1alpha.BettorService.GetBet:input_type -> bettor.v1alpha.GetBetRequest
+	69,  // 130: bettor.v1alpha.BettorService.ListBets:input_type -> bettor.v1alpha.ListBetsRequest
+	71,  // 131: bettor.v1alpha.BettorService.WatchMarket:input_type -> bettor.v1alpha.WatchMarketRequest
+	73,  // 132: bettor.v1alpha.BettorService.WatchBook:input_type -> bettor.v1alpha.WatchBookRequest
+	75,  // 133: bettor.v1alpha.BettorService.CreateWebhook:input_type -> bettor.v1alpha.CreateWebhookRequest
+	77,  // 134: bettor.v1alpha.BettorService.ListWebhooks:input_type -> bettor.v1alpha.ListWebhooksRequest
+	79,  // 135: bettor.v1alpha.BettorService.DeleteWebhook:input_type -> bettor.v1alpha.DeleteWebhookRequest
+	81,  // 136: bettor.v1alpha.BettorService.BatchGetUsers:input_type -> bettor.v1alpha.BatchGetUsersRequest
+	83,  // 137: bettor.v1alpha.BettorService.BatchGetMarkets:input_type -> bettor.v1alpha.BatchGetMarketsRequest
+	85,  // 138: bettor.v1alpha.BettorService.BatchGetBets:input_type -> bettor.v1alpha.BatchGetBetsRequest
+	87,  // 139: bettor.v1alpha.BettorService.GetMarketStats:input_type -> bettor.v1alpha.GetMarketStatsRequest
+	89,  // 140: bettor.v1alpha.BettorService.GetUserStats:input_type -> bettor.v1alpha.GetUserStatsRequest
+	91,  // 141: bettor.v1alpha.BettorService.GetUserForecastScore:input_type -> bettor.v1alpha.GetUserForecastScoreRequest
+	93,  // 142: bettor.v1alpha.BettorService.GetForecastLeaderboard:input_type -> bettor.v1alpha.GetForecastLeaderboardRequest
+	95,  // 143: bettor.v1alpha.BettorService.ListMarketOddsHistory:input_type -> bettor.v1alpha.ListMarketOddsHistoryRequest
+	97,  // 144: bettor.v1alpha.BettorService.GetUserBalanceHistory:input_type -> bettor.v1alpha.GetUserBalanceHistoryRequest
+	113, // 145: bettor.v1alpha.BettorService.GetBookSettings:input_type -> bettor.v1alpha.GetBookSettingsRequest
+	115, // 146: bettor.v1alpha.BettorService.UpdateBookSettings:input_type -> bettor.v1alpha.UpdateBookSettingsRequest
+	117, // 147: bettor.v1alpha.BettorService.ClaimAllowance:input_type -> bettor.v1alpha.ClaimAllowanceRequest
+	119, // 148: bettor.v1alpha.BettorService.ListGrants:input_type -> bettor.v1alpha.ListGrantsRequest
+	99,  // 149: bettor.v1alpha.BettorService.StartSeason:input_type -> bettor.v1alpha.StartSeasonRequest
+	101, // 150: bettor.v1alpha.BettorService.GetSeason:input_type -> bettor.v1alpha.GetSeasonRequest
+	103, // 151: bettor.v1alpha.BettorService.ListSeasons:input_type -> bettor.v1alpha.ListSeasonsRequest
+	105, // 152: bettor.v1alpha.BettorService.TransferPoints:input_type -> bettor.v1alpha.TransferPointsRequest
+	107, // 153: bettor.v1alpha.BettorService.ListTransfers:input_type -> bettor.v1alpha.ListTransfersRequest
+	109, // 154: bettor.v1alpha.BettorService.AdjustUserBalance:input_type -> bettor.v1alpha.AdjustUserBalanceRequest
+	111, // 155: bettor.v1alpha.BettorService.ListAdjustments:input_type -> bettor.v1alpha.ListAdjustmentsRequest
+	44,  // 156: bettor.v1alpha.BettorService.CreateUser:output_type -> bettor.v1alpha.CreateUserResponse
+	46,  // 157: bettor.v1alpha.BettorService.GetUser:output_type -> bettor.v1alpha.GetUserResponse
+	48,  // 158: bettor.v1alpha.BettorService.GetUserByUsername:output_type -> bettor.v1alpha.GetUserByUsernameResponse
+	50,  // 159: bettor.v1alpha.BettorService.ListUsers:output_type -> bettor.v1alpha.ListUsersResponse
+	52,  // 160: bettor.v1alpha.BettorService.CreateMarket:output_type -> bettor.v1alpha.CreateMarketResponse
+	54,  // 161: bettor.v1alpha.BettorService.GetMarket:output_type -> bettor.v1alpha.GetMarketResponse
+	56,  // 162: bettor.v1alpha.BettorService.ListMarkets:output_type -> bettor.v1alpha.ListMarketsResponse
+	58,  // 163: bettor.v1alpha.BettorService.LockMarket:output_type -> bettor.v1alpha.LockMarketResponse
+	60,  // 164: bettor.v1alpha.BettorService.SettleMarket:output_type -> bettor.v1alpha.SettleMarketResponse
+	62,  // 165: bettor.v1alpha.BettorService.ResettleMarket:output_type -> bettor.v1alpha.ResettleMarketResponse
+	64,  // 166: bettor.v1alpha.BettorService.CancelMarket:output_type -> bettor.v1alpha.CancelMarketResponse
+	66,  // 167: bettor.v1alpha.BettorService.CreateBet:output_type -> bettor.v1alpha.CreateBetResponse
+	68,  // 168: bettor.v1alpha.BettorService.GetBet:output_type -> bettor.v1alpha.GetBetResponse
+	70,  // 169: bettor.v1alpha.BettorService.ListBets:output_type -> bettor.v1alpha.ListBetsResponse
+	72,  // 170: bettor.v1alpha.BettorService.WatchMarket:output_type -> bettor.v1alpha.WatchMarketResponse
+	74,  // 171: bettor.v1alpha.BettorService.WatchBook:output_type -> bettor.v1alpha.WatchBookResponse
+	76,  // 172: bettor.v1alpha.BettorService.CreateWebhook:output_type -> bettor.v1alpha.CreateWebhookResponse
+	78,  // 173: bettor.v1alpha.BettorService.ListWebhooks:output_type -> bettor.v1alpha.ListWebhooksResponse
+	80,  // 174: bettor.v1alpha.BettorService.DeleteWebhook:output_type -> bettor.v1alpha.DeleteWebhookResponse
+	82,  // 175: bettor.v1alpha.BettorService.BatchGetUsers:output_type -> bettor.v1alpha.BatchGetUsersResponse
+	84,  // 176: bettor.v1alpha.BettorService.BatchGetMarkets:output_type -> bettor.v1alpha.BatchGetMarketsResponse
+	86,  // 177: bettor.v1alpha.BettorService.BatchGetBets:output_type -> bettor.v1alpha.BatchGetBetsResponse
+	88,  // 178: bettor.v1alpha.BettorService.GetMarketStats:output_type -> bettor.v1alpha.GetMarketStatsResponse
+	90,  // 179: bettor.v1alpha.BettorService.GetUserStats:output_type -> bettor.v1alpha.GetUserStatsResponse
+	92,  // 180: bettor.v1alpha.BettorService.GetUserForecastScore:output_type -> bettor.v1alpha.GetUserForecastScoreResponse
+	94,  // 181: bettor.v1alpha.BettorService.GetForecastLeaderboard:output_type -> bettor.v1alpha.GetForecastLeaderboardResponse
+	96,  // 182: bettor.v1alpha.BettorService.ListMarketOddsHistory:output_type -> bettor.v1alpha.ListMarketOddsHistoryResponse
+	98,  // 183: bettor.v1alpha.BettorService.GetUserBalanceHistory:output_type -> bettor.v1alpha.GetUserBalanceHistoryResponse
+	114, // 184: bettor.v1alpha.BettorService.GetBookSettings:output_type -> bettor.v1alpha.GetBookSettingsResponse
+	116, // 185: bettor.v1alpha.BettorService.UpdateBookSettings:output_type -> bettor.v1alpha.UpdateBookSettingsResponse
+	118, // 186: bettor.v1alpha.BettorService.ClaimAllowance:output_type -> bettor.v1alpha.ClaimAllowanceResponse
+	120, // 187: bettor.v1alpha.BettorService.ListGrants:output_type -> bettor.v1alpha.ListGrantsResponse
+	100, // 188: bettor.v1alpha.BettorService.StartSeason:output_type -> bettor.v1alpha.StartSeasonResponse
+	102, // 189: bettor.v1alpha.BettorService.GetSeason:output_type -> bettor.v1alpha.GetSeasonResponse
+	104, // 190: bettor.v1alpha.BettorService.ListSeasons:output_type -> bettor.v1alpha.ListSeasonsResponse
+	106, // 191: bettor.v1alpha.BettorService.TransferPoints:output_type -> bettor.v1alpha.TransferPointsResponse
+	108, // 192: bettor.v1alpha.BettorService.ListTransfers:output_type -> bettor.v1alpha.ListTransfersResponse
+	110, // 193: bettor.v1alpha.BettorService.AdjustUserBalance:output_type -> bettor.v1alpha.AdjustUserBalanceResponse
+	112, // 194: bettor.v1alpha.BettorService.ListAdjustments:output_type -> bettor.v1alpha.ListAdjustmentsResponse
+	156, // [156:195] is the sub-list for method output_type
+	117, // [117:156] is the sub-list for method input_type
+	117, // [117:117] is the sub-list for extension type_name
+	117, // [117:117] is the sub-list for extension extendee
+	0,   // [0:117] is the sub-list for field type_name
+}
+
+func init() { file_bettor_v1alpha_bettor_proto_init() }
+func file_bettor_v1alpha_bettor_proto_init() {
+	if File_bettor_v1alpha_bettor_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_bettor_v1alpha_bettor_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Market); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Settlement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Debt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Outcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DomainEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserBalanceChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketLocked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketSettled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketCanceled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketPoolChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BetPlaced); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BetSettled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutcomeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutcomeCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForecastScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalibrationBin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OddsSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutcomeOdds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Grant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Adjustment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Season); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Standing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeasonReset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalancePoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByUsernameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByUsernameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMarketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMarketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMarketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockMarketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettleMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettleMarketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResettleMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResettleMarketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMarketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMarketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchBookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetMarketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetMarketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetBetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetBetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarketStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bettor_v1alpha_bettor_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2414,8 +10504,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Market); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserForecastScoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2426,8 +10516,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pool); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserForecastScoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2438,8 +10528,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Outcome); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetForecastLeaderboardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2450,8 +10540,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bet); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetForecastLeaderboardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2462,8 +10552,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMarketOddsHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2474,8 +10564,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMarketOddsHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2486,8 +10576,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserBalanceHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2498,8 +10588,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserBalanceHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2510,8 +10600,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserByUsernameRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSeasonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2522,8 +10612,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserByUsernameResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSeasonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2534,8 +10624,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUsersRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSeasonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2546,8 +10636,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUsersResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSeasonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2558,8 +10648,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMarketRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSeasonsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2570,8 +10660,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMarketResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSeasonsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2582,8 +10672,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarketRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPointsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2594,8 +10684,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarketResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPointsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2606,8 +10696,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMarketsRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2618,8 +10708,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMarketsResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2630,8 +10720,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockMarketRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdjustUserBalanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2642,8 +10732,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockMarketResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdjustUserBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2654,8 +10744,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SettleMarketRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAdjustmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2666,8 +10756,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SettleMarketResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAdjustmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2678,8 +10768,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelMarketRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2690,8 +10780,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelMarketResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookSettingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2702,8 +10792,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBetRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBookSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2714,8 +10804,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBetResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBookSettingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2726,8 +10816,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBetRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimAllowanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2738,8 +10828,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBetResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimAllowanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2750,8 +10840,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBetsRequest); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGrantsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2762,8 +10852,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 				return nil
 			}
 		}
-		file_bettor_v1alpha_bettor_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBetsResponse); i {
+		file_bettor_v1alpha_bettor_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGrantsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2778,10 +10868,30 @@ func file_bettor_v1alpha_bettor_proto_init() {
 	file_bettor_v1alpha_bettor_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*Market_Pool)(nil),
 	}
-	file_bettor_v1alpha_bettor_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_bettor_v1alpha_bettor_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*Bet_Outcome)(nil),
 	}
-	file_bettor_v1alpha_bettor_proto_msgTypes[21].OneofWrappers = []interface{}{
+	file_bettor_v1alpha_bettor_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*Event_User)(nil),
+		(*Event_Market)(nil),
+		(*Event_Bet)(nil),
+	}
+	file_bettor_v1alpha_bettor_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*DomainEvent_UserCreated)(nil),
+		(*DomainEvent_UserBalanceChanged)(nil),
+		(*DomainEvent_MarketCreated)(nil),
+		(*DomainEvent_MarketLocked)(nil),
+		(*DomainEvent_MarketSettled)(nil),
+		(*DomainEvent_MarketCanceled)(nil),
+		(*DomainEvent_MarketPoolChanged)(nil),
+		(*DomainEvent_BetPlaced)(nil),
+		(*DomainEvent_BetSettled)(nil),
+	}
+	file_bettor_v1alpha_bettor_proto_msgTypes[20].OneofWrappers = []interface{}{
+		(*WebhookEvent_Market)(nil),
+		(*WebhookEvent_Bet)(nil),
+	}
+	file_bettor_v1alpha_bettor_proto_msgTypes[54].OneofWrappers = []interface{}{
 		(*SettleMarketRequest_Winner)(nil),
 	}
 	type x struct{}
@@ -2789,8 +10899,8 @@ func file_bettor_v1alpha_bettor_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bettor_v1alpha_bettor_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   31,
+			NumEnums:      5,
+			NumMessages:   116,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -126,6 +126,39 @@ func (m *User) validate(all bool) error {
 
 	// no validation rules for UnsettledCentipoints
 
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetAllowanceClaimedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "AllowanceClaimedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "AllowanceClaimedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAllowanceClaimedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "AllowanceClaimedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DebtCentipoints
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}
@@ -345,6 +378,44 @@ func (m *Market) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Etag
+
+	// no validation rules for Season
+
+	for idx, item := range m.GetSettlements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MarketValidationError{
+						field:  fmt.Sprintf("Settlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MarketValidationError{
+						field:  fmt.Sprintf("Settlements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MarketValidationError{
+					field:  fmt.Sprintf("Settlements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	oneofTypePresent := false
 	switch v := m.Type.(type) {
 	case *Market_Pool:
@@ -631,75 +702,212 @@ var _ interface {
 	ErrorName() string
 } = PoolValidationError{}
 
-// Validate checks the field values on Outcome with the rules defined in the
+// Validate checks the field values on Settlement with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Outcome) Validate() error {
+func (m *Settlement) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Outcome with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in OutcomeMultiError, or nil if none found.
-func (m *Outcome) ValidateAll() error {
+// ValidateAll checks the field values on Settlement with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SettlementMultiError, or
+// nil if none found.
+func (m *Settlement) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Outcome) validate(all bool) error {
+func (m *Settlement) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
-		err := OutcomeValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 1024 runes, inclusive",
+	// no validation rules for Winner
+
+	if all {
+		switch v := interface{}(m.GetSettledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettlementValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettlementValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetSettledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettlementValidationError{
+				field:  "SettledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if !_Outcome_Name_Pattern.MatchString(m.GetName()) {
-		err := OutcomeValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^books/[^/]{1,36}/markets/[^/]{36}/outcomes/[^/]{1,2}$\"",
-		}
-		if !all {
-			return err
+	// no validation rules for Actor
+
+	// no validation rules for Reason
+
+	for idx, item := range m.GetDebts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SettlementValidationError{
+						field:  fmt.Sprintf("Debts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SettlementValidationError{
+						field:  fmt.Sprintf("Debts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SettlementValidationError{
+					field:  fmt.Sprintf("Debts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 1024 {
-		err := OutcomeValidationError{
-			field:  "Title",
-			reason: "value length must be between 1 and 1024 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return SettlementMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettlementMultiError is an error wrapping multiple validation errors
+// returned by Settlement.ValidateAll() if the designated constraints aren't met.
+type SettlementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettlementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettlementMultiError) AllErrors() []error { return m }
+
+// SettlementValidationError is the validation error returned by
+// Settlement.Validate if the designated constraints aren't met.
+type SettlementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettlementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettlementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettlementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettlementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettlementValidationError) ErrorName() string { return "SettlementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SettlementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettlement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettlementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettlementValidationError{}
+
+// Validate checks the field values on Debt with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Debt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Debt with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in DebtMultiError, or nil if none found.
+func (m *Debt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Debt) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for User
+
 	// no validation rules for Centipoints
 
 	if len(errors) > 0 {
-		return OutcomeMultiError(errors)
+		return DebtMultiError(errors)
 	}
 
 	return nil
 }
 
-// OutcomeMultiError is an error wrapping multiple validation errors returned
-// by Outcome.ValidateAll() if the designated constraints aren't met.
-type OutcomeMultiError []error
+// DebtMultiError is an error wrapping multiple validation errors returned by
+// Debt.ValidateAll() if the designated constraints aren't met.
+type DebtMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OutcomeMultiError) Error() string {
+func (m DebtMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -708,11 +916,11 @@ func (m OutcomeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OutcomeMultiError) AllErrors() []error { return m }
+func (m DebtMultiError) AllErrors() []error { return m }
 
-// OutcomeValidationError is the validation error returned by Outcome.Validate
-// if the designated constraints aren't met.
-type OutcomeValidationError struct {
+// DebtValidationError is the validation error returned by Debt.Validate if the
+// designated constraints aren't met.
+type DebtValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -720,22 +928,22 @@ type OutcomeValidationError struct {
 }
 
 // Field function returns field value.
-func (e OutcomeValidationError) Field() string { return e.field }
+func (e DebtValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OutcomeValidationError) Reason() string { return e.reason }
+func (e DebtValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OutcomeValidationError) Cause() error { return e.cause }
+func (e DebtValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OutcomeValidationError) Key() bool { return e.key }
+func (e DebtValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OutcomeValidationError) ErrorName() string { return "OutcomeValidationError" }
+func (e DebtValidationError) ErrorName() string { return "DebtValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OutcomeValidationError) Error() string {
+func (e DebtValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -747,14 +955,14 @@ func (e OutcomeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOutcome.%s: %s%s",
+		"invalid %sDebt.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OutcomeValidationError{}
+var _ error = DebtValidationError{}
 
 var _ interface {
 	Field() string
@@ -762,25 +970,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OutcomeValidationError{}
-
-var _Outcome_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/markets/[^/]{36}/outcomes/[^/]{1,2}$")
+} = DebtValidationError{}
 
-// Validate checks the field values on Bet with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Bet) Validate() error {
+// Validate checks the field values on Outcome with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Outcome) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Bet with the rules defined in the
+// ValidateAll checks the field values on Outcome with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in BetMultiError, or nil if none found.
-func (m *Bet) ValidateAll() error {
+// a list of violation errors wrapped in OutcomeMultiError, or nil if none found.
+func (m *Outcome) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Bet) validate(all bool) error {
+func (m *Outcome) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -788,7 +994,7 @@ func (m *Bet) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
-		err := BetValidationError{
+		err := OutcomeValidationError{
 			field:  "Name",
 			reason: "value length must be between 1 and 1024 runes, inclusive",
 		}
@@ -798,10 +1004,145 @@ func (m *Bet) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_Bet_Name_Pattern.MatchString(m.GetName()) {
-		err := BetValidationError{
+	if !_Outcome_Name_Pattern.MatchString(m.GetName()) {
+		err := OutcomeValidationError{
 			field:  "Name",
-			reason: "value does not match regex pattern \"^books/[^/]{1,36}/bets/[^/]{36}$\"",
+			reason: "value does not match regex pattern \"^books/[^/]{1,36}/markets/[^/]{36}/outcomes/[^/]{1,2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 1024 {
+		err := OutcomeValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Centipoints
+
+	if len(errors) > 0 {
+		return OutcomeMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutcomeMultiError is an error wrapping multiple validation errors returned
+// by Outcome.ValidateAll() if the designated constraints aren't met.
+type OutcomeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutcomeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutcomeMultiError) AllErrors() []error { return m }
+
+// OutcomeValidationError is the validation error returned by Outcome.Validate
+// if the designated constraints aren't met.
+type OutcomeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutcomeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutcomeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutcomeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutcomeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutcomeValidationError) ErrorName() string { return "OutcomeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutcomeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutcome.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutcomeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutcomeValidationError{}
+
+var _Outcome_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/markets/[^/]{36}/outcomes/[^/]{1,2}$")
+
+// Validate checks the field values on Bet with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Bet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Bet with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BetMultiError, or nil if none found.
+func (m *Bet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Bet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := BetValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Bet_Name_Pattern.MatchString(m.GetName()) {
+		err := BetValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^books/[^/]{1,36}/bets/[^/]{36}$\"",
 		}
 		if !all {
 			return err
@@ -895,6 +1236,12 @@ func (m *Bet) validate(all bool) error {
 
 	// no validation rules for SettledCentipoints
 
+	// no validation rules for Etag
+
+	// no validation rules for ImpliedProbability
+
+	// no validation rules for Season
+
 	oneofTypePresent := false
 	switch v := m.Type.(type) {
 	case *Bet_Outcome:
@@ -903,40 +1250,11777 @@ func (m *Bet) validate(all bool) error {
 				field:  "Type",
 				reason: "oneof value cannot be a typed-nil",
 			}
-			if !all {
-				return err
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+		// no validation rules for Outcome
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofTypePresent {
+		err := BetValidationError{
+			field:  "Type",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BetMultiError(errors)
+	}
+
+	return nil
+}
+
+// BetMultiError is an error wrapping multiple validation errors returned by
+// Bet.ValidateAll() if the designated constraints aren't met.
+type BetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BetMultiError) AllErrors() []error { return m }
+
+// BetValidationError is the validation error returned by Bet.Validate if the
+// designated constraints aren't met.
+type BetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BetValidationError) ErrorName() string { return "BetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BetValidationError{}
+
+var _Bet_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/bets/[^/]{36}$")
+
+// Validate checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Event) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EventMultiError, or nil if none found.
+func (m *Event) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Event) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	switch v := m.Resource.(type) {
+	case *Event_User:
+		if v == nil {
+			err := EventValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUser()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "User",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_Market:
+		if v == nil {
+			err := EventValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMarket()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "Market",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "Market",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Event_Bet:
+		if v == nil {
+			err := EventValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBet()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "Bet",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventValidationError{
+						field:  "Bet",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return EventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventMultiError is an error wrapping multiple validation errors returned by
+// Event.ValidateAll() if the designated constraints aren't met.
+type EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventMultiError) AllErrors() []error { return m }
+
+// EventValidationError is the validation error returned by Event.Validate if
+// the designated constraints aren't met.
+type EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventValidationError{}
+
+// Validate checks the field values on DomainEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DomainEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DomainEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DomainEventMultiError, or
+// nil if none found.
+func (m *DomainEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DomainEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DomainEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DomainEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DomainEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Book
+
+	switch v := m.Payload.(type) {
+	case *DomainEvent_UserCreated:
+		if v == nil {
+			err := DomainEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUserCreated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "UserCreated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "UserCreated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUserCreated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DomainEventValidationError{
+					field:  "UserCreated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DomainEvent_UserBalanceChanged:
+		if v == nil {
+			err := DomainEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUserBalanceChanged()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "UserBalanceChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "UserBalanceChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUserBalanceChanged()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DomainEventValidationError{
+					field:  "UserBalanceChanged",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DomainEvent_MarketCreated:
+		if v == nil {
+			err := DomainEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMarketCreated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketCreated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketCreated",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMarketCreated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DomainEventValidationError{
+					field:  "MarketCreated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DomainEvent_MarketLocked:
+		if v == nil {
+			err := DomainEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMarketLocked()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketLocked",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketLocked",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMarketLocked()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DomainEventValidationError{
+					field:  "MarketLocked",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DomainEvent_MarketSettled:
+		if v == nil {
+			err := DomainEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMarketSettled()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketSettled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketSettled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMarketSettled()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DomainEventValidationError{
+					field:  "MarketSettled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DomainEvent_MarketCanceled:
+		if v == nil {
+			err := DomainEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMarketCanceled()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketCanceled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketCanceled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMarketCanceled()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DomainEventValidationError{
+					field:  "MarketCanceled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DomainEvent_MarketPoolChanged:
+		if v == nil {
+			err := DomainEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMarketPoolChanged()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketPoolChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "MarketPoolChanged",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMarketPoolChanged()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DomainEventValidationError{
+					field:  "MarketPoolChanged",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DomainEvent_BetPlaced:
+		if v == nil {
+			err := DomainEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBetPlaced()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "BetPlaced",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "BetPlaced",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBetPlaced()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DomainEventValidationError{
+					field:  "BetPlaced",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DomainEvent_BetSettled:
+		if v == nil {
+			err := DomainEventValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBetSettled()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "BetSettled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DomainEventValidationError{
+						field:  "BetSettled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBetSettled()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DomainEventValidationError{
+					field:  "BetSettled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DomainEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// DomainEventMultiError is an error wrapping multiple validation errors
+// returned by DomainEvent.ValidateAll() if the designated constraints aren't met.
+type DomainEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DomainEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DomainEventMultiError) AllErrors() []error { return m }
+
+// DomainEventValidationError is the validation error returned by
+// DomainEvent.Validate if the designated constraints aren't met.
+type DomainEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DomainEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DomainEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DomainEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DomainEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DomainEventValidationError) ErrorName() string { return "DomainEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DomainEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDomainEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DomainEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DomainEventValidationError{}
+
+// Validate checks the field values on UserCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserCreated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserCreatedMultiError, or
+// nil if none found.
+func (m *UserCreated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserCreated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserCreatedValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserCreatedValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserCreatedValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserCreatedMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserCreatedMultiError is an error wrapping multiple validation errors
+// returned by UserCreated.ValidateAll() if the designated constraints aren't met.
+type UserCreatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserCreatedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserCreatedMultiError) AllErrors() []error { return m }
+
+// UserCreatedValidationError is the validation error returned by
+// UserCreated.Validate if the designated constraints aren't met.
+type UserCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserCreatedValidationError) ErrorName() string { return "UserCreatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserCreatedValidationError{}
+
+// Validate checks the field values on UserBalanceChanged with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserBalanceChanged) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserBalanceChanged with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserBalanceChangedMultiError, or nil if none found.
+func (m *UserBalanceChanged) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserBalanceChanged) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserBalanceChangedValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserBalanceChangedValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserBalanceChangedValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DeltaCentipoints
+
+	// no validation rules for DebtDeltaCentipoints
+
+	// no validation rules for Bet
+
+	// no validation rules for Grant
+
+	// no validation rules for Season
+
+	// no validation rules for Transfer
+
+	// no validation rules for Adjustment
+
+	if len(errors) > 0 {
+		return UserBalanceChangedMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserBalanceChangedMultiError is an error wrapping multiple validation errors
+// returned by UserBalanceChanged.ValidateAll() if the designated constraints
+// aren't met.
+type UserBalanceChangedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserBalanceChangedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserBalanceChangedMultiError) AllErrors() []error { return m }
+
+// UserBalanceChangedValidationError is the validation error returned by
+// UserBalanceChanged.Validate if the designated constraints aren't met.
+type UserBalanceChangedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserBalanceChangedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserBalanceChangedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserBalanceChangedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserBalanceChangedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserBalanceChangedValidationError) ErrorName() string {
+	return "UserBalanceChangedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserBalanceChangedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserBalanceChanged.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserBalanceChangedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserBalanceChangedValidationError{}
+
+// Validate checks the field values on MarketCreated with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MarketCreated) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarketCreated with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MarketCreatedMultiError, or
+// nil if none found.
+func (m *MarketCreated) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarketCreated) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MarketCreatedValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MarketCreatedValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MarketCreatedValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MarketCreatedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarketCreatedMultiError is an error wrapping multiple validation errors
+// returned by MarketCreated.ValidateAll() if the designated constraints
+// aren't met.
+type MarketCreatedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarketCreatedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarketCreatedMultiError) AllErrors() []error { return m }
+
+// MarketCreatedValidationError is the validation error returned by
+// MarketCreated.Validate if the designated constraints aren't met.
+type MarketCreatedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarketCreatedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarketCreatedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarketCreatedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarketCreatedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarketCreatedValidationError) ErrorName() string { return "MarketCreatedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarketCreatedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarketCreated.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarketCreatedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarketCreatedValidationError{}
+
+// Validate checks the field values on MarketLocked with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MarketLocked) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarketLocked with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MarketLockedMultiError, or
+// nil if none found.
+func (m *MarketLocked) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarketLocked) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MarketLockedValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MarketLockedValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MarketLockedValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MarketLockedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarketLockedMultiError is an error wrapping multiple validation errors
+// returned by MarketLocked.ValidateAll() if the designated constraints aren't met.
+type MarketLockedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarketLockedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarketLockedMultiError) AllErrors() []error { return m }
+
+// MarketLockedValidationError is the validation error returned by
+// MarketLocked.Validate if the designated constraints aren't met.
+type MarketLockedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarketLockedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarketLockedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarketLockedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarketLockedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarketLockedValidationError) ErrorName() string { return "MarketLockedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarketLockedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarketLocked.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarketLockedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarketLockedValidationError{}
+
+// Validate checks the field values on MarketSettled with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MarketSettled) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarketSettled with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MarketSettledMultiError, or
+// nil if none found.
+func (m *MarketSettled) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarketSettled) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MarketSettledValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MarketSettledValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MarketSettledValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MarketSettledMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarketSettledMultiError is an error wrapping multiple validation errors
+// returned by MarketSettled.ValidateAll() if the designated constraints
+// aren't met.
+type MarketSettledMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarketSettledMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarketSettledMultiError) AllErrors() []error { return m }
+
+// MarketSettledValidationError is the validation error returned by
+// MarketSettled.Validate if the designated constraints aren't met.
+type MarketSettledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarketSettledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarketSettledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarketSettledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarketSettledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarketSettledValidationError) ErrorName() string { return "MarketSettledValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarketSettledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarketSettled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarketSettledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarketSettledValidationError{}
+
+// Validate checks the field values on MarketCanceled with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MarketCanceled) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarketCanceled with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MarketCanceledMultiError,
+// or nil if none found.
+func (m *MarketCanceled) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarketCanceled) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MarketCanceledValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MarketCanceledValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MarketCanceledValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MarketCanceledMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarketCanceledMultiError is an error wrapping multiple validation errors
+// returned by MarketCanceled.ValidateAll() if the designated constraints
+// aren't met.
+type MarketCanceledMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarketCanceledMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarketCanceledMultiError) AllErrors() []error { return m }
+
+// MarketCanceledValidationError is the validation error returned by
+// MarketCanceled.Validate if the designated constraints aren't met.
+type MarketCanceledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarketCanceledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarketCanceledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarketCanceledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarketCanceledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarketCanceledValidationError) ErrorName() string { return "MarketCanceledValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarketCanceledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarketCanceled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarketCanceledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarketCanceledValidationError{}
+
+// Validate checks the field values on MarketPoolChanged with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarketPoolChanged) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarketPoolChanged with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarketPoolChangedMultiError, or nil if none found.
+func (m *MarketPoolChanged) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarketPoolChanged) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MarketPoolChangedValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MarketPoolChangedValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MarketPoolChangedValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Bet
+
+	if len(errors) > 0 {
+		return MarketPoolChangedMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarketPoolChangedMultiError is an error wrapping multiple validation errors
+// returned by MarketPoolChanged.ValidateAll() if the designated constraints
+// aren't met.
+type MarketPoolChangedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarketPoolChangedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarketPoolChangedMultiError) AllErrors() []error { return m }
+
+// MarketPoolChangedValidationError is the validation error returned by
+// MarketPoolChanged.Validate if the designated constraints aren't met.
+type MarketPoolChangedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarketPoolChangedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarketPoolChangedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarketPoolChangedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarketPoolChangedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarketPoolChangedValidationError) ErrorName() string {
+	return "MarketPoolChangedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarketPoolChangedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarketPoolChanged.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarketPoolChangedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarketPoolChangedValidationError{}
+
+// Validate checks the field values on BetPlaced with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BetPlaced) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BetPlaced with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BetPlacedMultiError, or nil
+// if none found.
+func (m *BetPlaced) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BetPlaced) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BetPlacedValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BetPlacedValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BetPlacedValidationError{
+				field:  "Bet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BetPlacedMultiError(errors)
+	}
+
+	return nil
+}
+
+// BetPlacedMultiError is an error wrapping multiple validation errors returned
+// by BetPlaced.ValidateAll() if the designated constraints aren't met.
+type BetPlacedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BetPlacedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BetPlacedMultiError) AllErrors() []error { return m }
+
+// BetPlacedValidationError is the validation error returned by
+// BetPlaced.Validate if the designated constraints aren't met.
+type BetPlacedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BetPlacedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BetPlacedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BetPlacedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BetPlacedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BetPlacedValidationError) ErrorName() string { return "BetPlacedValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BetPlacedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBetPlaced.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BetPlacedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BetPlacedValidationError{}
+
+// Validate checks the field values on BetSettled with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BetSettled) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BetSettled with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BetSettledMultiError, or
+// nil if none found.
+func (m *BetSettled) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BetSettled) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BetSettledValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BetSettledValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BetSettledValidationError{
+				field:  "Bet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BetSettledMultiError(errors)
+	}
+
+	return nil
+}
+
+// BetSettledMultiError is an error wrapping multiple validation errors
+// returned by BetSettled.ValidateAll() if the designated constraints aren't met.
+type BetSettledMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BetSettledMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BetSettledMultiError) AllErrors() []error { return m }
+
+// BetSettledValidationError is the validation error returned by
+// BetSettled.Validate if the designated constraints aren't met.
+type BetSettledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BetSettledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BetSettledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BetSettledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BetSettledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BetSettledValidationError) ErrorName() string { return "BetSettledValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BetSettledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBetSettled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BetSettledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BetSettledValidationError{}
+
+// Validate checks the field values on ConsumerCursor with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConsumerCursor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsumerCursor with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConsumerCursorMultiError,
+// or nil if none found.
+func (m *ConsumerCursor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsumerCursor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Consumer
+
+	// no validation rules for Sequence
+
+	if len(errors) > 0 {
+		return ConsumerCursorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsumerCursorMultiError is an error wrapping multiple validation errors
+// returned by ConsumerCursor.ValidateAll() if the designated constraints
+// aren't met.
+type ConsumerCursorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsumerCursorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsumerCursorMultiError) AllErrors() []error { return m }
+
+// ConsumerCursorValidationError is the validation error returned by
+// ConsumerCursor.Validate if the designated constraints aren't met.
+type ConsumerCursorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsumerCursorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsumerCursorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsumerCursorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsumerCursorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsumerCursorValidationError) ErrorName() string { return "ConsumerCursorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsumerCursorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsumerCursor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsumerCursorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsumerCursorValidationError{}
+
+// Validate checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Webhook) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WebhookMultiError, or nil if none found.
+func (m *Webhook) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Webhook) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := WebhookValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Webhook_Name_Pattern.MatchString(m.GetName()) {
+		err := WebhookValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^books/[^/]{1,36}/webhooks/[^/]{36}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() == nil {
+		err := WebhookValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		err := WebhookValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = WebhookValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := WebhookValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Webhook_Url_Pattern.MatchString(m.GetUrl()) {
+		err := WebhookValidationError{
+			field:  "Url",
+			reason: "value does not match regex pattern \"^https?://\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return WebhookMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookMultiError is an error wrapping multiple validation errors returned
+// by Webhook.ValidateAll() if the designated constraints aren't met.
+type WebhookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookMultiError) AllErrors() []error { return m }
+
+// WebhookValidationError is the validation error returned by Webhook.Validate
+// if the designated constraints aren't met.
+type WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookValidationError) ErrorName() string { return "WebhookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookValidationError{}
+
+var _Webhook_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/webhooks/[^/]{36}$")
+
+var _Webhook_Url_Pattern = regexp.MustCompile("^https?://")
+
+// Validate checks the field values on WebhookEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookEventMultiError, or
+// nil if none found.
+func (m *WebhookEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Resource.(type) {
+	case *WebhookEvent_Market:
+		if v == nil {
+			err := WebhookEventValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMarket()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookEventValidationError{
+						field:  "Market",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookEventValidationError{
+						field:  "Market",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookEventValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *WebhookEvent_Bet:
+		if v == nil {
+			err := WebhookEventValidationError{
+				field:  "Resource",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBet()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookEventValidationError{
+						field:  "Bet",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookEventValidationError{
+						field:  "Bet",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookEventValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return WebhookEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookEventMultiError is an error wrapping multiple validation errors
+// returned by WebhookEvent.ValidateAll() if the designated constraints aren't met.
+type WebhookEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookEventMultiError) AllErrors() []error { return m }
+
+// WebhookEventValidationError is the validation error returned by
+// WebhookEvent.Validate if the designated constraints aren't met.
+type WebhookEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookEventValidationError) ErrorName() string { return "WebhookEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookEventValidationError{}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDelivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDelivery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryMultiError, or nil if none found.
+func (m *WebhookDelivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDelivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Attempts
+
+	if all {
+		switch v := interface{}(m.GetNextAttemptAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextAttemptAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "NextAttemptAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookDeliveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookDeliveryMultiError is an error wrapping multiple validation errors
+// returned by WebhookDelivery.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryValidationError) ErrorName() string { return "WebhookDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on MarketStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MarketStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarketStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MarketStatsMultiError, or
+// nil if none found.
+func (m *MarketStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarketStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Market
+
+	// no validation rules for TotalCentipoints
+
+	// no validation rules for BettorCount
+
+	for idx, item := range m.GetOutcomes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MarketStatsValidationError{
+						field:  fmt.Sprintf("Outcomes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MarketStatsValidationError{
+						field:  fmt.Sprintf("Outcomes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MarketStatsValidationError{
+					field:  fmt.Sprintf("Outcomes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLargestBet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MarketStatsValidationError{
+					field:  "LargestBet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MarketStatsValidationError{
+					field:  "LargestBet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLargestBet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MarketStatsValidationError{
+				field:  "LargestBet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MarketStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarketStatsMultiError is an error wrapping multiple validation errors
+// returned by MarketStats.ValidateAll() if the designated constraints aren't met.
+type MarketStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarketStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarketStatsMultiError) AllErrors() []error { return m }
+
+// MarketStatsValidationError is the validation error returned by
+// MarketStats.Validate if the designated constraints aren't met.
+type MarketStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarketStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarketStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarketStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarketStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarketStatsValidationError) ErrorName() string { return "MarketStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarketStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarketStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarketStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarketStatsValidationError{}
+
+// Validate checks the field values on OutcomeStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutcomeStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutcomeStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutcomeStatsMultiError, or
+// nil if none found.
+func (m *OutcomeStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutcomeStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Outcome
+
+	// no validation rules for Centipoints
+
+	// no validation rules for BettorCount
+
+	// no validation rules for ImpliedProbability
+
+	// no validation rules for PayoutMultiplier
+
+	if len(errors) > 0 {
+		return OutcomeStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutcomeStatsMultiError is an error wrapping multiple validation errors
+// returned by OutcomeStats.ValidateAll() if the designated constraints aren't met.
+type OutcomeStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutcomeStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutcomeStatsMultiError) AllErrors() []error { return m }
+
+// OutcomeStatsValidationError is the validation error returned by
+// OutcomeStats.Validate if the designated constraints aren't met.
+type OutcomeStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutcomeStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutcomeStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutcomeStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutcomeStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutcomeStatsValidationError) ErrorName() string { return "OutcomeStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutcomeStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutcomeStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutcomeStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutcomeStatsValidationError{}
+
+// Validate checks the field values on UserStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserStatsMultiError, or nil
+// if none found.
+func (m *UserStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for MarketsBetOn
+
+	// no validation rules for SettledBets
+
+	// no validation rules for Wins
+
+	// no validation rules for Losses
+
+	// no validation rules for WinRate
+
+	// no validation rules for TotalStakedCentipoints
+
+	// no validation rules for NetProfitCentipoints
+
+	// no validation rules for Roi
+
+	if all {
+		switch v := interface{}(m.GetBiggestWin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserStatsValidationError{
+					field:  "BiggestWin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserStatsValidationError{
+					field:  "BiggestWin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBiggestWin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserStatsValidationError{
+				field:  "BiggestWin",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LongestWinStreak
+
+	for idx, item := range m.GetFavoriteOutcomes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserStatsValidationError{
+						field:  fmt.Sprintf("FavoriteOutcomes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserStatsValidationError{
+						field:  fmt.Sprintf("FavoriteOutcomes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserStatsValidationError{
+					field:  fmt.Sprintf("FavoriteOutcomes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserStatsMultiError is an error wrapping multiple validation errors returned
+// by UserStats.ValidateAll() if the designated constraints aren't met.
+type UserStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserStatsMultiError) AllErrors() []error { return m }
+
+// UserStatsValidationError is the validation error returned by
+// UserStats.Validate if the designated constraints aren't met.
+type UserStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserStatsValidationError) ErrorName() string { return "UserStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserStatsValidationError{}
+
+// Validate checks the field values on OutcomeCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutcomeCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutcomeCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutcomeCountMultiError, or
+// nil if none found.
+func (m *OutcomeCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutcomeCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for BetCount
+
+	if len(errors) > 0 {
+		return OutcomeCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutcomeCountMultiError is an error wrapping multiple validation errors
+// returned by OutcomeCount.ValidateAll() if the designated constraints aren't met.
+type OutcomeCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutcomeCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutcomeCountMultiError) AllErrors() []error { return m }
+
+// OutcomeCountValidationError is the validation error returned by
+// OutcomeCount.Validate if the designated constraints aren't met.
+type OutcomeCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutcomeCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutcomeCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutcomeCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutcomeCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutcomeCountValidationError) ErrorName() string { return "OutcomeCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutcomeCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutcomeCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutcomeCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutcomeCountValidationError{}
+
+// Validate checks the field values on ForecastScore with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ForecastScore) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForecastScore with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ForecastScoreMultiError, or
+// nil if none found.
+func (m *ForecastScore) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForecastScore) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for Forecasts
+
+	// no validation rules for BrierScore
+
+	// no validation rules for LogScore
+
+	for idx, item := range m.GetCalibration() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ForecastScoreValidationError{
+						field:  fmt.Sprintf("Calibration[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ForecastScoreValidationError{
+						field:  fmt.Sprintf("Calibration[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ForecastScoreValidationError{
+					field:  fmt.Sprintf("Calibration[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ForecastScoreMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForecastScoreMultiError is an error wrapping multiple validation errors
+// returned by ForecastScore.ValidateAll() if the designated constraints
+// aren't met.
+type ForecastScoreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForecastScoreMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForecastScoreMultiError) AllErrors() []error { return m }
+
+// ForecastScoreValidationError is the validation error returned by
+// ForecastScore.Validate if the designated constraints aren't met.
+type ForecastScoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForecastScoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForecastScoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForecastScoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForecastScoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForecastScoreValidationError) ErrorName() string { return "ForecastScoreValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ForecastScoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForecastScore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForecastScoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForecastScoreValidationError{}
+
+// Validate checks the field values on CalibrationBin with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CalibrationBin) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalibrationBin with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CalibrationBinMultiError,
+// or nil if none found.
+func (m *CalibrationBin) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalibrationBin) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LowerBound
+
+	// no validation rules for UpperBound
+
+	// no validation rules for Forecasts
+
+	// no validation rules for MeanImpliedProbability
+
+	// no validation rules for ObservedFrequency
+
+	if len(errors) > 0 {
+		return CalibrationBinMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalibrationBinMultiError is an error wrapping multiple validation errors
+// returned by CalibrationBin.ValidateAll() if the designated constraints
+// aren't met.
+type CalibrationBinMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalibrationBinMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalibrationBinMultiError) AllErrors() []error { return m }
+
+// CalibrationBinValidationError is the validation error returned by
+// CalibrationBin.Validate if the designated constraints aren't met.
+type CalibrationBinValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalibrationBinValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalibrationBinValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalibrationBinValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalibrationBinValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalibrationBinValidationError) ErrorName() string { return "CalibrationBinValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CalibrationBinValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalibrationBin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalibrationBinValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalibrationBinValidationError{}
+
+// Validate checks the field values on OddsSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OddsSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OddsSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OddsSnapshotMultiError, or
+// nil if none found.
+func (m *OddsSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OddsSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Market
+
+	// no validation rules for Revision
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OddsSnapshotValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OddsSnapshotValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OddsSnapshotValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TotalCentipoints
+
+	for idx, item := range m.GetOutcomes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OddsSnapshotValidationError{
+						field:  fmt.Sprintf("Outcomes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OddsSnapshotValidationError{
+						field:  fmt.Sprintf("Outcomes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OddsSnapshotValidationError{
+					field:  fmt.Sprintf("Outcomes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OddsSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// OddsSnapshotMultiError is an error wrapping multiple validation errors
+// returned by OddsSnapshot.ValidateAll() if the designated constraints aren't met.
+type OddsSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OddsSnapshotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OddsSnapshotMultiError) AllErrors() []error { return m }
+
+// OddsSnapshotValidationError is the validation error returned by
+// OddsSnapshot.Validate if the designated constraints aren't met.
+type OddsSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OddsSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OddsSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OddsSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OddsSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OddsSnapshotValidationError) ErrorName() string { return "OddsSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OddsSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOddsSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OddsSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OddsSnapshotValidationError{}
+
+// Validate checks the field values on OutcomeOdds with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutcomeOdds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutcomeOdds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutcomeOddsMultiError, or
+// nil if none found.
+func (m *OutcomeOdds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutcomeOdds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Outcome
+
+	// no validation rules for Centipoints
+
+	// no validation rules for ImpliedProbability
+
+	if len(errors) > 0 {
+		return OutcomeOddsMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutcomeOddsMultiError is an error wrapping multiple validation errors
+// returned by OutcomeOdds.ValidateAll() if the designated constraints aren't met.
+type OutcomeOddsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutcomeOddsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutcomeOddsMultiError) AllErrors() []error { return m }
+
+// OutcomeOddsValidationError is the validation error returned by
+// OutcomeOdds.Validate if the designated constraints aren't met.
+type OutcomeOddsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutcomeOddsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutcomeOddsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutcomeOddsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutcomeOddsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutcomeOddsValidationError) ErrorName() string { return "OutcomeOddsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutcomeOddsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutcomeOdds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutcomeOddsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutcomeOddsValidationError{}
+
+// Validate checks the field values on BookSettings with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookSettingsMultiError, or
+// nil if none found.
+func (m *BookSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := BookSettingsValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BookSettings_Name_Pattern.MatchString(m.GetName()) {
+		err := BookSettingsValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^books/[^/]{1,36}/settings$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookSettingsValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookSettingsValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookSettingsValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AllowanceCentipoints
+
+	if d := m.GetAllowanceCooldown(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = BookSettingsValidationError{
+				field:  "AllowanceCooldown",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := BookSettingsValidationError{
+					field:  "AllowanceCooldown",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for BailoutCentipoints
+
+	if len(errors) > 0 {
+		return BookSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookSettingsMultiError is an error wrapping multiple validation errors
+// returned by BookSettings.ValidateAll() if the designated constraints aren't met.
+type BookSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookSettingsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookSettingsMultiError) AllErrors() []error { return m }
+
+// BookSettingsValidationError is the validation error returned by
+// BookSettings.Validate if the designated constraints aren't met.
+type BookSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookSettingsValidationError) ErrorName() string { return "BookSettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookSettingsValidationError{}
+
+var _BookSettings_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/settings$")
+
+// Validate checks the field values on Grant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Grant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Grant with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GrantMultiError, or nil if none found.
+func (m *Grant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Grant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := GrantValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Grant_Name_Pattern.MatchString(m.GetName()) {
+		err := GrantValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^books/[^/]{1,36}/grants/[^/]{36}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() == nil {
+		err := GrantValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUser()) < 1 {
+		err := GrantValidationError{
+			field:  "User",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCentipoints() <= 0 {
+		err := GrantValidationError{
+			field:  "Centipoints",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Grant_Reason_NotInLookup[m.GetReason()]; ok {
+		err := GrantValidationError{
+			field:  "Reason",
+			reason: "value must not be in list [REASON_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Grant_Reason_name[int32(m.GetReason())]; !ok {
+		err := GrantValidationError{
+			field:  "Reason",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DebtRepaidCentipoints
+
+	if len(errors) > 0 {
+		return GrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// GrantMultiError is an error wrapping multiple validation errors returned by
+// Grant.ValidateAll() if the designated constraints aren't met.
+type GrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantMultiError) AllErrors() []error { return m }
+
+// GrantValidationError is the validation error returned by Grant.Validate if
+// the designated constraints aren't met.
+type GrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantValidationError) ErrorName() string { return "GrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantValidationError{}
+
+var _Grant_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/grants/[^/]{36}$")
+
+var _Grant_Reason_NotInLookup = map[Grant_Reason]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Transfer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Transfer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Transfer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransferMultiError, or nil
+// if none found.
+func (m *Transfer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Transfer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := TransferValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Transfer_Name_Pattern.MatchString(m.GetName()) {
+		err := TransferValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^books/[^/]{1,36}/transfers/[^/]{36}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() == nil {
+		err := TransferValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFromUser()) < 1 {
+		err := TransferValidationError{
+			field:  "FromUser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetToUser()) < 1 {
+		err := TransferValidationError{
+			field:  "ToUser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCentipoints() <= 0 {
+		err := TransferValidationError{
+			field:  "Centipoints",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMemo()) > 256 {
+		err := TransferValidationError{
+			field:  "Memo",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DebtRepaidCentipoints
+
+	if len(errors) > 0 {
+		return TransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferMultiError is an error wrapping multiple validation errors returned
+// by Transfer.ValidateAll() if the designated constraints aren't met.
+type TransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferMultiError) AllErrors() []error { return m }
+
+// TransferValidationError is the validation error returned by
+// Transfer.Validate if the designated constraints aren't met.
+type TransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferValidationError) ErrorName() string { return "TransferValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferValidationError{}
+
+var _Transfer_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/transfers/[^/]{36}$")
+
+// Validate checks the field values on Adjustment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Adjustment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Adjustment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdjustmentMultiError, or
+// nil if none found.
+func (m *Adjustment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Adjustment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := AdjustmentValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Adjustment_Name_Pattern.MatchString(m.GetName()) {
+		err := AdjustmentValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^books/[^/]{1,36}/adjustments/[^/]{36}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedAt() == nil {
+		err := AdjustmentValidationError{
+			field:  "CreatedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUser()) < 1 {
+		err := AdjustmentValidationError{
+			field:  "User",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetActor()) < 1 {
+		err := AdjustmentValidationError{
+			field:  "Actor",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _Adjustment_DeltaCentipoints_NotInLookup[m.GetDeltaCentipoints()]; ok {
+		err := AdjustmentValidationError{
+			field:  "DeltaCentipoints",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 256 {
+		err := AdjustmentValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for BalanceDeltaCentipoints
+
+	// no validation rules for DebtRepaidCentipoints
+
+	if len(errors) > 0 {
+		return AdjustmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustmentMultiError is an error wrapping multiple validation errors
+// returned by Adjustment.ValidateAll() if the designated constraints aren't met.
+type AdjustmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustmentMultiError) AllErrors() []error { return m }
+
+// AdjustmentValidationError is the validation error returned by
+// Adjustment.Validate if the designated constraints aren't met.
+type AdjustmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustmentValidationError) ErrorName() string { return "AdjustmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdjustmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustmentValidationError{}
+
+var _Adjustment_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/adjustments/[^/]{36}$")
+
+var _Adjustment_DeltaCentipoints_NotInLookup = map[int64]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Season with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Season) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Season with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SeasonMultiError, or nil if none found.
+func (m *Season) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Season) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 1024 {
+		err := SeasonValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Season_Name_Pattern.MatchString(m.GetName()) {
+		err := SeasonValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^books/[^/]{1,36}/seasons/[1-9][0-9]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Number
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeasonValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeasonValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeasonValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StartingCentipoints
+
+	// no validation rules for OpenBetPolicy
+
+	for idx, item := range m.GetStandings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SeasonValidationError{
+						field:  fmt.Sprintf("Standings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SeasonValidationError{
+						field:  fmt.Sprintf("Standings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SeasonValidationError{
+					field:  fmt.Sprintf("Standings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetResets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SeasonValidationError{
+						field:  fmt.Sprintf("Resets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SeasonValidationError{
+						field:  fmt.Sprintf("Resets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SeasonValidationError{
+					field:  fmt.Sprintf("Resets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SeasonMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeasonMultiError is an error wrapping multiple validation errors returned by
+// Season.ValidateAll() if the designated constraints aren't met.
+type SeasonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeasonMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeasonMultiError) AllErrors() []error { return m }
+
+// SeasonValidationError is the validation error returned by Season.Validate if
+// the designated constraints aren't met.
+type SeasonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeasonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeasonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeasonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeasonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeasonValidationError) ErrorName() string { return "SeasonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeasonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeason.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeasonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeasonValidationError{}
+
+var _Season_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/seasons/[1-9][0-9]*$")
+
+// Validate checks the field values on Standing with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Standing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Standing with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StandingMultiError, or nil
+// if none found.
+func (m *Standing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Standing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for Username
+
+	// no validation rules for TotalCentipoints
+
+	// no validation rules for Rank
+
+	if len(errors) > 0 {
+		return StandingMultiError(errors)
+	}
+
+	return nil
+}
+
+// StandingMultiError is an error wrapping multiple validation errors returned
+// by Standing.ValidateAll() if the designated constraints aren't met.
+type StandingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StandingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StandingMultiError) AllErrors() []error { return m }
+
+// StandingValidationError is the validation error returned by
+// Standing.Validate if the designated constraints aren't met.
+type StandingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StandingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StandingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StandingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StandingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StandingValidationError) ErrorName() string { return "StandingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StandingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStanding.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StandingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StandingValidationError{}
+
+// Validate checks the field values on SeasonReset with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SeasonReset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeasonReset with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SeasonResetMultiError, or
+// nil if none found.
+func (m *SeasonReset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeasonReset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for DeltaCentipoints
+
+	if len(errors) > 0 {
+		return SeasonResetMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeasonResetMultiError is an error wrapping multiple validation errors
+// returned by SeasonReset.ValidateAll() if the designated constraints aren't met.
+type SeasonResetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeasonResetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeasonResetMultiError) AllErrors() []error { return m }
+
+// SeasonResetValidationError is the validation error returned by
+// SeasonReset.Validate if the designated constraints aren't met.
+type SeasonResetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeasonResetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeasonResetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeasonResetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeasonResetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeasonResetValidationError) ErrorName() string { return "SeasonResetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeasonResetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeasonReset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeasonResetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeasonResetValidationError{}
+
+// Validate checks the field values on BalancePoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BalancePoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BalancePoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BalancePointMultiError, or
+// nil if none found.
+func (m *BalancePoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BalancePoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BalancePointValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BalancePointValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BalancePointValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TotalCentipoints
+
+	if len(errors) > 0 {
+		return BalancePointMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalancePointMultiError is an error wrapping multiple validation errors
+// returned by BalancePoint.ValidateAll() if the designated constraints aren't met.
+type BalancePointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalancePointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalancePointMultiError) AllErrors() []error { return m }
+
+// BalancePointValidationError is the validation error returned by
+// BalancePoint.Validate if the designated constraints aren't met.
+type BalancePointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalancePointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalancePointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalancePointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalancePointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalancePointValidationError) ErrorName() string { return "BalancePointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BalancePointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalancePoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalancePointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalancePointValidationError{}
+
+// Validate checks the field values on CreateUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUserRequestMultiError, or nil if none found.
+func (m *CreateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Book
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUserRequestValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUserRequestValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUserRequestValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUserRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserRequestMultiError) AllErrors() []error { return m }
+
+// CreateUserRequestValidationError is the validation error returned by
+// CreateUserRequest.Validate if the designated constraints aren't met.
+type CreateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUserRequestValidationError) ErrorName() string {
+	return "CreateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUserRequestValidationError{}
+
+// Validate checks the field values on CreateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUserResponseMultiError, or nil if none found.
+func (m *CreateUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUserResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserResponseMultiError) AllErrors() []error { return m }
+
+// CreateUserResponseValidationError is the validation error returned by
+// CreateUserResponse.Validate if the designated constraints aren't met.
+type CreateUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUserResponseValidationError) ErrorName() string {
+	return "CreateUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUserResponseValidationError{}
+
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetUserRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
+
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRequestValidationError{}
+
+// Validate checks the field values on GetUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserResponseMultiError, or nil if none found.
+func (m *GetUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserResponseMultiError) AllErrors() []error { return m }
+
+// GetUserResponseValidationError is the validation error returned by
+// GetUserResponse.Validate if the designated constraints aren't met.
+type GetUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserResponseValidationError{}
+
+// Validate checks the field values on GetUserByUsernameRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserByUsernameRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserByUsernameRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserByUsernameRequestMultiError, or nil if none found.
+func (m *GetUserByUsernameRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserByUsernameRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := GetUserByUsernameRequestValidationError{
+			field:  "Book",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := GetUserByUsernameRequestValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserByUsernameRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserByUsernameRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserByUsernameRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserByUsernameRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserByUsernameRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserByUsernameRequestMultiError) AllErrors() []error { return m }
+
+// GetUserByUsernameRequestValidationError is the validation error returned by
+// GetUserByUsernameRequest.Validate if the designated constraints aren't met.
+type GetUserByUsernameRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserByUsernameRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserByUsernameRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserByUsernameRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserByUsernameRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserByUsernameRequestValidationError) ErrorName() string {
+	return "GetUserByUsernameRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserByUsernameRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserByUsernameRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserByUsernameRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserByUsernameRequestValidationError{}
+
+// Validate checks the field values on GetUserByUsernameResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserByUsernameResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserByUsernameResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserByUsernameResponseMultiError, or nil if none found.
+func (m *GetUserByUsernameResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserByUsernameResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserByUsernameResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserByUsernameResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserByUsernameResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserByUsernameResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserByUsernameResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserByUsernameResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetUserByUsernameResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserByUsernameResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserByUsernameResponseMultiError) AllErrors() []error { return m }
+
+// GetUserByUsernameResponseValidationError is the validation error returned by
+// GetUserByUsernameResponse.Validate if the designated constraints aren't met.
+type GetUserByUsernameResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserByUsernameResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserByUsernameResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserByUsernameResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserByUsernameResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserByUsernameResponseValidationError) ErrorName() string {
+	return "GetUserByUsernameResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserByUsernameResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserByUsernameResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserByUsernameResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserByUsernameResponseValidationError{}
+
+// Validate checks the field values on ListUsersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersRequestMultiError, or nil if none found.
+func (m *ListUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() > 50 {
+		err := ListUsersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := ListUsersRequestValidationError{
+			field:  "Book",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListUsersRequest_OrderBy_InLookup[m.GetOrderBy()]; !ok {
+		err := ListUsersRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be in list [ name total_centipoints]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListUsersRequestValidationError is the validation error returned by
+// ListUsersRequest.Validate if the designated constraints aren't met.
+type ListUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersRequestValidationError) ErrorName() string { return "ListUsersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersRequestValidationError{}
+
+var _ListUsersRequest_OrderBy_InLookup = map[string]struct{}{
+	"":                  {},
+	"name":              {},
+	"total_centipoints": {},
+}
+
+// Validate checks the field values on ListUsersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersResponseMultiError, or nil if none found.
+func (m *ListUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListUsersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListUsersResponseValidationError is the validation error returned by
+// ListUsersResponse.Validate if the designated constraints aren't met.
+type ListUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersResponseValidationError) ErrorName() string {
+	return "ListUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersResponseValidationError{}
+
+// Validate checks the field values on CreateMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMarketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMarketRequestMultiError, or nil if none found.
+func (m *CreateMarketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMarketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Book
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMarketRequestValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMarketRequestValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMarketRequestValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMarketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMarketRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateMarketRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMarketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMarketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMarketRequestMultiError) AllErrors() []error { return m }
+
+// CreateMarketRequestValidationError is the validation error returned by
+// CreateMarketRequest.Validate if the designated constraints aren't met.
+type CreateMarketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMarketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMarketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMarketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMarketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMarketRequestValidationError) ErrorName() string {
+	return "CreateMarketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMarketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMarketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMarketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMarketRequestValidationError{}
+
+// Validate checks the field values on CreateMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMarketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMarketResponseMultiError, or nil if none found.
+func (m *CreateMarketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMarketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMarketResponseValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMarketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMarketResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateMarketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMarketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMarketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMarketResponseMultiError) AllErrors() []error { return m }
+
+// CreateMarketResponseValidationError is the validation error returned by
+// CreateMarketResponse.Validate if the designated constraints aren't met.
+type CreateMarketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMarketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMarketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMarketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMarketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMarketResponseValidationError) ErrorName() string {
+	return "CreateMarketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMarketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMarketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMarketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMarketResponseValidationError{}
+
+// Validate checks the field values on GetMarketRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetMarketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMarketRequestMultiError, or nil if none found.
+func (m *GetMarketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMarketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetMarketRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMarketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMarketRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMarketRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMarketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMarketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMarketRequestMultiError) AllErrors() []error { return m }
+
+// GetMarketRequestValidationError is the validation error returned by
+// GetMarketRequest.Validate if the designated constraints aren't met.
+type GetMarketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMarketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMarketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMarketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMarketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMarketRequestValidationError) ErrorName() string { return "GetMarketRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetMarketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMarketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMarketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMarketRequestValidationError{}
+
+// Validate checks the field values on GetMarketResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetMarketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMarketResponseMultiError, or nil if none found.
+func (m *GetMarketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMarketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMarketResponseValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetMarketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMarketResponseMultiError is an error wrapping multiple validation errors
+// returned by GetMarketResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetMarketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMarketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMarketResponseMultiError) AllErrors() []error { return m }
+
+// GetMarketResponseValidationError is the validation error returned by
+// GetMarketResponse.Validate if the designated constraints aren't met.
+type GetMarketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMarketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMarketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMarketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMarketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMarketResponseValidationError) ErrorName() string {
+	return "GetMarketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMarketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMarketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMarketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMarketResponseValidationError{}
+
+// Validate checks the field values on ListMarketsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMarketsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMarketsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMarketsRequestMultiError, or nil if none found.
+func (m *ListMarketsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMarketsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() > 50 {
+		err := ListMarketsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := ListMarketsRequestValidationError{
+			field:  "Book",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for Filter
+
+	if _, ok := _ListMarketsRequest_OrderBy_InLookup[m.GetOrderBy()]; !ok {
+		err := ListMarketsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be in list [ name create_time desc total_centipoints desc settled_time desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListMarketsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMarketsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListMarketsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListMarketsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMarketsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMarketsRequestMultiError) AllErrors() []error { return m }
+
+// ListMarketsRequestValidationError is the validation error returned by
+// ListMarketsRequest.Validate if the designated constraints aren't met.
+type ListMarketsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMarketsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMarketsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMarketsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMarketsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMarketsRequestValidationError) ErrorName() string {
+	return "ListMarketsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMarketsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMarketsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMarketsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMarketsRequestValidationError{}
+
+var _ListMarketsRequest_OrderBy_InLookup = map[string]struct{}{
+	"":                       {},
+	"name":                   {},
+	"create_time desc":       {},
+	"total_centipoints desc": {},
+	"settled_time desc":      {},
+}
+
+// Validate checks the field values on ListMarketsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMarketsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMarketsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMarketsResponseMultiError, or nil if none found.
+func (m *ListMarketsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMarketsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMarkets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMarketsResponseValidationError{
+						field:  fmt.Sprintf("Markets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMarketsResponseValidationError{
+						field:  fmt.Sprintf("Markets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMarketsResponseValidationError{
+					field:  fmt.Sprintf("Markets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListMarketsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMarketsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMarketsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMarketsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMarketsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMarketsResponseMultiError) AllErrors() []error { return m }
+
+// ListMarketsResponseValidationError is the validation error returned by
+// ListMarketsResponse.Validate if the designated constraints aren't met.
+type ListMarketsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMarketsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMarketsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMarketsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMarketsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMarketsResponseValidationError) ErrorName() string {
+	return "ListMarketsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMarketsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMarketsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMarketsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMarketsResponseValidationError{}
+
+// Validate checks the field values on LockMarketRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LockMarketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LockMarketRequestMultiError, or nil if none found.
+func (m *LockMarketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockMarketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := LockMarketRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return LockMarketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LockMarketRequestMultiError is an error wrapping multiple validation errors
+// returned by LockMarketRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LockMarketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockMarketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockMarketRequestMultiError) AllErrors() []error { return m }
+
+// LockMarketRequestValidationError is the validation error returned by
+// LockMarketRequest.Validate if the designated constraints aren't met.
+type LockMarketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockMarketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockMarketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockMarketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockMarketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockMarketRequestValidationError) ErrorName() string {
+	return "LockMarketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LockMarketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLockMarketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LockMarketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LockMarketRequestValidationError{}
+
+// Validate checks the field values on LockMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LockMarketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LockMarketResponseMultiError, or nil if none found.
+func (m *LockMarketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockMarketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LockMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LockMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LockMarketResponseValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LockMarketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LockMarketResponseMultiError is an error wrapping multiple validation errors
+// returned by LockMarketResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LockMarketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockMarketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockMarketResponseMultiError) AllErrors() []error { return m }
+
+// LockMarketResponseValidationError is the validation error returned by
+// LockMarketResponse.Validate if the designated constraints aren't met.
+type LockMarketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockMarketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockMarketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockMarketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockMarketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockMarketResponseValidationError) ErrorName() string {
+	return "LockMarketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LockMarketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLockMarketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LockMarketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LockMarketResponseValidationError{}
+
+// Validate checks the field values on SettleMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SettleMarketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettleMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettleMarketRequestMultiError, or nil if none found.
+func (m *SettleMarketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettleMarketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := SettleMarketRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Etag
+
+	oneofTypePresent := false
+	switch v := m.Type.(type) {
+	case *SettleMarketRequest_Winner:
+		if v == nil {
+			err := SettleMarketRequestValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+		// no validation rules for Winner
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofTypePresent {
+		err := SettleMarketRequestValidationError{
+			field:  "Type",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SettleMarketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettleMarketRequestMultiError is an error wrapping multiple validation
+// errors returned by SettleMarketRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SettleMarketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettleMarketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettleMarketRequestMultiError) AllErrors() []error { return m }
+
+// SettleMarketRequestValidationError is the validation error returned by
+// SettleMarketRequest.Validate if the designated constraints aren't met.
+type SettleMarketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettleMarketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettleMarketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettleMarketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettleMarketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettleMarketRequestValidationError) ErrorName() string {
+	return "SettleMarketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettleMarketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettleMarketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettleMarketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettleMarketRequestValidationError{}
+
+// Validate checks the field values on SettleMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SettleMarketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettleMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettleMarketResponseMultiError, or nil if none found.
+func (m *SettleMarketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettleMarketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettleMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettleMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettleMarketResponseValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SettleMarketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettleMarketResponseMultiError is an error wrapping multiple validation
+// errors returned by SettleMarketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SettleMarketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettleMarketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettleMarketResponseMultiError) AllErrors() []error { return m }
+
+// SettleMarketResponseValidationError is the validation error returned by
+// SettleMarketResponse.Validate if the designated constraints aren't met.
+type SettleMarketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettleMarketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettleMarketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettleMarketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettleMarketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettleMarketResponseValidationError) ErrorName() string {
+	return "SettleMarketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettleMarketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettleMarketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettleMarketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettleMarketResponseValidationError{}
+
+// Validate checks the field values on ResettleMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResettleMarketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResettleMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResettleMarketRequestMultiError, or nil if none found.
+func (m *ResettleMarketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResettleMarketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ResettleMarketRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetWinner()) < 1 {
+		err := ResettleMarketRequestValidationError{
+			field:  "Winner",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetActor()) < 1 {
+		err := ResettleMarketRequestValidationError{
+			field:  "Actor",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 256 {
+		err := ResettleMarketRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return ResettleMarketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResettleMarketRequestMultiError is an error wrapping multiple validation
+// errors returned by ResettleMarketRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResettleMarketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResettleMarketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResettleMarketRequestMultiError) AllErrors() []error { return m }
+
+// ResettleMarketRequestValidationError is the validation error returned by
+// ResettleMarketRequest.Validate if the designated constraints aren't met.
+type ResettleMarketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResettleMarketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResettleMarketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResettleMarketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResettleMarketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResettleMarketRequestValidationError) ErrorName() string {
+	return "ResettleMarketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResettleMarketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResettleMarketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResettleMarketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResettleMarketRequestValidationError{}
+
+// Validate checks the field values on ResettleMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResettleMarketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResettleMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResettleMarketResponseMultiError, or nil if none found.
+func (m *ResettleMarketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResettleMarketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResettleMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResettleMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResettleMarketResponseValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResettleMarketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResettleMarketResponseMultiError is an error wrapping multiple validation
+// errors returned by ResettleMarketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResettleMarketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResettleMarketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResettleMarketResponseMultiError) AllErrors() []error { return m }
+
+// ResettleMarketResponseValidationError is the validation error returned by
+// ResettleMarketResponse.Validate if the designated constraints aren't met.
+type ResettleMarketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResettleMarketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResettleMarketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResettleMarketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResettleMarketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResettleMarketResponseValidationError) ErrorName() string {
+	return "ResettleMarketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResettleMarketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResettleMarketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResettleMarketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResettleMarketResponseValidationError{}
+
+// Validate checks the field values on CancelMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelMarketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelMarketRequestMultiError, or nil if none found.
+func (m *CancelMarketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelMarketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CancelMarketRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return CancelMarketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelMarketRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelMarketRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelMarketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelMarketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelMarketRequestMultiError) AllErrors() []error { return m }
+
+// CancelMarketRequestValidationError is the validation error returned by
+// CancelMarketRequest.Validate if the designated constraints aren't met.
+type CancelMarketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelMarketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelMarketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelMarketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelMarketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelMarketRequestValidationError) ErrorName() string {
+	return "CancelMarketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelMarketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelMarketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelMarketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelMarketRequestValidationError{}
+
+// Validate checks the field values on CancelMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelMarketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelMarketResponseMultiError, or nil if none found.
+func (m *CancelMarketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelMarketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMarket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelMarketResponseValidationError{
+					field:  "Market",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelMarketResponseValidationError{
+				field:  "Market",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelMarketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelMarketResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelMarketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelMarketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelMarketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelMarketResponseMultiError) AllErrors() []error { return m }
+
+// CancelMarketResponseValidationError is the validation error returned by
+// CancelMarketResponse.Validate if the designated constraints aren't met.
+type CancelMarketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelMarketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelMarketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelMarketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelMarketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelMarketResponseValidationError) ErrorName() string {
+	return "CancelMarketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelMarketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelMarketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelMarketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelMarketResponseValidationError{}
+
+// Validate checks the field values on CreateBetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateBetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBetRequestMultiError, or nil if none found.
+func (m *CreateBetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Book
+
+	if all {
+		switch v := interface{}(m.GetBet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBetRequestValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBetRequestValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBetRequestValidationError{
+				field:  "Bet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBetRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateBetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateBetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBetRequestMultiError) AllErrors() []error { return m }
+
+// CreateBetRequestValidationError is the validation error returned by
+// CreateBetRequest.Validate if the designated constraints aren't met.
+type CreateBetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBetRequestValidationError) ErrorName() string { return "CreateBetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateBetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBetRequestValidationError{}
+
+// Validate checks the field values on CreateBetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateBetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBetResponseMultiError, or nil if none found.
+func (m *CreateBetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBetResponseValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBetResponseValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBetResponseValidationError{
+				field:  "Bet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBetResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateBetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateBetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBetResponseMultiError) AllErrors() []error { return m }
+
+// CreateBetResponseValidationError is the validation error returned by
+// CreateBetResponse.Validate if the designated constraints aren't met.
+type CreateBetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBetResponseValidationError) ErrorName() string {
+	return "CreateBetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBetResponseValidationError{}
+
+// Validate checks the field values on GetBetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetBetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetBetRequestMultiError, or
+// nil if none found.
+func (m *GetBetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBet()) < 1 {
+		err := GetBetRequestValidationError{
+			field:  "Bet",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBetRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBetRequestMultiError) AllErrors() []error { return m }
+
+// GetBetRequestValidationError is the validation error returned by
+// GetBetRequest.Validate if the designated constraints aren't met.
+type GetBetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBetRequestValidationError) ErrorName() string { return "GetBetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBetRequestValidationError{}
+
+// Validate checks the field values on GetBetResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetBetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetBetResponseMultiError,
+// or nil if none found.
+func (m *GetBetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBetResponseValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBetResponseValidationError{
+					field:  "Bet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBetResponseValidationError{
+				field:  "Bet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBetResponseMultiError is an error wrapping multiple validation errors
+// returned by GetBetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetBetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBetResponseMultiError) AllErrors() []error { return m }
+
+// GetBetResponseValidationError is the validation error returned by
+// GetBetResponse.Validate if the designated constraints aren't met.
+type GetBetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBetResponseValidationError) ErrorName() string { return "GetBetResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBetResponseValidationError{}
+
+// Validate checks the field values on ListBetsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListBetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBetsRequestMultiError, or nil if none found.
+func (m *ListBetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() > 50 {
+		err := ListBetsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := ListBetsRequestValidationError{
+			field:  "Book",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for User
+
+	// no validation rules for Market
+
+	// no validation rules for ExcludeSettled
+
+	// no validation rules for Filter
+
+	if _, ok := _ListBetsRequest_OrderBy_InLookup[m.GetOrderBy()]; !ok {
+		err := ListBetsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be in list [ name create_time desc total_centipoints desc settled_time desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListBetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBetsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBetsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListBetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBetsRequestMultiError) AllErrors() []error { return m }
+
+// ListBetsRequestValidationError is the validation error returned by
+// ListBetsRequest.Validate if the designated constraints aren't met.
+type ListBetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBetsRequestValidationError) ErrorName() string { return "ListBetsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListBetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBetsRequestValidationError{}
+
+var _ListBetsRequest_OrderBy_InLookup = map[string]struct{}{
+	"":                       {},
+	"name":                   {},
+	"create_time desc":       {},
+	"total_centipoints desc": {},
+	"settled_time desc":      {},
+}
+
+// Validate checks the field values on ListBetsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListBetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBetsResponseMultiError, or nil if none found.
+func (m *ListBetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBetsResponseValidationError{
+						field:  fmt.Sprintf("Bets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBetsResponseValidationError{
+						field:  fmt.Sprintf("Bets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBetsResponseValidationError{
+					field:  fmt.Sprintf("Bets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBetsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListBetsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListBetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBetsResponseMultiError) AllErrors() []error { return m }
+
+// ListBetsResponseValidationError is the validation error returned by
+// ListBetsResponse.Validate if the designated constraints aren't met.
+type ListBetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBetsResponseValidationError) ErrorName() string { return "ListBetsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListBetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBetsResponseValidationError{}
+
+// Validate checks the field values on WatchMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchMarketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchMarketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchMarketRequestMultiError, or nil if none found.
+func (m *WatchMarketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchMarketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := WatchMarketRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchMarketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchMarketRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchMarketRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchMarketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchMarketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchMarketRequestMultiError) AllErrors() []error { return m }
+
+// WatchMarketRequestValidationError is the validation error returned by
+// WatchMarketRequest.Validate if the designated constraints aren't met.
+type WatchMarketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchMarketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchMarketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchMarketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchMarketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchMarketRequestValidationError) ErrorName() string {
+	return "WatchMarketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchMarketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchMarketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchMarketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchMarketRequestValidationError{}
+
+// Validate checks the field values on WatchMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchMarketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchMarketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchMarketResponseMultiError, or nil if none found.
+func (m *WatchMarketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchMarketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchMarketResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchMarketResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchMarketResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchMarketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchMarketResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchMarketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchMarketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchMarketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchMarketResponseMultiError) AllErrors() []error { return m }
+
+// WatchMarketResponseValidationError is the validation error returned by
+// WatchMarketResponse.Validate if the designated constraints aren't met.
+type WatchMarketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchMarketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchMarketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchMarketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchMarketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchMarketResponseValidationError) ErrorName() string {
+	return "WatchMarketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchMarketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchMarketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchMarketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchMarketResponseValidationError{}
+
+// Validate checks the field values on WatchBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchBookRequestMultiError, or nil if none found.
+func (m *WatchBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := WatchBookRequestValidationError{
+			field:  "Book",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchBookRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchBookRequestMultiError) AllErrors() []error { return m }
+
+// WatchBookRequestValidationError is the validation error returned by
+// WatchBookRequest.Validate if the designated constraints aren't met.
+type WatchBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchBookRequestValidationError) ErrorName() string { return "WatchBookRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchBookRequestValidationError{}
+
+// Validate checks the field values on WatchBookResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchBookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchBookResponseMultiError, or nil if none found.
+func (m *WatchBookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchBookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchBookResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchBookResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchBookResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchBookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchBookResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchBookResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchBookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchBookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchBookResponseMultiError) AllErrors() []error { return m }
+
+// WatchBookResponseValidationError is the validation error returned by
+// WatchBookResponse.Validate if the designated constraints aren't met.
+type WatchBookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchBookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchBookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchBookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchBookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchBookResponseValidationError) ErrorName() string {
+	return "WatchBookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchBookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchBookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchBookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchBookResponseValidationError{}
+
+// Validate checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookRequestMultiError, or nil if none found.
+func (m *CreateWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Book
+
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWebhookRequestValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWebhookRequestValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookRequestValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookRequestValidationError is the validation error returned by
+// CreateWebhookRequest.Validate if the designated constraints aren't met.
+type CreateWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookRequestValidationError) ErrorName() string {
+	return "CreateWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookRequestValidationError{}
+
+// Validate checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookResponseMultiError, or nil if none found.
+func (m *CreateWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookResponseValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateWebhookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookResponseMultiError) AllErrors() []error { return m }
+
+// CreateWebhookResponseValidationError is the validation error returned by
+// CreateWebhookResponse.Validate if the designated constraints aren't met.
+type CreateWebhookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookResponseValidationError) ErrorName() string {
+	return "CreateWebhookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookResponseValidationError{}
+
+// Validate checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksRequestMultiError, or nil if none found.
+func (m *ListWebhooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() > 50 {
+		err := ListWebhooksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := ListWebhooksRequestValidationError{
+			field:  "Book",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWebhooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhooksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhooksRequestValidationError is the validation error returned by
+// ListWebhooksRequest.Validate if the designated constraints aren't met.
+type ListWebhooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksRequestValidationError) ErrorName() string {
+	return "ListWebhooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksRequestValidationError{}
+
+// Validate checks the field values on ListWebhooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksResponseMultiError, or nil if none found.
+func (m *ListWebhooksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWebhooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhooksResponseValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhooksResponseValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhooksResponseValidationError{
+					field:  fmt.Sprintf("Webhooks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListWebhooksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhooksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksResponseMultiError) AllErrors() []error { return m }
+
+// ListWebhooksResponseValidationError is the validation error returned by
+// ListWebhooksResponse.Validate if the designated constraints aren't met.
+type ListWebhooksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksResponseValidationError) ErrorName() string {
+	return "ListWebhooksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksResponseValidationError{}
+
+// Validate checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookRequestMultiError, or nil if none found.
+func (m *DeleteWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteWebhookRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookRequestValidationError is the validation error returned by
+// DeleteWebhookRequest.Validate if the designated constraints aren't met.
+type DeleteWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookRequestValidationError) ErrorName() string {
+	return "DeleteWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookRequestValidationError{}
+
+// Validate checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookResponseMultiError, or nil if none found.
+func (m *DeleteWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteWebhookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookResponseMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookResponseValidationError is the validation error returned by
+// DeleteWebhookResponse.Validate if the designated constraints aren't met.
+type DeleteWebhookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookResponseValidationError) ErrorName() string {
+	return "DeleteWebhookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookResponseValidationError{}
+
+// Validate checks the field values on BatchGetUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetUsersRequestMultiError, or nil if none found.
+func (m *BatchGetUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetNames()); l < 1 || l > 100 {
+		err := BatchGetUsersRequestValidationError{
+			field:  "Names",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetNames() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := BatchGetUsersRequestValidationError{
+				field:  fmt.Sprintf("Names[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetUsersRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetUsersRequestValidationError is the validation error returned by
+// BatchGetUsersRequest.Validate if the designated constraints aren't met.
+type BatchGetUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetUsersRequestValidationError) ErrorName() string {
+	return "BatchGetUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetUsersRequestValidationError{}
+
+// Validate checks the field values on BatchGetUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetUsersResponseMultiError, or nil if none found.
+func (m *BatchGetUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetUsersResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetUsersResponseValidationError is the validation error returned by
+// BatchGetUsersResponse.Validate if the designated constraints aren't met.
+type BatchGetUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetUsersResponseValidationError) ErrorName() string {
+	return "BatchGetUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetUsersResponseValidationError{}
+
+// Validate checks the field values on BatchGetMarketsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetMarketsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetMarketsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetMarketsRequestMultiError, or nil if none found.
+func (m *BatchGetMarketsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetMarketsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetNames()); l < 1 || l > 100 {
+		err := BatchGetMarketsRequestValidationError{
+			field:  "Names",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetNames() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := BatchGetMarketsRequestValidationError{
+				field:  fmt.Sprintf("Names[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetMarketsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetMarketsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetMarketsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetMarketsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetMarketsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetMarketsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetMarketsRequestValidationError is the validation error returned by
+// BatchGetMarketsRequest.Validate if the designated constraints aren't met.
+type BatchGetMarketsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetMarketsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetMarketsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetMarketsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetMarketsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetMarketsRequestValidationError) ErrorName() string {
+	return "BatchGetMarketsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetMarketsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetMarketsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetMarketsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetMarketsRequestValidationError{}
+
+// Validate checks the field values on BatchGetMarketsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetMarketsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetMarketsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetMarketsResponseMultiError, or nil if none found.
+func (m *BatchGetMarketsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetMarketsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMarkets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetMarketsResponseValidationError{
+						field:  fmt.Sprintf("Markets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetMarketsResponseValidationError{
+						field:  fmt.Sprintf("Markets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetMarketsResponseValidationError{
+					field:  fmt.Sprintf("Markets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetMarketsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetMarketsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetMarketsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetMarketsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetMarketsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetMarketsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetMarketsResponseValidationError is the validation error returned by
+// BatchGetMarketsResponse.Validate if the designated constraints aren't met.
+type BatchGetMarketsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetMarketsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetMarketsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetMarketsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetMarketsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetMarketsResponseValidationError) ErrorName() string {
+	return "BatchGetMarketsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetMarketsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetMarketsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetMarketsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetMarketsResponseValidationError{}
+
+// Validate checks the field values on BatchGetBetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetBetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetBetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetBetsRequestMultiError, or nil if none found.
+func (m *BatchGetBetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetBetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetNames()); l < 1 || l > 100 {
+		err := BatchGetBetsRequestValidationError{
+			field:  "Names",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetNames() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := BatchGetBetsRequestValidationError{
+				field:  fmt.Sprintf("Names[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetBetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetBetsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetBetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetBetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetBetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetBetsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetBetsRequestValidationError is the validation error returned by
+// BatchGetBetsRequest.Validate if the designated constraints aren't met.
+type BatchGetBetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetBetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetBetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetBetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetBetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetBetsRequestValidationError) ErrorName() string {
+	return "BatchGetBetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetBetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetBetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetBetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetBetsRequestValidationError{}
+
+// Validate checks the field values on BatchGetBetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetBetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetBetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetBetsResponseMultiError, or nil if none found.
+func (m *BatchGetBetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetBetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetBetsResponseValidationError{
+						field:  fmt.Sprintf("Bets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetBetsResponseValidationError{
+						field:  fmt.Sprintf("Bets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetBetsResponseValidationError{
+					field:  fmt.Sprintf("Bets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetBetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetBetsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetBetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetBetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetBetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetBetsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetBetsResponseValidationError is the validation error returned by
+// BatchGetBetsResponse.Validate if the designated constraints aren't met.
+type BatchGetBetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetBetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetBetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetBetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetBetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetBetsResponseValidationError) ErrorName() string {
+	return "BatchGetBetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetBetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetBetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetBetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetBetsResponseValidationError{}
+
+// Validate checks the field values on GetMarketStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMarketStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMarketStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMarketStatsRequestMultiError, or nil if none found.
+func (m *GetMarketStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMarketStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetMarketStatsRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMarketStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMarketStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMarketStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMarketStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMarketStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMarketStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetMarketStatsRequestValidationError is the validation error returned by
+// GetMarketStatsRequest.Validate if the designated constraints aren't met.
+type GetMarketStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMarketStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMarketStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMarketStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMarketStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMarketStatsRequestValidationError) ErrorName() string {
+	return "GetMarketStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMarketStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMarketStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMarketStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMarketStatsRequestValidationError{}
+
+// Validate checks the field values on GetMarketStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMarketStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMarketStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMarketStatsResponseMultiError, or nil if none found.
+func (m *GetMarketStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMarketStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMarketStatsResponseValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMarketStatsResponseValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMarketStatsResponseValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetMarketStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMarketStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMarketStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMarketStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMarketStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMarketStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetMarketStatsResponseValidationError is the validation error returned by
+// GetMarketStatsResponse.Validate if the designated constraints aren't met.
+type GetMarketStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMarketStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMarketStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMarketStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMarketStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMarketStatsResponseValidationError) ErrorName() string {
+	return "GetMarketStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMarketStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMarketStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMarketStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMarketStatsResponseValidationError{}
+
+// Validate checks the field values on GetUserStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserStatsRequestMultiError, or nil if none found.
+func (m *GetUserStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUser()) < 1 {
+		err := GetUserStatsRequestValidationError{
+			field:  "User",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserStatsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserStatsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserStatsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserStatsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserStatsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserStatsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetUserStatsRequestValidationError is the validation error returned by
+// GetUserStatsRequest.Validate if the designated constraints aren't met.
+type GetUserStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserStatsRequestValidationError) ErrorName() string {
+	return "GetUserStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserStatsRequestValidationError{}
+
+// Validate checks the field values on GetUserStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserStatsResponseMultiError, or nil if none found.
+func (m *GetUserStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserStatsResponseValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserStatsResponseValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserStatsResponseValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetUserStatsResponseValidationError is the validation error returned by
+// GetUserStatsResponse.Validate if the designated constraints aren't met.
+type GetUserStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserStatsResponseValidationError) ErrorName() string {
+	return "GetUserStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserStatsResponseValidationError{}
+
+// Validate checks the field values on GetUserForecastScoreRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserForecastScoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserForecastScoreRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserForecastScoreRequestMultiError, or nil if none found.
+func (m *GetUserForecastScoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserForecastScoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUser()) < 1 {
+		err := GetUserForecastScoreRequestValidationError{
+			field:  "User",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserForecastScoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserForecastScoreRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUserForecastScoreRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserForecastScoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserForecastScoreRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserForecastScoreRequestMultiError) AllErrors() []error { return m }
+
+// GetUserForecastScoreRequestValidationError is the validation error returned
+// by GetUserForecastScoreRequest.Validate if the designated constraints
+// aren't met.
+type GetUserForecastScoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserForecastScoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserForecastScoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserForecastScoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserForecastScoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserForecastScoreRequestValidationError) ErrorName() string {
+	return "GetUserForecastScoreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserForecastScoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserForecastScoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserForecastScoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserForecastScoreRequestValidationError{}
+
+// Validate checks the field values on GetUserForecastScoreResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserForecastScoreResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserForecastScoreResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserForecastScoreResponseMultiError, or nil if none found.
+func (m *GetUserForecastScoreResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserForecastScoreResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserForecastScoreResponseValidationError{
+					field:  "Score",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserForecastScoreResponseValidationError{
+					field:  "Score",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserForecastScoreResponseValidationError{
+				field:  "Score",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserForecastScoreResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserForecastScoreResponseMultiError is an error wrapping multiple
+// validation errors returned by GetUserForecastScoreResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserForecastScoreResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserForecastScoreResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserForecastScoreResponseMultiError) AllErrors() []error { return m }
+
+// GetUserForecastScoreResponseValidationError is the validation error returned
+// by GetUserForecastScoreResponse.Validate if the designated constraints
+// aren't met.
+type GetUserForecastScoreResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserForecastScoreResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserForecastScoreResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserForecastScoreResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserForecastScoreResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserForecastScoreResponseValidationError) ErrorName() string {
+	return "GetUserForecastScoreResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserForecastScoreResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserForecastScoreResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserForecastScoreResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserForecastScoreResponseValidationError{}
+
+// Validate checks the field values on GetForecastLeaderboardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetForecastLeaderboardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetForecastLeaderboardRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetForecastLeaderboardRequestMultiError, or nil if none found.
+func (m *GetForecastLeaderboardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetForecastLeaderboardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() > 50 {
+		err := GetForecastLeaderboardRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := GetForecastLeaderboardRequestValidationError{
+			field:  "Book",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetForecastLeaderboardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetForecastLeaderboardRequestMultiError is an error wrapping multiple
+// validation errors returned by GetForecastLeaderboardRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetForecastLeaderboardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetForecastLeaderboardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetForecastLeaderboardRequestMultiError) AllErrors() []error { return m }
+
+// GetForecastLeaderboardRequestValidationError is the validation error
+// returned by GetForecastLeaderboardRequest.Validate if the designated
+// constraints aren't met.
+type GetForecastLeaderboardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetForecastLeaderboardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetForecastLeaderboardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetForecastLeaderboardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetForecastLeaderboardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetForecastLeaderboardRequestValidationError) ErrorName() string {
+	return "GetForecastLeaderboardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetForecastLeaderboardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetForecastLeaderboardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetForecastLeaderboardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetForecastLeaderboardRequestValidationError{}
+
+// Validate checks the field values on GetForecastLeaderboardResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetForecastLeaderboardResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetForecastLeaderboardResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetForecastLeaderboardResponseMultiError, or nil if none found.
+func (m *GetForecastLeaderboardResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetForecastLeaderboardResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetScores() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetForecastLeaderboardResponseValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetForecastLeaderboardResponseValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetForecastLeaderboardResponseValidationError{
+					field:  fmt.Sprintf("Scores[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
-		}
-		oneofTypePresent = true
-		// no validation rules for Outcome
-	default:
-		_ = v // ensures v is used
-	}
-	if !oneofTypePresent {
-		err := BetValidationError{
-			field:  "Type",
-			reason: "value is required",
-		}
-		if !all {
-			return err
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return BetMultiError(errors)
+		return GetForecastLeaderboardResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BetMultiError is an error wrapping multiple validation errors returned by
-// Bet.ValidateAll() if the designated constraints aren't met.
-type BetMultiError []error
+// GetForecastLeaderboardResponseMultiError is an error wrapping multiple
+// validation errors returned by GetForecastLeaderboardResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetForecastLeaderboardResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BetMultiError) Error() string {
+func (m GetForecastLeaderboardResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -945,11 +13029,12 @@ func (m BetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BetMultiError) AllErrors() []error { return m }
+func (m GetForecastLeaderboardResponseMultiError) AllErrors() []error { return m }
 
-// BetValidationError is the validation error returned by Bet.Validate if the
-// designated constraints aren't met.
-type BetValidationError struct {
+// GetForecastLeaderboardResponseValidationError is the validation error
+// returned by GetForecastLeaderboardResponse.Validate if the designated
+// constraints aren't met.
+type GetForecastLeaderboardResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -957,22 +13042,24 @@ type BetValidationError struct {
 }
 
 // Field function returns field value.
-func (e BetValidationError) Field() string { return e.field }
+func (e GetForecastLeaderboardResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BetValidationError) Reason() string { return e.reason }
+func (e GetForecastLeaderboardResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BetValidationError) Cause() error { return e.cause }
+func (e GetForecastLeaderboardResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BetValidationError) Key() bool { return e.key }
+func (e GetForecastLeaderboardResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BetValidationError) ErrorName() string { return "BetValidationError" }
+func (e GetForecastLeaderboardResponseValidationError) ErrorName() string {
+	return "GetForecastLeaderboardResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BetValidationError) Error() string {
+func (e GetForecastLeaderboardResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -984,14 +13071,14 @@ func (e BetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBet.%s: %s%s",
+		"invalid %sGetForecastLeaderboardResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BetValidationError{}
+var _ error = GetForecastLeaderboardResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -999,77 +13086,70 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BetValidationError{}
+} = GetForecastLeaderboardResponseValidationError{}
 
-var _Bet_Name_Pattern = regexp.MustCompile("^books/[^/]{1,36}/bets/[^/]{36}$")
-
-// Validate checks the field values on CreateUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateUserRequest) Validate() error {
+// Validate checks the field values on ListMarketOddsHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMarketOddsHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListMarketOddsHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateUserRequestMultiError, or nil if none found.
-func (m *CreateUserRequest) ValidateAll() error {
+// ListMarketOddsHistoryRequestMultiError, or nil if none found.
+func (m *ListMarketOddsHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateUserRequest) validate(all bool) error {
+func (m *ListMarketOddsHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Book
+	if m.GetPageSize() > 100 {
+		err := ListMarketOddsHistoryRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateUserRequestValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateUserRequestValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetMarket()) < 1 {
+		err := ListMarketOddsHistoryRequestValidationError{
+			field:  "Market",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateUserRequestValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for AfterRevision
+
 	if len(errors) > 0 {
-		return CreateUserRequestMultiError(errors)
+		return ListMarketOddsHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateUserRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateUserRequestMultiError []error
+// ListMarketOddsHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMarketOddsHistoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMarketOddsHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateUserRequestMultiError) Error() string {
+func (m ListMarketOddsHistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1078,11 +13158,12 @@ func (m CreateUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateUserRequestMultiError) AllErrors() []error { return m }
+func (m ListMarketOddsHistoryRequestMultiError) AllErrors() []error { return m }
 
-// CreateUserRequestValidationError is the validation error returned by
-// CreateUserRequest.Validate if the designated constraints aren't met.
-type CreateUserRequestValidationError struct {
+// ListMarketOddsHistoryRequestValidationError is the validation error returned
+// by ListMarketOddsHistoryRequest.Validate if the designated constraints
+// aren't met.
+type ListMarketOddsHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1090,24 +13171,24 @@ type CreateUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateUserRequestValidationError) Field() string { return e.field }
+func (e ListMarketOddsHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateUserRequestValidationError) Reason() string { return e.reason }
+func (e ListMarketOddsHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateUserRequestValidationError) Cause() error { return e.cause }
+func (e ListMarketOddsHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateUserRequestValidationError) Key() bool { return e.key }
+func (e ListMarketOddsHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateUserRequestValidationError) ErrorName() string {
-	return "CreateUserRequestValidationError"
+func (e ListMarketOddsHistoryRequestValidationError) ErrorName() string {
+	return "ListMarketOddsHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateUserRequestValidationError) Error() string {
+func (e ListMarketOddsHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1119,14 +13200,14 @@ func (e CreateUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateUserRequest.%s: %s%s",
+		"invalid %sListMarketOddsHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateUserRequestValidationError{}
+var _ error = ListMarketOddsHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1134,73 +13215,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateUserRequestValidationError{}
+} = ListMarketOddsHistoryRequestValidationError{}
 
-// Validate checks the field values on CreateUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListMarketOddsHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateUserResponse) Validate() error {
+func (m *ListMarketOddsHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateUserResponseMultiError, or nil if none found.
-func (m *CreateUserResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListMarketOddsHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListMarketOddsHistoryResponseMultiError, or nil if none found.
+func (m *ListMarketOddsHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateUserResponse) validate(all bool) error {
+func (m *ListMarketOddsHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMarketOddsHistoryResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMarketOddsHistoryResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateUserResponseValidationError{
-					field:  "User",
+				return ListMarketOddsHistoryResponseValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return CreateUserResponseMultiError(errors)
+		return ListMarketOddsHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateUserResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateUserResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CreateUserResponseMultiError []error
+// ListMarketOddsHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMarketOddsHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListMarketOddsHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateUserResponseMultiError) Error() string {
+func (m ListMarketOddsHistoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1209,11 +13297,12 @@ func (m CreateUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateUserResponseMultiError) AllErrors() []error { return m }
+func (m ListMarketOddsHistoryResponseMultiError) AllErrors() []error { return m }
 
-// CreateUserResponseValidationError is the validation error returned by
-// CreateUserResponse.Validate if the designated constraints aren't met.
-type CreateUserResponseValidationError struct {
+// ListMarketOddsHistoryResponseValidationError is the validation error
+// returned by ListMarketOddsHistoryResponse.Validate if the designated
+// constraints aren't met.
+type ListMarketOddsHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1221,24 +13310,24 @@ type CreateUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateUserResponseValidationError) Field() string { return e.field }
+func (e ListMarketOddsHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateUserResponseValidationError) Reason() string { return e.reason }
+func (e ListMarketOddsHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateUserResponseValidationError) Cause() error { return e.cause }
+func (e ListMarketOddsHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateUserResponseValidationError) Key() bool { return e.key }
+func (e ListMarketOddsHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateUserResponseValidationError) ErrorName() string {
-	return "CreateUserResponseValidationError"
+func (e ListMarketOddsHistoryResponseValidationError) ErrorName() string {
+	return "ListMarketOddsHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateUserResponseValidationError) Error() string {
+func (e ListMarketOddsHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1250,14 +13339,14 @@ func (e CreateUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateUserResponse.%s: %s%s",
+		"invalid %sListMarketOddsHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateUserResponseValidationError{}
+var _ error = ListMarketOddsHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1265,33 +13354,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateUserResponseValidationError{}
+} = ListMarketOddsHistoryResponseValidationError{}
 
-// Validate checks the field values on GetUserRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetUserRequest) Validate() error {
+// Validate checks the field values on GetUserBalanceHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserBalanceHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetUserRequestMultiError,
-// or nil if none found.
-func (m *GetUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetUserBalanceHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserBalanceHistoryRequestMultiError, or nil if none found.
+func (m *GetUserBalanceHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserRequest) validate(all bool) error {
+func (m *GetUserBalanceHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := GetUserRequestValidationError{
-			field:  "Name",
+	if utf8.RuneCountInString(m.GetUser()) < 1 {
+		err := GetUserBalanceHistoryRequestValidationError{
+			field:  "User",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -1301,19 +13390,19 @@ func (m *GetUserRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUserRequestMultiError(errors)
+		return GetUserBalanceHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserRequestMultiError is an error wrapping multiple validation errors
-// returned by GetUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserRequestMultiError []error
+// GetUserBalanceHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUserBalanceHistoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserBalanceHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserRequestMultiError) Error() string {
+func (m GetUserBalanceHistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1322,11 +13411,12 @@ func (m GetUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserRequestMultiError) AllErrors() []error { return m }
+func (m GetUserBalanceHistoryRequestMultiError) AllErrors() []error { return m }
 
-// GetUserRequestValidationError is the validation error returned by
-// GetUserRequest.Validate if the designated constraints aren't met.
-type GetUserRequestValidationError struct {
+// GetUserBalanceHistoryRequestValidationError is the validation error returned
+// by GetUserBalanceHistoryRequest.Validate if the designated constraints
+// aren't met.
+type GetUserBalanceHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1334,22 +13424,24 @@ type GetUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserRequestValidationError) Field() string { return e.field }
+func (e GetUserBalanceHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserRequestValidationError) Reason() string { return e.reason }
+func (e GetUserBalanceHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserRequestValidationError) Cause() error { return e.cause }
+func (e GetUserBalanceHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserRequestValidationError) Key() bool { return e.key }
+func (e GetUserBalanceHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+func (e GetUserBalanceHistoryRequestValidationError) ErrorName() string {
+	return "GetUserBalanceHistoryRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserRequestValidationError) Error() string {
+func (e GetUserBalanceHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1361,88 +13453,93 @@ func (e GetUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserRequest.%s: %s%s",
+		"invalid %sGetUserBalanceHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserRequestValidationError{}
+var _ error = GetUserBalanceHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
 	Reason() string
 	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetUserRequestValidationError{}
-
-// Validate checks the field values on GetUserResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetUserResponse) Validate() error {
+	Cause() error
+	ErrorName() string
+} = GetUserBalanceHistoryRequestValidationError{}
+
+// Validate checks the field values on GetUserBalanceHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserBalanceHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUserResponseMultiError, or nil if none found.
-func (m *GetUserResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetUserBalanceHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetUserBalanceHistoryResponseMultiError, or nil if none found.
+func (m *GetUserBalanceHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserResponse) validate(all bool) error {
+func (m *GetUserBalanceHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserBalanceHistoryResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserBalanceHistoryResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
+				return GetUserBalanceHistoryResponseValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetUserResponseMultiError(errors)
+		return GetUserBalanceHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserResponseMultiError is an error wrapping multiple validation errors
-// returned by GetUserResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserResponseMultiError []error
+// GetUserBalanceHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetUserBalanceHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetUserBalanceHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserResponseMultiError) Error() string {
+func (m GetUserBalanceHistoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1451,11 +13548,12 @@ func (m GetUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserResponseMultiError) AllErrors() []error { return m }
+func (m GetUserBalanceHistoryResponseMultiError) AllErrors() []error { return m }
 
-// GetUserResponseValidationError is the validation error returned by
-// GetUserResponse.Validate if the designated constraints aren't met.
-type GetUserResponseValidationError struct {
+// GetUserBalanceHistoryResponseValidationError is the validation error
+// returned by GetUserBalanceHistoryResponse.Validate if the designated
+// constraints aren't met.
+type GetUserBalanceHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1463,22 +13561,24 @@ type GetUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserResponseValidationError) Field() string { return e.field }
+func (e GetUserBalanceHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserResponseValidationError) Reason() string { return e.reason }
+func (e GetUserBalanceHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserResponseValidationError) Cause() error { return e.cause }
+func (e GetUserBalanceHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserResponseValidationError) Key() bool { return e.key }
+func (e GetUserBalanceHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+func (e GetUserBalanceHistoryResponseValidationError) ErrorName() string {
+	return "GetUserBalanceHistoryResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserResponseValidationError) Error() string {
+func (e GetUserBalanceHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1490,14 +13590,14 @@ func (e GetUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserResponse.%s: %s%s",
+		"invalid %sGetUserBalanceHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserResponseValidationError{}
+var _ error = GetUserBalanceHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1505,24 +13605,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserResponseValidationError{}
+} = GetUserBalanceHistoryResponseValidationError{}
 
-// Validate checks the field values on GetUserByUsernameRequest with the rules
+// Validate checks the field values on StartSeasonRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserByUsernameRequest) Validate() error {
+func (m *StartSeasonRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserByUsernameRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StartSeasonRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserByUsernameRequestMultiError, or nil if none found.
-func (m *GetUserByUsernameRequest) ValidateAll() error {
+// StartSeasonRequestMultiError, or nil if none found.
+func (m *StartSeasonRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserByUsernameRequest) validate(all bool) error {
+func (m *StartSeasonRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1530,7 +13630,7 @@ func (m *GetUserByUsernameRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetBook()) < 1 {
-		err := GetUserByUsernameRequestValidationError{
+		err := StartSeasonRequestValidationError{
 			field:  "Book",
 			reason: "value length must be at least 1 runes",
 		}
@@ -1540,9 +13640,42 @@ func (m *GetUserByUsernameRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := GetUserByUsernameRequestValidationError{
-			field:  "Username",
+	if m.GetStartingCentipoints() <= 0 {
+		err := StartSeasonRequestValidationError{
+			field:  "StartingCentipoints",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _StartSeasonRequest_OpenBetPolicy_NotInLookup[m.GetOpenBetPolicy()]; ok {
+		err := StartSeasonRequestValidationError{
+			field:  "OpenBetPolicy",
+			reason: "value must not be in list [OPEN_BET_POLICY_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Season_OpenBetPolicy_name[int32(m.GetOpenBetPolicy())]; !ok {
+		err := StartSeasonRequestValidationError{
+			field:  "OpenBetPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetActor()) < 1 {
+		err := StartSeasonRequestValidationError{
+			field:  "Actor",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -1551,20 +13684,22 @@ func (m *GetUserByUsernameRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Number
+
 	if len(errors) > 0 {
-		return GetUserByUsernameRequestMultiError(errors)
+		return StartSeasonRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserByUsernameRequestMultiError is an error wrapping multiple validation
-// errors returned by GetUserByUsernameRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetUserByUsernameRequestMultiError []error
+// StartSeasonRequestMultiError is an error wrapping multiple validation errors
+// returned by StartSeasonRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartSeasonRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserByUsernameRequestMultiError) Error() string {
+func (m StartSeasonRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1573,11 +13708,11 @@ func (m GetUserByUsernameRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserByUsernameRequestMultiError) AllErrors() []error { return m }
+func (m StartSeasonRequestMultiError) AllErrors() []error { return m }
 
-// GetUserByUsernameRequestValidationError is the validation error returned by
-// GetUserByUsernameRequest.Validate if the designated constraints aren't met.
-type GetUserByUsernameRequestValidationError struct {
+// StartSeasonRequestValidationError is the validation error returned by
+// StartSeasonRequest.Validate if the designated constraints aren't met.
+type StartSeasonRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1585,24 +13720,24 @@ type GetUserByUsernameRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserByUsernameRequestValidationError) Field() string { return e.field }
+func (e StartSeasonRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserByUsernameRequestValidationError) Reason() string { return e.reason }
+func (e StartSeasonRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserByUsernameRequestValidationError) Cause() error { return e.cause }
+func (e StartSeasonRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserByUsernameRequestValidationError) Key() bool { return e.key }
+func (e StartSeasonRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserByUsernameRequestValidationError) ErrorName() string {
-	return "GetUserByUsernameRequestValidationError"
+func (e StartSeasonRequestValidationError) ErrorName() string {
+	return "StartSeasonRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserByUsernameRequestValidationError) Error() string {
+func (e StartSeasonRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1614,14 +13749,14 @@ func (e GetUserByUsernameRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserByUsernameRequest.%s: %s%s",
+		"invalid %sStartSeasonRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserByUsernameRequestValidationError{}
+var _ error = StartSeasonRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1629,24 +13764,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserByUsernameRequestValidationError{}
+} = StartSeasonRequestValidationError{}
 
-// Validate checks the field values on GetUserByUsernameResponse with the rules
+var _StartSeasonRequest_OpenBetPolicy_NotInLookup = map[Season_OpenBetPolicy]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on StartSeasonResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserByUsernameResponse) Validate() error {
+func (m *StartSeasonResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserByUsernameResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StartSeasonResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserByUsernameResponseMultiError, or nil if none found.
-func (m *GetUserByUsernameResponse) ValidateAll() error {
+// StartSeasonResponseMultiError, or nil if none found.
+func (m *StartSeasonResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserByUsernameResponse) validate(all bool) error {
+func (m *StartSeasonResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1654,28 +13793,57 @@ func (m *GetUserByUsernameResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetUser()).(type) {
+		switch v := interface{}(m.GetEndedSeason()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUserByUsernameResponseValidationError{
-					field:  "User",
+				errors = append(errors, StartSeasonResponseValidationError{
+					field:  "EndedSeason",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUserByUsernameResponseValidationError{
-					field:  "User",
+				errors = append(errors, StartSeasonResponseValidationError{
+					field:  "EndedSeason",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEndedSeason()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetUserByUsernameResponseValidationError{
-				field:  "User",
+			return StartSeasonResponseValidationError{
+				field:  "EndedSeason",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSeason()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartSeasonResponseValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartSeasonResponseValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeason()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartSeasonResponseValidationError{
+				field:  "Season",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1683,19 +13851,19 @@ func (m *GetUserByUsernameResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUserByUsernameResponseMultiError(errors)
+		return StartSeasonResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserByUsernameResponseMultiError is an error wrapping multiple validation
-// errors returned by GetUserByUsernameResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetUserByUsernameResponseMultiError []error
+// StartSeasonResponseMultiError is an error wrapping multiple validation
+// errors returned by StartSeasonResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartSeasonResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserByUsernameResponseMultiError) Error() string {
+func (m StartSeasonResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1704,11 +13872,11 @@ func (m GetUserByUsernameResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserByUsernameResponseMultiError) AllErrors() []error { return m }
+func (m StartSeasonResponseMultiError) AllErrors() []error { return m }
 
-// GetUserByUsernameResponseValidationError is the validation error returned by
-// GetUserByUsernameResponse.Validate if the designated constraints aren't met.
-type GetUserByUsernameResponseValidationError struct {
+// StartSeasonResponseValidationError is the validation error returned by
+// StartSeasonResponse.Validate if the designated constraints aren't met.
+type StartSeasonResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1716,24 +13884,24 @@ type GetUserByUsernameResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserByUsernameResponseValidationError) Field() string { return e.field }
+func (e StartSeasonResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserByUsernameResponseValidationError) Reason() string { return e.reason }
+func (e StartSeasonResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserByUsernameResponseValidationError) Cause() error { return e.cause }
+func (e StartSeasonResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserByUsernameResponseValidationError) Key() bool { return e.key }
+func (e StartSeasonResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserByUsernameResponseValidationError) ErrorName() string {
-	return "GetUserByUsernameResponseValidationError"
+func (e StartSeasonResponseValidationError) ErrorName() string {
+	return "StartSeasonResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserByUsernameResponseValidationError) Error() string {
+func (e StartSeasonResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1745,14 +13913,14 @@ func (e GetUserByUsernameResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserByUsernameResponse.%s: %s%s",
+		"invalid %sStartSeasonResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserByUsernameResponseValidationError{}
+var _ error = StartSeasonResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1760,46 +13928,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserByUsernameResponseValidationError{}
+} = StartSeasonResponseValidationError{}
 
-// Validate checks the field values on ListUsersRequest with the rules defined
+// Validate checks the field values on GetSeasonRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListUsersRequest) Validate() error {
+func (m *GetSeasonRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUsersRequest with the rules
+// ValidateAll checks the field values on GetSeasonRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListUsersRequestMultiError, or nil if none found.
-func (m *ListUsersRequest) ValidateAll() error {
+// GetSeasonRequestMultiError, or nil if none found.
+func (m *GetSeasonRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUsersRequest) validate(all bool) error {
+func (m *GetSeasonRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPageSize() > 50 {
-		err := ListUsersRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be less than or equal to 50",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for PageToken
-
-	if utf8.RuneCountInString(m.GetBook()) < 1 {
-		err := ListUsersRequestValidationError{
-			field:  "Book",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetSeasonRequestValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -1808,31 +13963,20 @@ func (m *ListUsersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _ListUsersRequest_OrderBy_InLookup[m.GetOrderBy()]; !ok {
-		err := ListUsersRequestValidationError{
-			field:  "OrderBy",
-			reason: "value must be in list [ name total_centipoints]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return ListUsersRequestMultiError(errors)
+		return GetSeasonRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUsersRequestMultiError is an error wrapping multiple validation errors
-// returned by ListUsersRequest.ValidateAll() if the designated constraints
+// GetSeasonRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSeasonRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListUsersRequestMultiError []error
+type GetSeasonRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUsersRequestMultiError) Error() string {
+func (m GetSeasonRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1841,11 +13985,11 @@ func (m ListUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUsersRequestMultiError) AllErrors() []error { return m }
+func (m GetSeasonRequestMultiError) AllErrors() []error { return m }
 
-// ListUsersRequestValidationError is the validation error returned by
-// ListUsersRequest.Validate if the designated constraints aren't met.
-type ListUsersRequestValidationError struct {
+// GetSeasonRequestValidationError is the validation error returned by
+// GetSeasonRequest.Validate if the designated constraints aren't met.
+type GetSeasonRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1853,22 +13997,22 @@ type ListUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUsersRequestValidationError) Field() string { return e.field }
+func (e GetSeasonRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUsersRequestValidationError) Reason() string { return e.reason }
+func (e GetSeasonRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUsersRequestValidationError) Cause() error { return e.cause }
+func (e GetSeasonRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUsersRequestValidationError) Key() bool { return e.key }
+func (e GetSeasonRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUsersRequestValidationError) ErrorName() string { return "ListUsersRequestValidationError" }
+func (e GetSeasonRequestValidationError) ErrorName() string { return "GetSeasonRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListUsersRequestValidationError) Error() string {
+func (e GetSeasonRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1880,14 +14024,14 @@ func (e ListUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUsersRequest.%s: %s%s",
+		"invalid %sGetSeasonRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUsersRequestValidationError{}
+var _ error = GetSeasonRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1895,86 +14039,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUsersRequestValidationError{}
-
-var _ListUsersRequest_OrderBy_InLookup = map[string]struct{}{
-	"":                  {},
-	"name":              {},
-	"total_centipoints": {},
-}
+} = GetSeasonRequestValidationError{}
 
-// Validate checks the field values on ListUsersResponse with the rules defined
+// Validate checks the field values on GetSeasonResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListUsersResponse) Validate() error {
+func (m *GetSeasonResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUsersResponse with the rules
+// ValidateAll checks the field values on GetSeasonResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListUsersResponseMultiError, or nil if none found.
-func (m *ListUsersResponse) ValidateAll() error {
+// GetSeasonResponseMultiError, or nil if none found.
+func (m *GetSeasonResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUsersResponse) validate(all bool) error {
+func (m *GetSeasonResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSeason()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSeasonResponseValidationError{
+					field:  "Season",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListUsersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
+				errors = append(errors, GetSeasonResponseValidationError{
+					field:  "Season",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSeason()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSeasonResponseValidationError{
+				field:  "Season",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListUsersResponseMultiError(errors)
+		return GetSeasonResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUsersResponseMultiError is an error wrapping multiple validation errors
-// returned by ListUsersResponse.ValidateAll() if the designated constraints
+// GetSeasonResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSeasonResponse.ValidateAll() if the designated constraints
 // aren't met.
-type ListUsersResponseMultiError []error
+type GetSeasonResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUsersResponseMultiError) Error() string {
+func (m GetSeasonResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1983,11 +14114,11 @@ func (m ListUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUsersResponseMultiError) AllErrors() []error { return m }
+func (m GetSeasonResponseMultiError) AllErrors() []error { return m }
 
-// ListUsersResponseValidationError is the validation error returned by
-// ListUsersResponse.Validate if the designated constraints aren't met.
-type ListUsersResponseValidationError struct {
+// GetSeasonResponseValidationError is the validation error returned by
+// GetSeasonResponse.Validate if the designated constraints aren't met.
+type GetSeasonResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1995,24 +14126,24 @@ type ListUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUsersResponseValidationError) Field() string { return e.field }
+func (e GetSeasonResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUsersResponseValidationError) Reason() string { return e.reason }
+func (e GetSeasonResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUsersResponseValidationError) Cause() error { return e.cause }
+func (e GetSeasonResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUsersResponseValidationError) Key() bool { return e.key }
+func (e GetSeasonResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUsersResponseValidationError) ErrorName() string {
-	return "ListUsersResponseValidationError"
+func (e GetSeasonResponseValidationError) ErrorName() string {
+	return "GetSeasonResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListUsersResponseValidationError) Error() string {
+func (e GetSeasonResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2024,14 +14155,14 @@ func (e ListUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUsersResponse.%s: %s%s",
+		"invalid %sGetSeasonResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUsersResponseValidationError{}
+var _ error = GetSeasonResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2039,75 +14170,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUsersResponseValidationError{}
+} = GetSeasonResponseValidationError{}
 
-// Validate checks the field values on CreateMarketRequest with the rules
+// Validate checks the field values on ListSeasonsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateMarketRequest) Validate() error {
+func (m *ListSeasonsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateMarketRequest with the rules
+// ValidateAll checks the field values on ListSeasonsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateMarketRequestMultiError, or nil if none found.
-func (m *CreateMarketRequest) ValidateAll() error {
+// ListSeasonsRequestMultiError, or nil if none found.
+func (m *ListSeasonsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateMarketRequest) validate(all bool) error {
+func (m *ListSeasonsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Book
+	if m.GetPageSize() > 50 {
+		err := ListSeasonsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 50",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetMarket()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateMarketRequestValidationError{
-					field:  "Market",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateMarketRequestValidationError{
-					field:  "Market",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := ListSeasonsRequestValidationError{
+			field:  "Book",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateMarketRequestValidationError{
-				field:  "Market",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateMarketRequestMultiError(errors)
+		return ListSeasonsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateMarketRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateMarketRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateMarketRequestMultiError []error
+// ListSeasonsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSeasonsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListSeasonsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateMarketRequestMultiError) Error() string {
+func (m ListSeasonsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2116,11 +14240,11 @@ func (m CreateMarketRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateMarketRequestMultiError) AllErrors() []error { return m }
+func (m ListSeasonsRequestMultiError) AllErrors() []error { return m }
 
-// CreateMarketRequestValidationError is the validation error returned by
-// CreateMarketRequest.Validate if the designated constraints aren't met.
-type CreateMarketRequestValidationError struct {
+// ListSeasonsRequestValidationError is the validation error returned by
+// ListSeasonsRequest.Validate if the designated constraints aren't met.
+type ListSeasonsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2128,24 +14252,24 @@ type CreateMarketRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateMarketRequestValidationError) Field() string { return e.field }
+func (e ListSeasonsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateMarketRequestValidationError) Reason() string { return e.reason }
+func (e ListSeasonsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateMarketRequestValidationError) Cause() error { return e.cause }
+func (e ListSeasonsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateMarketRequestValidationError) Key() bool { return e.key }
+func (e ListSeasonsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateMarketRequestValidationError) ErrorName() string {
-	return "CreateMarketRequestValidationError"
+func (e ListSeasonsRequestValidationError) ErrorName() string {
+	return "ListSeasonsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateMarketRequestValidationError) Error() string {
+func (e ListSeasonsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2157,14 +14281,14 @@ func (e CreateMarketRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateMarketRequest.%s: %s%s",
+		"invalid %sListSeasonsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateMarketRequestValidationError{}
+var _ error = ListSeasonsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2172,73 +14296,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateMarketRequestValidationError{}
+} = ListSeasonsRequestValidationError{}
 
-// Validate checks the field values on CreateMarketResponse with the rules
+// Validate checks the field values on ListSeasonsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateMarketResponse) Validate() error {
+func (m *ListSeasonsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateMarketResponse with the rules
+// ValidateAll checks the field values on ListSeasonsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateMarketResponseMultiError, or nil if none found.
-func (m *CreateMarketResponse) ValidateAll() error {
+// ListSeasonsResponseMultiError, or nil if none found.
+func (m *ListSeasonsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateMarketResponse) validate(all bool) error {
+func (m *ListSeasonsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMarket()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateMarketResponseValidationError{
-					field:  "Market",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetSeasons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSeasonsResponseValidationError{
+						field:  fmt.Sprintf("Seasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSeasonsResponseValidationError{
+						field:  fmt.Sprintf("Seasons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateMarketResponseValidationError{
-					field:  "Market",
+				return ListSeasonsResponseValidationError{
+					field:  fmt.Sprintf("Seasons[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateMarketResponseValidationError{
-				field:  "Market",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return CreateMarketResponseMultiError(errors)
+		return ListSeasonsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateMarketResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateMarketResponse.ValidateAll() if the designated
+// ListSeasonsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSeasonsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateMarketResponseMultiError []error
+type ListSeasonsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateMarketResponseMultiError) Error() string {
+func (m ListSeasonsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2247,11 +14378,11 @@ func (m CreateMarketResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateMarketResponseMultiError) AllErrors() []error { return m }
+func (m ListSeasonsResponseMultiError) AllErrors() []error { return m }
 
-// CreateMarketResponseValidationError is the validation error returned by
-// CreateMarketResponse.Validate if the designated constraints aren't met.
-type CreateMarketResponseValidationError struct {
+// ListSeasonsResponseValidationError is the validation error returned by
+// ListSeasonsResponse.Validate if the designated constraints aren't met.
+type ListSeasonsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2259,24 +14390,24 @@ type CreateMarketResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateMarketResponseValidationError) Field() string { return e.field }
+func (e ListSeasonsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateMarketResponseValidationError) Reason() string { return e.reason }
+func (e ListSeasonsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateMarketResponseValidationError) Cause() error { return e.cause }
+func (e ListSeasonsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateMarketResponseValidationError) Key() bool { return e.key }
+func (e ListSeasonsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateMarketResponseValidationError) ErrorName() string {
-	return "CreateMarketResponseValidationError"
+func (e ListSeasonsResponseValidationError) ErrorName() string {
+	return "ListSeasonsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateMarketResponseValidationError) Error() string {
+func (e ListSeasonsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2288,14 +14419,14 @@ func (e CreateMarketResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateMarketResponse.%s: %s%s",
+		"invalid %sListSeasonsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateMarketResponseValidationError{}
+var _ error = ListSeasonsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2303,33 +14434,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateMarketResponseValidationError{}
+} = ListSeasonsResponseValidationError{}
 
-// Validate checks the field values on GetMarketRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetMarketRequest) Validate() error {
+// Validate checks the field values on TransferPointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferPointsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMarketRequest with the rules
+// ValidateAll checks the field values on TransferPointsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMarketRequestMultiError, or nil if none found.
-func (m *GetMarketRequest) ValidateAll() error {
+// TransferPointsRequestMultiError, or nil if none found.
+func (m *TransferPointsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMarketRequest) validate(all bool) error {
+func (m *TransferPointsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := GetMarketRequestValidationError{
-			field:  "Name",
+	if utf8.RuneCountInString(m.GetFromUser()) < 1 {
+		err := TransferPointsRequestValidationError{
+			field:  "FromUser",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetToUser()) < 1 {
+		err := TransferPointsRequestValidationError{
+			field:  "ToUser",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -2338,20 +14480,42 @@ func (m *GetMarketRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetCentipoints() <= 0 {
+		err := TransferPointsRequestValidationError{
+			field:  "Centipoints",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMemo()) > 256 {
+		err := TransferPointsRequestValidationError{
+			field:  "Memo",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetMarketRequestMultiError(errors)
+		return TransferPointsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMarketRequestMultiError is an error wrapping multiple validation errors
-// returned by GetMarketRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetMarketRequestMultiError []error
+// TransferPointsRequestMultiError is an error wrapping multiple validation
+// errors returned by TransferPointsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TransferPointsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMarketRequestMultiError) Error() string {
+func (m TransferPointsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2360,11 +14524,11 @@ func (m GetMarketRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMarketRequestMultiError) AllErrors() []error { return m }
+func (m TransferPointsRequestMultiError) AllErrors() []error { return m }
 
-// GetMarketRequestValidationError is the validation error returned by
-// GetMarketRequest.Validate if the designated constraints aren't met.
-type GetMarketRequestValidationError struct {
+// TransferPointsRequestValidationError is the validation error returned by
+// TransferPointsRequest.Validate if the designated constraints aren't met.
+type TransferPointsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2372,22 +14536,24 @@ type GetMarketRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMarketRequestValidationError) Field() string { return e.field }
+func (e TransferPointsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMarketRequestValidationError) Reason() string { return e.reason }
+func (e TransferPointsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMarketRequestValidationError) Cause() error { return e.cause }
+func (e TransferPointsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMarketRequestValidationError) Key() bool { return e.key }
+func (e TransferPointsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMarketRequestValidationError) ErrorName() string { return "GetMarketRequestValidationError" }
+func (e TransferPointsRequestValidationError) ErrorName() string {
+	return "TransferPointsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetMarketRequestValidationError) Error() string {
+func (e TransferPointsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2399,14 +14565,14 @@ func (e GetMarketRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMarketRequest.%s: %s%s",
+		"invalid %sTransferPointsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMarketRequestValidationError{}
+var _ error = TransferPointsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2414,24 +14580,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMarketRequestValidationError{}
+} = TransferPointsRequestValidationError{}
 
-// Validate checks the field values on GetMarketResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetMarketResponse) Validate() error {
+// Validate checks the field values on TransferPointsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferPointsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMarketResponse with the rules
+// ValidateAll checks the field values on TransferPointsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMarketResponseMultiError, or nil if none found.
-func (m *GetMarketResponse) ValidateAll() error {
+// TransferPointsResponseMultiError, or nil if none found.
+func (m *TransferPointsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMarketResponse) validate(all bool) error {
+func (m *TransferPointsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2439,28 +14605,86 @@ func (m *GetMarketResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetMarket()).(type) {
+		switch v := interface{}(m.GetTransfer()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetMarketResponseValidationError{
-					field:  "Market",
+				errors = append(errors, TransferPointsResponseValidationError{
+					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetMarketResponseValidationError{
-					field:  "Market",
+				errors = append(errors, TransferPointsResponseValidationError{
+					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetMarketResponseValidationError{
-				field:  "Market",
+			return TransferPointsResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFromUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferPointsResponseValidationError{
+					field:  "FromUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferPointsResponseValidationError{
+					field:  "FromUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFromUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferPointsResponseValidationError{
+				field:  "FromUser",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetToUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferPointsResponseValidationError{
+					field:  "ToUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferPointsResponseValidationError{
+					field:  "ToUser",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferPointsResponseValidationError{
+				field:  "ToUser",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2468,19 +14692,19 @@ func (m *GetMarketResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetMarketResponseMultiError(errors)
+		return TransferPointsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMarketResponseMultiError is an error wrapping multiple validation errors
-// returned by GetMarketResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetMarketResponseMultiError []error
+// TransferPointsResponseMultiError is an error wrapping multiple validation
+// errors returned by TransferPointsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TransferPointsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMarketResponseMultiError) Error() string {
+func (m TransferPointsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2489,11 +14713,11 @@ func (m GetMarketResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMarketResponseMultiError) AllErrors() []error { return m }
+func (m TransferPointsResponseMultiError) AllErrors() []error { return m }
 
-// GetMarketResponseValidationError is the validation error returned by
-// GetMarketResponse.Validate if the designated constraints aren't met.
-type GetMarketResponseValidationError struct {
+// TransferPointsResponseValidationError is the validation error returned by
+// TransferPointsResponse.Validate if the designated constraints aren't met.
+type TransferPointsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2501,24 +14725,24 @@ type GetMarketResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMarketResponseValidationError) Field() string { return e.field }
+func (e TransferPointsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMarketResponseValidationError) Reason() string { return e.reason }
+func (e TransferPointsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMarketResponseValidationError) Cause() error { return e.cause }
+func (e TransferPointsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMarketResponseValidationError) Key() bool { return e.key }
+func (e TransferPointsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMarketResponseValidationError) ErrorName() string {
-	return "GetMarketResponseValidationError"
+func (e TransferPointsResponseValidationError) ErrorName() string {
+	return "TransferPointsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMarketResponseValidationError) Error() string {
+func (e TransferPointsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2530,14 +14754,14 @@ func (e GetMarketResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMarketResponse.%s: %s%s",
+		"invalid %sTransferPointsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMarketResponseValidationError{}
+var _ error = TransferPointsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2545,24 +14769,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMarketResponseValidationError{}
+} = TransferPointsResponseValidationError{}
 
-// Validate checks the field values on ListMarketsRequest with the rules
+// Validate checks the field values on ListTransfersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMarketsRequest) Validate() error {
+func (m *ListTransfersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMarketsRequest with the rules
+// ValidateAll checks the field values on ListTransfersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMarketsRequestMultiError, or nil if none found.
-func (m *ListMarketsRequest) ValidateAll() error {
+// ListTransfersRequestMultiError, or nil if none found.
+func (m *ListTransfersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMarketsRequest) validate(all bool) error {
+func (m *ListTransfersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2570,7 +14794,7 @@ func (m *ListMarketsRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetPageSize() > 50 {
-		err := ListMarketsRequestValidationError{
+		err := ListTransfersRequestValidationError{
 			field:  "PageSize",
 			reason: "value must be less than or equal to 50",
 		}
@@ -2583,7 +14807,7 @@ func (m *ListMarketsRequest) validate(all bool) error {
 	// no validation rules for PageToken
 
 	if utf8.RuneCountInString(m.GetBook()) < 1 {
-		err := ListMarketsRequestValidationError{
+		err := ListTransfersRequestValidationError{
 			field:  "Book",
 			reason: "value length must be at least 1 runes",
 		}
@@ -2593,22 +14817,22 @@ func (m *ListMarketsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Status
+	// no validation rules for User
 
 	if len(errors) > 0 {
-		return ListMarketsRequestMultiError(errors)
+		return ListTransfersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMarketsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListMarketsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListMarketsRequestMultiError []error
+// ListTransfersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTransfersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTransfersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMarketsRequestMultiError) Error() string {
+func (m ListTransfersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2617,11 +14841,11 @@ func (m ListMarketsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMarketsRequestMultiError) AllErrors() []error { return m }
+func (m ListTransfersRequestMultiError) AllErrors() []error { return m }
 
-// ListMarketsRequestValidationError is the validation error returned by
-// ListMarketsRequest.Validate if the designated constraints aren't met.
-type ListMarketsRequestValidationError struct {
+// ListTransfersRequestValidationError is the validation error returned by
+// ListTransfersRequest.Validate if the designated constraints aren't met.
+type ListTransfersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2629,24 +14853,24 @@ type ListMarketsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMarketsRequestValidationError) Field() string { return e.field }
+func (e ListTransfersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMarketsRequestValidationError) Reason() string { return e.reason }
+func (e ListTransfersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMarketsRequestValidationError) Cause() error { return e.cause }
+func (e ListTransfersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMarketsRequestValidationError) Key() bool { return e.key }
+func (e ListTransfersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMarketsRequestValidationError) ErrorName() string {
-	return "ListMarketsRequestValidationError"
+func (e ListTransfersRequestValidationError) ErrorName() string {
+	return "ListTransfersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMarketsRequestValidationError) Error() string {
+func (e ListTransfersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2658,14 +14882,14 @@ func (e ListMarketsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMarketsRequest.%s: %s%s",
+		"invalid %sListTransfersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMarketsRequestValidationError{}
+var _ error = ListTransfersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2673,47 +14897,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMarketsRequestValidationError{}
+} = ListTransfersRequestValidationError{}
 
-// Validate checks the field values on ListMarketsResponse with the rules
+// Validate checks the field values on ListTransfersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMarketsResponse) Validate() error {
+func (m *ListTransfersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMarketsResponse with the rules
+// ValidateAll checks the field values on ListTransfersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMarketsResponseMultiError, or nil if none found.
-func (m *ListMarketsResponse) ValidateAll() error {
+// ListTransfersResponseMultiError, or nil if none found.
+func (m *ListTransfersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMarketsResponse) validate(all bool) error {
+func (m *ListTransfersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMarkets() {
+	for idx, item := range m.GetTransfers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListMarketsResponseValidationError{
-						field:  fmt.Sprintf("Markets[%v]", idx),
+					errors = append(errors, ListTransfersResponseValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListMarketsResponseValidationError{
-						field:  fmt.Sprintf("Markets[%v]", idx),
+					errors = append(errors, ListTransfersResponseValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2721,8 +14945,8 @@ func (m *ListMarketsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListMarketsResponseValidationError{
-					field:  fmt.Sprintf("Markets[%v]", idx),
+				return ListTransfersResponseValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2734,19 +14958,19 @@ func (m *ListMarketsResponse) validate(all bool) error {
 	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return ListMarketsResponseMultiError(errors)
+		return ListTransfersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMarketsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListMarketsResponse.ValidateAll() if the designated
+// ListTransfersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTransfersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListMarketsResponseMultiError []error
+type ListTransfersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMarketsResponseMultiError) Error() string {
+func (m ListTransfersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2755,11 +14979,11 @@ func (m ListMarketsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMarketsResponseMultiError) AllErrors() []error { return m }
+func (m ListTransfersResponseMultiError) AllErrors() []error { return m }
 
-// ListMarketsResponseValidationError is the validation error returned by
-// ListMarketsResponse.Validate if the designated constraints aren't met.
-type ListMarketsResponseValidationError struct {
+// ListTransfersResponseValidationError is the validation error returned by
+// ListTransfersResponse.Validate if the designated constraints aren't met.
+type ListTransfersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2767,24 +14991,24 @@ type ListMarketsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMarketsResponseValidationError) Field() string { return e.field }
+func (e ListTransfersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMarketsResponseValidationError) Reason() string { return e.reason }
+func (e ListTransfersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMarketsResponseValidationError) Cause() error { return e.cause }
+func (e ListTransfersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMarketsResponseValidationError) Key() bool { return e.key }
+func (e ListTransfersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMarketsResponseValidationError) ErrorName() string {
-	return "ListMarketsResponseValidationError"
+func (e ListTransfersResponseValidationError) ErrorName() string {
+	return "ListTransfersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMarketsResponseValidationError) Error() string {
+func (e ListTransfersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2796,14 +15020,14 @@ func (e ListMarketsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMarketsResponse.%s: %s%s",
+		"invalid %sListTransfersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMarketsResponseValidationError{}
+var _ error = ListTransfersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2811,33 +15035,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMarketsResponseValidationError{}
+} = ListTransfersResponseValidationError{}
 
-// Validate checks the field values on LockMarketRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *LockMarketRequest) Validate() error {
+// Validate checks the field values on AdjustUserBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustUserBalanceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LockMarketRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdjustUserBalanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// LockMarketRequestMultiError, or nil if none found.
-func (m *LockMarketRequest) ValidateAll() error {
+// AdjustUserBalanceRequestMultiError, or nil if none found.
+func (m *AdjustUserBalanceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LockMarketRequest) validate(all bool) error {
+func (m *AdjustUserBalanceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := LockMarketRequestValidationError{
-			field:  "Name",
+	if utf8.RuneCountInString(m.GetUser()) < 1 {
+		err := AdjustUserBalanceRequestValidationError{
+			field:  "User",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetActor()) < 1 {
+		err := AdjustUserBalanceRequestValidationError{
+			field:  "Actor",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -2846,20 +15081,42 @@ func (m *LockMarketRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _AdjustUserBalanceRequest_DeltaCentipoints_NotInLookup[m.GetDeltaCentipoints()]; ok {
+		err := AdjustUserBalanceRequestValidationError{
+			field:  "DeltaCentipoints",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 256 {
+		err := AdjustUserBalanceRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return LockMarketRequestMultiError(errors)
+		return AdjustUserBalanceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LockMarketRequestMultiError is an error wrapping multiple validation errors
-// returned by LockMarketRequest.ValidateAll() if the designated constraints
-// aren't met.
-type LockMarketRequestMultiError []error
+// AdjustUserBalanceRequestMultiError is an error wrapping multiple validation
+// errors returned by AdjustUserBalanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustUserBalanceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LockMarketRequestMultiError) Error() string {
+func (m AdjustUserBalanceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2868,11 +15125,11 @@ func (m LockMarketRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LockMarketRequestMultiError) AllErrors() []error { return m }
+func (m AdjustUserBalanceRequestMultiError) AllErrors() []error { return m }
 
-// LockMarketRequestValidationError is the validation error returned by
-// LockMarketRequest.Validate if the designated constraints aren't met.
-type LockMarketRequestValidationError struct {
+// AdjustUserBalanceRequestValidationError is the validation error returned by
+// AdjustUserBalanceRequest.Validate if the designated constraints aren't met.
+type AdjustUserBalanceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2880,24 +15137,24 @@ type LockMarketRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LockMarketRequestValidationError) Field() string { return e.field }
+func (e AdjustUserBalanceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LockMarketRequestValidationError) Reason() string { return e.reason }
+func (e AdjustUserBalanceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LockMarketRequestValidationError) Cause() error { return e.cause }
+func (e AdjustUserBalanceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LockMarketRequestValidationError) Key() bool { return e.key }
+func (e AdjustUserBalanceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LockMarketRequestValidationError) ErrorName() string {
-	return "LockMarketRequestValidationError"
+func (e AdjustUserBalanceRequestValidationError) ErrorName() string {
+	return "AdjustUserBalanceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LockMarketRequestValidationError) Error() string {
+func (e AdjustUserBalanceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2909,14 +15166,14 @@ func (e LockMarketRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLockMarketRequest.%s: %s%s",
+		"invalid %sAdjustUserBalanceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LockMarketRequestValidationError{}
+var _ error = AdjustUserBalanceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2924,24 +15181,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LockMarketRequestValidationError{}
+} = AdjustUserBalanceRequestValidationError{}
 
-// Validate checks the field values on LockMarketResponse with the rules
+var _AdjustUserBalanceRequest_DeltaCentipoints_NotInLookup = map[int64]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AdjustUserBalanceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LockMarketResponse) Validate() error {
+func (m *AdjustUserBalanceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LockMarketResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdjustUserBalanceResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// LockMarketResponseMultiError, or nil if none found.
-func (m *LockMarketResponse) ValidateAll() error {
+// AdjustUserBalanceResponseMultiError, or nil if none found.
+func (m *AdjustUserBalanceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LockMarketResponse) validate(all bool) error {
+func (m *AdjustUserBalanceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2949,28 +15210,57 @@ func (m *LockMarketResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetMarket()).(type) {
+		switch v := interface{}(m.GetAdjustment()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LockMarketResponseValidationError{
-					field:  "Market",
+				errors = append(errors, AdjustUserBalanceResponseValidationError{
+					field:  "Adjustment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LockMarketResponseValidationError{
-					field:  "Market",
+				errors = append(errors, AdjustUserBalanceResponseValidationError{
+					field:  "Adjustment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAdjustment()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LockMarketResponseValidationError{
-				field:  "Market",
+			return AdjustUserBalanceResponseValidationError{
+				field:  "Adjustment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdjustUserBalanceResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdjustUserBalanceResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdjustUserBalanceResponseValidationError{
+				field:  "User",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2978,19 +15268,19 @@ func (m *LockMarketResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return LockMarketResponseMultiError(errors)
+		return AdjustUserBalanceResponseMultiError(errors)
 	}
 
 	return nil
-}
-
-// LockMarketResponseMultiError is an error wrapping multiple validation errors
-// returned by LockMarketResponse.ValidateAll() if the designated constraints
-// aren't met.
-type LockMarketResponseMultiError []error
+}
+
+// AdjustUserBalanceResponseMultiError is an error wrapping multiple validation
+// errors returned by AdjustUserBalanceResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AdjustUserBalanceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LockMarketResponseMultiError) Error() string {
+func (m AdjustUserBalanceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2999,11 +15289,11 @@ func (m LockMarketResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LockMarketResponseMultiError) AllErrors() []error { return m }
+func (m AdjustUserBalanceResponseMultiError) AllErrors() []error { return m }
 
-// LockMarketResponseValidationError is the validation error returned by
-// LockMarketResponse.Validate if the designated constraints aren't met.
-type LockMarketResponseValidationError struct {
+// AdjustUserBalanceResponseValidationError is the validation error returned by
+// AdjustUserBalanceResponse.Validate if the designated constraints aren't met.
+type AdjustUserBalanceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3011,24 +15301,24 @@ type LockMarketResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e LockMarketResponseValidationError) Field() string { return e.field }
+func (e AdjustUserBalanceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LockMarketResponseValidationError) Reason() string { return e.reason }
+func (e AdjustUserBalanceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LockMarketResponseValidationError) Cause() error { return e.cause }
+func (e AdjustUserBalanceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LockMarketResponseValidationError) Key() bool { return e.key }
+func (e AdjustUserBalanceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LockMarketResponseValidationError) ErrorName() string {
-	return "LockMarketResponseValidationError"
+func (e AdjustUserBalanceResponseValidationError) ErrorName() string {
+	return "AdjustUserBalanceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LockMarketResponseValidationError) Error() string {
+func (e AdjustUserBalanceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3040,14 +15330,14 @@ func (e LockMarketResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLockMarketResponse.%s: %s%s",
+		"invalid %sAdjustUserBalanceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LockMarketResponseValidationError{}
+var _ error = AdjustUserBalanceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3055,34 +15345,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LockMarketResponseValidationError{}
+} = AdjustUserBalanceResponseValidationError{}
 
-// Validate checks the field values on SettleMarketRequest with the rules
+// Validate checks the field values on ListAdjustmentsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SettleMarketRequest) Validate() error {
+func (m *ListAdjustmentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SettleMarketRequest with the rules
+// ValidateAll checks the field values on ListAdjustmentsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SettleMarketRequestMultiError, or nil if none found.
-func (m *SettleMarketRequest) ValidateAll() error {
+// ListAdjustmentsRequestMultiError, or nil if none found.
+func (m *ListAdjustmentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SettleMarketRequest) validate(all bool) error {
+func (m *ListAdjustmentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := SettleMarketRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
+	if m.GetPageSize() > 50 {
+		err := ListAdjustmentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 50",
 		}
 		if !all {
 			return err
@@ -3090,28 +15380,12 @@ func (m *SettleMarketRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	oneofTypePresent := false
-	switch v := m.Type.(type) {
-	case *SettleMarketRequest_Winner:
-		if v == nil {
-			err := SettleMarketRequestValidationError{
-				field:  "Type",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		oneofTypePresent = true
-		// no validation rules for Winner
-	default:
-		_ = v // ensures v is used
-	}
-	if !oneofTypePresent {
-		err := SettleMarketRequestValidationError{
-			field:  "Type",
-			reason: "value is required",
+	// no validation rules for PageToken
+
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := ListAdjustmentsRequestValidationError{
+			field:  "Book",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -3119,20 +15393,22 @@ func (m *SettleMarketRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for User
+
 	if len(errors) > 0 {
-		return SettleMarketRequestMultiError(errors)
+		return ListAdjustmentsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SettleMarketRequestMultiError is an error wrapping multiple validation
-// errors returned by SettleMarketRequest.ValidateAll() if the designated
+// ListAdjustmentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAdjustmentsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SettleMarketRequestMultiError []error
+type ListAdjustmentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SettleMarketRequestMultiError) Error() string {
+func (m ListAdjustmentsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3141,11 +15417,11 @@ func (m SettleMarketRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SettleMarketRequestMultiError) AllErrors() []error { return m }
+func (m ListAdjustmentsRequestMultiError) AllErrors() []error { return m }
 
-// SettleMarketRequestValidationError is the validation error returned by
-// SettleMarketRequest.Validate if the designated constraints aren't met.
-type SettleMarketRequestValidationError struct {
+// ListAdjustmentsRequestValidationError is the validation error returned by
+// ListAdjustmentsRequest.Validate if the designated constraints aren't met.
+type ListAdjustmentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3153,24 +15429,24 @@ type SettleMarketRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SettleMarketRequestValidationError) Field() string { return e.field }
+func (e ListAdjustmentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SettleMarketRequestValidationError) Reason() string { return e.reason }
+func (e ListAdjustmentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SettleMarketRequestValidationError) Cause() error { return e.cause }
+func (e ListAdjustmentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SettleMarketRequestValidationError) Key() bool { return e.key }
+func (e ListAdjustmentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SettleMarketRequestValidationError) ErrorName() string {
-	return "SettleMarketRequestValidationError"
+func (e ListAdjustmentsRequestValidationError) ErrorName() string {
+	return "ListAdjustmentsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SettleMarketRequestValidationError) Error() string {
+func (e ListAdjustmentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3182,14 +15458,14 @@ func (e SettleMarketRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSettleMarketRequest.%s: %s%s",
+		"invalid %sListAdjustmentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SettleMarketRequestValidationError{}
+var _ error = ListAdjustmentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3197,73 +15473,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SettleMarketRequestValidationError{}
+} = ListAdjustmentsRequestValidationError{}
 
-// Validate checks the field values on SettleMarketResponse with the rules
+// Validate checks the field values on ListAdjustmentsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SettleMarketResponse) Validate() error {
+func (m *ListAdjustmentsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SettleMarketResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAdjustmentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SettleMarketResponseMultiError, or nil if none found.
-func (m *SettleMarketResponse) ValidateAll() error {
+// ListAdjustmentsResponseMultiError, or nil if none found.
+func (m *ListAdjustmentsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SettleMarketResponse) validate(all bool) error {
+func (m *ListAdjustmentsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMarket()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SettleMarketResponseValidationError{
-					field:  "Market",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetAdjustments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAdjustmentsResponseValidationError{
+						field:  fmt.Sprintf("Adjustments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAdjustmentsResponseValidationError{
+						field:  fmt.Sprintf("Adjustments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SettleMarketResponseValidationError{
-					field:  "Market",
+				return ListAdjustmentsResponseValidationError{
+					field:  fmt.Sprintf("Adjustments[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SettleMarketResponseValidationError{
-				field:  "Market",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return SettleMarketResponseMultiError(errors)
+		return ListAdjustmentsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SettleMarketResponseMultiError is an error wrapping multiple validation
-// errors returned by SettleMarketResponse.ValidateAll() if the designated
+// ListAdjustmentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAdjustmentsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SettleMarketResponseMultiError []error
+type ListAdjustmentsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SettleMarketResponseMultiError) Error() string {
+func (m ListAdjustmentsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3272,11 +15555,11 @@ func (m SettleMarketResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SettleMarketResponseMultiError) AllErrors() []error { return m }
+func (m ListAdjustmentsResponseMultiError) AllErrors() []error { return m }
 
-// SettleMarketResponseValidationError is the validation error returned by
-// SettleMarketResponse.Validate if the designated constraints aren't met.
-type SettleMarketResponseValidationError struct {
+// ListAdjustmentsResponseValidationError is the validation error returned by
+// ListAdjustmentsResponse.Validate if the designated constraints aren't met.
+type ListAdjustmentsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3284,24 +15567,24 @@ type SettleMarketResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SettleMarketResponseValidationError) Field() string { return e.field }
+func (e ListAdjustmentsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SettleMarketResponseValidationError) Reason() string { return e.reason }
+func (e ListAdjustmentsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SettleMarketResponseValidationError) Cause() error { return e.cause }
+func (e ListAdjustmentsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SettleMarketResponseValidationError) Key() bool { return e.key }
+func (e ListAdjustmentsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SettleMarketResponseValidationError) ErrorName() string {
-	return "SettleMarketResponseValidationError"
+func (e ListAdjustmentsResponseValidationError) ErrorName() string {
+	return "ListAdjustmentsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SettleMarketResponseValidationError) Error() string {
+func (e ListAdjustmentsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3313,14 +15596,14 @@ func (e SettleMarketResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSettleMarketResponse.%s: %s%s",
+		"invalid %sListAdjustmentsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SettleMarketResponseValidationError{}
+var _ error = ListAdjustmentsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3328,33 +15611,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SettleMarketResponseValidationError{}
+} = ListAdjustmentsResponseValidationError{}
 
-// Validate checks the field values on CancelMarketRequest with the rules
+// Validate checks the field values on GetBookSettingsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelMarketRequest) Validate() error {
+func (m *GetBookSettingsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelMarketRequest with the rules
+// ValidateAll checks the field values on GetBookSettingsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelMarketRequestMultiError, or nil if none found.
-func (m *CancelMarketRequest) ValidateAll() error {
+// GetBookSettingsRequestMultiError, or nil if none found.
+func (m *GetBookSettingsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelMarketRequest) validate(all bool) error {
+func (m *GetBookSettingsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := CancelMarketRequestValidationError{
-			field:  "Name",
+	if utf8.RuneCountInString(m.GetBook()) < 1 {
+		err := GetBookSettingsRequestValidationError{
+			field:  "Book",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3364,19 +15647,19 @@ func (m *CancelMarketRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CancelMarketRequestMultiError(errors)
+		return GetBookSettingsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelMarketRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelMarketRequest.ValidateAll() if the designated
+// GetBookSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBookSettingsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CancelMarketRequestMultiError []error
+type GetBookSettingsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelMarketRequestMultiError) Error() string {
+func (m GetBookSettingsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3385,11 +15668,11 @@ func (m CancelMarketRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelMarketRequestMultiError) AllErrors() []error { return m }
+func (m GetBookSettingsRequestMultiError) AllErrors() []error { return m }
 
-// CancelMarketRequestValidationError is the validation error returned by
-// CancelMarketRequest.Validate if the designated constraints aren't met.
-type CancelMarketRequestValidationError struct {
+// GetBookSettingsRequestValidationError is the validation error returned by
+// GetBookSettingsRequest.Validate if the designated constraints aren't met.
+type GetBookSettingsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3397,24 +15680,24 @@ type CancelMarketRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelMarketRequestValidationError) Field() string { return e.field }
+func (e GetBookSettingsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelMarketRequestValidationError) Reason() string { return e.reason }
+func (e GetBookSettingsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelMarketRequestValidationError) Cause() error { return e.cause }
+func (e GetBookSettingsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelMarketRequestValidationError) Key() bool { return e.key }
+func (e GetBookSettingsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelMarketRequestValidationError) ErrorName() string {
-	return "CancelMarketRequestValidationError"
+func (e GetBookSettingsRequestValidationError) ErrorName() string {
+	return "GetBookSettingsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelMarketRequestValidationError) Error() string {
+func (e GetBookSettingsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3426,14 +15709,14 @@ func (e CancelMarketRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelMarketRequest.%s: %s%s",
+		"invalid %sGetBookSettingsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelMarketRequestValidationError{}
+var _ error = GetBookSettingsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3441,24 +15724,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelMarketRequestValidationError{}
+} = GetBookSettingsRequestValidationError{}
 
-// Validate checks the field values on CancelMarketResponse with the rules
+// Validate checks the field values on GetBookSettingsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelMarketResponse) Validate() error {
+func (m *GetBookSettingsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelMarketResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBookSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelMarketResponseMultiError, or nil if none found.
-func (m *CancelMarketResponse) ValidateAll() error {
+// GetBookSettingsResponseMultiError, or nil if none found.
+func (m *GetBookSettingsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelMarketResponse) validate(all bool) error {
+func (m *GetBookSettingsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3466,28 +15749,28 @@ func (m *CancelMarketResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetMarket()).(type) {
+		switch v := interface{}(m.GetSettings()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CancelMarketResponseValidationError{
-					field:  "Market",
+				errors = append(errors, GetBookSettingsResponseValidationError{
+					field:  "Settings",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CancelMarketResponseValidationError{
-					field:  "Market",
+				errors = append(errors, GetBookSettingsResponseValidationError{
+					field:  "Settings",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMarket()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CancelMarketResponseValidationError{
-				field:  "Market",
+			return GetBookSettingsResponseValidationError{
+				field:  "Settings",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3495,19 +15778,19 @@ func (m *CancelMarketResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CancelMarketResponseMultiError(errors)
+		return GetBookSettingsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelMarketResponseMultiError is an error wrapping multiple validation
-// errors returned by CancelMarketResponse.ValidateAll() if the designated
+// GetBookSettingsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBookSettingsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CancelMarketResponseMultiError []error
+type GetBookSettingsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelMarketResponseMultiError) Error() string {
+func (m GetBookSettingsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3516,11 +15799,11 @@ func (m CancelMarketResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelMarketResponseMultiError) AllErrors() []error { return m }
+func (m GetBookSettingsResponseMultiError) AllErrors() []error { return m }
 
-// CancelMarketResponseValidationError is the validation error returned by
-// CancelMarketResponse.Validate if the designated constraints aren't met.
-type CancelMarketResponseValidationError struct {
+// GetBookSettingsResponseValidationError is the validation error returned by
+// GetBookSettingsResponse.Validate if the designated constraints aren't met.
+type GetBookSettingsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3528,24 +15811,24 @@ type CancelMarketResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelMarketResponseValidationError) Field() string { return e.field }
+func (e GetBookSettingsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelMarketResponseValidationError) Reason() string { return e.reason }
+func (e GetBookSettingsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelMarketResponseValidationError) Cause() error { return e.cause }
+func (e GetBookSettingsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelMarketResponseValidationError) Key() bool { return e.key }
+func (e GetBookSettingsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelMarketResponseValidationError) ErrorName() string {
-	return "CancelMarketResponseValidationError"
+func (e GetBookSettingsResponseValidationError) ErrorName() string {
+	return "GetBookSettingsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelMarketResponseValidationError) Error() string {
+func (e GetBookSettingsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3557,14 +15840,14 @@ func (e CancelMarketResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelMarketResponse.%s: %s%s",
+		"invalid %sGetBookSettingsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelMarketResponseValidationError{}
+var _ error = GetBookSettingsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3572,75 +15855,95 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelMarketResponseValidationError{}
+} = GetBookSettingsResponseValidationError{}
 
-// Validate checks the field values on CreateBetRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateBetRequest) Validate() error {
+// Validate checks the field values on UpdateBookSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookSettingsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateBookSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBetRequestMultiError, or nil if none found.
-func (m *CreateBetRequest) ValidateAll() error {
+// UpdateBookSettingsRequestMultiError, or nil if none found.
+func (m *UpdateBookSettingsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBetRequest) validate(all bool) error {
+func (m *UpdateBookSettingsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Book
+	if m.GetSettings() == nil {
+		err := UpdateBookSettingsRequestValidationError{
+			field:  "Settings",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if all {
-		switch v := interface{}(m.GetBet()).(type) {
+		switch v := interface{}(m.GetSettings()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBetRequestValidationError{
-					field:  "Bet",
+				errors = append(errors, UpdateBookSettingsRequestValidationError{
+					field:  "Settings",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBetRequestValidationError{
-					field:  "Bet",
+				errors = append(errors, UpdateBookSettingsRequestValidationError{
+					field:  "Settings",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateBetRequestValidationError{
-				field:  "Bet",
+			return UpdateBookSettingsRequestValidationError{
+				field:  "Settings",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if utf8.RuneCountInString(m.GetActor()) < 1 {
+		err := UpdateBookSettingsRequestValidationError{
+			field:  "Actor",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return CreateBetRequestMultiError(errors)
+		return UpdateBookSettingsRequestMultiError(errors)
 	}
 
 	return nil
-}
-
-// CreateBetRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateBetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateBetRequestMultiError []error
+}
+
+// UpdateBookSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookSettingsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateBookSettingsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBetRequestMultiError) Error() string {
+func (m UpdateBookSettingsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3649,11 +15952,11 @@ func (m CreateBetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBetRequestMultiError) AllErrors() []error { return m }
+func (m UpdateBookSettingsRequestMultiError) AllErrors() []error { return m }
 
-// CreateBetRequestValidationError is the validation error returned by
-// CreateBetRequest.Validate if the designated constraints aren't met.
-type CreateBetRequestValidationError struct {
+// UpdateBookSettingsRequestValidationError is the validation error returned by
+// UpdateBookSettingsRequest.Validate if the designated constraints aren't met.
+type UpdateBookSettingsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3661,22 +15964,24 @@ type CreateBetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBetRequestValidationError) Field() string { return e.field }
+func (e UpdateBookSettingsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBetRequestValidationError) Reason() string { return e.reason }
+func (e UpdateBookSettingsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBetRequestValidationError) Cause() error { return e.cause }
+func (e UpdateBookSettingsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBetRequestValidationError) Key() bool { return e.key }
+func (e UpdateBookSettingsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBetRequestValidationError) ErrorName() string { return "CreateBetRequestValidationError" }
+func (e UpdateBookSettingsRequestValidationError) ErrorName() string {
+	return "UpdateBookSettingsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreateBetRequestValidationError) Error() string {
+func (e UpdateBookSettingsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3688,14 +15993,14 @@ func (e CreateBetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBetRequest.%s: %s%s",
+		"invalid %sUpdateBookSettingsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBetRequestValidationError{}
+var _ error = UpdateBookSettingsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3703,24 +16008,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBetRequestValidationError{}
+} = UpdateBookSettingsRequestValidationError{}
 
-// Validate checks the field values on CreateBetResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateBetResponse) Validate() error {
+// Validate checks the field values on UpdateBookSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookSettingsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateBookSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBetResponseMultiError, or nil if none found.
-func (m *CreateBetResponse) ValidateAll() error {
+// UpdateBookSettingsResponseMultiError, or nil if none found.
+func (m *UpdateBookSettingsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBetResponse) validate(all bool) error {
+func (m *UpdateBookSettingsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3728,28 +16033,28 @@ func (m *CreateBetResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetBet()).(type) {
+		switch v := interface{}(m.GetSettings()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBetResponseValidationError{
-					field:  "Bet",
+				errors = append(errors, UpdateBookSettingsResponseValidationError{
+					field:  "Settings",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBetResponseValidationError{
-					field:  "Bet",
+				errors = append(errors, UpdateBookSettingsResponseValidationError{
+					field:  "Settings",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateBetResponseValidationError{
-				field:  "Bet",
+			return UpdateBookSettingsResponseValidationError{
+				field:  "Settings",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3757,19 +16062,19 @@ func (m *CreateBetResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateBetResponseMultiError(errors)
+		return UpdateBookSettingsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBetResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateBetResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CreateBetResponseMultiError []error
+// UpdateBookSettingsResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateBookSettingsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateBookSettingsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBetResponseMultiError) Error() string {
+func (m UpdateBookSettingsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3778,11 +16083,11 @@ func (m CreateBetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBetResponseMultiError) AllErrors() []error { return m }
+func (m UpdateBookSettingsResponseMultiError) AllErrors() []error { return m }
 
-// CreateBetResponseValidationError is the validation error returned by
-// CreateBetResponse.Validate if the designated constraints aren't met.
-type CreateBetResponseValidationError struct {
+// UpdateBookSettingsResponseValidationError is the validation error returned
+// by UpdateBookSettingsResponse.Validate if the designated constraints aren't met.
+type UpdateBookSettingsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3790,24 +16095,24 @@ type CreateBetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBetResponseValidationError) Field() string { return e.field }
+func (e UpdateBookSettingsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBetResponseValidationError) Reason() string { return e.reason }
+func (e UpdateBookSettingsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBetResponseValidationError) Cause() error { return e.cause }
+func (e UpdateBookSettingsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBetResponseValidationError) Key() bool { return e.key }
+func (e UpdateBookSettingsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBetResponseValidationError) ErrorName() string {
-	return "CreateBetResponseValidationError"
+func (e UpdateBookSettingsResponseValidationError) ErrorName() string {
+	return "UpdateBookSettingsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBetResponseValidationError) Error() string {
+func (e UpdateBookSettingsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3819,14 +16124,14 @@ func (e CreateBetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBetResponse.%s: %s%s",
+		"invalid %sUpdateBookSettingsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBetResponseValidationError{}
+var _ error = UpdateBookSettingsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3834,33 +16139,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBetResponseValidationError{}
+} = UpdateBookSettingsResponseValidationError{}
 
-// Validate checks the field values on GetBetRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetBetRequest) Validate() error {
+// Validate checks the field values on ClaimAllowanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClaimAllowanceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBetRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetBetRequestMultiError, or
-// nil if none found.
-func (m *GetBetRequest) ValidateAll() error {
+// ValidateAll checks the field values on ClaimAllowanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClaimAllowanceRequestMultiError, or nil if none found.
+func (m *ClaimAllowanceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBetRequest) validate(all bool) error {
+func (m *ClaimAllowanceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetBet()) < 1 {
-		err := GetBetRequestValidationError{
-			field:  "Bet",
+	if utf8.RuneCountInString(m.GetUser()) < 1 {
+		err := ClaimAllowanceRequestValidationError{
+			field:  "User",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3870,19 +16175,19 @@ func (m *GetBetRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetBetRequestMultiError(errors)
+		return ClaimAllowanceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBetRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetBetRequestMultiError []error
+// ClaimAllowanceRequestMultiError is an error wrapping multiple validation
+// errors returned by ClaimAllowanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ClaimAllowanceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBetRequestMultiError) Error() string {
+func (m ClaimAllowanceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3891,11 +16196,11 @@ func (m GetBetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBetRequestMultiError) AllErrors() []error { return m }
+func (m ClaimAllowanceRequestMultiError) AllErrors() []error { return m }
 
-// GetBetRequestValidationError is the validation error returned by
-// GetBetRequest.Validate if the designated constraints aren't met.
-type GetBetRequestValidationError struct {
+// ClaimAllowanceRequestValidationError is the validation error returned by
+// ClaimAllowanceRequest.Validate if the designated constraints aren't met.
+type ClaimAllowanceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3903,22 +16208,24 @@ type GetBetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBetRequestValidationError) Field() string { return e.field }
+func (e ClaimAllowanceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBetRequestValidationError) Reason() string { return e.reason }
+func (e ClaimAllowanceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBetRequestValidationError) Cause() error { return e.cause }
+func (e ClaimAllowanceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBetRequestValidationError) Key() bool { return e.key }
+func (e ClaimAllowanceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBetRequestValidationError) ErrorName() string { return "GetBetRequestValidationError" }
+func (e ClaimAllowanceRequestValidationError) ErrorName() string {
+	return "ClaimAllowanceRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetBetRequestValidationError) Error() string {
+func (e ClaimAllowanceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3930,14 +16237,14 @@ func (e GetBetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBetRequest.%s: %s%s",
+		"invalid %sClaimAllowanceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBetRequestValidationError{}
+var _ error = ClaimAllowanceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3945,24 +16252,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBetRequestValidationError{}
+} = ClaimAllowanceRequestValidationError{}
 
-// Validate checks the field values on GetBetResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetBetResponse) Validate() error {
+// Validate checks the field values on ClaimAllowanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClaimAllowanceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBetResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetBetResponseMultiError,
-// or nil if none found.
-func (m *GetBetResponse) ValidateAll() error {
+// ValidateAll checks the field values on ClaimAllowanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClaimAllowanceResponseMultiError, or nil if none found.
+func (m *ClaimAllowanceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBetResponse) validate(all bool) error {
+func (m *ClaimAllowanceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3970,28 +16277,86 @@ func (m *GetBetResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetBet()).(type) {
+		switch v := interface{}(m.GetUser()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBetResponseValidationError{
-					field:  "Bet",
+				errors = append(errors, ClaimAllowanceResponseValidationError{
+					field:  "User",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBetResponseValidationError{
-					field:  "Bet",
+				errors = append(errors, ClaimAllowanceResponseValidationError{
+					field:  "User",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBet()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetBetResponseValidationError{
-				field:  "Bet",
+			return ClaimAllowanceResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGrant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClaimAllowanceResponseValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClaimAllowanceResponseValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGrant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClaimAllowanceResponseValidationError{
+				field:  "Grant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextClaimTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClaimAllowanceResponseValidationError{
+					field:  "NextClaimTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClaimAllowanceResponseValidationError{
+					field:  "NextClaimTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextClaimTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClaimAllowanceResponseValidationError{
+				field:  "NextClaimTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3999,19 +16364,19 @@ func (m *GetBetResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetBetResponseMultiError(errors)
+		return ClaimAllowanceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBetResponseMultiError is an error wrapping multiple validation errors
-// returned by GetBetResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetBetResponseMultiError []error
+// ClaimAllowanceResponseMultiError is an error wrapping multiple validation
+// errors returned by ClaimAllowanceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ClaimAllowanceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBetResponseMultiError) Error() string {
+func (m ClaimAllowanceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4020,11 +16385,11 @@ func (m GetBetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBetResponseMultiError) AllErrors() []error { return m }
+func (m ClaimAllowanceResponseMultiError) AllErrors() []error { return m }
 
-// GetBetResponseValidationError is the validation error returned by
-// GetBetResponse.Validate if the designated constraints aren't met.
-type GetBetResponseValidationError struct {
+// ClaimAllowanceResponseValidationError is the validation error returned by
+// ClaimAllowanceResponse.Validate if the designated constraints aren't met.
+type ClaimAllowanceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4032,22 +16397,24 @@ type GetBetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBetResponseValidationError) Field() string { return e.field }
+func (e ClaimAllowanceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBetResponseValidationError) Reason() string { return e.reason }
+func (e ClaimAllowanceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBetResponseValidationError) Cause() error { return e.cause }
+func (e ClaimAllowanceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBetResponseValidationError) Key() bool { return e.key }
+func (e ClaimAllowanceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBetResponseValidationError) ErrorName() string { return "GetBetResponseValidationError" }
+func (e ClaimAllowanceResponseValidationError) ErrorName() string {
+	return "ClaimAllowanceResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetBetResponseValidationError) Error() string {
+func (e ClaimAllowanceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4059,14 +16426,14 @@ func (e GetBetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBetResponse.%s: %s%s",
+		"invalid %sClaimAllowanceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBetResponseValidationError{}
+var _ error = ClaimAllowanceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4074,24 +16441,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBetResponseValidationError{}
+} = ClaimAllowanceResponseValidationError{}
 
-// Validate checks the field values on ListBetsRequest with the rules defined
+// Validate checks the field values on ListGrantsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListBetsRequest) Validate() error {
+func (m *ListGrantsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBetsRequest with the rules
+// ValidateAll checks the field values on ListGrantsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBetsRequestMultiError, or nil if none found.
-func (m *ListBetsRequest) ValidateAll() error {
+// ListGrantsRequestMultiError, or nil if none found.
+func (m *ListGrantsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBetsRequest) validate(all bool) error {
+func (m *ListGrantsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4099,7 +16466,7 @@ func (m *ListBetsRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetPageSize() > 50 {
-		err := ListBetsRequestValidationError{
+		err := ListGrantsRequestValidationError{
 			field:  "PageSize",
 			reason: "value must be less than or equal to 50",
 		}
@@ -4112,7 +16479,7 @@ func (m *ListBetsRequest) validate(all bool) error {
 	// no validation rules for PageToken
 
 	if utf8.RuneCountInString(m.GetBook()) < 1 {
-		err := ListBetsRequestValidationError{
+		err := ListGrantsRequestValidationError{
 			field:  "Book",
 			reason: "value length must be at least 1 runes",
 		}
@@ -4124,24 +16491,20 @@ func (m *ListBetsRequest) validate(all bool) error {
 
 	// no validation rules for User
 
-	// no validation rules for Market
-
-	// no validation rules for ExcludeSettled
-
 	if len(errors) > 0 {
-		return ListBetsRequestMultiError(errors)
+		return ListGrantsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBetsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListBetsRequest.ValidateAll() if the designated constraints
+// ListGrantsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListGrantsRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListBetsRequestMultiError []error
+type ListGrantsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBetsRequestMultiError) Error() string {
+func (m ListGrantsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4150,11 +16513,11 @@ func (m ListBetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBetsRequestMultiError) AllErrors() []error { return m }
+func (m ListGrantsRequestMultiError) AllErrors() []error { return m }
 
-// ListBetsRequestValidationError is the validation error returned by
-// ListBetsRequest.Validate if the designated constraints aren't met.
-type ListBetsRequestValidationError struct {
+// ListGrantsRequestValidationError is the validation error returned by
+// ListGrantsRequest.Validate if the designated constraints aren't met.
+type ListGrantsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4162,22 +16525,24 @@ type ListBetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBetsRequestValidationError) Field() string { return e.field }
+func (e ListGrantsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBetsRequestValidationError) Reason() string { return e.reason }
+func (e ListGrantsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBetsRequestValidationError) Cause() error { return e.cause }
+func (e ListGrantsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBetsRequestValidationError) Key() bool { return e.key }
+func (e ListGrantsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBetsRequestValidationError) ErrorName() string { return "ListBetsRequestValidationError" }
+func (e ListGrantsRequestValidationError) ErrorName() string {
+	return "ListGrantsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListBetsRequestValidationError) Error() string {
+func (e ListGrantsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4189,14 +16554,14 @@ func (e ListBetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBetsRequest.%s: %s%s",
+		"invalid %sListGrantsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBetsRequestValidationError{}
+var _ error = ListGrantsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4204,47 +16569,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBetsRequestValidationError{}
+} = ListGrantsRequestValidationError{}
 
-// Validate checks the field values on ListBetsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListBetsResponse) Validate() error {
+// Validate checks the field values on ListGrantsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGrantsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBetsResponse with the rules
+// ValidateAll checks the field values on ListGrantsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBetsResponseMultiError, or nil if none found.
-func (m *ListBetsResponse) ValidateAll() error {
+// ListGrantsResponseMultiError, or nil if none found.
+func (m *ListGrantsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBetsResponse) validate(all bool) error {
+func (m *ListGrantsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBets() {
+	for idx, item := range m.GetGrants() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBetsResponseValidationError{
-						field:  fmt.Sprintf("Bets[%v]", idx),
+					errors = append(errors, ListGrantsResponseValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBetsResponseValidationError{
-						field:  fmt.Sprintf("Bets[%v]", idx),
+					errors = append(errors, ListGrantsResponseValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4252,8 +16617,8 @@ func (m *ListBetsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListBetsResponseValidationError{
-					field:  fmt.Sprintf("Bets[%v]", idx),
+				return ListGrantsResponseValidationError{
+					field:  fmt.Sprintf("Grants[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4265,19 +16630,19 @@ func (m *ListBetsResponse) validate(all bool) error {
 	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return ListBetsResponseMultiError(errors)
+		return ListGrantsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBetsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListBetsResponse.ValidateAll() if the designated constraints
+// ListGrantsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListGrantsResponse.ValidateAll() if the designated constraints
 // aren't met.
-type ListBetsResponseMultiError []error
+type ListGrantsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBetsResponseMultiError) Error() string {
+func (m ListGrantsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4286,11 +16651,11 @@ func (m ListBetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBetsResponseMultiError) AllErrors() []error { return m }
+func (m ListGrantsResponseMultiError) AllErrors() []error { return m }
 
-// ListBetsResponseValidationError is the validation error returned by
-// ListBetsResponse.Validate if the designated constraints aren't met.
-type ListBetsResponseValidationError struct {
+// ListGrantsResponseValidationError is the validation error returned by
+// ListGrantsResponse.Validate if the designated constraints aren't met.
+type ListGrantsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4298,22 +16663,24 @@ type ListBetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBetsResponseValidationError) Field() string { return e.field }
+func (e ListGrantsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBetsResponseValidationError) Reason() string { return e.reason }
+func (e ListGrantsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBetsResponseValidationError) Cause() error { return e.cause }
+func (e ListGrantsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBetsResponseValidationError) Key() bool { return e.key }
+func (e ListGrantsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBetsResponseValidationError) ErrorName() string { return "ListBetsResponseValidationError" }
+func (e ListGrantsResponseValidationError) ErrorName() string {
+	return "ListGrantsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListBetsResponseValidationError) Error() string {
+func (e ListGrantsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4325,14 +16692,14 @@ func (e ListBetsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBetsResponse.%s: %s%s",
+		"invalid %sListGrantsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBetsResponseValidationError{}
+var _ error = ListGrantsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4340,4 +16707,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBetsResponseValidationError{}
+} = ListGrantsResponseValidationError{}
@@ -57,6 +57,9 @@ const (
 	// BettorServiceSettleMarketProcedure is the fully-qualified name of the BettorService's
 	// SettleMarket RPC.
 	BettorServiceSettleMarketProcedure = "/bettor.v1alpha.BettorService/SettleMarket"
+	// BettorServiceResettleMarketProcedure is the fully-qualified name of the BettorService's
+	// ResettleMarket RPC.
+	BettorServiceResettleMarketProcedure = "/bettor.v1alpha.BettorService/ResettleMarket"
 	// BettorServiceCancelMarketProcedure is the fully-qualified name of the BettorService's
 	// CancelMarket RPC.
 	BettorServiceCancelMarketProcedure = "/bettor.v1alpha.BettorService/CancelMarket"
@@ -66,6 +69,79 @@ const (
 	BettorServiceGetBetProcedure = "/bettor.v1alpha.BettorService/GetBet"
 	// BettorServiceListBetsProcedure is the fully-qualified name of the BettorService's ListBets RPC.
 	BettorServiceListBetsProcedure = "/bettor.v1alpha.BettorService/ListBets"
+	// BettorServiceWatchMarketProcedure is the fully-qualified name of the BettorService's WatchMarket
+	// RPC.
+	BettorServiceWatchMarketProcedure = "/bettor.v1alpha.BettorService/WatchMarket"
+	// BettorServiceWatchBookProcedure is the fully-qualified name of the BettorService's WatchBook RPC.
+	BettorServiceWatchBookProcedure = "/bettor.v1alpha.BettorService/WatchBook"
+	// BettorServiceCreateWebhookProcedure is the fully-qualified name of the BettorService's
+	// CreateWebhook RPC.
+	BettorServiceCreateWebhookProcedure = "/bettor.v1alpha.BettorService/CreateWebhook"
+	// BettorServiceListWebhooksProcedure is the fully-qualified name of the BettorService's
+	// ListWebhooks RPC.
+	BettorServiceListWebhooksProcedure = "/bettor.v1alpha.BettorService/ListWebhooks"
+	// BettorServiceDeleteWebhookProcedure is the fully-qualified name of the BettorService's
+	// DeleteWebhook RPC.
+	BettorServiceDeleteWebhookProcedure = "/bettor.v1alpha.BettorService/DeleteWebhook"
+	// BettorServiceBatchGetUsersProcedure is the fully-qualified name of the BettorService's
+	// BatchGetUsers RPC.
+	BettorServiceBatchGetUsersProcedure = "/bettor.v1alpha.BettorService/BatchGetUsers"
+	// BettorServiceBatchGetMarketsProcedure is the fully-qualified name of the BettorService's
+	// BatchGetMarkets RPC.
+	BettorServiceBatchGetMarketsProcedure = "/bettor.v1alpha.BettorService/BatchGetMarkets"
+	// BettorServiceBatchGetBetsProcedure is the fully-qualified name of the BettorService's
+	// BatchGetBets RPC.
+	BettorServiceBatchGetBetsProcedure = "/bettor.v1alpha.BettorService/BatchGetBets"
+	// BettorServiceGetMarketStatsProcedure is the fully-qualified name of the BettorService's
+	// GetMarketStats RPC.
+	BettorServiceGetMarketStatsProcedure = "/bettor.v1alpha.BettorService/GetMarketStats"
+	// BettorServiceGetUserStatsProcedure is the fully-qualified name of the BettorService's
+	// GetUserStats RPC.
+	BettorServiceGetUserStatsProcedure = "/bettor.v1alpha.BettorService/GetUserStats"
+	// BettorServiceGetUserForecastScoreProcedure is the fully-qualified name of the BettorService's
+	// GetUserForecastScore RPC.
+	BettorServiceGetUserForecastScoreProcedure = "/bettor.v1alpha.BettorService/GetUserForecastScore"
+	// BettorServiceGetForecastLeaderboardProcedure is the fully-qualified name of the BettorService's
+	// GetForecastLeaderboard RPC.
+	BettorServiceGetForecastLeaderboardProcedure = "/bettor.v1alpha.BettorService/GetForecastLeaderboard"
+	// BettorServiceListMarketOddsHistoryProcedure is the fully-qualified name of the BettorService's
+	// ListMarketOddsHistory RPC.
+	BettorServiceListMarketOddsHistoryProcedure = "/bettor.v1alpha.BettorService/ListMarketOddsHistory"
+	// BettorServiceGetUserBalanceHistoryProcedure is the fully-qualified name of the BettorService's
+	// GetUserBalanceHistory RPC.
+	BettorServiceGetUserBalanceHistoryProcedure = "/bettor.v1alpha.BettorService/GetUserBalanceHistory"
+	// BettorServiceGetBookSettingsProcedure is the fully-qualified name of the BettorService's
+	// GetBookSettings RPC.
+	BettorServiceGetBookSettingsProcedure = "/bettor.v1alpha.BettorService/GetBookSettings"
+	// BettorServiceUpdateBookSettingsProcedure is the fully-qualified name of the BettorService's
+	// UpdateBookSettings RPC.
+	BettorServiceUpdateBookSettingsProcedure = "/bettor.v1alpha.BettorService/UpdateBookSettings"
+	// BettorServiceClaimAllowanceProcedure is the fully-qualified name of the BettorService's
+	// ClaimAllowance RPC.
+	BettorServiceClaimAllowanceProcedure = "/bettor.v1alpha.BettorService/ClaimAllowance"
+	// BettorServiceListGrantsProcedure is the fully-qualified name of the BettorService's ListGrants
+	// RPC.
+	BettorServiceListGrantsProcedure = "/bettor.v1alpha.BettorService/ListGrants"
+	// BettorServiceStartSeasonProcedure is the fully-qualified name of the BettorService's StartSeason
+	// RPC.
+	BettorServiceStartSeasonProcedure = "/bettor.v1alpha.BettorService/StartSeason"
+	// BettorServiceGetSeasonProcedure is the fully-qualified name of the BettorService's GetSeason RPC.
+	BettorServiceGetSeasonProcedure = "/bettor.v1alpha.BettorService/GetSeason"
+	// BettorServiceListSeasonsProcedure is the fully-qualified name of the BettorService's ListSeasons
+	// RPC.
+	BettorServiceListSeasonsProcedure = "/bettor.v1alpha.BettorService/ListSeasons"
+	// BettorServiceTransferPointsProcedure is the fully-qualified name of the BettorService's
+	// TransferPoints RPC.
+	BettorServiceTransferPointsProcedure = "/bettor.v1alpha.BettorService/TransferPoints"
+	// BettorServiceListTransfersProcedure is the fully-qualified name of the BettorService's
+	// ListTransfers RPC.
+	BettorServiceListTransfersProcedure = "/bettor.v1alpha.BettorService/ListTransfers"
+	// BettorServiceAdjustUserBalanceProcedure is the fully-qualified name of the BettorService's
+	// AdjustUserBalance RPC.
+	BettorServiceAdjustUserBalanceProcedure = "/bettor.v1alpha.BettorService/AdjustUserBalance"
+	// BettorServiceListAdjustmentsProcedure is the fully-qualified name of the BettorService's
+	// ListAdjustments RPC.
+	BettorServiceListAdjustmentsProcedure = "/bettor.v1alpha.BettorService/ListAdjustments"
 )
 
 // BettorServiceClient is a client for the bettor.v1alpha.BettorService service.
@@ -88,6 +164,10 @@ type BettorServiceClient interface {
 	LockMarket(context.Context, *connect_go.Request[v1alpha.LockMarketRequest]) (*connect_go.Response[v1alpha.LockMarketResponse], error)
 	// SettleMarket settles a betting market and pays out bets.
 	SettleMarket(context.Context, *connect_go.Request[v1alpha.SettleMarketRequest]) (*connect_go.Response[v1alpha.SettleMarketResponse], error)
+	// ResettleMarket corrects the winner of a recently settled market. Previous payouts are clawed back and bets are paid
+	// out again for the new winner. Points that cannot be clawed back are recorded as debts on the new settlement. It may
+	// only be called on behalf of the market's creator or book admins.
+	ResettleMarket(context.Context, *connect_go.Request[v1alpha.ResettleMarketRequest]) (*connect_go.Response[v1alpha.ResettleMarketResponse], error)
 	// CancelMarket cancels a betting market and redunds all bettors.
 	CancelMarket(context.Context, *connect_go.Request[v1alpha.CancelMarketRequest]) (*connect_go.Response[v1alpha.CancelMarketResponse], error)
 	// CreateBet places a bet on an open betting market.
@@ -96,6 +176,61 @@ type BettorServiceClient interface {
 	GetBet(context.Context, *connect_go.Request[v1alpha.GetBetRequest]) (*connect_go.Response[v1alpha.GetBetResponse], error)
 	// ListBet lists bets by filters.
 	ListBets(context.Context, *connect_go.Request[v1alpha.ListBetsRequest]) (*connect_go.Response[v1alpha.ListBetsResponse], error)
+	// WatchMarket streams changes to a market and its bets. The current market is sent first.
+	WatchMarket(context.Context, *connect_go.Request[v1alpha.WatchMarketRequest]) (*connect_go.ServerStreamForClient[v1alpha.WatchMarketResponse], error)
+	// WatchBook streams changes to all users, markets, and bets in a book.
+	WatchBook(context.Context, *connect_go.Request[v1alpha.WatchBookRequest]) (*connect_go.ServerStreamForClient[v1alpha.WatchBookResponse], error)
+	// CreateWebhook subscribes a URL to a book's market and bet events.
+	CreateWebhook(context.Context, *connect_go.Request[v1alpha.CreateWebhookRequest]) (*connect_go.Response[v1alpha.CreateWebhookResponse], error)
+	// ListWebhooks lists a book's webhooks. Secrets are not returned.
+	ListWebhooks(context.Context, *connect_go.Request[v1alpha.ListWebhooksRequest]) (*connect_go.Response[v1alpha.ListWebhooksResponse], error)
+	// DeleteWebhook deletes a webhook. Its pending deliveries are dropped.
+	DeleteWebhook(context.Context, *connect_go.Request[v1alpha.DeleteWebhookRequest]) (*connect_go.Response[v1alpha.DeleteWebhookResponse], error)
+	// BatchGetUsers gets up to 100 users by name. Names that are not found are returned in not_found.
+	BatchGetUsers(context.Context, *connect_go.Request[v1alpha.BatchGetUsersRequest]) (*connect_go.Response[v1alpha.BatchGetUsersResponse], error)
+	// BatchGetMarkets gets up to 100 markets by name. Names that are not found are returned in not_found.
+	BatchGetMarkets(context.Context, *connect_go.Request[v1alpha.BatchGetMarketsRequest]) (*connect_go.Response[v1alpha.BatchGetMarketsResponse], error)
+	// BatchGetBets gets up to 100 bets by name. Names that are not found are returned in not_found.
+	BatchGetBets(context.Context, *connect_go.Request[v1alpha.BatchGetBetsRequest]) (*connect_go.Response[v1alpha.BatchGetBetsResponse], error)
+	// GetMarketStats returns aggregate stats of a market like implied probabilities and payout multipliers.
+	GetMarketStats(context.Context, *connect_go.Request[v1alpha.GetMarketStatsRequest]) (*connect_go.Response[v1alpha.GetMarketStatsResponse], error)
+	// GetUserStats returns betting performance of a user like win rate and net profit.
+	GetUserStats(context.Context, *connect_go.Request[v1alpha.GetUserStatsRequest]) (*connect_go.Response[v1alpha.GetUserStatsResponse], error)
+	// GetUserForecastScore returns the Brier score, log score, and calibration of a user's bets.
+	GetUserForecastScore(context.Context, *connect_go.Request[v1alpha.GetUserForecastScoreRequest]) (*connect_go.Response[v1alpha.GetUserForecastScoreResponse], error)
+	// GetForecastLeaderboard returns the users of a book with the best Brier scores. Users need a minimum number of
+	// scored bets to be ranked.
+	GetForecastLeaderboard(context.Context, *connect_go.Request[v1alpha.GetForecastLeaderboardRequest]) (*connect_go.Response[v1alpha.GetForecastLeaderboardResponse], error)
+	// ListMarketOddsHistory lists snapshots of a market's pool taken each time a bet changed it. Only the most recent
+	// snapshots of a market are retained.
+	ListMarketOddsHistory(context.Context, *connect_go.Request[v1alpha.ListMarketOddsHistoryRequest]) (*connect_go.Response[v1alpha.ListMarketOddsHistoryResponse], error)
+	// GetUserBalanceHistory returns a user's total points over time. It is derived from the user's settled bets back
+	// from their current total.
+	GetUserBalanceHistory(context.Context, *connect_go.Request[v1alpha.GetUserBalanceHistoryRequest]) (*connect_go.Response[v1alpha.GetUserBalanceHistoryResponse], error)
+	// GetBookSettings returns the settings of a book.
+	GetBookSettings(context.Context, *connect_go.Request[v1alpha.GetBookSettingsRequest]) (*connect_go.Response[v1alpha.GetBookSettingsResponse], error)
+	// UpdateBookSettings replaces the settings of a book.
+	UpdateBookSettings(context.Context, *connect_go.Request[v1alpha.UpdateBookSettingsRequest]) (*connect_go.Response[v1alpha.UpdateBookSettingsResponse], error)
+	// ClaimAllowance grants a user their book's allowance. It can be claimed once per the book's allowance cooldown.
+	ClaimAllowance(context.Context, *connect_go.Request[v1alpha.ClaimAllowanceRequest]) (*connect_go.Response[v1alpha.ClaimAllowanceResponse], error)
+	// ListGrants lists grants of points to users of a book.
+	ListGrants(context.Context, *connect_go.Request[v1alpha.ListGrantsRequest]) (*connect_go.Response[v1alpha.ListGrantsResponse], error)
+	// StartSeason ends a book's current season, archiving its standings, and starts a new one with every user's balance
+	// reset.
+	StartSeason(context.Context, *connect_go.Request[v1alpha.StartSeasonRequest]) (*connect_go.Response[v1alpha.StartSeasonResponse], error)
+	// GetSeason returns a season by name.
+	GetSeason(context.Context, *connect_go.Request[v1alpha.GetSeasonRequest]) (*connect_go.Response[v1alpha.GetSeasonResponse], error)
+	// ListSeasons lists a book's seasons.
+	ListSeasons(context.Context, *connect_go.Request[v1alpha.ListSeasonsRequest]) (*connect_go.Response[v1alpha.ListSeasonsResponse], error)
+	// TransferPoints sends points from one user to another in the same book.
+	TransferPoints(context.Context, *connect_go.Request[v1alpha.TransferPointsRequest]) (*connect_go.Response[v1alpha.TransferPointsResponse], error)
+	// ListTransfers lists transfers of points between users of a book.
+	ListTransfers(context.Context, *connect_go.Request[v1alpha.ListTransfersRequest]) (*connect_go.Response[v1alpha.ListTransfersResponse], error)
+	// AdjustUserBalance credits or debits a user's balance to correct mistakes. It may only be called on behalf of book
+	// admins and does not allow balances to go negative.
+	AdjustUserBalance(context.Context, *connect_go.Request[v1alpha.AdjustUserBalanceRequest]) (*connect_go.Response[v1alpha.AdjustUserBalanceResponse], error)
+	// ListAdjustments lists admin adjustments to user balances in a book.
+	ListAdjustments(context.Context, *connect_go.Request[v1alpha.ListAdjustmentsRequest]) (*connect_go.Response[v1alpha.ListAdjustmentsResponse], error)
 }
 
 // NewBettorServiceClient constructs a client for the bettor.v1alpha.BettorService service. By
@@ -153,6 +288,11 @@ func NewBettorServiceClient(httpClient connect_go.HTTPClient, baseURL string, op
 			baseURL+BettorServiceSettleMarketProcedure,
 			opts...,
 		),
+		resettleMarket: connect_go.NewClient[v1alpha.ResettleMarketRequest, v1alpha.ResettleMarketResponse](
+			httpClient,
+			baseURL+BettorServiceResettleMarketProcedure,
+			opts...,
+		),
 		cancelMarket: connect_go.NewClient[v1alpha.CancelMarketRequest, v1alpha.CancelMarketResponse](
 			httpClient,
 			baseURL+BettorServiceCancelMarketProcedure,
@@ -173,24 +313,175 @@ func NewBettorServiceClient(httpClient connect_go.HTTPClient, baseURL string, op
 			baseURL+BettorServiceListBetsProcedure,
 			opts...,
 		),
+		watchMarket: connect_go.NewClient[v1alpha.WatchMarketRequest, v1alpha.WatchMarketResponse](
+			httpClient,
+			baseURL+BettorServiceWatchMarketProcedure,
+			opts...,
+		),
+		watchBook: connect_go.NewClient[v1alpha.WatchBookRequest, v1alpha.WatchBookResponse](
+			httpClient,
+			baseURL+BettorServiceWatchBookProcedure,
+			opts...,
+		),
+		createWebhook: connect_go.NewClient[v1alpha.CreateWebhookRequest, v1alpha.CreateWebhookResponse](
+			httpClient,
+			baseURL+BettorServiceCreateWebhookProcedure,
+			opts...,
+		),
+		listWebhooks: connect_go.NewClient[v1alpha.ListWebhooksRequest, v1alpha.ListWebhooksResponse](
+			httpClient,
+			baseURL+BettorServiceListWebhooksProcedure,
+			opts...,
+		),
+		deleteWebhook: connect_go.NewClient[v1alpha.DeleteWebhookRequest, v1alpha.DeleteWebhookResponse](
+			httpClient,
+			baseURL+BettorServiceDeleteWebhookProcedure,
+			opts...,
+		),
+		batchGetUsers: connect_go.NewClient[v1alpha.BatchGetUsersRequest, v1alpha.BatchGetUsersResponse](
+			httpClient,
+			baseURL+BettorServiceBatchGetUsersProcedure,
+			opts...,
+		),
+		batchGetMarkets: connect_go.NewClient[v1alpha.BatchGetMarketsRequest, v1alpha.BatchGetMarketsResponse](
+			httpClient,
+			baseURL+BettorServiceBatchGetMarketsProcedure,
+			opts...,
+		),
+		batchGetBets: connect_go.NewClient[v1alpha.BatchGetBetsRequest, v1alpha.BatchGetBetsResponse](
+			httpClient,
+			baseURL+BettorServiceBatchGetBetsProcedure,
+			opts...,
+		),
+		getMarketStats: connect_go.NewClient[v1alpha.GetMarketStatsRequest, v1alpha.GetMarketStatsResponse](
+			httpClient,
+			baseURL+BettorServiceGetMarketStatsProcedure,
+			opts...,
+		),
+		getUserStats: connect_go.NewClient[v1alpha.GetUserStatsRequest, v1alpha.GetUserStatsResponse](
+			httpClient,
+			baseURL+BettorServiceGetUserStatsProcedure,
+			opts...,
+		),
+		getUserForecastScore: connect_go.NewClient[v1alpha.GetUserForecastScoreRequest, v1alpha.GetUserForecastScoreResponse](
+			httpClient,
+			baseURL+BettorServiceGetUserForecastScoreProcedure,
+			opts...,
+		),
+		getForecastLeaderboard: connect_go.NewClient[v1alpha.GetForecastLeaderboardRequest, v1alpha.GetForecastLeaderboardResponse](
+			httpClient,
+			baseURL+BettorServiceGetForecastLeaderboardProcedure,
+			opts...,
+		),
+		listMarketOddsHistory: connect_go.NewClient[v1alpha.ListMarketOddsHistoryRequest, v1alpha.ListMarketOddsHistoryResponse](
+			httpClient,
+			baseURL+BettorServiceListMarketOddsHistoryProcedure,
+			opts...,
+		),
+		getUserBalanceHistory: connect_go.NewClient[v1alpha.GetUserBalanceHistoryRequest, v1alpha.GetUserBalanceHistoryResponse](
+			httpClient,
+			baseURL+BettorServiceGetUserBalanceHistoryProcedure,
+			opts...,
+		),
+		getBookSettings: connect_go.NewClient[v1alpha.GetBookSettingsRequest, v1alpha.GetBookSettingsResponse](
+			httpClient,
+			baseURL+BettorServiceGetBookSettingsProcedure,
+			opts...,
+		),
+		updateBookSettings: connect_go.NewClient[v1alpha.UpdateBookSettingsRequest, v1alpha.UpdateBookSettingsResponse](
+			httpClient,
+			baseURL+BettorServiceUpdateBookSettingsProcedure,
+			opts...,
+		),
+		claimAllowance: connect_go.NewClient[v1alpha.ClaimAllowanceRequest, v1alpha.ClaimAllowanceResponse](
+			httpClient,
+			baseURL+BettorServiceClaimAllowanceProcedure,
+			opts...,
+		),
+		listGrants: connect_go.NewClient[v1alpha.ListGrantsRequest, v1alpha.ListGrantsResponse](
+			httpClient,
+			baseURL+BettorServiceListGrantsProcedure,
+			opts...,
+		),
+		startSeason: connect_go.NewClient[v1alpha.StartSeasonRequest, v1alpha.StartSeasonResponse](
+			httpClient,
+			baseURL+BettorServiceStartSeasonProcedure,
+			opts...,
+		),
+		getSeason: connect_go.NewClient[v1alpha.GetSeasonRequest, v1alpha.GetSeasonResponse](
+			httpClient,
+			baseURL+BettorServiceGetSeasonProcedure,
+			opts...,
+		),
+		listSeasons: connect_go.NewClient[v1alpha.ListSeasonsRequest, v1alpha.ListSeasonsResponse](
+			httpClient,
+			baseURL+BettorServiceListSeasonsProcedure,
+			opts...,
+		),
+		transferPoints: connect_go.NewClient[v1alpha.TransferPointsRequest, v1alpha.TransferPointsResponse](
+			httpClient,
+			baseURL+BettorServiceTransferPointsProcedure,
+			opts...,
+		),
+		listTransfers: connect_go.NewClient[v1alpha.ListTransfersRequest, v1alpha.ListTransfersResponse](
+			httpClient,
+			baseURL+BettorServiceListTransfersProcedure,
+			opts...,
+		),
+		adjustUserBalance: connect_go.NewClient[v1alpha.AdjustUserBalanceRequest, v1alpha.AdjustUserBalanceResponse](
+			httpClient,
+			baseURL+BettorServiceAdjustUserBalanceProcedure,
+			opts...,
+		),
+		listAdjustments: connect_go.NewClient[v1alpha.ListAdjustmentsRequest, v1alpha.ListAdjustmentsResponse](
+			httpClient,
+			baseURL+BettorServiceListAdjustmentsProcedure,
+			opts...,
+		),
 	}
 }
 
 // bettorServiceClient implements BettorServiceClient.
 type bettorServiceClient struct {
-	createUser        *connect_go.Client[v1alpha.CreateUserRequest, v1alpha.CreateUserResponse]
-	getUser           *connect_go.Client[v1alpha.GetUserRequest, v1alpha.GetUserResponse]
-	getUserByUsername *connect_go.Client[v1alpha.GetUserByUsernameRequest, v1alpha.GetUserByUsernameResponse]
-	listUsers         *connect_go.Client[v1alpha.ListUsersRequest, v1alpha.ListUsersResponse]
-	createMarket      *connect_go.Client[v1alpha.CreateMarketRequest, v1alpha.CreateMarketResponse]
-	getMarket         *connect_go.Client[v1alpha.GetMarketRequest, v1alpha.GetMarketResponse]
-	listMarkets       *connect_go.Client[v1alpha.ListMarketsRequest, v1alpha.ListMarketsResponse]
-	lockMarket        *connect_go.Client[v1alpha.LockMarketRequest, v1alpha.LockMarketResponse]
-	settleMarket      *connect_go.Client[v1alpha.SettleMarketRequest, v1alpha.SettleMarketResponse]
-	cancelMarket      *connect_go.Client[v1alpha.CancelMarketRequest, v1alpha.CancelMarketResponse]
-	createBet         *connect_go.Client[v1alpha.CreateBetRequest, v1alpha.CreateBetResponse]
-	getBet            *connect_go.Client[v1alpha.GetBetRequest, v1alpha.GetBetResponse]
-	listBets          *connect_go.Client[v1alpha.ListBetsRequest, v1alpha.ListBetsResponse]
+	createUser             *connect_go.Client[v1alpha.CreateUserRequest, v1alpha.CreateUserResponse]
+	getUser                *connect_go.Client[v1alpha.GetUserRequest, v1alpha.GetUserResponse]
+	getUserByUsername      *connect_go.Client[v1alpha.GetUserByUsernameRequest, v1alpha.GetUserByUsernameResponse]
+	listUsers              *connect_go.Client[v1alpha.ListUsersRequest, v1alpha.ListUsersResponse]
+	createMarket           *connect_go.Client[v1alpha.CreateMarketRequest, v1alpha.CreateMarketResponse]
+	getMarket              *connect_go.Client[v1alpha.GetMarketRequest, v1alpha.GetMarketResponse]
+	listMarkets            *connect_go.Client[v1alpha.ListMarketsRequest, v1alpha.ListMarketsResponse]
+	lockMarket             *connect_go.Client[v1alpha.LockMarketRequest, v1alpha.LockMarketResponse]
+	settleMarket           *connect_go.Client[v1alpha.SettleMarketRequest, v1alpha.SettleMarketResponse]
+	resettleMarket         *connect_go.Client[v1alpha.ResettleMarketRequest, v1alpha.ResettleMarketResponse]
+	cancelMarket           *connect_go.Client[v1alpha.CancelMarketRequest, v1alpha.CancelMarketResponse]
+	createBet              *connect_go.Client[v1alpha.CreateBetRequest, v1alpha.CreateBetResponse]
+	getBet                 *connect_go.Client[v1alpha.GetBetRequest, v1alpha.GetBetResponse]
+	listBets               *connect_go.Client[v1alpha.ListBetsRequest, v1alpha.ListBetsResponse]
+	watchMarket            *connect_go.Client[v1alpha.WatchMarketRequest, v1alpha.WatchMarketResponse]
+	watchBook              *connect_go.Client[v1alpha.WatchBookRequest, v1alpha.WatchBookResponse]
+	createWebhook          *connect_go.Client[v1alpha.CreateWebhookRequest, v1alpha.CreateWebhookResponse]
+	listWebhooks           *connect_go.Client[v1alpha.ListWebhooksRequest, v1alpha.ListWebhooksResponse]
+	deleteWebhook          *connect_go.Client[v1alpha.DeleteWebhookRequest, v1alpha.DeleteWebhookResponse]
+	batchGetUsers          *connect_go.Client[v1alpha.BatchGetUsersRequest, v1alpha.BatchGetUsersResponse]
+	batchGetMarkets        *connect_go.Client[v1alpha.BatchGetMarketsRequest, v1alpha.BatchGetMarketsResponse]
+	batchGetBets           *connect_go.Client[v1alpha.BatchGetBetsRequest, v1alpha.BatchGetBetsResponse]
+	getMarketStats         *connect_go.Client[v1alpha.GetMarketStatsRequest, v1alpha.GetMarketStatsResponse]
+	getUserStats           *connect_go.Client[v1alpha.GetUserStatsRequest, v1alpha.GetUserStatsResponse]
+	getUserForecastScore   *connect_go.Client[v1alpha.GetUserForecastScoreRequest, v1alpha.GetUserForecastScoreResponse]
+	getForecastLeaderboard *connect_go.Client[v1alpha.GetForecastLeaderboardRequest, v1alpha.GetForecastLeaderboardResponse]
+	listMarketOddsHistory  *connect_go.Client[v1alpha.ListMarketOddsHistoryRequest, v1alpha.ListMarketOddsHistoryResponse]
+	getUserBalanceHistory  *connect_go.Client[v1alpha.GetUserBalanceHistoryRequest, v1alpha.GetUserBalanceHistoryResponse]
+	getBookSettings        *connect_go.Client[v1alpha.GetBookSettingsRequest, v1alpha.GetBookSettingsResponse]
+	updateBookSettings     *connect_go.Client[v1alpha.UpdateBookSettingsRequest, v1alpha.UpdateBookSettingsResponse]
+	claimAllowance         *connect_go.Client[v1alpha.ClaimAllowanceRequest, v1alpha.ClaimAllowanceResponse]
+	listGrants             *connect_go.Client[v1alpha.ListGrantsRequest, v1alpha.ListGrantsResponse]
+	startSeason            *connect_go.Client[v1alpha.StartSeasonRequest, v1alpha.StartSeasonResponse]
+	getSeason              *connect_go.Client[v1alpha.GetSeasonRequest, v1alpha.GetSeasonResponse]
+	listSeasons            *connect_go.Client[v1alpha.ListSeasonsRequest, v1alpha.ListSeasonsResponse]
+	transferPoints         *connect_go.Client[v1alpha.TransferPointsRequest, v1alpha.TransferPointsResponse]
+	listTransfers          *connect_go.Client[v1alpha.ListTransfersRequest, v1alpha.ListTransfersResponse]
+	adjustUserBalance      *connect_go.Client[v1alpha.AdjustUserBalanceRequest, v1alpha.AdjustUserBalanceResponse]
+	listAdjustments        *connect_go.Client[v1alpha.ListAdjustmentsRequest, v1alpha.ListAdjustmentsResponse]
 }
 
 // CreateUser calls bettor.v1alpha.BettorService.CreateUser.
@@ -238,6 +529,11 @@ func (c *bettorServiceClient) SettleMarket(ctx context.Context, req *connect_go.
 	return c.settleMarket.CallUnary(ctx, req)
 }
 
+// ResettleMarket calls bettor.v1alpha.BettorService.ResettleMarket.
+func (c *bettorServiceClient) ResettleMarket(ctx context.Context, req *connect_go.Request[v1alpha.ResettleMarketRequest]) (*connect_go.Response[v1alpha.ResettleMarketResponse], error) {
+	return c.resettleMarket.CallUnary(ctx, req)
+}
+
 // CancelMarket calls bettor.v1alpha.BettorService.CancelMarket.
 func (c *bettorServiceClient) CancelMarket(ctx context.Context, req *connect_go.Request[v1alpha.CancelMarketRequest]) (*connect_go.Response[v1alpha.CancelMarketResponse], error) {
 	return c.cancelMarket.CallUnary(ctx, req)
@@ -258,6 +554,131 @@ func (c *bettorServiceClient) ListBets(ctx context.Context, req *connect_go.Requ
 	return c.listBets.CallUnary(ctx, req)
 }
 
+// WatchMarket calls bettor.v1alpha.BettorService.WatchMarket.
+func (c *bettorServiceClient) WatchMarket(ctx context.Context, req *connect_go.Request[v1alpha.WatchMarketRequest]) (*connect_go.ServerStreamForClient[v1alpha.WatchMarketResponse], error) {
+	return c.watchMarket.CallServerStream(ctx, req)
+}
+
+// WatchBook calls bettor.v1alpha.BettorService.WatchBook.
+func (c *bettorServiceClient) WatchBook(ctx context.Context, req *connect_go.Request[v1alpha.WatchBookRequest]) (*connect_go.ServerStreamForClient[v1alpha.WatchBookResponse], error) {
+	return c.watchBook.CallServerStream(ctx, req)
+}
+
+// CreateWebhook calls bettor.v1alpha.BettorService.CreateWebhook.
+func (c *bettorServiceClient) CreateWebhook(ctx context.Context, req *connect_go.Request[v1alpha.CreateWebhookRequest]) (*connect_go.Response[v1alpha.CreateWebhookResponse], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhooks calls bettor.v1alpha.BettorService.ListWebhooks.
+func (c *bettorServiceClient) ListWebhooks(ctx context.Context, req *connect_go.Request[v1alpha.ListWebhooksRequest]) (*connect_go.Response[v1alpha.ListWebhooksResponse], error) {
+	return c.listWebhooks.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls bettor.v1alpha.BettorService.DeleteWebhook.
+func (c *bettorServiceClient) DeleteWebhook(ctx context.Context, req *connect_go.Request[v1alpha.DeleteWebhookRequest]) (*connect_go.Response[v1alpha.DeleteWebhookResponse], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// BatchGetUsers calls bettor.v1alpha.BettorService.BatchGetUsers.
+func (c *bettorServiceClient) BatchGetUsers(ctx context.Context, req *connect_go.Request[v1alpha.BatchGetUsersRequest]) (*connect_go.Response[v1alpha.BatchGetUsersResponse], error) {
+	return c.batchGetUsers.CallUnary(ctx, req)
+}
+
+// BatchGetMarkets calls bettor.v1alpha.BettorService.BatchGetMarkets.
+func (c *bettorServiceClient) BatchGetMarkets(ctx context.Context, req *connect_go.Request[v1alpha.BatchGetMarketsRequest]) (*connect_go.Response[v1alpha.BatchGetMarketsResponse], error) {
+	return c.batchGetMarkets.CallUnary(ctx, req)
+}
+
+// BatchGetBets calls bettor.v1alpha.BettorService.BatchGetBets.
+func (c *bettorServiceClient) BatchGetBets(ctx context.Context, req *connect_go.Request[v1alpha.BatchGetBetsRequest]) (*connect_go.Response[v1alpha.BatchGetBetsResponse], error) {
+	return c.batchGetBets.CallUnary(ctx, req)
+}
+
+// GetMarketStats calls bettor.v1alpha.BettorService.GetMarketStats.
+func (c *bettorServiceClient) GetMarketStats(ctx context.Context, req *connect_go.Request[v1alpha.GetMarketStatsRequest]) (*connect_go.Response[v1alpha.GetMarketStatsResponse], error) {
+	return c.getMarketStats.CallUnary(ctx, req)
+}
+
+// GetUserStats calls bettor.v1alpha.BettorService.GetUserStats.
+func (c *bettorServiceClient) GetUserStats(ctx context.Context, req *connect_go.Request[v1alpha.GetUserStatsRequest]) (*connect_go.Response[v1alpha.GetUserStatsResponse], error) {
+	return c.getUserStats.CallUnary(ctx, req)
+}
+
+// GetUserForecastScore calls bettor.v1alpha.BettorService.GetUserForecastScore.
+func (c *bettorServiceClient) GetUserForecastScore(ctx context.Context, req *connect_go.Request[v1alpha.GetUserForecastScoreRequest]) (*connect_go.Response[v1alpha.GetUserForecastScoreResponse], error) {
+	return c.getUserForecastScore.CallUnary(ctx, req)
+}
+
+// GetForecastLeaderboard calls bettor.v1alpha.BettorService.GetForecastLeaderboard.
+func (c *bettorServiceClient) GetForecastLeaderboard(ctx context.Context, req *connect_go.Request[v1alpha.GetForecastLeaderboardRequest]) (*connect_go.Response[v1alpha.GetForecastLeaderboardResponse], error) {
+	return c.getForecastLeaderboard.CallUnary(ctx, req)
+}
+
+// ListMarketOddsHistory calls bettor.v1alpha.BettorService.ListMarketOddsHistory.
+func (c *bettorServiceClient) ListMarketOddsHistory(ctx context.Context, req *connect_go.Request[v1alpha.ListMarketOddsHistoryRequest]) (*connect_go.Response[v1alpha.ListMarketOddsHistoryResponse], error) {
+	return c.listMarketOddsHistory.CallUnary(ctx, req)
+}
+
+// GetUserBalanceHistory calls bettor.v1alpha.BettorService.GetUserBalanceHistory.
+func (c *bettorServiceClient) GetUserBalanceHistory(ctx context.Context, req *connect_go.Request[v1alpha.GetUserBalanceHistoryRequest]) (*connect_go.Response[v1alpha.GetUserBalanceHistoryResponse], error) {
+	return c.getUserBalanceHistory.CallUnary(ctx, req)
+}
+
+// GetBookSettings calls bettor.v1alpha.BettorService.GetBookSettings.
+func (c *bettorServiceClient) GetBookSettings(ctx context.Context, req *connect_go.Request[v1alpha.GetBookSettingsRequest]) (*connect_go.Response[v1alpha.GetBookSettingsResponse], error) {
+	return c.getBookSettings.CallUnary(ctx, req)
+}
+
+// UpdateBookSettings calls bettor.v1alpha.BettorService.UpdateBookSettings.
+func (c *bettorServiceClient) UpdateBookSettings(ctx context.Context, req *connect_go.Request[v1alpha.UpdateBookSettingsRequest]) (*connect_go.Response[v1alpha.UpdateBookSettingsResponse], error) {
+	return c.updateBookSettings.CallUnary(ctx, req)
+}
+
+// ClaimAllowance calls bettor.v1alpha.BettorService.ClaimAllowance.
+func (c *bettorServiceClient) ClaimAllowance(ctx context.Context, req *connect_go.Request[v1alpha.ClaimAllowanceRequest]) (*connect_go.Response[v1alpha.ClaimAllowanceResponse], error) {
+	return c.claimAllowance.CallUnary(ctx, req)
+}
+
+// ListGrants calls bettor.v1alpha.BettorService.ListGrants.
+func (c *bettorServiceClient) ListGrants(ctx context.Context, req *connect_go.Request[v1alpha.ListGrantsRequest]) (*connect_go.Response[v1alpha.ListGrantsResponse], error) {
+	return c.listGrants.CallUnary(ctx, req)
+}
+
+// StartSeason calls bettor.v1alpha.BettorService.StartSeason.
+func (c *bettorServiceClient) StartSeason(ctx context.Context, req *connect_go.Request[v1alpha.StartSeasonRequest]) (*connect_go.Response[v1alpha.StartSeasonResponse], error) {
+	return c.startSeason.CallUnary(ctx, req)
+}
+
+// GetSeason calls bettor.v1alpha.BettorService.GetSeason.
+func (c *bettorServiceClient) GetSeason(ctx context.Context, req *connect_go.Request[v1alpha.GetSeasonRequest]) (*connect_go.Response[v1alpha.GetSeasonResponse], error) {
+	return c.getSeason.CallUnary(ctx, req)
+}
+
+// ListSeasons calls bettor.v1alpha.BettorService.ListSeasons.
+func (c *bettorServiceClient) ListSeasons(ctx context.Context, req *connect_go.Request[v1alpha.ListSeasonsRequest]) (*connect_go.Response[v1alpha.ListSeasonsResponse], error) {
+	return c.listSeasons.CallUnary(ctx, req)
+}
+
+// TransferPoints calls bettor.v1alpha.BettorService.TransferPoints.
+func (c *bettorServiceClient) TransferPoints(ctx context.Context, req *connect_go.Request[v1alpha.TransferPointsRequest]) (*connect_go.Response[v1alpha.TransferPointsResponse], error) {
+	return c.transferPoints.CallUnary(ctx, req)
+}
+
+// ListTransfers calls bettor.v1alpha.BettorService.ListTransfers.
+func (c *bettorServiceClient) ListTransfers(ctx context.Context, req *connect_go.Request[v1alpha.ListTransfersRequest]) (*connect_go.Response[v1alpha.ListTransfersResponse], error) {
+	return c.listTransfers.CallUnary(ctx, req)
+}
+
+// AdjustUserBalance calls bettor.v1alpha.BettorService.AdjustUserBalance.
+func (c *bettorServiceClient) AdjustUserBalance(ctx context.Context, req *connect_go.Request[v1alpha.AdjustUserBalanceRequest]) (*connect_go.Response[v1alpha.AdjustUserBalanceResponse], error) {
+	return c.adjustUserBalance.CallUnary(ctx, req)
+}
+
+// ListAdjustments calls bettor.v1alpha.BettorService.ListAdjustments.
+func (c *bettorServiceClient) ListAdjustments(ctx context.Context, req *connect_go.Request[v1alpha.ListAdjustmentsRequest]) (*connect_go.Response[v1alpha.ListAdjustmentsResponse], error) {
+	return c.listAdjustments.CallUnary(ctx, req)
+}
+
 // BettorServiceHandler is an implementation of the bettor.v1alpha.BettorService service.
 type BettorServiceHandler interface {
 	// CreateUser creates a new user.
@@ -278,6 +699,10 @@ type BettorServiceHandler interface {
 	LockMarket(context.Context, *connect_go.Request[v1alpha.LockMarketRequest]) (*connect_go.Response[v1alpha.LockMarketResponse], error)
 	// SettleMarket settles a betting market and pays out bets.
 	SettleMarket(context.Context, *connect_go.Request[v1alpha.SettleMarketRequest]) (*connect_go.Response[v1alpha.SettleMarketResponse], error)
+	// ResettleMarket corrects the winner of a recently settled market. Previous payouts are clawed back and bets are paid
+	// out again for the new winner. Points that cannot be clawed back are recorded as debts on the new settlement. It may
+	// only be called on behalf of the market's creator or book admins.
+	ResettleMarket(context.Context, *connect_go.Request[v1alpha.ResettleMarketRequest]) (*connect_go.Response[v1alpha.ResettleMarketResponse], error)
 	// CancelMarket cancels a betting market and redunds all bettors.
 	CancelMarket(context.Context, *connect_go.Request[v1alpha.CancelMarketRequest]) (*connect_go.Response[v1alpha.CancelMarketResponse], error)
 	// CreateBet places a bet on an open betting market.
@@ -286,6 +711,61 @@ type BettorServiceHandler interface {
 	GetBet(context.Context, *connect_go.Request[v1alpha.GetBetRequest]) (*connect_go.Response[v1alpha.GetBetResponse], error)
 	// ListBet lists bets by filters.
 	ListBets(context.Context, *connect_go.Request[v1alpha.ListBetsRequest]) (*connect_go.Response[v1alpha.ListBetsResponse], error)
+	// WatchMarket streams changes to a market and its bets. The current market is sent first.
+	WatchMarket(context.Context, *connect_go.Request[v1alpha.WatchMarketRequest], *connect_go.ServerStream[v1alpha.WatchMarketResponse]) error
+	// WatchBook streams changes to all users, markets, and bets in a book.
+	WatchBook(context.Context, *connect_go.Request[v1alpha.WatchBookRequest], *connect_go.ServerStream[v1alpha.WatchBookResponse]) error
+	// CreateWebhook subscribes a URL to a book's market and bet events.
+	CreateWebhook(context.Context, *connect_go.Request[v1alpha.CreateWebhookRequest]) (*connect_go.Response[v1alpha.CreateWebhookResponse], error)
+	// ListWebhooks lists a book's webhooks. Secrets are not returned.
+	ListWebhooks(context.Context, *connect_go.Request[v1alpha.ListWebhooksRequest]) (*connect_go.Response[v1alpha.ListWebhooksResponse], error)
+	// DeleteWebhook deletes a webhook. Its pending deliveries are dropped.
+	DeleteWebhook(context.Context, *connect_go.Request[v1alpha.DeleteWebhookRequest]) (*connect_go.Response[v1alpha.DeleteWebhookResponse], error)
+	// BatchGetUsers gets up to 100 users by name. Names that are not found are returned in not_found.
+	BatchGetUsers(context.Context, *connect_go.Request[v1alpha.BatchGetUsersRequest]) (*connect_go.Response[v1alpha.BatchGetUsersResponse], error)
+	// BatchGetMarkets gets up to 100 markets by name. Names that are not found are returned in not_found.
+	BatchGetMarkets(context.Context, *connect_go.Request[v1alpha.BatchGetMarketsRequest]) (*connect_go.Response[v1alpha.BatchGetMarketsResponse], error)
+	// BatchGetBets gets up to 100 bets by name. Names that are not found are returned in not_found.
+	BatchGetBets(context.Context, *connect_go.Request[v1alpha.BatchGetBetsRequest]) (*connect_go.Response[v1alpha.BatchGetBetsResponse], error)
+	// GetMarketStats returns aggregate stats of a market like implied probabilities and payout multipliers.
+	GetMarketStats(context.Context, *connect_go.Request[v1alpha.GetMarketStatsRequest]) (*connect_go.Response[v1alpha.GetMarketStatsResponse], error)
+	// GetUserStats returns betting performance of a user like win rate and net profit.
+	GetUserStats(context.Context, *connect_go.Request[v1alpha.GetUserStatsRequest]) (*connect_go.Response[v1alpha.GetUserStatsResponse], error)
+	// GetUserForecastScore returns the Brier score, log score, and calibration of a user's bets.
+	GetUserForecastScore(context.Context, *connect_go.Request[v1alpha.GetUserForecastScoreRequest]) (*connect_go.Response[v1alpha.GetUserForecastScoreResponse], error)
+	// GetForecastLeaderboard returns the users of a book with the best Brier scores. Users need a minimum number of
+	// scored bets to be ranked.
+	GetForecastLeaderboard(context.Context, *connect_go.Request[v1alpha.GetForecastLeaderboardRequest]) (*connect_go.Response[v1alpha.GetForecastLeaderboardResponse], error)
+	// ListMarketOddsHistory lists snapshots of a market's pool taken each time a bet changed it. Only the most recent
+	// snapshots of a market are retained.
+	ListMarketOddsHistory(context.Context, *connect_go.Request[v1alpha.ListMarketOddsHistoryRequest]) (*connect_go.Response[v1alpha.ListMarketOddsHistoryResponse], error)
+	// GetUserBalanceHistory returns a user's total points over time. It is derived from the user's settled bets back
+	// from their current total.
+	GetUserBalanceHistory(context.Context, *connect_go.Request[v1alpha.GetUserBalanceHistoryRequest]) (*connect_go.Response[v1alpha.GetUserBalanceHistoryResponse], error)
+	// GetBookSettings returns the settings of a book.
+	GetBookSettings(context.Context, *connect_go.Request[v1alpha.GetBookSettingsRequest]) (*connect_go.Response[v1alpha.GetBookSettingsResponse], error)
+	// UpdateBookSettings replaces the settings of a book.
+	UpdateBookSettings(context.Context, *connect_go.Request[v1alpha.UpdateBookSettingsRequest]) (*connect_go.Response[v1alpha.UpdateBookSettingsResponse], error)
+	// ClaimAllowance grants a user their book's allowance. It can be claimed once per the book's allowance cooldown.
+	ClaimAllowance(context.Context, *connect_go.Request[v1alpha.ClaimAllowanceRequest]) (*connect_go.Response[v1alpha.ClaimAllowanceResponse], error)
+	// ListGrants lists grants of points to users of a book.
+	ListGrants(context.Context, *connect_go.Request[v1alpha.ListGrantsRequest]) (*connect_go.Response[v1alpha.ListGrantsResponse], error)
+	// StartSeason ends a book's current season, archiving its standings, and starts a new one with every user's balance
+	// reset.
+	StartSeason(context.Context, *connect_go.Request[v1alpha.StartSeasonRequest]) (*connect_go.Response[v1alpha.StartSeasonResponse], error)
+	// GetSeason returns a season by name.
+	GetSeason(context.Context, *connect_go.Request[v1alpha.GetSeasonRequest]) (*connect_go.Response[v1alpha.GetSeasonResponse], error)
+	// ListSeasons lists a book's seasons.
+	ListSeasons(context.Context, *connect_go.Request[v1alpha.ListSeasonsRequest]) (*connect_go.Response[v1alpha.ListSeasonsResponse], error)
+	// TransferPoints sends points from one user to another in the same book.
+	TransferPoints(context.Context, *connect_go.Request[v1alpha.TransferPointsRequest]) (*connect_go.Response[v1alpha.TransferPointsResponse], error)
+	// ListTransfers lists transfers of points between users of a book.
+	ListTransfers(context.Context, *connect_go.Request[v1alpha.ListTransfersRequest]) (*connect_go.Response[v1alpha.ListTransfersResponse], error)
+	// AdjustUserBalance credits or debits a user's balance to correct mistakes. It may only be called on behalf of book
+	// admins and does not allow balances to go negative.
+	AdjustUserBalance(context.Context, *connect_go.Request[v1alpha.AdjustUserBalanceRequest]) (*connect_go.Response[v1alpha.AdjustUserBalanceResponse], error)
+	// ListAdjustments lists admin adjustments to user balances in a book.
+	ListAdjustments(context.Context, *connect_go.Request[v1alpha.ListAdjustmentsRequest]) (*connect_go.Response[v1alpha.ListAdjustmentsResponse], error)
 }
 
 // NewBettorServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -340,6 +820,11 @@ func NewBettorServiceHandler(svc BettorServiceHandler, opts ...connect_go.Handle
 		svc.SettleMarket,
 		opts...,
 	))
+	mux.Handle(BettorServiceResettleMarketProcedure, connect_go.NewUnaryHandler(
+		BettorServiceResettleMarketProcedure,
+		svc.ResettleMarket,
+		opts...,
+	))
 	mux.Handle(BettorServiceCancelMarketProcedure, connect_go.NewUnaryHandler(
 		BettorServiceCancelMarketProcedure,
 		svc.CancelMarket,
@@ -360,6 +845,131 @@ func NewBettorServiceHandler(svc BettorServiceHandler, opts ...connect_go.Handle
 		svc.ListBets,
 		opts...,
 	))
+	mux.Handle(BettorServiceWatchMarketProcedure, connect_go.NewServerStreamHandler(
+		BettorServiceWatchMarketProcedure,
+		svc.WatchMarket,
+		opts...,
+	))
+	mux.Handle(BettorServiceWatchBookProcedure, connect_go.NewServerStreamHandler(
+		BettorServiceWatchBookProcedure,
+		svc.WatchBook,
+		opts...,
+	))
+	mux.Handle(BettorServiceCreateWebhookProcedure, connect_go.NewUnaryHandler(
+		BettorServiceCreateWebhookProcedure,
+		svc.CreateWebhook,
+		opts...,
+	))
+	mux.Handle(BettorServiceListWebhooksProcedure, connect_go.NewUnaryHandler(
+		BettorServiceListWebhooksProcedure,
+		svc.ListWebhooks,
+		opts...,
+	))
+	mux.Handle(BettorServiceDeleteWebhookProcedure, connect_go.NewUnaryHandler(
+		BettorServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		opts...,
+	))
+	mux.Handle(BettorServiceBatchGetUsersProcedure, connect_go.NewUnaryHandler(
+		BettorServiceBatchGetUsersProcedure,
+		svc.BatchGetUsers,
+		opts...,
+	))
+	mux.Handle(BettorServiceBatchGetMarketsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceBatchGetMarketsProcedure,
+		svc.BatchGetMarkets,
+		opts...,
+	))
+	mux.Handle(BettorServiceBatchGetBetsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceBatchGetBetsProcedure,
+		svc.BatchGetBets,
+		opts...,
+	))
+	mux.Handle(BettorServiceGetMarketStatsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceGetMarketStatsProcedure,
+		svc.GetMarketStats,
+		opts...,
+	))
+	mux.Handle(BettorServiceGetUserStatsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceGetUserStatsProcedure,
+		svc.GetUserStats,
+		opts...,
+	))
+	mux.Handle(BettorServiceGetUserForecastScoreProcedure, connect_go.NewUnaryHandler(
+		BettorServiceGetUserForecastScoreProcedure,
+		svc.GetUserForecastScore,
+		opts...,
+	))
+	mux.Handle(BettorServiceGetForecastLeaderboardProcedure, connect_go.NewUnaryHandler(
+		BettorServiceGetForecastLeaderboardProcedure,
+		svc.GetForecastLeaderboard,
+		opts...,
+	))
+	mux.Handle(BettorServiceListMarketOddsHistoryProcedure, connect_go.NewUnaryHandler(
+		BettorServiceListMarketOddsHistoryProcedure,
+		svc.ListMarketOddsHistory,
+		opts...,
+	))
+	mux.Handle(BettorServiceGetUserBalanceHistoryProcedure, connect_go.NewUnaryHandler(
+		BettorServiceGetUserBalanceHistoryProcedure,
+		svc.GetUserBalanceHistory,
+		opts...,
+	))
+	mux.Handle(BettorServiceGetBookSettingsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceGetBookSettingsProcedure,
+		svc.GetBookSettings,
+		opts...,
+	))
+	mux.Handle(BettorServiceUpdateBookSettingsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceUpdateBookSettingsProcedure,
+		svc.UpdateBookSettings,
+		opts...,
+	))
+	mux.Handle(BettorServiceClaimAllowanceProcedure, connect_go.NewUnaryHandler(
+		BettorServiceClaimAllowanceProcedure,
+		svc.ClaimAllowance,
+		opts...,
+	))
+	mux.Handle(BettorServiceListGrantsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceListGrantsProcedure,
+		svc.ListGrants,
+		opts...,
+	))
+	mux.Handle(BettorServiceStartSeasonProcedure, connect_go.NewUnaryHandler(
+		BettorServiceStartSeasonProcedure,
+		svc.StartSeason,
+		opts...,
+	))
+	mux.Handle(BettorServiceGetSeasonProcedure, connect_go.NewUnaryHandler(
+		BettorServiceGetSeasonProcedure,
+		svc.GetSeason,
+		opts...,
+	))
+	mux.Handle(BettorServiceListSeasonsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceListSeasonsProcedure,
+		svc.ListSeasons,
+		opts...,
+	))
+	mux.Handle(BettorServiceTransferPointsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceTransferPointsProcedure,
+		svc.TransferPoints,
+		opts...,
+	))
+	mux.Handle(BettorServiceListTransfersProcedure, connect_go.NewUnaryHandler(
+		BettorServiceListTransfersProcedure,
+		svc.ListTransfers,
+		opts...,
+	))
+	mux.Handle(BettorServiceAdjustUserBalanceProcedure, connect_go.NewUnaryHandler(
+		BettorServiceAdjustUserBalanceProcedure,
+		svc.AdjustUserBalance,
+		opts...,
+	))
+	mux.Handle(BettorServiceListAdjustmentsProcedure, connect_go.NewUnaryHandler(
+		BettorServiceListAdjustmentsProcedure,
+		svc.ListAdjustments,
+		opts...,
+	))
 	return "/bettor.v1alpha.BettorService/", mux
 }
 
@@ -402,6 +1012,10 @@ func (UnimplementedBettorServiceHandler) SettleMarket(context.Context, *connect_
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.SettleMarket is not implemented"))
 }
 
+func (UnimplementedBettorServiceHandler) ResettleMarket(context.Context, *connect_go.Request[v1alpha.ResettleMarketRequest]) (*connect_go.Response[v1alpha.ResettleMarketResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.ResettleMarket is not implemented"))
+}
+
 func (UnimplementedBettorServiceHandler) CancelMarket(context.Context, *connect_go.Request[v1alpha.CancelMarketRequest]) (*connect_go.Response[v1alpha.CancelMarketResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.CancelMarket is not implemented"))
 }
@@ -417,3 +1031,103 @@ func (UnimplementedBettorServiceHandler) GetBet(context.Context, *connect_go.Req
 func (UnimplementedBettorServiceHandler) ListBets(context.Context, *connect_go.Request[v1alpha.ListBetsRequest]) (*connect_go.Response[v1alpha.ListBetsResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.ListBets is not implemented"))
 }
+
+func (UnimplementedBettorServiceHandler) WatchMarket(context.Context, *connect_go.Request[v1alpha.WatchMarketRequest], *connect_go.ServerStream[v1alpha.WatchMarketResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.WatchMarket is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) WatchBook(context.Context, *connect_go.Request[v1alpha.WatchBookRequest], *connect_go.ServerStream[v1alpha.WatchBookResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.WatchBook is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) CreateWebhook(context.Context, *connect_go.Request[v1alpha.CreateWebhookRequest]) (*connect_go.Response[v1alpha.CreateWebhookResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) ListWebhooks(context.Context, *connect_go.Request[v1alpha.ListWebhooksRequest]) (*connect_go.Response[v1alpha.ListWebhooksResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.ListWebhooks is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) DeleteWebhook(context.Context, *connect_go.Request[v1alpha.DeleteWebhookRequest]) (*connect_go.Response[v1alpha.DeleteWebhookResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) BatchGetUsers(context.Context, *connect_go.Request[v1alpha.BatchGetUsersRequest]) (*connect_go.Response[v1alpha.BatchGetUsersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.BatchGetUsers is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) BatchGetMarkets(context.Context, *connect_go.Request[v1alpha.BatchGetMarketsRequest]) (*connect_go.Response[v1alpha.BatchGetMarketsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.BatchGetMarkets is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) BatchGetBets(context.Context, *connect_go.Request[v1alpha.BatchGetBetsRequest]) (*connect_go.Response[v1alpha.BatchGetBetsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.BatchGetBets is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) GetMarketStats(context.Context, *connect_go.Request[v1alpha.GetMarketStatsRequest]) (*connect_go.Response[v1alpha.GetMarketStatsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.GetMarketStats is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) GetUserStats(context.Context, *connect_go.Request[v1alpha.GetUserStatsRequest]) (*connect_go.Response[v1alpha.GetUserStatsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.GetUserStats is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) GetUserForecastScore(context.Context, *connect_go.Request[v1alpha.GetUserForecastScoreRequest]) (*connect_go.Response[v1alpha.GetUserForecastScoreResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.GetUserForecastScore is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) GetForecastLeaderboard(context.Context, *connect_go.Request[v1alpha.GetForecastLeaderboardRequest]) (*connect_go.Response[v1alpha.GetForecastLeaderboardResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.GetForecastLeaderboard is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) ListMarketOddsHistory(context.Context, *connect_go.Request[v1alpha.ListMarketOddsHistoryRequest]) (*connect_go.Response[v1alpha.ListMarketOddsHistoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.ListMarketOddsHistory is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) GetUserBalanceHistory(context.Context, *connect_go.Request[v1alpha.GetUserBalanceHistoryRequest]) (*connect_go.Response[v1alpha.GetUserBalanceHistoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.GetUserBalanceHistory is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) GetBookSettings(context.Context, *connect_go.Request[v1alpha.GetBookSettingsRequest]) (*connect_go.Response[v1alpha.GetBookSettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.GetBookSettings is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) UpdateBookSettings(context.Context, *connect_go.Request[v1alpha.UpdateBookSettingsRequest]) (*connect_go.Response[v1alpha.UpdateBookSettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.UpdateBookSettings is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) ClaimAllowance(context.Context, *connect_go.Request[v1alpha.ClaimAllowanceRequest]) (*connect_go.Response[v1alpha.ClaimAllowanceResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.ClaimAllowance is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) ListGrants(context.Context, *connect_go.Request[v1alpha.ListGrantsRequest]) (*connect_go.Response[v1alpha.ListGrantsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.ListGrants is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) StartSeason(context.Context, *connect_go.Request[v1alpha.StartSeasonRequest]) (*connect_go.Response[v1alpha.StartSeasonResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.StartSeason is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) GetSeason(context.Context, *connect_go.Request[v1alpha.GetSeasonRequest]) (*connect_go.Response[v1alpha.GetSeasonResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.GetSeason is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) ListSeasons(context.Context, *connect_go.Request[v1alpha.ListSeasonsRequest]) (*connect_go.Response[v1alpha.ListSeasonsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.ListSeasons is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) TransferPoints(context.Context, *connect_go.Request[v1alpha.TransferPointsRequest]) (*connect_go.Response[v1alpha.TransferPointsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.TransferPoints is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) ListTransfers(context.Context, *connect_go.Request[v1alpha.ListTransfersRequest]) (*connect_go.Response[v1alpha.ListTransfersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.ListTransfers is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) AdjustUserBalance(context.Context, *connect_go.Request[v1alpha.AdjustUserBalanceRequest]) (*connect_go.Response[v1alpha.AdjustUserBalanceResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.AdjustUserBalance is not implemented"))
+}
+
+func (UnimplementedBettorServiceHandler) ListAdjustments(context.Context, *connect_go.Request[v1alpha.ListAdjustmentsRequest]) (*connect_go.Response[v1alpha.ListAdjustmentsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bettor.v1alpha.BettorService.ListAdjustments is not implemented"))
+}
@@ -25,3 +25,51 @@ func StripListBetsPagination(in *ListBetsRequest) *ListBetsRequest {
 	out.PageToken = ""
 	return out
 }
+
+// StripListWebhooksPagination assists verifying next page tokens.
+func StripListWebhooksPagination(in *ListWebhooksRequest) *ListWebhooksRequest {
+	out := proto.Clone(in).(*ListWebhooksRequest)
+	out.PageSize = 0
+	out.PageToken = ""
+	return out
+}
+
+// StripListMarketOddsHistoryPagination assists verifying next page tokens.
+func StripListMarketOddsHistoryPagination(in *ListMarketOddsHistoryRequest) *ListMarketOddsHistoryRequest {
+	out := proto.Clone(in).(*ListMarketOddsHistoryRequest)
+	out.PageSize = 0
+	out.PageToken = ""
+	return out
+}
+
+// StripListGrantsPagination assists verifying next page tokens.
+func StripListGrantsPagination(in *ListGrantsRequest) *ListGrantsRequest {
+	out := proto.Clone(in).(*ListGrantsRequest)
+	out.PageSize = 0
+	out.PageToken = ""
+	return out
+}
+
+// StripListSeasonsPagination assists verifying next page tokens.
+func StripListSeasonsPagination(in *ListSeasonsRequest) *ListSeasonsRequest {
+	out := proto.Clone(in).(*ListSeasonsRequest)
+	out.PageSize = 0
+	out.PageToken = ""
+	return out
+}
+
+// StripListTransfersPagination assists verifying next page tokens.
+func StripListTransfersPagination(in *ListTransfersRequest) *ListTransfersRequest {
+	out := proto.Clone(in).(*ListTransfersRequest)
+	out.PageSize = 0
+	out.PageToken = ""
+	return out
+}
+
+// StripListAdjustmentsPagination assists verifying next page tokens.
+func StripListAdjustmentsPagination(in *ListAdjustmentsRequest) *ListAdjustmentsRequest {
+	out := proto.Clone(in).(*ListAdjustmentsRequest)
+	out.PageSize = 0
+	out.PageToken = ""
+	return out
+}
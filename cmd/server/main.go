@@ -15,8 +15,13 @@ import (
 	otelconnect "github.com/bufbuild/connect-opentelemetry-go"
 	"github.com/elh/bettor/api/bettor/v1alpha/bettorv1alphaconnect"
 	"github.com/elh/bettor/internal/app/bettor/discord"
+	"github.com/elh/bettor/internal/app/bettor/outbox"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/bolt"
 	"github.com/elh/bettor/internal/app/bettor/repo/gob"
+	"github.com/elh/bettor/internal/app/bettor/repo/sqlite"
 	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/elh/bettor/internal/app/bettor/webhook"
 	"github.com/elh/bettor/internal/pkg/envflag"
 	"github.com/go-kit/log"
 	"github.com/google/uuid"
@@ -31,8 +36,12 @@ import (
 )
 
 var (
-	port      = envflag.Int("port", 8080, "The server port")
-	gobDBFile = envflag.String("gobDBFile", "bettor.gob", "Gob file to use for persistence")
+	port         = envflag.Int("port", 8080, "The server port")
+	dbType       = envflag.String("dbType", "gob", "Persistence backend to use: gob, sqlite, or bolt")
+	gobDBFile    = envflag.String("gobDBFile", "bettor.gob", "Gob file to use for persistence")
+	sqliteDBFile = envflag.String("sqliteDBFile", "bettor.db", "SQLite file to use for persistence")
+	boltDBFile   = envflag.String("boltDBFile", "bettor.bolt", "bbolt file to use for persistence")
+	pageTokenKey = envflag.String("pageTokenKey", "", "Key used to sign page tokens (secret). If unset, a random key is used and page tokens do not survive restarts")
 
 	// Discord bot flags.
 	runDiscord             = envflag.Bool("runDiscord", false, "Run the Discord bot")
@@ -52,13 +61,24 @@ func main() {
 	logger := log.With(log.NewJSONLogger(os.Stdout), "instance", uuid.NewString())
 	serverLogger := log.With(logger, "component", "server")
 
-	// Server with gob file-backed repo
-	r, err := gob.New(*gobDBFile)
+	// Server with file-backed repo
+	r, err := newRepo(log.With(logger, "component", "repo"))
 	if err != nil {
 		logger.Log("msg", "error creating repo", "err", err)
 		panic(err)
 	}
-	s, err := server.New(server.WithRepo(r), server.WithLogger(serverLogger))
+	if closer, ok := r.(io.Closer); ok {
+		defer func() {
+			if err := closer.Close(); err != nil {
+				logger.Log("msg", "error closing repo", "err", err)
+			}
+		}()
+	}
+	serverOpts := []server.Arg{server.WithRepo(r), server.WithLogger(serverLogger)}
+	if *pageTokenKey != "" {
+		serverOpts = append(serverOpts, server.WithPageTokenKey([]byte(*pageTokenKey)))
+	}
+	s, err := server.New(serverOpts...)
 	if err != nil {
 		logger.Log("msg", "error creating server", "err", err)
 		panic(err)
@@ -79,7 +99,7 @@ func main() {
 	// Use context cancellation to coordinate graceful shutdown. Exit on interrupt or any worker exiting.
 	ctx, cancelFn := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
 	wg := sync.WaitGroup{}
-	wg.Add(2)
+	wg.Add(4)
 
 	// Buf Connect server
 	go func() {
@@ -106,6 +126,38 @@ func main() {
 		}
 	}()
 
+	// Outbox dispatcher
+	go func() {
+		defer wg.Done()
+		outboxLogger := log.With(logger, "component", "outbox-dispatcher")
+		dispatcher, err := outbox.New(
+			outbox.WithRepo(r),
+			outbox.WithLogger(outboxLogger),
+			outbox.WithConsumer("webhooks", webhook.Consumer(r)),
+		)
+		if err != nil {
+			outboxLogger.Log("msg", "error creating outbox dispatcher", "err", err)
+			cancelFn()
+			return
+		}
+		outboxLogger.Log("msg", "starting outbox dispatcher")
+		dispatcher.Run(ctx)
+	}()
+
+	// Webhook dispatcher
+	go func() {
+		defer wg.Done()
+		webhookLogger := log.With(logger, "component", "webhook-dispatcher")
+		dispatcher, err := webhook.New(webhook.WithRepo(r), webhook.WithLogger(webhookLogger))
+		if err != nil {
+			webhookLogger.Log("msg", "error creating webhook dispatcher", "err", err)
+			cancelFn()
+			return
+		}
+		webhookLogger.Log("msg", "starting webhook dispatcher")
+		dispatcher.Run(ctx)
+	}()
+
 	// Discord bot
 	if *runDiscord {
 		if *discordToken == "" {
@@ -148,11 +200,29 @@ func main() {
 		wg.Done()
 	}
 
-	// Wait for graceful shutdown of server and discord bot
+	// Wait for graceful shutdown of server, dispatchers, and discord bot
 	wg.Wait()
 	logger.Log("msg", "exiting")
 }
 
+func newRepo(logger log.Logger) (repo.Repo, error) {
+	switch *dbType {
+	case "gob":
+		r, err := gob.New(*gobDBFile)
+		if err != nil {
+			return nil, err
+		}
+		r.Logger = logger
+		return r, nil
+	case "sqlite":
+		return sqlite.New(*sqliteDBFile)
+	case "bolt":
+		return bolt.New(*boltDBFile)
+	default:
+		return nil, fmt.Errorf("unknown dbType: %q", *dbType)
+	}
+}
+
 func tracerProvider(w io.Writer) (*trace.TracerProvider, error) {
 	r, err := resource.Merge(
 		resource.Default(),
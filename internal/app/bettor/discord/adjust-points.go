@@ -0,0 +1,123 @@
+package discord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/bwmarrin/discordgo"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+)
+
+// adminPermissions are the Discord permissions that make a member a book admin.
+var adminPermissions int64 = discordgo.PermissionManageServer
+
+// isBookAdmin returns whether the member who triggered the interaction is an admin of the guild's book.
+func isBookAdmin(event *discordgo.InteractionCreate) bool {
+	return event.Member != nil && event.Member.Permissions&adminPermissions == adminPermissions
+}
+
+// ensureBookAdmin makes a server manager an admin of the guild's book if the book has no admins yet. The server only
+// lets listed admins administer a book, so later server managers must be added by an existing admin.
+func ensureBookAdmin(ctx context.Context, client bettorClient, guildID string, user *api.User) error {
+	resp, err := client.GetBookSettings(ctx, &connect.Request[api.GetBookSettingsRequest]{Msg: &api.GetBookSettingsRequest{Book: guildBookName(guildID)}})
+	if err != nil {
+		return err
+	}
+	settings := resp.Msg.GetSettings()
+	if len(settings.GetAdmins()) > 0 {
+		return nil
+	}
+	settings.Admins = []string{user.GetName()}
+	_, err = client.UpdateBookSettings(ctx, &connect.Request[api.UpdateBookSettingsRequest]{Msg: &api.UpdateBookSettingsRequest{
+		Settings: settings,
+		Actor:    user.GetName(),
+	}})
+	return err
+}
+
+var adjustPointsCommand = &discordgo.ApplicationCommand{
+	Name:                     "adjust-points",
+	Description:              "Credit or debit a bettor's points to fix a mistake. Only server managers can adjust points",
+	DefaultMemberPermissions: &adminPermissions,
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionUser,
+			Name:        "user",
+			Description: "Who to adjust",
+			Required:    true,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionNumber,
+			Name:        "points",
+			Description: "Points to credit. Negative points debit",
+			Required:    true,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "reason",
+			Description: "Why the points are being adjusted",
+			Required:    true,
+			MinLength:   &one,
+			MaxLength:   256,
+		},
+	},
+}
+
+// AdjustPoints is the handler for the /adjust-points command.
+func AdjustPoints(ctx context.Context, client bettorClient) Handler {
+	return func(s *discordgo.Session, event *discordgo.InteractionCreate) (*discordgo.InteractionResponseData, error) {
+		guildID, discordUserID, options, err := commandArgs(event)
+		if err != nil {
+			return nil, CErr("Failed to handle command", err)
+		}
+		if !isBookAdmin(event) {
+			return nil, CErr("Only server managers can adjust points", errors.New("user is not a book admin"))
+		}
+
+		centipoints := int64(options["points"].FloatValue() * 100)
+		if centipoints == 0 {
+			return nil, CErr("Points must not be 0", errors.New("points must not be 0"))
+		}
+
+		bettorUser, err := getUserOrCreateIfNotExist(ctx, client, guildID, discordUserID)
+		if err != nil {
+			return nil, CErr("Failed to get or create new user", err)
+		}
+		if err := ensureBookAdmin(ctx, client, guildID, bettorUser); err != nil {
+			return nil, CErr("Failed to lookup book admins", err)
+		}
+		adjustedDiscordUserID := options["user"].UserValue(nil).ID
+		adjustedBettorUser, err := getUserOrCreateIfNotExist(ctx, client, guildID, adjustedDiscordUserID)
+		if err != nil {
+			return nil, CErr("Failed to get or create new user", err)
+		}
+
+		resp, err := client.AdjustUserBalance(ctx, &connect.Request[api.AdjustUserBalanceRequest]{Msg: &api.AdjustUserBalanceRequest{
+			User:             adjustedBettorUser.GetName(),
+			Actor:            bettorUser.GetName(),
+			DeltaCentipoints: centipoints,
+			Reason:           options["reason"].StringValue(),
+		}})
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+				return nil, CErr("Adjustment would make their points negative", err)
+			}
+			if connect.CodeOf(err) == connect.CodePermissionDenied {
+				return nil, CErr("Only book admins can adjust points. Ask an admin to add you", err)
+			}
+			return nil, CErr("Failed to adjust points", err)
+		}
+
+		adjustment := resp.Msg.GetAdjustment()
+		msgformat := "🎲 🛠️\n\n<@!%s> credited <@!%s> **%v** points\nReason: %s\n\n"
+		delta := adjustment.GetDeltaCentipoints()
+		if delta < 0 {
+			msgformat = "🎲 🛠️\n\n<@!%s> debited <@!%s> **%v** points\nReason: %s\n\n"
+			delta = -delta
+		}
+		margs := []interface{}{discordUserID, adjustedDiscordUserID, float32(delta) / 100, adjustment.GetReason()}
+		userformat, userargs := formatUser(resp.Msg.GetUser(), resp.Msg.GetUser().GetUnsettledCentipoints())
+		return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat+userformat, append(margs, userargs...)...)}, nil
+	}
+}
@@ -3,29 +3,69 @@ package discord
 import (
 	"context"
 
+	"github.com/bufbuild/connect-go"
 	"github.com/bwmarrin/discordgo"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
 )
 
 var getBettorCommand = &discordgo.ApplicationCommand{
 	Name:        "bettor",
 	Description: "Get your bettor stats",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionUser,
+			Name:        "user",
+			Description: "View someone else's stats",
+		},
+	},
 }
 
 // GetBettor is the handler for the /bettor command.
 func GetBettor(ctx context.Context, client bettorClient) Handler {
 	return func(s *discordgo.Session, event *discordgo.InteractionCreate) (*discordgo.InteractionResponseData, error) {
-		guildID, discordUserID, _, err := commandArgs(event)
+		guildID, discordUserID, options, err := commandArgs(event)
 		if err != nil {
 			return nil, CErr("Failed to handle command", err)
 		}
 
-		bettorUser, err := getUserOrCreateIfNotExist(ctx, client, guildID, discordUserID)
+		var bettorUser *api.User
+		if opt, ok := options["user"]; ok && opt.UserValue(nil).ID != discordUserID {
+			resp, err := client.GetUserByUsername(ctx, &connect.Request[api.GetUserByUsernameRequest]{Msg: &api.GetUserByUsernameRequest{
+				Book:     guildBookName(guildID),
+				Username: opt.UserValue(nil).ID,
+			}})
+			if err != nil {
+				if connect.CodeOf(err) == connect.CodeNotFound {
+					return nil, CErr("That user has not used Bettor yet", err)
+				}
+				return nil, CErr("Failed to lookup user", err)
+			}
+			bettorUser = resp.Msg.GetUser()
+		} else {
+			bettorUser, err = getUserOrCreateIfNotExist(ctx, client, guildID, discordUserID)
+			if err != nil {
+				return nil, CErr("Failed to get or create new user", err)
+			}
+		}
+
+		statsResp, err := client.GetUserStats(ctx, &connect.Request[api.GetUserStatsRequest]{Msg: &api.GetUserStatsRequest{User: bettorUser.GetName()}})
 		if err != nil {
-			return nil, CErr("Failed to get or create new user", err)
+			return nil, CErr("Failed to get bettor stats", err)
 		}
 
+		// the chart only supplements the text so the bettor is still shown without it if it cannot be rendered
+		balanceFile, chartErr := balanceChart(ctx, client, bettorUser)
+
 		msgformat, margs := formatUser(bettorUser, bettorUser.UnsettledCentipoints)
-		msgformat = "🎲 👤\n\n" + msgformat
-		return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat, margs...)}, nil
+		statsformat, statsargs := formatUserStats(statsResp.Msg.GetStats())
+		msgformat = "🎲 👤\n\n" + msgformat + statsformat
+		respData := &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat, append(margs, statsargs...)...)}
+		if balanceFile != nil {
+			respData.Files = []*discordgo.File{balanceFile}
+		}
+		if chartErr != nil {
+			return respData, CErr("Failed to render balance chart", chartErr)
+		}
+		return respData, nil
 	}
 }
@@ -13,16 +13,40 @@ import (
 var getBettorsCommand = &discordgo.ApplicationCommand{
 	Name:        "bettors",
 	Description: "Get bettor leaderboard",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionInteger,
+			Name:        "season",
+			Description: "View the final standings of a past season",
+			MinValue:    &oneFloat,
+		},
+	},
 }
 
 // GetBettors is the handler for the /get-bet command.
 func GetBettors(ctx context.Context, client bettorClient) Handler {
 	return func(s *discordgo.Session, event *discordgo.InteractionCreate) (*discordgo.InteractionResponseData, error) {
-		guildID, _, _, err := commandArgs(event)
+		guildID, _, options, err := commandArgs(event)
 		if err != nil {
 			return nil, CErr("Failed to handle command", err)
 		}
 
+		if opt, ok := options["season"]; ok {
+			seasonResp, err := client.GetSeason(ctx, &connect.Request[api.GetSeasonRequest]{Msg: &api.GetSeasonRequest{
+				Name: fmt.Sprintf("%s/seasons/%d", guildBookName(guildID), opt.IntValue()),
+			}})
+			if err != nil {
+				if connect.CodeOf(err) == connect.CodeNotFound {
+					return nil, CErr("That season does not exist", err)
+				}
+				return nil, CErr("Failed to lookup season", err)
+			}
+			// the current season does not have final standings yet so fall through to the live leaderboard
+			if season := seasonResp.Msg.GetSeason(); season.GetEndTime() != nil {
+				return formatSeason(season), nil
+			}
+		}
+
 		listUsersResp, err := client.ListUsers(ctx, &connect.Request[api.ListUsersRequest]{Msg: &api.ListUsersRequest{
 			Book:     guildBookName(guildID),
 			PageSize: 10,
@@ -57,3 +81,31 @@ func GetBettors(ctx context.Context, client bettorClient) Handler {
 		return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat, args...)}, nil
 	}
 }
+
+// formatSeason formats the top final standings of an ended season.
+func formatSeason(season *api.Season) *discordgo.InteractionResponseData {
+	var formattedStandings []string
+	args := []interface{}{season.GetNumber(), season.GetEndTime().AsTime().Unix()}
+	for i, standing := range season.GetStandings() {
+		if i == 10 {
+			break
+		}
+		msgformat := "%d) <@!%s> Points: **%v**"
+		switch standing.GetRank() {
+		case 1:
+			msgformat += " 🥇"
+		case 2:
+			msgformat += " 🥈"
+		case 3:
+			msgformat += " 🥉"
+		}
+		formattedStandings = append(formattedStandings, msgformat)
+		args = append(args, standing.GetRank(), standing.GetUsername(), float32(standing.GetTotalCentipoints())/100)
+	}
+	if len(formattedStandings) == 0 {
+		formattedStandings = append(formattedStandings, "No bettors")
+	}
+
+	msgformat := "🎲 👤 Season **%d** final standings (ended <t:%d:D>)\n\n" + strings.Join(formattedStandings, "\n")
+	return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat, args...)}
+}
@@ -0,0 +1,61 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/bwmarrin/discordgo"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+)
+
+var getCalibrationCommand = &discordgo.ApplicationCommand{
+	Name:        "calibration",
+	Description: "Get forecasting leaderboard by Brier score",
+}
+
+// GetCalibration is the handler for the /calibration command.
+func GetCalibration(ctx context.Context, client bettorClient) Handler {
+	return func(s *discordgo.Session, event *discordgo.InteractionCreate) (*discordgo.InteractionResponseData, error) {
+		guildID, _, _, err := commandArgs(event)
+		if err != nil {
+			return nil, CErr("Failed to handle command", err)
+		}
+
+		leaderboardResp, err := client.GetForecastLeaderboard(ctx, &connect.Request[api.GetForecastLeaderboardRequest]{Msg: &api.GetForecastLeaderboardRequest{
+			Book:     guildBookName(guildID),
+			PageSize: 10,
+		}})
+		if err != nil {
+			return nil, CErr("Failed to lookup forecast scores", err)
+		}
+		scores := leaderboardResp.Msg.GetScores()
+		if len(scores) == 0 {
+			return &discordgo.InteractionResponseData{Content: "No bettors have enough settled bets to be scored yet"}, nil
+		}
+
+		var names []string
+		for _, score := range scores {
+			names = append(names, score.GetUser())
+		}
+		usersResp, err := client.BatchGetUsers(ctx, &connect.Request[api.BatchGetUsersRequest]{Msg: &api.BatchGetUsersRequest{Names: names}})
+		if err != nil {
+			return nil, CErr("Failed to lookup bettors", err)
+		}
+		usernames := map[string]string{}
+		for _, user := range usersResp.Msg.GetUsers() {
+			usernames[user.GetName()] = user.GetUsername()
+		}
+
+		var formattedScores []string
+		var args []interface{}
+		for i, score := range scores {
+			formattedScores = append(formattedScores, fmt.Sprintf("%d", i+1)+") <@!%s> Brier: **%.3f** Log: **%.3f** (**%d** forecasts)")
+			args = append(args, usernames[score.GetUser()], score.GetBrierScore(), score.GetLogScore(), score.GetForecasts())
+		}
+
+		msgformat := "🎲 🎯 Lower Brier scores are better\n\n" + strings.Join(formattedScores, "\n")
+		return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat, args...)}, nil
+	}
+}
@@ -0,0 +1,83 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/bwmarrin/discordgo"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/pkg/chart"
+)
+
+// oddsChartRevisions is how many of a market's most recent revisions are charted. Each bet is one revision so this
+// bounds the snapshots fetched to one page.
+const oddsChartRevisions = 100
+
+// oddsChart renders a market's recent odds over time as a PNG attachment. It returns nil if no bets have been placed.
+func oddsChart(ctx context.Context, client bettorClient, market *api.Market) (*discordgo.File, error) {
+	var afterRevision uint64
+	if revision, _ := strconv.ParseUint(market.GetEtag(), 10, 64); revision > oddsChartRevisions {
+		afterRevision = revision - oddsChartRevisions
+	}
+	resp, err := client.ListMarketOddsHistory(ctx, &connect.Request[api.ListMarketOddsHistoryRequest]{Msg: &api.ListMarketOddsHistoryRequest{
+		Market:        market.GetName(),
+		PageSize:      oddsChartRevisions,
+		AfterRevision: afterRevision,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	snapshots := resp.Msg.GetSnapshots()
+
+	c := &chart.Chart{
+		Title:       market.GetTitle(),
+		YMin:        0,
+		YMax:        1,
+		FormatValue: func(v float64) string { return fmt.Sprintf("%.0f%%", v*100) },
+	}
+	if market.GetStatus() == api.Market_STATUS_OPEN {
+		c.End = time.Now()
+	}
+	for _, outcome := range market.GetPool().GetOutcomes() {
+		series := chart.Series{Name: outcome.GetTitle()}
+		for _, snapshot := range snapshots {
+			for _, odds := range snapshot.GetOutcomes() {
+				if odds.GetOutcome() == outcome.GetName() {
+					series.Points = append(series.Points, chart.Point{Time: snapshot.GetCreatedAt().AsTime(), Value: odds.GetImpliedProbability()})
+				}
+			}
+		}
+		c.Series = append(c.Series, series)
+	}
+	return pngFile("odds.png", c)
+}
+
+// balanceChart renders a user's total points over time as a PNG attachment.
+func balanceChart(ctx context.Context, client bettorClient, user *api.User) (*discordgo.File, error) {
+	resp, err := client.GetUserBalanceHistory(ctx, &connect.Request[api.GetUserBalanceHistoryRequest]{Msg: &api.GetUserBalanceHistoryRequest{User: user.GetName()}})
+	if err != nil {
+		return nil, err
+	}
+	var series chart.Series
+	for _, p := range resp.Msg.GetPoints() {
+		series.Points = append(series.Points, chart.Point{Time: p.GetTime().AsTime(), Value: float64(p.GetTotalCentipoints()) / 100})
+	}
+	return pngFile("points.png", &chart.Chart{Title: "Total points", Series: []chart.Series{series}})
+}
+
+// pngFile renders a chart as a Discord attachment. It returns nil if the chart has no data.
+func pngFile(name string, c *chart.Chart) (*discordgo.File, error) {
+	b, err := c.PNG()
+	if errors.Is(err, chart.ErrNoData) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &discordgo.File{Name: name, ContentType: "image/png", Reader: bytes.NewReader(b)}, nil
+}
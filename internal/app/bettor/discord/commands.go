@@ -18,7 +18,7 @@ type Command struct {
 }
 
 // Handler is an InteractionCreate handler. It returns a InteractionResponseData which will serve as our universal interface
-// for generic handling.
+// for generic handling. If it returns data along with an error, the data is still sent and the error is only logged.
 type Handler func(*discordgo.Session, *discordgo.InteractionCreate) (*discordgo.InteractionResponseData, error)
 
 // initCommands initializes bot commands from a source of truth mapping. It ensure names are correct by defering to map
@@ -37,6 +37,10 @@ func initCommands(ctx context.Context, client bettorClient, logger log.Logger) m
 			Def:     settleBetCommand,
 			Handler: SettleBet(ctx, client),
 		},
+		"resettle-bet": {
+			Def:     resettleBetCommand,
+			Handler: ResettleBet(ctx, client),
+		},
 		"cancel-bet": {
 			Def:     cancelBetCommand,
 			Handler: CancelBet(ctx, client),
@@ -57,6 +61,22 @@ func initCommands(ctx context.Context, client bettorClient, logger log.Logger) m
 			Def:     getBettorsCommand,
 			Handler: GetBettors(ctx, client),
 		},
+		"calibration": {
+			Def:     getCalibrationCommand,
+			Handler: GetCalibration(ctx, client),
+		},
+		"daily": {
+			Def:     claimDailyCommand,
+			Handler: ClaimDaily(ctx, client),
+		},
+		"tip": {
+			Def:     tipCommand,
+			Handler: Tip(ctx, client),
+		},
+		"adjust-points": {
+			Def:     adjustPointsCommand,
+			Handler: AdjustPoints(ctx, client),
+		},
 	}
 
 	out := map[string]*DGCommand{}
@@ -71,7 +91,10 @@ func initCommands(ctx context.Context, client bettorClient, logger log.Logger) m
 				now := time.Now()
 				respData, err := handlerFn(s, event)
 				durMS := time.Now().Sub(now).Milliseconds()
-				if err != nil {
+				switch {
+				case err != nil && respData != nil:
+					logger.Log("msg", "command handler partial failure", "dur_ms", durMS, "err", err)
+				case err != nil:
 					var cErr *CommandError
 					if !errors.As(err, &cErr) {
 						cErr = CErr("An error occurred while processing your command.", err)
@@ -80,7 +103,7 @@ func initCommands(ctx context.Context, client bettorClient, logger log.Logger) m
 						Content: fmt.Sprintf("🔺 %s", cErr.UserMsg),
 					}
 					logger.Log("msg", "command handler failure", "dur_ms", durMS, "err", cErr)
-				} else {
+				default:
 					logger.Log("msg", "command handler success", "dur_ms", durMS)
 				}
 
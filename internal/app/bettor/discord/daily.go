@@ -0,0 +1,56 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/bwmarrin/discordgo"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+)
+
+var claimDailyCommand = &discordgo.ApplicationCommand{
+	Name:        "daily",
+	Description: "Claim your daily points",
+}
+
+// ClaimDaily is the handler for the /daily command.
+func ClaimDaily(ctx context.Context, client bettorClient) Handler {
+	return func(s *discordgo.Session, event *discordgo.InteractionCreate) (*discordgo.InteractionResponseData, error) {
+		guildID, discordUserID, _, err := commandArgs(event)
+		if err != nil {
+			return nil, CErr("Failed to handle command", err)
+		}
+
+		bettorUser, err := getUserOrCreateIfNotExist(ctx, client, guildID, discordUserID)
+		if err != nil {
+			return nil, CErr("Failed to get or create new user", err)
+		}
+
+		resp, err := client.ClaimAllowance(ctx, &connect.Request[api.ClaimAllowanceRequest]{Msg: &api.ClaimAllowanceRequest{User: bettorUser.GetName()}})
+		if err != nil {
+			if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+				return nil, CErr("Failed to claim daily points", err)
+			}
+			settingsResp, err := client.GetBookSettings(ctx, &connect.Request[api.GetBookSettingsRequest]{Msg: &api.GetBookSettingsRequest{Book: guildBookName(guildID)}})
+			if err != nil {
+				return nil, CErr("Failed to lookup daily points settings", err)
+			}
+			if claimedAt := bettorUser.GetAllowanceClaimedAt(); claimedAt != nil {
+				if next := claimedAt.AsTime().Add(settingsResp.Msg.GetSettings().GetAllowanceCooldown().AsDuration()); next.After(time.Now()) {
+					return &discordgo.InteractionResponseData{Content: localized.Sprintf("🎲 ⏳\n\nYou already claimed your daily points. Come back <t:%d:R>", next.Unix())}, nil
+				}
+			}
+			return &discordgo.InteractionResponseData{Content: "🎲 ⏳\n\nThere are no daily points to claim"}, nil
+		}
+
+		grant := resp.Msg.GetGrant()
+		msgformat := "🎲 🎁\n\nClaimed **%v** points. Come back <t:%d:R> for more\n\n"
+		if grant.GetReason() == api.Grant_REASON_BAILOUT {
+			msgformat = "🎲 🛟\n\nBailed out with **%v** points. Come back <t:%d:R> for more\n\n"
+		}
+		margs := []interface{}{float32(grant.GetCentipoints()) / 100, resp.Msg.GetNextClaimTime().AsTime().Unix()}
+		userformat, userargs := formatUser(resp.Msg.GetUser(), resp.Msg.GetUser().GetUnsettledCentipoints())
+		return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat+userformat, append(margs, userargs...)...)}, nil
+	}
+}
@@ -14,20 +14,56 @@ var localized = message.NewPrinter(language.English)
 func formatUser(user *api.User, unsettledCentipoints uint64) (fmtStr string, args []interface{}) {
 	margs := []interface{}{user.GetUsername(), float32(user.GetCentipoints()) / 100, float32(unsettledCentipoints) / 100}
 	msgformat := "<@!%s> Points: **%v** (Unsettled points: **%v**)\n"
+	if user.GetDebtCentipoints() > 0 {
+		margs = append(margs, float32(user.GetDebtCentipoints())/100)
+		msgformat += "Owes **%v** points from resettled bets. Repaid from future points\n"
+	}
 	return msgformat, margs
 }
 
-// formatMarket formats a market for display in Discord.
-func formatMarket(market *api.Market, creator *api.User, bets []*api.Bet, bettors []*api.User) (fmtStr string, args []interface{}) {
-	var totalCentipoints uint64
-	for _, outcome := range market.GetPool().GetOutcomes() {
-		totalCentipoints += outcome.GetCentipoints()
+// formatUserStats formats a user's betting stats for display in Discord.
+func formatUserStats(stats *api.UserStats) (fmtStr string, args []interface{}) {
+	if stats.GetSettledBets() == 0 {
+		return "No settled bets yet\n", nil
+	}
+	margs := []interface{}{
+		stats.GetMarketsBetOn(), stats.GetSettledBets(),
+		stats.GetWins(), stats.GetLosses(), stats.GetWinRate() * 100,
+		float32(stats.GetTotalStakedCentipoints()) / 100, float32(stats.GetNetProfitCentipoints()) / 100, stats.GetRoi() * 100,
+		stats.GetLongestWinStreak(),
+	}
+	msgformat := "Bets: **%d** markets, **%d** settled bets\n" +
+		"Record: **%d**W **%d**L (Win rate: **%.1f%%**)\n" +
+		"Staked: **%v** Net profit: **%v** (ROI: **%.1f%%**)\n" +
+		"Longest win streak: **%d**\n"
+	if stats.GetBiggestWin() != nil {
+		biggestWin := stats.GetBiggestWin()
+		margs = append(margs, float32(biggestWin.GetSettledCentipoints()-biggestWin.GetCentipoints())/100)
+		msgformat += "Biggest win: **%v**\n"
+	}
+	if len(stats.GetFavoriteOutcomes()) > 0 {
+		var titles []string
+		for _, outcome := range stats.GetFavoriteOutcomes() {
+			titles = append(titles, outcome.GetTitle())
+		}
+		margs = append(margs, strings.Join(titles, ", "))
+		msgformat += "Favorite outcomes: **%s**\n"
+	}
+	return msgformat, margs
+}
+
+// formatMarket formats a market for display in Discord. Odds are the payout multipliers computed by the server.
+func formatMarket(market *api.Market, details *marketDetails) (fmtStr string, args []interface{}) {
+	creator, bets, bettors := details.creator, details.bets, details.bettors
+	payoutMultipliers := map[string]float64{} // outcome resource names
+	for _, outcome := range details.stats.GetOutcomes() {
+		payoutMultipliers[outcome.GetOutcome()] = outcome.GetPayoutMultiplier()
 	}
 	margs := []interface{}{market.GetTitle(), creator.GetUsername(), strings.TrimPrefix(market.GetStatus().String(), "STATUS_")}
 	msgformat := "Bet: **%s**\nCreator: <@!%s>\nStatus: `%s`\n"
 	for _, outcome := range market.GetPool().GetOutcomes() {
-		if outcome.GetCentipoints() > 0 && totalCentipoints != outcome.GetCentipoints() {
-			margs = append(margs, outcome.GetTitle(), (float32(outcome.GetCentipoints()) / 100), float32(totalCentipoints)/float32(outcome.GetCentipoints()))
+		if multiplier := payoutMultipliers[outcome.GetName()]; multiplier > 0 {
+			margs = append(margs, outcome.GetTitle(), float32(outcome.GetCentipoints())/100, multiplier)
 			msgformat += "- **%s** (Points: **%v**, Odds: **1:%.3f**)"
 		} else {
 			margs = append(margs, outcome.GetTitle(), float32(outcome.GetCentipoints())/100)
@@ -41,20 +41,24 @@ func GetBet(ctx context.Context, client bettorClient) Handler {
 			}
 			market := resp.Msg.GetMarket()
 
-			userResp, err := client.GetUser(ctx, &connect.Request[api.GetUserRequest]{Msg: &api.GetUserRequest{Name: market.GetCreator()}})
-			if err != nil {
-				return nil, CErr("Failed to lookup bet creator", err)
-			}
-			marketCreator := userResp.Msg.GetUser()
-
-			bets, bettors, err := getMarketBets(ctx, client, market.GetName())
+			details, err := getMarketDetails(ctx, client, market)
 			if err != nil {
 				return nil, CErr("Failed to lookup bettors", err)
 			}
 
-			msgformat, margs := formatMarket(market, marketCreator, bets, bettors)
+			// the chart only supplements the text so the bet is still shown without it if it cannot be rendered
+			oddsFile, chartErr := oddsChart(ctx, client, market)
+
+			msgformat, margs := formatMarket(market, details)
 			msgformat = "🎲\n" + msgformat
-			return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat, margs...)}, nil
+			respData := &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat, margs...)}
+			if oddsFile != nil {
+				respData.Files = []*discordgo.File{oddsFile}
+			}
+			if chartErr != nil {
+				return respData, CErr("Failed to render odds chart", chartErr)
+			}
+			return respData, nil
 		case discordgo.InteractionApplicationCommandAutocomplete:
 			var choices []*discordgo.ApplicationCommandOptionChoice
 			resp, err := client.ListMarkets(ctx, &connect.Request[api.ListMarketsRequest]{Msg: &api.ListMarketsRequest{Book: guildBookName(guildID), PageSize: 25}})
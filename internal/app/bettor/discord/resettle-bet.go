@@ -0,0 +1,180 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/bwmarrin/discordgo"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+)
+
+var resettleBetCommand = &discordgo.ApplicationCommand{
+	Name:        "resettle-bet",
+	Description: "Correct the winner of a recently settled bet. Only the bet creator or server managers can resettle the bet",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:         discordgo.ApplicationCommandOptionString,
+			Name:         "bet",
+			Description:  "Bet",
+			Required:     true,
+			MinLength:    &one,
+			MaxLength:    1024,
+			Autocomplete: true,
+		},
+		{
+			Type:         discordgo.ApplicationCommandOptionString,
+			Name:         "winner",
+			Description:  "Corrected winning outcome",
+			Required:     true,
+			MinLength:    &one,
+			MaxLength:    1024,
+			Autocomplete: true,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "reason",
+			Description: "Why the bet is being resettled",
+			MaxLength:   256,
+		},
+	},
+}
+
+// ResettleBet is the handler for the /resettle-bet command.
+func ResettleBet(ctx context.Context, client bettorClient) Handler {
+	return func(s *discordgo.Session, event *discordgo.InteractionCreate) (*discordgo.InteractionResponseData, error) {
+		guildID, discordUserID, options, err := commandArgs(event)
+		if err != nil {
+			return nil, CErr("Failed to handle command", err)
+		}
+		bettorUser, err := getUserOrCreateIfNotExist(ctx, client, guildID, discordUserID)
+		if err != nil {
+			return nil, CErr("Failed to lookup (or create nonexistent) user", err)
+		}
+		canResettle := func(market *api.Market) bool {
+			return market.GetCreator() == bettorUser.GetName() || isBookAdmin(event)
+		}
+
+		switch event.Type { //nolint:exhaustive
+		case discordgo.InteractionApplicationCommand:
+			getResp, err := client.GetMarket(ctx, &connect.Request[api.GetMarketRequest]{Msg: &api.GetMarketRequest{Name: options["bet"].StringValue()}})
+			if err != nil {
+				return nil, CErr("Failed to lookup bet", err)
+			}
+			if !canResettle(getResp.Msg.GetMarket()) {
+				return nil, CErr("Only the bet creator or server managers can resettle the bet", errors.New("user cannot resettle market"))
+			}
+			if getResp.Msg.GetMarket().GetCreator() != bettorUser.GetName() {
+				if err := ensureBookAdmin(ctx, client, guildID, bettorUser); err != nil {
+					return nil, CErr("Failed to lookup book admins", err)
+				}
+			}
+
+			var reason string
+			if opt, ok := options["reason"]; ok {
+				reason = opt.StringValue()
+			}
+			resp, err := client.ResettleMarket(ctx, &connect.Request[api.ResettleMarketRequest]{Msg: &api.ResettleMarketRequest{
+				Name:   options["bet"].StringValue(),
+				Winner: options["winner"].StringValue(),
+				Actor:  bettorUser.GetName(),
+				Reason: reason,
+				Etag:   getResp.Msg.GetMarket().GetEtag(),
+			}})
+			if err != nil {
+				if connect.CodeOf(err) == connect.CodeFailedPrecondition {
+					return nil, CErr("This bet can no longer be resettled", err)
+				}
+				if connect.CodeOf(err) == connect.CodePermissionDenied {
+					return nil, CErr("Only the bet creator or book admins can resettle the bet. Ask an admin to add you", err)
+				}
+				return nil, CErr("Failed to resettle bet", err)
+			}
+			market := resp.Msg.GetMarket()
+			var winnerTitle string
+			for _, outcome := range market.GetPool().GetOutcomes() {
+				if outcome.GetName() == options["winner"].StringValue() {
+					winnerTitle = outcome.GetTitle()
+					break
+				}
+			}
+
+			details, err := getMarketDetails(ctx, client, market)
+			if err != nil {
+				return nil, CErr("Failed to lookup bettors", err)
+			}
+
+			msgformat, margs := formatMarket(market, details)
+			preformat, preargs := "🎲 🔁 Bet resettled with winner **%s**!\n", []interface{}{winnerTitle}
+			if reason != "" {
+				preformat += "Reason: %s\n"
+				preargs = append(preargs, reason)
+			}
+			settlements := market.GetSettlements()
+			if debts := settlements[len(settlements)-1].GetDebts(); len(debts) > 0 {
+				users := map[string]string{}
+				for _, bettor := range details.bettors {
+					users[bettor.GetName()] = bettor.GetUsername()
+				}
+				preformat += "Points owed until repaid from future points:"
+				for _, debt := range debts {
+					if _, ok := users[debt.GetUser()]; !ok {
+						userResp, err := client.GetUser(ctx, &connect.Request[api.GetUserRequest]{Msg: &api.GetUserRequest{Name: debt.GetUser()}})
+						if err != nil {
+							return nil, CErr("Failed to lookup bettors", err)
+						}
+						users[debt.GetUser()] = userResp.Msg.GetUser().GetUsername()
+					}
+					preformat += " <@!%s> **%v**"
+					preargs = append(preargs, users[debt.GetUser()], float32(debt.GetCentipoints())/100)
+				}
+				preformat += "\n"
+			}
+			return &discordgo.InteractionResponseData{Content: localized.Sprintf(preformat+"\n"+msgformat, append(preargs, margs...)...)}, nil
+		case discordgo.InteractionApplicationCommandAutocomplete:
+			resp, err := client.ListMarkets(ctx, &connect.Request[api.ListMarketsRequest]{Msg: &api.ListMarketsRequest{
+				Book:     guildBookName(guildID),
+				Status:   api.Market_STATUS_SETTLED,
+				PageSize: 25,
+			}})
+			if err != nil {
+				return nil, CErr("Failed to lookup bets", err)
+			}
+
+			var choices []*discordgo.ApplicationCommandOptionChoice
+			switch {
+			case options["bet"] != nil && options["bet"].Focused:
+				for _, market := range resp.Msg.GetMarkets() {
+					if !canResettle(market) {
+						continue
+					}
+					choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+						Name:  market.GetTitle(),
+						Value: market.GetName(),
+					})
+				}
+			case options["winner"] != nil && options["winner"].Focused:
+				if options["bet"] != nil && options["bet"].StringValue() != "" {
+					for _, market := range resp.Msg.GetMarkets() {
+						if !canResettle(market) || market.GetName() != options["bet"].StringValue() {
+							continue
+						}
+						for _, outcome := range market.GetPool().GetOutcomes() {
+							if outcome.GetName() == market.GetPool().GetWinner() {
+								continue
+							}
+							choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+								Name:  outcome.GetTitle(),
+								Value: outcome.GetName(),
+							})
+						}
+					}
+				}
+			}
+			return &discordgo.InteractionResponseData{Choices: withDefaultChoices(choices)}, nil
+		default:
+			return nil, CErr("Something went wrong", fmt.Errorf("unexpected event type %v", event.Type))
+		}
+	}
+}
@@ -62,18 +62,12 @@ func SettleBet(ctx context.Context, client bettorClient) Handler {
 				}
 			}
 
-			userResp, err := client.GetUser(ctx, &connect.Request[api.GetUserRequest]{Msg: &api.GetUserRequest{Name: market.GetCreator()}})
-			if err != nil {
-				return nil, CErr("Failed to lookup bet creator", err)
-			}
-			marketCreator := userResp.Msg.GetUser()
-
-			bets, bettors, err := getMarketBets(ctx, client, market.GetName())
+			details, err := getMarketDetails(ctx, client, market)
 			if err != nil {
 				return nil, CErr("Failed to lookup bettors", err)
 			}
 
-			msgformat, margs := formatMarket(market, marketCreator, bets, bettors)
+			msgformat, margs := formatMarket(market, details)
 			msgformat = "🎲 ✅ Bet settled with winner **%s**!\n\n" + msgformat
 			margs = append([]interface{}{winnerTitle}, margs...)
 			return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat, margs...)}, nil
@@ -93,12 +93,12 @@ func StartBet(ctx context.Context, client bettorClient) Handler {
 		}
 		market := resp.Msg.GetMarket()
 
-		bets, bettors, err := getMarketBets(ctx, client, market.GetName())
+		details, err := getMarketDetails(ctx, client, market)
 		if err != nil {
 			return nil, CErr("Failed to lookup bettors", err)
 		}
 
-		msgformat, margs := formatMarket(market, bettorUser, bets, bettors)
+		msgformat, margs := formatMarket(market, details)
 		msgformat = "🎲 🆕 New bet started. `/join-bet` to join the bet until it is locked with `/lock-bet`.\n\n" + msgformat
 		return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat, margs...)}, nil
 	}
@@ -136,35 +136,50 @@ func guildBookName(guildID string) string {
 	return fmt.Sprintf("books/discord:%s", guildID)
 }
 
-// returns a potentially nonexhaustive list of bettors in a market.
-func getMarketBets(ctx context.Context, client bettorClient, marketName string) ([]*api.Bet, []*api.User, error) {
-	bookID, _ := entity.MarketIDs(marketName)
-	bookName := entity.BookN(bookID)
+// marketDetails is what is displayed with a market.
+type marketDetails struct {
+	creator *api.User
+	bets    []*api.Bet // potentially nonexhaustive
+	bettors []*api.User
+	stats   *api.MarketStats
+}
+
+// getMarketDetails returns a market's creator, stats, and a potentially nonexhaustive list of its bets and bettors.
+// Users are fetched in one batch.
+func getMarketDetails(ctx context.Context, client bettorClient, market *api.Market) (*marketDetails, error) {
+	bookID, _ := entity.MarketIDs(market.GetName())
 	betsResp, err := client.ListBets(ctx, &connect.Request[api.ListBetsRequest]{Msg: &api.ListBetsRequest{
-		Book:     bookName,
+		Book:     entity.BookN(bookID),
 		PageSize: 50,
-		Market:   marketName,
+		Market:   market.GetName(),
 	}})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to list bets: %w", err)
+		return nil, fmt.Errorf("failed to list bets: %w", err)
 	}
-	bets := betsResp.Msg.GetBets()
+	details := &marketDetails{bets: betsResp.Msg.GetBets()}
 
-	var bettors []*api.User
-	if len(bets) != 0 {
-		var userIDs []string //nolint:prealloc
-		for _, bet := range bets {
-			userIDs = append(userIDs, bet.GetUser())
-		}
-		userResp, err := client.ListUsers(ctx, &connect.Request[api.ListUsersRequest]{Msg: &api.ListUsersRequest{
-			Book:     bookName,
-			PageSize: 50,
-			Users:    userIDs,
-		}})
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to list users: %w", err)
+	userNames := []string{market.GetCreator()}
+	for _, bet := range details.bets {
+		userNames = append(userNames, bet.GetUser())
+	}
+	usersResp, err := client.BatchGetUsers(ctx, &connect.Request[api.BatchGetUsersRequest]{Msg: &api.BatchGetUsersRequest{Names: userNames}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	details.bettors = usersResp.Msg.GetUsers()
+	for _, user := range details.bettors {
+		if user.GetName() == market.GetCreator() {
+			details.creator = user
 		}
-		bettors = userResp.Msg.GetUsers()
 	}
-	return bets, bettors, nil
+	if details.creator == nil {
+		return nil, fmt.Errorf("failed to get bet creator %s", market.GetCreator())
+	}
+
+	statsResp, err := client.GetMarketStats(ctx, &connect.Request[api.GetMarketStatsRequest]{Msg: &api.GetMarketStatsRequest{Name: market.GetName()}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get market stats: %w", err)
+	}
+	details.stats = statsResp.Msg.GetStats()
+	return details, nil
 }
@@ -0,0 +1,84 @@
+package discord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/bwmarrin/discordgo"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+)
+
+var tipCommand = &discordgo.ApplicationCommand{
+	Name:        "tip",
+	Description: "Give some of your points to another bettor",
+	Options: []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionUser,
+			Name:        "user",
+			Description: "Who to tip",
+			Required:    true,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionNumber,
+			Name:        "points",
+			Description: "Points",
+			Required:    true,
+			MinValue:    &oneFloat,
+		},
+		{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "memo",
+			Description: "What it's for",
+			MaxLength:   256,
+		},
+	},
+}
+
+// Tip is the handler for the /tip command.
+func Tip(ctx context.Context, client bettorClient) Handler {
+	return func(s *discordgo.Session, event *discordgo.InteractionCreate) (*discordgo.InteractionResponseData, error) {
+		guildID, discordUserID, options, err := commandArgs(event)
+		if err != nil {
+			return nil, CErr("Failed to handle command", err)
+		}
+
+		toDiscordUserID := options["user"].UserValue(nil).ID
+		if toDiscordUserID == discordUserID {
+			return nil, CErr("You cannot tip yourself", errors.New("cannot tip self"))
+		}
+
+		bettorUser, err := getUserOrCreateIfNotExist(ctx, client, guildID, discordUserID)
+		if err != nil {
+			return nil, CErr("Failed to get or create new user", err)
+		}
+		toBettorUser, err := getUserOrCreateIfNotExist(ctx, client, guildID, toDiscordUserID)
+		if err != nil {
+			return nil, CErr("Failed to get or create new user", err)
+		}
+
+		var memo string
+		if opt, ok := options["memo"]; ok {
+			memo = opt.StringValue()
+		}
+		resp, err := client.TransferPoints(ctx, &connect.Request[api.TransferPointsRequest]{Msg: &api.TransferPointsRequest{
+			FromUser:    bettorUser.GetName(),
+			ToUser:      toBettorUser.GetName(),
+			Centipoints: uint64(options["points"].FloatValue() * 100),
+			Memo:        memo,
+		}})
+		if err != nil {
+			return nil, CErr("Failed to tip user", err)
+		}
+
+		transfer := resp.Msg.GetTransfer()
+		msgformat, margs := "🎲 💸\n\n<@!%s> tipped <@!%s> **%v** points\n", []interface{}{discordUserID, toDiscordUserID, float32(transfer.GetCentipoints()) / 100}
+		if transfer.GetMemo() != "" {
+			msgformat += "Memo: %s\n"
+			margs = append(margs, transfer.GetMemo())
+		}
+		msgformat += "\n"
+		userformat, userargs := formatUser(resp.Msg.GetFromUser(), resp.Msg.GetFromUser().GetUnsettledCentipoints())
+		return &discordgo.InteractionResponseData{Content: localized.Sprintf(msgformat+userformat, append(margs, userargs...)...)}, nil
+	}
+}
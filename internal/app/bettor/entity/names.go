@@ -3,19 +3,33 @@ package entity
 import "regexp"
 
 const (
-	bookCollection    = "books"
-	userCollection    = "users"
-	marketCollection  = "markets"
-	outcomeCollection = "outcomes"
-	betCollection     = "bets"
+	bookCollection       = "books"
+	userCollection       = "users"
+	marketCollection     = "markets"
+	outcomeCollection    = "outcomes"
+	betCollection        = "bets"
+	webhookCollection    = "webhooks"
+	deliveryCollection   = "deliveries"
+	grantCollection      = "grants"
+	seasonCollection     = "seasons"
+	transferCollection   = "transfers"
+	adjustmentCollection = "adjustments"
+	settingsSingleton    = "settings"
 )
 
 var (
-	bookRegex    = regexp.MustCompile(`books\/([^/]*)`)
-	userRegex    = regexp.MustCompile(`books\/([^/]*)\/users\/([^/]*)`)
-	marketRegex  = regexp.MustCompile(`books\/([^/]*)\/markets\/([^/]*)`)
-	outcomeRegex = regexp.MustCompile(`books\/([^/]*)\/markets\/([^/]*)\/outcomes\/([^/]*)`)
-	betRegex     = regexp.MustCompile(`books\/([^/]*)\/bets\/([^/]*)`)
+	bookRegex       = regexp.MustCompile(`books\/([^/]*)`)
+	userRegex       = regexp.MustCompile(`books\/([^/]*)\/users\/([^/]*)`)
+	marketRegex     = regexp.MustCompile(`books\/([^/]*)\/markets\/([^/]*)`)
+	outcomeRegex    = regexp.MustCompile(`books\/([^/]*)\/markets\/([^/]*)\/outcomes\/([^/]*)`)
+	betRegex        = regexp.MustCompile(`books\/([^/]*)\/bets\/([^/]*)`)
+	webhookRegex    = regexp.MustCompile(`books\/([^/]*)\/webhooks\/([^/]*)`)
+	deliveryRegex   = regexp.MustCompile(`books\/([^/]*)\/webhooks\/([^/]*)\/deliveries\/([^/]*)`)
+	grantRegex      = regexp.MustCompile(`books\/([^/]*)\/grants\/([^/]*)`)
+	settingsRegex   = regexp.MustCompile(`books\/([^/]*)\/settings`)
+	seasonRegex     = regexp.MustCompile(`books\/([^/]*)\/seasons\/([^/]*)`)
+	transferRegex   = regexp.MustCompile(`books\/([^/]*)\/transfers\/([^/]*)`)
+	adjustmentRegex = regexp.MustCompile(`books\/([^/]*)\/adjustments\/([^/]*)`)
 )
 
 // BookN returns a resource name from book ids.
@@ -87,3 +101,101 @@ func BetIDs(name string) (bookID, betID string) {
 	}
 	return parts[1], parts[2]
 }
+
+// WebhookN returns a resource name from webhook ids.
+func WebhookN(bookID, webhookID string) string {
+	return bookCollection + "/" + bookID + "/" + webhookCollection + "/" + webhookID
+}
+
+// WebhookIDs returns ids from webhook resource name.
+func WebhookIDs(name string) (bookID, webhookID string) {
+	parts := webhookRegex.FindStringSubmatch(name)
+	if len(parts) != 3 {
+		return "", ""
+	}
+	return parts[1], parts[2]
+}
+
+// DeliveryN returns a resource name from webhook delivery ids.
+func DeliveryN(bookID, webhookID, deliveryID string) string {
+	return WebhookN(bookID, webhookID) + "/" + deliveryCollection + "/" + deliveryID
+}
+
+// DeliveryIDs returns ids from webhook delivery resource name.
+func DeliveryIDs(name string) (bookID, webhookID, deliveryID string) {
+	parts := deliveryRegex.FindStringSubmatch(name)
+	if len(parts) != 4 {
+		return "", "", ""
+	}
+	return parts[1], parts[2], parts[3]
+}
+
+// GrantN returns a resource name from grant ids.
+func GrantN(bookID, grantID string) string {
+	return bookCollection + "/" + bookID + "/" + grantCollection + "/" + grantID
+}
+
+// GrantIDs returns ids from grant resource name.
+func GrantIDs(name string) (bookID, grantID string) {
+	parts := grantRegex.FindStringSubmatch(name)
+	if len(parts) != 3 {
+		return "", ""
+	}
+	return parts[1], parts[2]
+}
+
+// BookSettingsN returns a resource name from book ids.
+func BookSettingsN(bookID string) string {
+	return BookN(bookID) + "/" + settingsSingleton
+}
+
+// BookSettingsIDs returns ids from book settings resource name.
+func BookSettingsIDs(name string) (bookID string) {
+	parts := settingsRegex.FindStringSubmatch(name)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// SeasonN returns a resource name from season ids.
+func SeasonN(bookID, seasonID string) string {
+	return bookCollection + "/" + bookID + "/" + seasonCollection + "/" + seasonID
+}
+
+// SeasonIDs returns ids from season resource name.
+func SeasonIDs(name string) (bookID, seasonID string) {
+	parts := seasonRegex.FindStringSubmatch(name)
+	if len(parts) != 3 {
+		return "", ""
+	}
+	return parts[1], parts[2]
+}
+
+// TransferN returns a resource name from transfer ids.
+func TransferN(bookID, transferID string) string {
+	return bookCollection + "/" + bookID + "/" + transferCollection + "/" + transferID
+}
+
+// TransferIDs returns ids from transfer resource name.
+func TransferIDs(name string) (bookID, transferID string) {
+	parts := transferRegex.FindStringSubmatch(name)
+	if len(parts) != 3 {
+		return "", ""
+	}
+	return parts[1], parts[2]
+}
+
+// AdjustmentN returns a resource name from adjustment ids.
+func AdjustmentN(bookID, adjustmentID string) string {
+	return bookCollection + "/" + bookID + "/" + adjustmentCollection + "/" + adjustmentID
+}
+
+// AdjustmentIDs returns ids from adjustment resource name.
+func AdjustmentIDs(name string) (bookID, adjustmentID string) {
+	parts := adjustmentRegex.FindStringSubmatch(name)
+	if len(parts) != 3 {
+		return "", ""
+	}
+	return parts[1], parts[2]
+}
@@ -0,0 +1,165 @@
+// Package outbox dispatches the domain events that repos persist atomically with writes to consumers. Each consumer
+// has a cursor in the repo of the last event it handled so that dispatch resumes where it left off.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/go-kit/log"
+)
+
+// Dispatcher defaults.
+const (
+	DefaultInterval = time.Second
+	eventsPerPoll   = 100
+)
+
+// Handler handles a domain event. Events are delivered at-least-once so handlers must be idempotent.
+type Handler func(ctx context.Context, event *api.DomainEvent) error
+
+// Dispatcher delivers domain events in sequence order to consumers. A consumer's cursor is advanced after each batch
+// of events it handles. If a handler fails, the consumer's cursor is advanced past the events it handled and the failed
+// event is retried on the next poll. Events that every consumer has handled are deleted.
+type Dispatcher struct {
+	Repo      repo.Repo
+	Logger    log.Logger
+	Interval  time.Duration
+	Consumers map[string]Handler
+	deleted   uint64 // sequence events have been deleted through
+}
+
+// New initializes a new Dispatcher.
+func New(args ...Arg) (*Dispatcher, error) {
+	dispatcherArgs := &dispatcherArgs{
+		logger:    log.NewNopLogger(),
+		consumers: map[string]Handler{},
+	}
+	for _, arg := range args {
+		arg(dispatcherArgs)
+	}
+	if dispatcherArgs.repo == nil || dispatcherArgs.logger == nil {
+		return nil, fmt.Errorf("missing required arguments")
+	}
+	return &Dispatcher{
+		Repo:      dispatcherArgs.repo,
+		Logger:    dispatcherArgs.logger,
+		Interval:  DefaultInterval,
+		Consumers: dispatcherArgs.consumers,
+	}, nil
+}
+
+// Run delivers pending events every Interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+	for {
+		if err := d.DeliverPending(ctx); err != nil && ctx.Err() == nil {
+			d.Logger.Log("msg", "failed to deliver domain events", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverPending delivers every event after each consumer's cursor and then deletes the events that every consumer
+// has handled. A failing consumer does not block the others. The first error is returned.
+func (d *Dispatcher) DeliverPending(ctx context.Context) error {
+	consumers := make([]string, 0, len(d.Consumers))
+	for consumer := range d.Consumers {
+		consumers = append(consumers, consumer)
+	}
+	sort.Strings(consumers)
+	var firstErr error
+	var minCursor uint64
+	for i, consumer := range consumers {
+		cursor, err := d.deliver(ctx, consumer, d.Consumers[consumer])
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("consumer %s: %w", consumer, err)
+		}
+		if i == 0 || cursor < minCursor {
+			minCursor = cursor
+		}
+	}
+	if minCursor > d.deleted {
+		if err := d.Repo.DeleteEvents(ctx, minCursor); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("delete events: %w", err)
+			}
+		} else {
+			d.deleted = minCursor
+		}
+	}
+	return firstErr
+}
+
+// deliver delivers events after a consumer's cursor and returns its cursor.
+func (d *Dispatcher) deliver(ctx context.Context, consumer string, handler Handler) (uint64, error) {
+	cursor, err := d.Repo.GetCursor(ctx, consumer)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		events, hasMore, err := d.Repo.ListEvents(ctx, &repo.ListEventsArgs{AfterSequence: cursor, Limit: eventsPerPoll})
+		if err != nil {
+			return cursor, err
+		}
+		handled := cursor
+		var handlerErr error
+		for _, event := range events {
+			if handlerErr = handler(ctx, event); handlerErr != nil {
+				break
+			}
+			handled = event.GetSequence()
+		}
+		if handled > cursor {
+			if err := d.Repo.PutCursor(ctx, consumer, handled); err != nil {
+				return cursor, err
+			}
+			cursor = handled
+		}
+		if handlerErr != nil {
+			return cursor, handlerErr
+		}
+		if !hasMore {
+			return cursor, nil
+		}
+	}
+}
+
+type dispatcherArgs struct {
+	repo      repo.Repo
+	logger    log.Logger
+	consumers map[string]Handler
+}
+
+// Arg is an argument for constructing a Dispatcher.
+type Arg func(o *dispatcherArgs)
+
+// WithRepo provides a repo to the Dispatcher.
+func WithRepo(repo repo.Repo) Arg {
+	return Arg(func(a *dispatcherArgs) {
+		a.repo = repo
+	})
+}
+
+// WithLogger provides a logger to the Dispatcher.
+func WithLogger(logger log.Logger) Arg {
+	return Arg(func(a *dispatcherArgs) {
+		a.logger = logger
+	})
+}
+
+// WithConsumer registers a named consumer. The name keys the consumer's cursor so it must be stable across restarts.
+func WithConsumer(name string, handler Handler) Arg {
+	return Arg(func(a *dispatcherArgs) {
+		a.consumers[name] = handler
+	})
+}
@@ -0,0 +1,143 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/outbox"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder is a consumer that records the ids of handled events and fails on the ids in failOn.
+type recorder struct {
+	handled []string
+	failOn  map[string]bool
+}
+
+func (rc *recorder) handle(_ context.Context, event *api.DomainEvent) error {
+	if rc.failOn[event.GetId()] {
+		return errors.New("boom")
+	}
+	rc.handled = append(rc.handled, event.GetId())
+	return nil
+}
+
+func writeEvents(t *testing.T, r *mem.Repo, ids ...string) {
+	for _, id := range ids {
+		user := &api.User{Name: entity.UserN("guild:1", id), Username: id}
+		require.Nil(t, r.CreateUser(context.Background(), user, &api.DomainEvent{
+			Id:      id,
+			Book:    entity.BookN("guild:1"),
+			Payload: &api.DomainEvent_UserCreated{UserCreated: &api.UserCreated{User: user}},
+		}))
+	}
+}
+
+func TestDeliverPending(t *testing.T) {
+	ctx := context.Background()
+	r := &mem.Repo{}
+	a, b := &recorder{}, &recorder{}
+	d, err := outbox.New(outbox.WithRepo(r), outbox.WithConsumer("a", a.handle), outbox.WithConsumer("b", b.handle))
+	require.Nil(t, err)
+
+	writeEvents(t, r, "1", "2")
+	require.Nil(t, d.DeliverPending(ctx))
+	writeEvents(t, r, "3")
+	require.Nil(t, d.DeliverPending(ctx))
+	require.Nil(t, d.DeliverPending(ctx))
+
+	assert.Equal(t, []string{"1", "2", "3"}, a.handled)
+	assert.Equal(t, []string{"1", "2", "3"}, b.handled)
+	cursor, err := r.GetCursor(ctx, "a")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), cursor)
+}
+
+func TestDeliverPendingRetriesFailedEvent(t *testing.T) {
+	ctx := context.Background()
+	r := &mem.Repo{}
+	failing, ok := &recorder{failOn: map[string]bool{"2": true}}, &recorder{}
+	d, err := outbox.New(outbox.WithRepo(r), outbox.WithConsumer("failing", failing.handle), outbox.WithConsumer("ok", ok.handle))
+	require.Nil(t, err)
+
+	writeEvents(t, r, "1", "2", "3")
+	require.NotNil(t, d.DeliverPending(ctx))
+	assert.Equal(t, []string{"1"}, failing.handled)
+	assert.Equal(t, []string{"1", "2", "3"}, ok.handled) // a failing consumer does not block others
+	cursor, err := r.GetCursor(ctx, "failing")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), cursor)
+
+	failing.failOn = nil
+	require.Nil(t, d.DeliverPending(ctx))
+	assert.Equal(t, []string{"1", "2", "3"}, failing.handled)
+}
+
+func TestDeliverPendingResumesFromCursor(t *testing.T) {
+	ctx := context.Background()
+	r := &mem.Repo{}
+	writeEvents(t, r, "1", "2", "3")
+	require.Nil(t, r.PutCursor(ctx, "a", 2))
+
+	a := &recorder{}
+	d, err := outbox.New(outbox.WithRepo(r), outbox.WithConsumer("a", a.handle))
+	require.Nil(t, err)
+	require.Nil(t, d.DeliverPending(ctx))
+	assert.Equal(t, []string{"3"}, a.handled)
+}
+
+// countingRepo counts cursor writes.
+type countingRepo struct {
+	*mem.Repo
+	cursorPuts int
+}
+
+func (r *countingRepo) PutCursor(ctx context.Context, consumer string, sequence uint64) error {
+	r.cursorPuts++
+	return r.Repo.PutCursor(ctx, consumer, sequence)
+}
+
+func TestDeliverPendingPutsCursorPerBatch(t *testing.T) {
+	ctx := context.Background()
+	r := &countingRepo{Repo: &mem.Repo{}}
+	a := &recorder{}
+	d, err := outbox.New(outbox.WithRepo(r), outbox.WithConsumer("a", a.handle))
+	require.Nil(t, err)
+
+	writeEvents(t, r.Repo, "1", "2", "3")
+	require.Nil(t, d.DeliverPending(ctx))
+	require.Nil(t, d.DeliverPending(ctx))
+	assert.Equal(t, []string{"1", "2", "3"}, a.handled)
+	assert.Equal(t, 1, r.cursorPuts)
+}
+
+func TestDeliverPendingDeletesHandledEvents(t *testing.T) {
+	ctx := context.Background()
+	r := &mem.Repo{}
+	failing, ok := &recorder{failOn: map[string]bool{"2": true}}, &recorder{}
+	d, err := outbox.New(outbox.WithRepo(r), outbox.WithConsumer("failing", failing.handle), outbox.WithConsumer("ok", ok.handle))
+	require.Nil(t, err)
+	listIDs := func() []string {
+		events, _, err := r.ListEvents(ctx, &repo.ListEventsArgs{Limit: 10})
+		require.Nil(t, err)
+		var ids []string
+		for _, e := range events {
+			ids = append(ids, e.GetId())
+		}
+		return ids
+	}
+
+	writeEvents(t, r, "1", "2", "3")
+	require.NotNil(t, d.DeliverPending(ctx))
+	assert.Equal(t, []string{"2", "3"}, listIDs()) // the failing consumer has not handled 2
+
+	failing.failOn = nil
+	require.Nil(t, d.DeliverPending(ctx))
+	assert.Equal(t, []string{"3"}, listIDs()) // the newest event is kept
+}
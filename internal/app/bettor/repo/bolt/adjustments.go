@@ -0,0 +1,68 @@
+package bolt
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateAdjustment creates a new adjustment.
+func (r *Repo) CreateAdjustment(_ context.Context, adjustment *api.Adjustment) error {
+	bookID, _ := entity.AdjustmentIDs(adjustment.GetName())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		return insertAdjustment(book, adjustment)
+	})
+}
+
+// insertAdjustment puts a new adjustment and indexes it by user.
+func insertAdjustment(book *bbolt.Bucket, adjustment *api.Adjustment) error {
+	adjustments := book.Bucket(adjustmentsBucket)
+	if adjustments.Get([]byte(adjustment.GetName())) != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("adjustment with id already exists"))
+	}
+	if err := put(adjustments, adjustment.GetName(), adjustment); err != nil {
+		return err
+	}
+	return book.Bucket(adjustmentsByUserBucket).Put(indexKey(adjustment.GetUser(), adjustment.GetName()), []byte{})
+}
+
+// ListAdjustments lists adjustments by filters.
+func (r *Repo) ListAdjustments(_ context.Context, args *repo.ListAdjustmentsArgs) (adjustments []*api.Adjustment, hasMore bool, err error) {
+	var out []*api.Adjustment
+	err = r.view(entity.BooksIDs(args.Book), func(book *bbolt.Bucket) error {
+		if book == nil || book.Bucket(adjustmentsBucket) == nil {
+			return nil
+		}
+		adjustmentsB := book.Bucket(adjustmentsBucket)
+		collect := func(v []byte) (bool, error) {
+			a := &api.Adjustment{}
+			if err := proto.Unmarshal(v, a); err != nil {
+				return false, err
+			}
+			out = append(out, a)
+			return len(out) < args.Limit+1, nil
+		}
+		if args.User == "" {
+			return scan(adjustmentsB, nil, args.GreaterThanName, func(_, v []byte) (bool, error) {
+				return collect(v)
+			})
+		}
+		prefix := indexPrefix(args.User)
+		return scan(book.Bucket(adjustmentsByUserBucket), prefix, args.GreaterThanName, func(k, _ []byte) (bool, error) {
+			return collect(adjustmentsB.Get(k[len(prefix):]))
+		})
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
@@ -0,0 +1,93 @@
+package bolt
+
+import (
+	"context"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// getMany calls fn with the value of each name that exists in its book's nested bucket. All names are read in one
+// transaction.
+func (r *Repo) getMany(names []string, bookID func(name string) string, bucket []byte, fn func(book *bbolt.Bucket, v []byte) error) error {
+	err := r.DB.View(func(tx *bbolt.Tx) error {
+		for _, name := range names {
+			book := tx.Bucket([]byte(bookID(name)))
+			if book == nil || book.Bucket(bucket) == nil {
+				continue
+			}
+			v := book.Bucket(bucket).Get([]byte(name))
+			if v == nil {
+				continue
+			}
+			if err := fn(book, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return toConnectErr(err)
+}
+
+// GetUsers gets the users with the given names that exist.
+func (r *Repo) GetUsers(_ context.Context, names []string) ([]*api.User, error) {
+	var out []*api.User
+	err := r.getMany(names, func(name string) string {
+		bookID, _ := entity.UserIDs(name)
+		return bookID
+	}, usersBucket, func(book *bbolt.Bucket, v []byte) error {
+		u := &api.User{}
+		if err := proto.Unmarshal(v, u); err != nil {
+			return err
+		}
+		hydrateUser(book, u)
+		out = append(out, u)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetMarkets gets the markets with the given names that exist.
+func (r *Repo) GetMarkets(_ context.Context, names []string) ([]*api.Market, error) {
+	var out []*api.Market
+	err := r.getMany(names, func(name string) string {
+		bookID, _ := entity.MarketIDs(name)
+		return bookID
+	}, marketsBucket, func(_ *bbolt.Bucket, v []byte) error {
+		m := &api.Market{}
+		if err := proto.Unmarshal(v, m); err != nil {
+			return err
+		}
+		out = append(out, m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBets gets the bets with the given names that exist.
+func (r *Repo) GetBets(_ context.Context, names []string) ([]*api.Bet, error) {
+	var out []*api.Bet
+	err := r.getMany(names, func(name string) string {
+		bookID, _ := entity.BetIDs(name)
+		return bookID
+	}, betsBucket, func(_ *bbolt.Bucket, v []byte) error {
+		b := &api.Bet{}
+		if err := proto.Unmarshal(v, b); err != nil {
+			return err
+		}
+		out = append(out, b)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
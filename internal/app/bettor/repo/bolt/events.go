@@ -0,0 +1,126 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// Events and cursors are not scoped to a book so they are kept in top-level buckets whose names can not be book IDs.
+var (
+	eventsBucket  = []byte("/events")
+	cursorsBucket = []byte("/cursors")
+)
+
+func sequenceKey(sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, sequence)
+}
+
+// appendEvents assigns events the next sequences and puts them in the transaction of the write they describe.
+func appendEvents(tx *bbolt.Tx, events []*api.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	b, err := tx.CreateBucketIfNotExists(eventsBucket)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		sequence, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		e.Sequence = sequence
+		data, err := proto.Marshal(e)
+		if err != nil {
+			return err
+		}
+		if err := b.Put(sequenceKey(sequence), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListEvents lists domain events in sequence order.
+func (r *Repo) ListEvents(_ context.Context, args *repo.ListEventsArgs) (events []*api.DomainEvent, hasMore bool, err error) {
+	var out []*api.DomainEvent
+	err = r.DB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(eventsBucket)
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		for k, v := c.Seek(sequenceKey(args.AfterSequence + 1)); k != nil && len(out) < args.Limit+1; k, v = c.Next() {
+			e := &api.DomainEvent{}
+			if err := proto.Unmarshal(v, e); err != nil {
+				return err
+			}
+			out = append(out, e)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, toConnectErr(err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// DeleteEvents deletes events through sequence besides the newest event.
+func (r *Repo) DeleteEvents(_ context.Context, sequence uint64) error {
+	err := r.DB.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(eventsBucket)
+		if b == nil {
+			return nil
+		}
+		newest, _ := b.Cursor().Last()
+		var keys [][]byte // collected first since deleting while iterating skips keys
+		c := b.Cursor()
+		for k, _ := c.First(); k != nil && binary.BigEndian.Uint64(k) <= sequence && !bytes.Equal(k, newest); k, _ = c.Next() {
+			keys = append(keys, k)
+		}
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return toConnectErr(err)
+}
+
+// GetCursor returns a consumer's cursor.
+func (r *Repo) GetCursor(_ context.Context, consumer string) (uint64, error) {
+	var sequence uint64
+	err := r.DB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(cursorsBucket)
+		if b == nil {
+			return nil
+		}
+		if v := b.Get([]byte(consumer)); v != nil {
+			sequence = binary.BigEndian.Uint64(v)
+		}
+		return nil
+	})
+	return sequence, toConnectErr(err)
+}
+
+// PutCursor sets a consumer's cursor.
+func (r *Repo) PutCursor(_ context.Context, consumer string, sequence uint64) error {
+	err := r.DB.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(cursorsBucket)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(consumer), sequenceKey(sequence))
+	})
+	return toConnectErr(err)
+}
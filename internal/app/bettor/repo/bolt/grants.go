@@ -0,0 +1,96 @@
+package bolt
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateGrant creates a new grant.
+func (r *Repo) CreateGrant(_ context.Context, grant *api.Grant) error {
+	bookID, _ := entity.GrantIDs(grant.GetName())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		return insertGrant(book, grant)
+	})
+}
+
+// insertGrant puts a new grant and indexes it by user.
+func insertGrant(book *bbolt.Bucket, grant *api.Grant) error {
+	grants := book.Bucket(grantsBucket)
+	if grants.Get([]byte(grant.GetName())) != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("grant with id already exists"))
+	}
+	if err := put(grants, grant.GetName(), grant); err != nil {
+		return err
+	}
+	return book.Bucket(grantsByUserBucket).Put(indexKey(grant.GetUser(), grant.GetName()), []byte{})
+}
+
+// ListGrants lists grants by filters.
+func (r *Repo) ListGrants(_ context.Context, args *repo.ListGrantsArgs) (grants []*api.Grant, hasMore bool, err error) {
+	var out []*api.Grant
+	err = r.view(entity.BooksIDs(args.Book), func(book *bbolt.Bucket) error {
+		if book == nil || book.Bucket(grantsBucket) == nil {
+			return nil
+		}
+		grantsB := book.Bucket(grantsBucket)
+		collect := func(v []byte) (bool, error) {
+			g := &api.Grant{}
+			if err := proto.Unmarshal(v, g); err != nil {
+				return false, err
+			}
+			out = append(out, g)
+			return len(out) < args.Limit+1, nil
+		}
+		if args.User == "" {
+			return scan(grantsB, nil, args.GreaterThanName, func(_, v []byte) (bool, error) {
+				return collect(v)
+			})
+		}
+		prefix := indexPrefix(args.User)
+		return scan(book.Bucket(grantsByUserBucket), prefix, args.GreaterThanName, func(k, _ []byte) (bool, error) {
+			return collect(grantsB.Get(k[len(prefix):]))
+		})
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// GetBookSettings gets a book's settings by name.
+func (r *Repo) GetBookSettings(_ context.Context, name string) (*api.BookSettings, error) {
+	settings := &api.BookSettings{}
+	var found bool
+	err := r.view(entity.BookSettingsIDs(name), func(book *bbolt.Bucket) error {
+		if book == nil {
+			return nil
+		}
+		var err error
+		found, err = get(book.Bucket(settingsBucket), name, settings)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("book settings not found"))
+	}
+	return settings, nil
+}
+
+// PutBookSettings creates or overwrites a book's settings.
+func (r *Repo) PutBookSettings(_ context.Context, settings *api.BookSettings) error {
+	return r.update(entity.BookSettingsIDs(settings.GetName()), func(book *bbolt.Bucket) error {
+		return put(book.Bucket(settingsBucket), settings.GetName(), settings)
+	})
+}
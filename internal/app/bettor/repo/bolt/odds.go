@@ -0,0 +1,79 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// oddsKey is an odds bucket key. Keys sort by market name then revision.
+func oddsKey(market string, revision uint64) []byte {
+	return binary.BigEndian.AppendUint64(indexPrefix(market), revision)
+}
+
+// PutOddsSnapshot creates or overwrites the snapshot of a market at its revision.
+func (r *Repo) PutOddsSnapshot(_ context.Context, snapshot *api.OddsSnapshot) error {
+	bookID, _ := entity.MarketIDs(snapshot.GetMarket())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		return putOddsSnapshot(book, snapshot)
+	})
+}
+
+// putOddsSnapshot upserts a snapshot and drops all but the most recent snapshots of its market.
+func putOddsSnapshot(book *bbolt.Bucket, snapshot *api.OddsSnapshot) error {
+	odds := book.Bucket(oddsBucket)
+	if err := put(odds, string(oddsKey(snapshot.GetMarket(), snapshot.GetRevision())), snapshot); err != nil {
+		return err
+	}
+	// drop all but the most recent snapshots
+	var keys [][]byte
+	if err := scan(odds, indexPrefix(snapshot.GetMarket()), "", func(k, _ []byte) (bool, error) {
+		keys = append(keys, k)
+		return true, nil
+	}); err != nil {
+		return err
+	}
+	for i := 0; i < len(keys)-repo.MaxOddsSnapshots; i++ {
+		if err := odds.Delete(keys[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListOddsSnapshots lists a market's odds snapshots in revision order.
+func (r *Repo) ListOddsSnapshots(_ context.Context, args *repo.ListOddsSnapshotsArgs) (snapshots []*api.OddsSnapshot, hasMore bool, err error) {
+	bookID, _ := entity.MarketIDs(args.Market)
+	var out []*api.OddsSnapshot
+	err = r.view(bookID, func(book *bbolt.Bucket) error {
+		if book == nil || book.Bucket(oddsBucket) == nil {
+			return nil
+		}
+		prefix := indexPrefix(args.Market)
+		c := book.Bucket(oddsBucket).Cursor()
+		for k, v := c.Seek(oddsKey(args.Market, args.AfterRevision+1)); k != nil && len(out) < args.Limit+1; k, v = c.Next() {
+			if !bytes.HasPrefix(k, prefix) {
+				break
+			}
+			s := &api.OddsSnapshot{}
+			if err := proto.Unmarshal(v, s); err != nil {
+				return err
+			}
+			out = append(out, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
@@ -0,0 +1,908 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/connect-go" // too lazy to isolate errors. repo pkgs will return connect errors
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ repo.Repo = (*Repo)(nil)
+
+// Every book has a top-level bucket keyed by book ID holding these nested buckets. Resources are keyed by resource
+// name so cursors iterate in name order. Index keys are "<indexed value>\x00<resource name>" with empty values.
+var (
+	usersBucket             = []byte("users")
+	usernamesBucket         = []byte("usernames")      // username -> user name
+	unsettledBucket         = []byte("unsettled")      // user name -> sum of unsettled bet centipoints
+	usersByTotalBucket      = []byte("users_by_total") // see totalKey
+	marketsBucket           = []byte("markets")
+	marketsByStatusBucket   = []byte("markets_by_status")
+	marketsByOrderBucket    = []byte("markets_by_order") // see orderKey
+	betsBucket              = []byte("bets")
+	betsByUserBucket        = []byte("bets_by_user")
+	betsByMarketBucket      = []byte("bets_by_market")
+	betsByOrderBucket       = []byte("bets_by_order") // see orderKey. grouped by book, user, and market
+	webhooksBucket          = []byte("webhooks")
+	deliveriesBucket        = []byte("deliveries")
+	deliveriesByDueBucket   = []byte("deliveries_by_due") // see dueKey
+	oddsBucket              = []byte("odds")              // see oddsKey
+	grantsBucket            = []byte("grants")
+	grantsByUserBucket      = []byte("grants_by_user")
+	settingsBucket          = []byte("settings")
+	seasonsBucket           = []byte("seasons") // see seasonKey
+	transfersBucket         = []byte("transfers")
+	transfersByUserBucket   = []byte("transfers_by_user") // indexed by both the sender and the recipient
+	adjustmentsBucket       = []byte("adjustments")
+	adjustmentsByUserBucket = []byte("adjustments_by_user")
+
+	bookBuckets = [][]byte{usersBucket, usernamesBucket, unsettledBucket, usersByTotalBucket, marketsBucket, marketsByStatusBucket, marketsByOrderBucket, betsBucket, betsByUserBucket, betsByMarketBucket, betsByOrderBucket, webhooksBucket, deliveriesBucket, deliveriesByDueBucket, oddsBucket, grantsBucket, grantsByUserBucket, settingsBucket, seasonsBucket, transfersBucket, transfersByUserBucket, adjustmentsBucket, adjustmentsByUserBucket}
+)
+
+// Repo is an embedded bbolt key-value persistence repository.
+type Repo struct {
+	DB *bbolt.DB
+}
+
+// New initializes a bbolt-backed repository.
+func New(fileName string) (*Repo, error) {
+	db, err := bbolt.Open(fileName, 0o600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("bolt db could not be opened: %w", err))
+	}
+	for _, index := range []func(tx *bbolt.Tx) error{indexUserTotals, indexOrders} {
+		if err := db.Update(index); err != nil {
+			_ = db.Close()
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("bolt db could not be indexed: %w", err))
+		}
+	}
+	return &Repo{DB: db}, nil
+}
+
+// indexUserTotals builds the users_by_total index for books written before it existed.
+func indexUserTotals(tx *bbolt.Tx) error {
+	return tx.ForEach(func(_ []byte, book *bbolt.Bucket) error {
+		users := book.Bucket(usersBucket)
+		if users == nil || book.Bucket(usersByTotalBucket) != nil {
+			return nil
+		}
+		byTotal, err := book.CreateBucket(usersByTotalBucket)
+		if err != nil {
+			return err
+		}
+		unsettled := book.Bucket(unsettledBucket)
+		return users.ForEach(func(k, v []byte) error {
+			u := &api.User{}
+			if err := proto.Unmarshal(v, u); err != nil {
+				return err
+			}
+			return byTotal.Put(totalKey(u.GetCentipoints()+getUint64(unsettled, string(k)), string(k)), []byte{})
+		})
+	})
+}
+
+// indexOrders builds the markets_by_order and bets_by_order indexes for books written before they existed.
+func indexOrders(tx *bbolt.Tx) error {
+	return tx.ForEach(func(_ []byte, book *bbolt.Bucket) error {
+		if markets := book.Bucket(marketsBucket); markets != nil && book.Bucket(marketsByOrderBucket) == nil {
+			byOrder, err := book.CreateBucket(marketsByOrderBucket)
+			if err != nil {
+				return err
+			}
+			err = markets.ForEach(func(_, v []byte) error {
+				m := &api.Market{}
+				if err := proto.Unmarshal(v, m); err != nil {
+					return err
+				}
+				return putOrders(byOrder, marketOrders(m))
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if bets := book.Bucket(betsBucket); bets != nil && book.Bucket(betsByOrderBucket) == nil {
+			byOrder, err := book.CreateBucket(betsByOrderBucket)
+			if err != nil {
+				return err
+			}
+			return bets.ForEach(func(_, v []byte) error {
+				b := &api.Bet{}
+				if err := proto.Unmarshal(v, b); err != nil {
+					return err
+				}
+				return putOrders(byOrder, betOrders(b))
+			})
+		}
+		return nil
+	})
+}
+
+// Close closes the underlying database.
+func (r *Repo) Close() error {
+	return r.DB.Close()
+}
+
+// view runs fn in a read-only transaction over the book's bucket. b is nil if the book does not exist yet.
+func (r *Repo) view(bookID string, fn func(b *bbolt.Bucket) error) error {
+	err := r.DB.View(func(tx *bbolt.Tx) error {
+		return fn(tx.Bucket([]byte(bookID)))
+	})
+	return toConnectErr(err)
+}
+
+// update runs fn in a read-write transaction over the book's bucket, creating it if needed. Events are appended in the
+// same transaction if fn succeeds.
+func (r *Repo) update(bookID string, fn func(b *bbolt.Bucket) error, events ...*api.DomainEvent) error {
+	if bookID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("book is required"))
+	}
+	err := r.DB.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bookID))
+		if err != nil {
+			return err
+		}
+		for _, name := range bookBuckets {
+			if _, err := b.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		if err := fn(b); err != nil {
+			return err
+		}
+		return appendEvents(tx, events)
+	})
+	return toConnectErr(err)
+}
+
+func toConnectErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func indexKey(value, name string) []byte {
+	return []byte(value + "\x00" + name)
+}
+
+func indexPrefix(value string) []byte {
+	return []byte(value + "\x00")
+}
+
+// totalKey is a users_by_total index key. Keys sort by total centipoints desc then user name asc.
+func totalKey(total uint64, name string) []byte {
+	k := make([]byte, 8, 8+len(name))
+	binary.BigEndian.PutUint64(k, math.MaxUint64-total)
+	return append(k, name...)
+}
+
+// keyedOrders are the orders indexed in markets_by_order and bets_by_order.
+var keyedOrders = []string{repo.OrderByCreateTime, repo.OrderByTotalCentipoints, repo.OrderBySettledTime}
+
+// orderPrefix is the prefix of order index keys for a keyed order and a group of resources.
+func orderPrefix(orderBy, group string) []byte {
+	return indexPrefix(orderBy + "\x00" + group)
+}
+
+// orderKey is a markets_by_order or bets_by_order index key. Keys with the same prefix sort by the resource's order key
+// desc then name asc.
+func orderKey(orderBy, group string, value uint64, name string) []byte {
+	return append(orderPrefix(orderBy, group), totalKey(value, name)...)
+}
+
+// marketOrders returns a market's keys in markets_by_order. Markets are grouped by book.
+func marketOrders(market *api.Market) [][]byte {
+	keys := make([][]byte, 0, len(keyedOrders))
+	for _, orderBy := range keyedOrders {
+		keys = append(keys, orderKey(orderBy, "", repo.MarketOrderKey(orderBy, market), market.GetName()))
+	}
+	return keys
+}
+
+// betOrders returns a bet's keys in bets_by_order. Bets are grouped by book (""), by user, and by market.
+func betOrders(bet *api.Bet) [][]byte {
+	keys := make([][]byte, 0, 3*len(keyedOrders))
+	for _, orderBy := range keyedOrders {
+		for _, group := range []string{"", bet.GetUser(), bet.GetMarket()} {
+			keys = append(keys, orderKey(orderBy, group, repo.BetOrderKey(orderBy, bet), bet.GetName()))
+		}
+	}
+	return keys
+}
+
+func putOrders(index *bbolt.Bucket, keys [][]byte) error {
+	for _, k := range keys {
+		if err := index.Put(k, []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteOrders(index *bbolt.Bucket, keys [][]byte) error {
+	for _, k := range keys {
+		if err := index.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveTotal moves a user in the users_by_total index.
+func moveTotal(book *bbolt.Bucket, name string, from, to uint64) error {
+	if from == to {
+		return nil
+	}
+	byTotal := book.Bucket(usersByTotalBucket)
+	if err := byTotal.Delete(totalKey(from, name)); err != nil {
+		return err
+	}
+	return byTotal.Put(totalKey(to, name), []byte{})
+}
+
+// addUnsettled adds delta to a user's unsettled centipoints and keeps the users_by_total index in sync.
+func addUnsettled(book *bbolt.Bucket, name string, delta int64) error {
+	if name == "" || delta == 0 {
+		return nil
+	}
+	unsettled := book.Bucket(unsettledBucket)
+	prev := getUint64(unsettled, name)
+	next := uint64(int64(prev) + delta)
+	if err := putUint64(unsettled, name, next); err != nil {
+		return err
+	}
+	user := &api.User{}
+	found, err := get(book.Bucket(usersBucket), name, user)
+	if err != nil || !found {
+		return err
+	}
+	return moveTotal(book, name, user.GetCentipoints()+prev, user.GetCentipoints()+next)
+}
+
+// scan iterates over keys with prefix that sort after prefix + greaterThan, calling fn until it returns false.
+func scan(b *bbolt.Bucket, prefix []byte, greaterThan string, fn func(k, v []byte) (bool, error)) error {
+	start := make([]byte, 0, len(prefix)+len(greaterThan))
+	start = append(start, prefix...)
+	start = append(start, greaterThan...)
+	c := b.Cursor()
+	k, v := c.Seek(start)
+	if k != nil && bytes.Equal(k, start) {
+		k, v = c.Next()
+	}
+	for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		more, err := fn(k, v)
+		if err != nil {
+			return err
+		}
+		if !more {
+			return nil
+		}
+	}
+	return nil
+}
+
+// scanOrder iterates over the resources of a group in a markets_by_order or bets_by_order index that come after cursor,
+// calling fn with their values from resources until it returns false.
+func scanOrder(index, resources *bbolt.Bucket, orderBy, group string, cursor *repo.OrderCursor, fn func(v []byte) (bool, error)) error {
+	prefix := orderPrefix(orderBy, group)
+	var start string
+	if cursor != nil {
+		start = string(totalKey(cursor.Value, cursor.Name))
+	}
+	return scan(index, prefix, start, func(k, _ []byte) (bool, error) {
+		name := k[len(prefix)+8:]
+		v := resources.Get(name)
+		if v == nil {
+			return false, fmt.Errorf("order index references missing resource %q", name)
+		}
+		return fn(v)
+	})
+}
+
+func getUint64(b *bbolt.Bucket, key string) uint64 {
+	v := b.Get([]byte(key))
+	if len(v) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(v)
+}
+
+func putUint64(b *bbolt.Bucket, key string, n uint64) error {
+	if key == "" {
+		return nil
+	}
+	if n == 0 {
+		return b.Delete([]byte(key))
+	}
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, n)
+	return b.Put([]byte(key), v)
+}
+
+func put(b *bbolt.Bucket, key string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(key), data)
+}
+
+// get unmarshals the value at key into msg, returning false if there is no value.
+func get(b *bbolt.Bucket, key string, msg proto.Message) (bool, error) {
+	if b == nil {
+		return false, nil
+	}
+	data := b.Get([]byte(key))
+	if data == nil {
+		return false, nil
+	}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// hydrate virtual fields like unsettled_centipoints.
+func hydrateUser(book *bbolt.Bucket, user *api.User) {
+	user.UnsettledCentipoints = getUint64(book.Bucket(unsettledBucket), user.GetName())
+}
+
+// CreateUser creates a new user.
+func (r *Repo) CreateUser(_ context.Context, user *api.User, events ...*api.DomainEvent) error {
+	user.UnsettledCentipoints = 0 // defensive
+	user.Etag = repo.NextEtag("")
+
+	bookID, _ := entity.UserIDs(user.GetName())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		users, usernames := book.Bucket(usersBucket), book.Bucket(usernamesBucket)
+		if users.Get([]byte(user.GetName())) != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("user with id already exists"))
+		}
+		if usernames.Get([]byte(user.GetUsername())) != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("user with username already exists in book"))
+		}
+		if err := put(users, user.GetName(), user); err != nil {
+			return err
+		}
+		total := user.GetCentipoints() + getUint64(book.Bucket(unsettledBucket), user.GetName())
+		if err := book.Bucket(usersByTotalBucket).Put(totalKey(total, user.GetName()), []byte{}); err != nil {
+			return err
+		}
+		return usernames.Put([]byte(user.GetUsername()), []byte(user.GetName()))
+	}, events...)
+}
+
+// UpdateUser updates a user.
+func (r *Repo) UpdateUser(_ context.Context, user *api.User, events ...*api.DomainEvent) error {
+	userCopy := proto.Clone(user).(*api.User)
+	userCopy.UnsettledCentipoints = 0 // virtual field is not persisted
+	userCopy.Etag = repo.NextEtag(user.GetEtag())
+
+	bookID, _ := entity.UserIDs(user.GetName())
+	err := r.update(bookID, func(book *bbolt.Bucket) error {
+		return updateUser(book, user, userCopy)
+	}, events...)
+	if err != nil {
+		return err
+	}
+	user.Etag = userCopy.GetEtag()
+	return nil
+}
+
+// updateUser puts userCopy in place of user if user's etag matches and maintains the indexes.
+func updateUser(book *bbolt.Bucket, user, userCopy *api.User) error {
+	users, usernames := book.Bucket(usersBucket), book.Bucket(usernamesBucket)
+	existing := &api.User{}
+	found, err := get(users, user.GetName(), existing)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	if existing.GetEtag() != user.GetEtag() {
+		return connect.NewError(connect.CodeAborted, errors.New("user has been modified"))
+	}
+	if existing.GetUsername() != user.GetUsername() {
+		if usernames.Get([]byte(user.GetUsername())) != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("user with username already exists in book"))
+		}
+		if err := usernames.Delete([]byte(existing.GetUsername())); err != nil {
+			return err
+		}
+		if err := usernames.Put([]byte(user.GetUsername()), []byte(user.GetName())); err != nil {
+			return err
+		}
+	}
+	unsettled := getUint64(book.Bucket(unsettledBucket), user.GetName())
+	if err := moveTotal(book, user.GetName(), existing.GetCentipoints()+unsettled, user.GetCentipoints()+unsettled); err != nil {
+		return err
+	}
+	return put(users, user.GetName(), userCopy)
+}
+
+// GetUser gets a user by ID.
+func (r *Repo) GetUser(_ context.Context, name string) (*api.User, error) {
+	bookID, _ := entity.UserIDs(name)
+	user := &api.User{}
+	var found bool
+	err := r.view(bookID, func(book *bbolt.Bucket) error {
+		if book == nil {
+			return nil
+		}
+		var err error
+		if found, err = get(book.Bucket(usersBucket), name, user); err != nil || !found {
+			return err
+		}
+		hydrateUser(book, user)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	return user, nil
+}
+
+// GetUserByUsername gets a user by username.
+func (r *Repo) GetUserByUsername(_ context.Context, book, username string) (*api.User, error) {
+	user := &api.User{}
+	var found bool
+	err := r.view(entity.BooksIDs(book), func(book *bbolt.Bucket) error {
+		if book == nil {
+			return nil
+		}
+		name := book.Bucket(usernamesBucket).Get([]byte(username))
+		if name == nil {
+			return nil
+		}
+		var err error
+		if found, err = get(book.Bucket(usersBucket), string(name), user); err != nil || !found {
+			return err
+		}
+		hydrateUser(book, user)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	return user, nil
+}
+
+// ListUsers lists users by filters.
+func (r *Repo) ListUsers(_ context.Context, args *repo.ListUsersArgs) (users []*api.User, hasMore bool, err error) {
+	switch args.OrderBy {
+	case "", "name":
+	case "total_centipoints":
+		if args.GreaterThanName != "" {
+			return nil, false, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot use GreaterThanName with total_centipoints order"))
+		}
+	default:
+		return nil, false, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid order by"))
+	}
+
+	var out []*api.User
+	err = r.view(entity.BooksIDs(args.Book), func(book *bbolt.Bucket) error {
+		if book == nil {
+			return nil
+		}
+		// filtered users are sorted by total_centipoints after they are all read
+		limit := args.Limit + 1
+		if args.OrderBy == "total_centipoints" && len(args.Users) > 0 {
+			limit = -1
+		}
+		collect := func(v []byte) (bool, error) {
+			u := &api.User{}
+			if err := proto.Unmarshal(v, u); err != nil {
+				return false, err
+			}
+			hydrateUser(book, u)
+			out = append(out, u)
+			return limit < 0 || len(out) < limit, nil
+		}
+
+		usersB := book.Bucket(usersBucket)
+		if args.OrderBy == "total_centipoints" && len(args.Users) == 0 {
+			var start string
+			if args.After != nil {
+				start = string(totalKey(args.After.Value, args.After.Name))
+			}
+			return scan(book.Bucket(usersByTotalBucket), nil, start, func(k, _ []byte) (bool, error) {
+				v := usersB.Get(k[8:])
+				if v == nil {
+					return false, fmt.Errorf("users_by_total index references missing user %q", k[8:])
+				}
+				return collect(v)
+			})
+		}
+		if len(args.Users) > 0 {
+			names := append([]string(nil), args.Users...)
+			sort.Strings(names)
+			for i, name := range names {
+				if name <= args.GreaterThanName || (i > 0 && name == names[i-1]) {
+					continue
+				}
+				v := usersB.Get([]byte(name))
+				if v == nil {
+					continue
+				}
+				if more, err := collect(v); err != nil || !more {
+					return err
+				}
+			}
+			return nil
+		}
+		return scan(usersB, nil, args.GreaterThanName, func(_, v []byte) (bool, error) {
+			return collect(v)
+		})
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if args.OrderBy == "total_centipoints" && len(args.Users) > 0 {
+		total := func(u *api.User) uint64 { return u.GetCentipoints() + u.GetUnsettledCentipoints() }
+		sort.Slice(out, func(i, j int) bool {
+			return total(out[i]) > total(out[j]) || (total(out[i]) == total(out[j]) && out[i].GetName() < out[j].GetName())
+		})
+		page := out[:0]
+		for _, u := range out {
+			if args.After.After(total(u), u.GetName()) {
+				page = append(page, u)
+			}
+		}
+		out = page
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+func statusKey(status api.Market_Status) string {
+	return strconv.Itoa(int(status))
+}
+
+// CreateMarket creates a new market.
+func (r *Repo) CreateMarket(_ context.Context, market *api.Market, events ...*api.DomainEvent) error {
+	market.Etag = repo.NextEtag("")
+	bookID, _ := entity.MarketIDs(market.GetName())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		markets := book.Bucket(marketsBucket)
+		if markets.Get([]byte(market.GetName())) != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("market with id already exists"))
+		}
+		if err := put(markets, market.GetName(), market); err != nil {
+			return err
+		}
+		if err := putOrders(book.Bucket(marketsByOrderBucket), marketOrders(market)); err != nil {
+			return err
+		}
+		return book.Bucket(marketsByStatusBucket).Put(indexKey(statusKey(market.GetStatus()), market.GetName()), []byte{})
+	}, events...)
+}
+
+// UpdateMarket updates a market.
+func (r *Repo) UpdateMarket(_ context.Context, market *api.Market, events ...*api.DomainEvent) error {
+	marketCopy := proto.Clone(market).(*api.Market)
+	marketCopy.Etag = repo.NextEtag(market.GetEtag())
+
+	bookID, _ := entity.MarketIDs(market.GetName())
+	err := r.update(bookID, func(book *bbolt.Bucket) error {
+		return updateMarket(book, market, marketCopy)
+	}, events...)
+	if err != nil {
+		return err
+	}
+	market.Etag = marketCopy.GetEtag()
+	return nil
+}
+
+// updateMarket puts marketCopy in place of market if market's etag matches and maintains the indexes.
+func updateMarket(book *bbolt.Bucket, market, marketCopy *api.Market) error {
+	markets, byStatus := book.Bucket(marketsBucket), book.Bucket(marketsByStatusBucket)
+	existing := &api.Market{}
+	found, err := get(markets, market.GetName(), existing)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return connect.NewError(connect.CodeNotFound, errors.New("market not found"))
+	}
+	if existing.GetEtag() != market.GetEtag() {
+		return connect.NewError(connect.CodeAborted, errors.New("market has been modified"))
+	}
+	if existing.GetStatus() != market.GetStatus() {
+		if err := byStatus.Delete(indexKey(statusKey(existing.GetStatus()), market.GetName())); err != nil {
+			return err
+		}
+		if err := byStatus.Put(indexKey(statusKey(market.GetStatus()), market.GetName()), []byte{}); err != nil {
+			return err
+		}
+	}
+	byOrder := book.Bucket(marketsByOrderBucket)
+	if err := deleteOrders(byOrder, marketOrders(existing)); err != nil {
+		return err
+	}
+	if err := putOrders(byOrder, marketOrders(market)); err != nil {
+		return err
+	}
+	return put(markets, market.GetName(), marketCopy)
+}
+
+// GetMarket gets a market by ID.
+func (r *Repo) GetMarket(_ context.Context, name string) (*api.Market, error) {
+	bookID, _ := entity.MarketIDs(name)
+	market := &api.Market{}
+	var found bool
+	err := r.view(bookID, func(book *bbolt.Bucket) error {
+		if book == nil {
+			return nil
+		}
+		var err error
+		found, err = get(book.Bucket(marketsBucket), name, market)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("market not found"))
+	}
+	return market, nil
+}
+
+// ListMarkets lists markets by filters.
+func (r *Repo) ListMarkets(_ context.Context, args *repo.ListMarketsArgs) (markets []*api.Market, hasMore bool, err error) {
+	if err := repo.CheckOrder(args.OrderBy, args.GreaterThanName); err != nil {
+		return nil, false, err
+	}
+	var out []*api.Market
+	err = r.view(entity.BooksIDs(args.Book), func(book *bbolt.Bucket) error {
+		if book == nil {
+			return nil
+		}
+		marketsB := book.Bucket(marketsBucket)
+		collect := func(v []byte) (bool, error) {
+			m := &api.Market{}
+			if err := proto.Unmarshal(v, m); err != nil {
+				return false, err
+			}
+			if args.Status != api.Market_STATUS_UNSPECIFIED && m.GetStatus() != args.Status {
+				return true, nil
+			}
+			if !args.Filter.Match(m) {
+				return true, nil
+			}
+			out = append(out, m)
+			return len(out) < args.Limit+1, nil
+		}
+		if repo.IsKeyedOrder(args.OrderBy) {
+			return scanOrder(book.Bucket(marketsByOrderBucket), marketsB, args.OrderBy, "", args.After, collect)
+		}
+		if args.Status != api.Market_STATUS_UNSPECIFIED {
+			prefix := indexPrefix(statusKey(args.Status))
+			return scan(book.Bucket(marketsByStatusBucket), prefix, args.GreaterThanName, func(k, _ []byte) (bool, error) {
+				return collect(marketsB.Get(k[len(prefix):]))
+			})
+		}
+		return scan(marketsB, nil, args.GreaterThanName, func(_, v []byte) (bool, error) {
+			return collect(v)
+		})
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+func unsettledCentipoints(bet *api.Bet) uint64 {
+	if bet.GetSettledAt() != nil {
+		return 0
+	}
+	return bet.GetCentipoints()
+}
+
+// CreateBet creates a new bet.
+func (r *Repo) CreateBet(_ context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
+	bet.Etag = repo.NextEtag("")
+	bookID, _ := entity.BetIDs(bet.GetName())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		return insertBet(book, bet)
+	}, events...)
+}
+
+// insertBet puts a new bet as is and maintains the indexes.
+func insertBet(book *bbolt.Bucket, bet *api.Bet) error {
+	bets := book.Bucket(betsBucket)
+	if bets.Get([]byte(bet.GetName())) != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("bet with id already exists"))
+	}
+	if err := put(bets, bet.GetName(), bet); err != nil {
+		return err
+	}
+	if err := book.Bucket(betsByUserBucket).Put(indexKey(bet.GetUser(), bet.GetName()), []byte{}); err != nil {
+		return err
+	}
+	if err := book.Bucket(betsByMarketBucket).Put(indexKey(bet.GetMarket(), bet.GetName()), []byte{}); err != nil {
+		return err
+	}
+	if err := putOrders(book.Bucket(betsByOrderBucket), betOrders(bet)); err != nil {
+		return err
+	}
+	return addUnsettled(book, bet.GetUser(), int64(unsettledCentipoints(bet)))
+}
+
+// UpdateBet updates a bet.
+func (r *Repo) UpdateBet(_ context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
+	betCopy := proto.Clone(bet).(*api.Bet)
+	betCopy.Etag = repo.NextEtag(bet.GetEtag())
+
+	bookID, _ := entity.BetIDs(bet.GetName())
+	err := r.update(bookID, func(book *bbolt.Bucket) error {
+		return updateBet(book, bet, betCopy)
+	}, events...)
+	if err != nil {
+		return err
+	}
+	bet.Etag = betCopy.GetEtag()
+	return nil
+}
+
+// updateBet puts betCopy in place of bet if bet's etag matches and maintains the indexes.
+func updateBet(book *bbolt.Bucket, bet, betCopy *api.Bet) error {
+	bets := book.Bucket(betsBucket)
+	existing := &api.Bet{}
+	found, err := get(bets, bet.GetName(), existing)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return connect.NewError(connect.CodeNotFound, errors.New("bet not found"))
+	}
+	if existing.GetEtag() != bet.GetEtag() {
+		return connect.NewError(connect.CodeAborted, errors.New("bet has been modified"))
+	}
+	if existing.GetUser() != bet.GetUser() {
+		byUser := book.Bucket(betsByUserBucket)
+		if err := byUser.Delete(indexKey(existing.GetUser(), bet.GetName())); err != nil {
+			return err
+		}
+		if err := byUser.Put(indexKey(bet.GetUser(), bet.GetName()), []byte{}); err != nil {
+			return err
+		}
+	}
+	if existing.GetMarket() != bet.GetMarket() {
+		byMarket := book.Bucket(betsByMarketBucket)
+		if err := byMarket.Delete(indexKey(existing.GetMarket(), bet.GetName())); err != nil {
+			return err
+		}
+		if err := byMarket.Put(indexKey(bet.GetMarket(), bet.GetName()), []byte{}); err != nil {
+			return err
+		}
+	}
+	byOrder := book.Bucket(betsByOrderBucket)
+	if err := deleteOrders(byOrder, betOrders(existing)); err != nil {
+		return err
+	}
+	if err := putOrders(byOrder, betOrders(bet)); err != nil {
+		return err
+	}
+	if err := addUnsettled(book, existing.GetUser(), -int64(unsettledCentipoints(existing))); err != nil {
+		return err
+	}
+	if err := addUnsettled(book, bet.GetUser(), int64(unsettledCentipoints(bet))); err != nil {
+		return err
+	}
+	return put(bets, bet.GetName(), betCopy)
+}
+
+// GetBet gets a bet by ID.
+func (r *Repo) GetBet(_ context.Context, name string) (*api.Bet, error) {
+	bookID, _ := entity.BetIDs(name)
+	bet := &api.Bet{}
+	var found bool
+	err := r.view(bookID, func(book *bbolt.Bucket) error {
+		if book == nil {
+			return nil
+		}
+		var err error
+		found, err = get(book.Bucket(betsBucket), name, bet)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("bet not found"))
+	}
+	return bet, nil
+}
+
+// ListBets lists bets by filters. Filtering by market or user uses an index so pages are read without scanning the
+// rest of the book.
+func (r *Repo) ListBets(_ context.Context, args *repo.ListBetsArgs) (bets []*api.Bet, hasMore bool, err error) {
+	if err := repo.CheckOrder(args.OrderBy, args.GreaterThanName); err != nil {
+		return nil, false, err
+	}
+	var out []*api.Bet
+	err = r.view(entity.BooksIDs(args.Book), func(book *bbolt.Bucket) error {
+		if book == nil {
+			return nil
+		}
+		betsB := book.Bucket(betsBucket)
+		collect := func(v []byte) (bool, error) {
+			b := &api.Bet{}
+			if err := proto.Unmarshal(v, b); err != nil {
+				return false, err
+			}
+			if args.User != "" && b.GetUser() != args.User {
+				return true, nil
+			}
+			if args.Market != "" && b.GetMarket() != args.Market {
+				return true, nil
+			}
+			if args.ExcludeSettled && b.GetSettledAt() != nil {
+				return true, nil
+			}
+			if !args.Filter.Match(b) {
+				return true, nil
+			}
+			out = append(out, b)
+			return len(out) < args.Limit+1, nil
+		}
+		if repo.IsKeyedOrder(args.OrderBy) {
+			group := args.Market
+			if group == "" {
+				group = args.User
+			}
+			return scanOrder(book.Bucket(betsByOrderBucket), betsB, args.OrderBy, group, args.After, collect)
+		}
+		var index *bbolt.Bucket
+		var prefix []byte
+		switch {
+		case args.Market != "":
+			index, prefix = book.Bucket(betsByMarketBucket), indexPrefix(args.Market)
+		case args.User != "":
+			index, prefix = book.Bucket(betsByUserBucket), indexPrefix(args.User)
+		default:
+			return scan(betsB, nil, args.GreaterThanName, func(_, v []byte) (bool, error) {
+				return collect(v)
+			})
+		}
+		return scan(index, prefix, args.GreaterThanName, func(k, _ []byte) (bool, error) {
+			return collect(betsB.Get(k[len(prefix):]))
+		})
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
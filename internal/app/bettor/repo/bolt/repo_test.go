@@ -0,0 +1,121 @@
+package bolt_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/bolt"
+	"github.com/elh/bettor/internal/app/bettor/repo/repotest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRepo(t *testing.T) {
+	repotest.Run(t, func() repo.Repo {
+		r, err := bolt.New(filepath.Join(t.TempDir(), "bettor.bolt"))
+		require.Nil(t, err)
+		t.Cleanup(func() { r.Close() })
+		return r
+	})
+}
+
+func TestReopen(t *testing.T) {
+	ctx := context.Background()
+	fileName := filepath.Join(t.TempDir(), "bettor.bolt")
+	r, err := bolt.New(fileName)
+	require.Nil(t, err)
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	bet := &api.Bet{Name: entity.BetN("guild:1", "a"), User: user.GetName(), Market: entity.MarketN("guild:1", "a"), Centipoints: 10}
+	require.Nil(t, r.CreateUser(ctx, proto.Clone(user).(*api.User)))
+	require.Nil(t, r.CreateBet(ctx, proto.Clone(bet).(*api.Bet)))
+	snapshot := &api.OddsSnapshot{Market: bet.GetMarket(), Revision: 2, TotalCentipoints: 10}
+	require.Nil(t, r.PutOddsSnapshot(ctx, snapshot))
+	require.Nil(t, r.Close())
+
+	r, err = bolt.New(fileName)
+	require.Nil(t, err)
+	defer r.Close()
+	got, err := r.GetUserByUsername(ctx, entity.BookN("guild:1"), user.GetUsername())
+	require.Nil(t, err)
+	assert.Equal(t, uint64(10), got.GetUnsettledCentipoints())
+	snapshots, _, err := r.ListOddsSnapshots(ctx, &repo.ListOddsSnapshotsArgs{Market: bet.GetMarket(), Limit: 10})
+	require.Nil(t, err)
+	require.Len(t, snapshots, 1)
+	assert.True(t, proto.Equal(snapshot, snapshots[0]))
+}
+
+func TestReopenIndexesUserTotals(t *testing.T) {
+	ctx := context.Background()
+	fileName := filepath.Join(t.TempDir(), "bettor.bolt")
+	r, err := bolt.New(fileName)
+	require.Nil(t, err)
+	user1 := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	user2 := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 50}
+	bet := &api.Bet{Name: entity.BetN("guild:1", "a"), User: user2.GetName(), Market: entity.MarketN("guild:1", "a"), Centipoints: 100}
+	require.Nil(t, r.CreateUser(ctx, user1))
+	require.Nil(t, r.CreateUser(ctx, user2))
+	require.Nil(t, r.CreateBet(ctx, bet))
+	// simulate a book written before the index existed
+	require.Nil(t, r.DB.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte("guild:1")).DeleteBucket([]byte("users_by_total"))
+	}))
+	require.Nil(t, r.Close())
+
+	r, err = bolt.New(fileName)
+	require.Nil(t, err)
+	defer r.Close()
+	users, hasMore, err := r.ListUsers(ctx, &repo.ListUsersArgs{Book: entity.BookN("guild:1"), OrderBy: "total_centipoints", Limit: 10})
+	require.Nil(t, err)
+	assert.False(t, hasMore)
+	require.Len(t, users, 2)
+	assert.Equal(t, user2.GetName(), users[0].GetName())
+	assert.Equal(t, user1.GetName(), users[1].GetName())
+}
+
+func TestReopenIndexesOrders(t *testing.T) {
+	ctx := context.Background()
+	fileName := filepath.Join(t.TempDir(), "bettor.bolt")
+	r, err := bolt.New(fileName)
+	require.Nil(t, err)
+	market1 := &api.Market{Name: entity.MarketN("guild:1", "a"), CreatedAt: timestamppb.New(time.Unix(1, 0))}
+	market2 := &api.Market{Name: entity.MarketN("guild:1", "b"), CreatedAt: timestamppb.New(time.Unix(2, 0))}
+	bet1 := &api.Bet{Name: entity.BetN("guild:1", "a"), User: entity.UserN("guild:1", "a"), Market: market1.GetName(), Centipoints: 100}
+	bet2 := &api.Bet{Name: entity.BetN("guild:1", "b"), User: entity.UserN("guild:1", "a"), Market: market2.GetName(), Centipoints: 200}
+	require.Nil(t, r.CreateMarket(ctx, market1))
+	require.Nil(t, r.CreateMarket(ctx, market2))
+	require.Nil(t, r.CreateBet(ctx, bet1))
+	require.Nil(t, r.CreateBet(ctx, bet2))
+	// simulate a book written before the indexes existed
+	require.Nil(t, r.DB.Update(func(tx *bbolt.Tx) error {
+		book := tx.Bucket([]byte("guild:1"))
+		if err := book.DeleteBucket([]byte("markets_by_order")); err != nil {
+			return err
+		}
+		return book.DeleteBucket([]byte("bets_by_order"))
+	}))
+	require.Nil(t, r.Close())
+
+	r, err = bolt.New(fileName)
+	require.Nil(t, err)
+	defer r.Close()
+	markets, hasMore, err := r.ListMarkets(ctx, &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderByCreateTime, Limit: 10})
+	require.Nil(t, err)
+	assert.False(t, hasMore)
+	require.Len(t, markets, 2)
+	assert.Equal(t, market2.GetName(), markets[0].GetName())
+	assert.Equal(t, market1.GetName(), markets[1].GetName())
+	bets, hasMore, err := r.ListBets(ctx, &repo.ListBetsArgs{Book: entity.BookN("guild:1"), User: bet1.GetUser(), OrderBy: repo.OrderByTotalCentipoints, Limit: 10})
+	require.Nil(t, err)
+	assert.False(t, hasMore)
+	require.Len(t, bets, 2)
+	assert.Equal(t, bet2.GetName(), bets[0].GetName())
+	assert.Equal(t, bet1.GetName(), bets[1].GetName())
+}
@@ -0,0 +1,83 @@
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"strconv"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// seasonKey is a seasons bucket key. Keys sort by season number.
+func seasonKey(number uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, number)
+}
+
+// PutSeason creates or overwrites a season.
+func (r *Repo) PutSeason(_ context.Context, season *api.Season) error {
+	bookID, _ := entity.SeasonIDs(season.GetName())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		return putSeason(book, season)
+	})
+}
+
+// putSeason upserts a season keyed by its number.
+func putSeason(book *bbolt.Bucket, season *api.Season) error {
+	return put(book.Bucket(seasonsBucket), string(seasonKey(season.GetNumber())), season)
+}
+
+// GetSeason gets a season by name.
+func (r *Repo) GetSeason(_ context.Context, name string) (*api.Season, error) {
+	bookID, seasonID := entity.SeasonIDs(name)
+	season := &api.Season{}
+	var found bool
+	if number, err := strconv.ParseUint(seasonID, 10, 32); err == nil {
+		err := r.view(bookID, func(book *bbolt.Bucket) error {
+			if book == nil {
+				return nil
+			}
+			var err error
+			found, err = get(book.Bucket(seasonsBucket), string(seasonKey(uint32(number))), season)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !found || season.GetName() != name {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("season not found"))
+	}
+	return season, nil
+}
+
+// ListSeasons lists a book's seasons in number order.
+func (r *Repo) ListSeasons(_ context.Context, args *repo.ListSeasonsArgs) (seasons []*api.Season, hasMore bool, err error) {
+	var out []*api.Season
+	err = r.view(entity.BooksIDs(args.Book), func(book *bbolt.Bucket) error {
+		if book == nil || book.Bucket(seasonsBucket) == nil {
+			return nil
+		}
+		c := book.Bucket(seasonsBucket).Cursor()
+		for k, v := c.Seek(seasonKey(args.AfterNumber + 1)); k != nil && len(out) < args.Limit+1; k, v = c.Next() {
+			s := &api.Season{}
+			if err := proto.Unmarshal(v, s); err != nil {
+				return err
+			}
+			out = append(out, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
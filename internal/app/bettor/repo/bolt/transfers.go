@@ -0,0 +1,72 @@
+package bolt
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateTransfer creates a new transfer.
+func (r *Repo) CreateTransfer(_ context.Context, transfer *api.Transfer) error {
+	bookID, _ := entity.TransferIDs(transfer.GetName())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		return insertTransfer(book, transfer)
+	})
+}
+
+// insertTransfer puts a new transfer and indexes it by both users.
+func insertTransfer(book *bbolt.Bucket, transfer *api.Transfer) error {
+	transfers := book.Bucket(transfersBucket)
+	if transfers.Get([]byte(transfer.GetName())) != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("transfer with id already exists"))
+	}
+	if err := put(transfers, transfer.GetName(), transfer); err != nil {
+		return err
+	}
+	byUser := book.Bucket(transfersByUserBucket)
+	if err := byUser.Put(indexKey(transfer.GetFromUser(), transfer.GetName()), []byte{}); err != nil {
+		return err
+	}
+	return byUser.Put(indexKey(transfer.GetToUser(), transfer.GetName()), []byte{})
+}
+
+// ListTransfers lists transfers by filters.
+func (r *Repo) ListTransfers(_ context.Context, args *repo.ListTransfersArgs) (transfers []*api.Transfer, hasMore bool, err error) {
+	var out []*api.Transfer
+	err = r.view(entity.BooksIDs(args.Book), func(book *bbolt.Bucket) error {
+		if book == nil || book.Bucket(transfersBucket) == nil {
+			return nil
+		}
+		transfersB := book.Bucket(transfersBucket)
+		collect := func(v []byte) (bool, error) {
+			t := &api.Transfer{}
+			if err := proto.Unmarshal(v, t); err != nil {
+				return false, err
+			}
+			out = append(out, t)
+			return len(out) < args.Limit+1, nil
+		}
+		if args.User == "" {
+			return scan(transfersB, nil, args.GreaterThanName, func(_, v []byte) (bool, error) {
+				return collect(v)
+			})
+		}
+		prefix := indexPrefix(args.User)
+		return scan(book.Bucket(transfersByUserBucket), prefix, args.GreaterThanName, func(k, _ []byte) (bool, error) {
+			return collect(transfersB.Get(k[len(prefix):]))
+		})
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
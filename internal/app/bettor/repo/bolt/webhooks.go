@@ -0,0 +1,175 @@
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// dueKey is a deliveries_by_due index key. Keys sort by next attempt asc then delivery name asc.
+func dueKey(delivery *api.WebhookDelivery) []byte {
+	k := make([]byte, 8, 8+len(delivery.GetName()))
+	binary.BigEndian.PutUint64(k, dueNanos(delivery.GetNextAttemptAt().AsTime()))
+	return append(k, delivery.GetName()...)
+}
+
+func dueNanos(t time.Time) uint64 {
+	if t.UnixNano() < 0 {
+		return 0
+	}
+	return uint64(t.UnixNano())
+}
+
+// CreateWebhook creates a new webhook.
+func (r *Repo) CreateWebhook(_ context.Context, webhook *api.Webhook) error {
+	bookID, _ := entity.WebhookIDs(webhook.GetName())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		webhooks := book.Bucket(webhooksBucket)
+		if webhooks.Get([]byte(webhook.GetName())) != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("webhook with id already exists"))
+		}
+		return put(webhooks, webhook.GetName(), webhook)
+	})
+}
+
+// GetWebhook gets a webhook by name.
+func (r *Repo) GetWebhook(_ context.Context, name string) (*api.Webhook, error) {
+	bookID, _ := entity.WebhookIDs(name)
+	webhook := &api.Webhook{}
+	var found bool
+	err := r.view(bookID, func(book *bbolt.Bucket) error {
+		if book == nil {
+			return nil
+		}
+		var err error
+		found, err = get(book.Bucket(webhooksBucket), name, webhook)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("webhook not found"))
+	}
+	return webhook, nil
+}
+
+// ListWebhooks lists webhooks by filters.
+func (r *Repo) ListWebhooks(_ context.Context, args *repo.ListWebhooksArgs) (webhooks []*api.Webhook, hasMore bool, err error) {
+	var out []*api.Webhook
+	err = r.view(entity.BooksIDs(args.Book), func(book *bbolt.Bucket) error {
+		if book == nil || book.Bucket(webhooksBucket) == nil {
+			return nil
+		}
+		return scan(book.Bucket(webhooksBucket), nil, args.GreaterThanName, func(_, v []byte) (bool, error) {
+			w := &api.Webhook{}
+			if err := proto.Unmarshal(v, w); err != nil {
+				return false, err
+			}
+			out = append(out, w)
+			return len(out) < args.Limit+1, nil
+		})
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// DeleteWebhook deletes a webhook.
+func (r *Repo) DeleteWebhook(_ context.Context, name string) error {
+	bookID, _ := entity.WebhookIDs(name)
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		webhooks := book.Bucket(webhooksBucket)
+		if webhooks.Get([]byte(name)) == nil {
+			return connect.NewError(connect.CodeNotFound, errors.New("webhook not found"))
+		}
+		return webhooks.Delete([]byte(name))
+	})
+}
+
+// PutDelivery creates or overwrites a webhook delivery.
+func (r *Repo) PutDelivery(_ context.Context, delivery *api.WebhookDelivery) error {
+	bookID, _, _ := entity.DeliveryIDs(delivery.GetName())
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		deliveries, byDue := book.Bucket(deliveriesBucket), book.Bucket(deliveriesByDueBucket)
+		existing := &api.WebhookDelivery{}
+		found, err := get(deliveries, delivery.GetName(), existing)
+		if err != nil {
+			return err
+		}
+		if found {
+			if err := byDue.Delete(dueKey(existing)); err != nil {
+				return err
+			}
+		}
+		if err := byDue.Put(dueKey(delivery), []byte{}); err != nil {
+			return err
+		}
+		return put(deliveries, delivery.GetName(), delivery)
+	})
+}
+
+// DeleteDelivery deletes a webhook delivery.
+func (r *Repo) DeleteDelivery(_ context.Context, name string) error {
+	bookID, _, _ := entity.DeliveryIDs(name)
+	return r.update(bookID, func(book *bbolt.Bucket) error {
+		deliveries := book.Bucket(deliveriesBucket)
+		existing := &api.WebhookDelivery{}
+		found, err := get(deliveries, name, existing)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return connect.NewError(connect.CodeNotFound, errors.New("delivery not found"))
+		}
+		if err := book.Bucket(deliveriesByDueBucket).Delete(dueKey(existing)); err != nil {
+			return err
+		}
+		return deliveries.Delete([]byte(name))
+	})
+}
+
+// ListDeliveries lists webhook deliveries across all books. Each book's due index is scanned up to the limit and the
+// results are merged.
+func (r *Repo) ListDeliveries(_ context.Context, args *repo.ListDeliveriesArgs) (deliveries []*api.WebhookDelivery, hasMore bool, err error) {
+	var out []*api.WebhookDelivery
+	err = r.DB.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(_ []byte, book *bbolt.Bucket) error {
+			byDue := book.Bucket(deliveriesByDueBucket)
+			if byDue == nil {
+				return nil
+			}
+			deliveriesB := book.Bucket(deliveriesBucket)
+			var n int
+			return scan(byDue, nil, "", func(k, _ []byte) (bool, error) {
+				if !args.DueBefore.IsZero() && binary.BigEndian.Uint64(k[:8]) > dueNanos(args.DueBefore) {
+					return false, nil
+				}
+				d := &api.WebhookDelivery{}
+				if _, err := get(deliveriesB, string(k[8:]), d); err != nil {
+					return false, err
+				}
+				out = append(out, d)
+				n++
+				return n < args.Limit+1, nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, false, toConnectErr(err)
+	}
+	out, hasMore = repo.PageDeliveries(out, args)
+	return out, hasMore, nil
+}
@@ -0,0 +1,153 @@
+package bolt
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// batchBookID returns the book that all writes in batch belong to.
+func batchBookID(batch *repo.Batch) (string, error) {
+	var bookIDs []string
+	for _, user := range batch.Users {
+		bookID, _ := entity.UserIDs(user.GetName())
+		bookIDs = append(bookIDs, bookID)
+	}
+	for _, market := range batch.Markets {
+		bookID, _ := entity.MarketIDs(market.GetName())
+		bookIDs = append(bookIDs, bookID)
+	}
+	for _, bet := range append(append([]*api.Bet{}, batch.Bets...), batch.NewBets...) {
+		bookID, _ := entity.BetIDs(bet.GetName())
+		bookIDs = append(bookIDs, bookID)
+	}
+	for _, snapshot := range batch.OddsSnapshots {
+		bookID, _ := entity.MarketIDs(snapshot.GetMarket())
+		bookIDs = append(bookIDs, bookID)
+	}
+	for _, grant := range batch.Grants {
+		bookID, _ := entity.GrantIDs(grant.GetName())
+		bookIDs = append(bookIDs, bookID)
+	}
+	for _, season := range batch.Seasons {
+		bookID, _ := entity.SeasonIDs(season.GetName())
+		bookIDs = append(bookIDs, bookID)
+	}
+	for _, transfer := range batch.Transfers {
+		bookID, _ := entity.TransferIDs(transfer.GetName())
+		bookIDs = append(bookIDs, bookID)
+	}
+	for _, adjustment := range batch.Adjustments {
+		bookID, _ := entity.AdjustmentIDs(adjustment.GetName())
+		bookIDs = append(bookIDs, bookID)
+	}
+	if len(bookIDs) == 0 {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("batch is empty"))
+	}
+	for _, bookID := range bookIDs[1:] {
+		if bookID != bookIDs[0] {
+			return "", connect.NewError(connect.CodeInvalidArgument, errors.New("batch writes must be in one book"))
+		}
+	}
+	return bookIDs[0], nil
+}
+
+// Write applies a batch of writes atomically in one transaction.
+func (r *Repo) Write(_ context.Context, batch *repo.Batch) error {
+	bookID, err := batchBookID(batch)
+	if err != nil {
+		return err
+	}
+	userCopies := make([]*api.User, len(batch.Users))
+	for i, user := range batch.Users {
+		userCopies[i] = proto.Clone(user).(*api.User)
+		userCopies[i].UnsettledCentipoints = 0 // virtual field is not persisted
+		userCopies[i].Etag = repo.NextEtag(user.GetEtag())
+	}
+	marketCopies := make([]*api.Market, len(batch.Markets))
+	for i, market := range batch.Markets {
+		marketCopies[i] = proto.Clone(market).(*api.Market)
+		marketCopies[i].Etag = repo.NextEtag(market.GetEtag())
+	}
+	betCopies := make([]*api.Bet, len(batch.Bets))
+	for i, bet := range batch.Bets {
+		betCopies[i] = proto.Clone(bet).(*api.Bet)
+		betCopies[i].Etag = repo.NextEtag(bet.GetEtag())
+	}
+	newBets := make([]*api.Bet, len(batch.NewBets))
+	for i, bet := range batch.NewBets {
+		newBets[i] = proto.Clone(bet).(*api.Bet)
+		newBets[i].Etag = repo.NextEtag("")
+	}
+
+	err = r.update(bookID, func(book *bbolt.Bucket) error {
+		for i, user := range batch.Users {
+			if err := updateUser(book, user, userCopies[i]); err != nil {
+				return err
+			}
+		}
+		for i, market := range batch.Markets {
+			if err := updateMarket(book, market, marketCopies[i]); err != nil {
+				return err
+			}
+		}
+		for i, bet := range batch.Bets {
+			if err := updateBet(book, bet, betCopies[i]); err != nil {
+				return err
+			}
+		}
+		for _, bet := range newBets {
+			if err := insertBet(book, bet); err != nil {
+				return err
+			}
+		}
+		for _, snapshot := range batch.OddsSnapshots {
+			if err := putOddsSnapshot(book, snapshot); err != nil {
+				return err
+			}
+		}
+		for _, grant := range batch.Grants {
+			if err := insertGrant(book, grant); err != nil {
+				return err
+			}
+		}
+		for _, season := range batch.Seasons {
+			if err := putSeason(book, season); err != nil {
+				return err
+			}
+		}
+		for _, transfer := range batch.Transfers {
+			if err := insertTransfer(book, transfer); err != nil {
+				return err
+			}
+		}
+		for _, adjustment := range batch.Adjustments {
+			if err := insertAdjustment(book, adjustment); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, batch.Events...)
+	if err != nil {
+		return err
+	}
+	for i, user := range batch.Users {
+		user.Etag = userCopies[i].GetEtag()
+	}
+	for i, market := range batch.Markets {
+		market.Etag = marketCopies[i].GetEtag()
+	}
+	for i, bet := range batch.Bets {
+		bet.Etag = betCopies[i].GetEtag()
+	}
+	for i, bet := range batch.NewBets {
+		bet.Etag = newBets[i].GetEtag()
+	}
+	return nil
+}
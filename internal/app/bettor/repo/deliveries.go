@@ -0,0 +1,34 @@
+package repo
+
+import (
+	"sort"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+)
+
+// IsDue returns whether a delivery matches the DueBefore of args.
+func (args *ListDeliveriesArgs) IsDue(d *api.WebhookDelivery) bool {
+	return args.DueBefore.IsZero() || !d.GetNextAttemptAt().AsTime().After(args.DueBefore)
+}
+
+// PageDeliveries returns up to limit due deliveries in next_attempt_at asc then name asc order and whether there are
+// more. It is for repos that do not index deliveries across books.
+func PageDeliveries(deliveries []*api.WebhookDelivery, args *ListDeliveriesArgs) ([]*api.WebhookDelivery, bool) {
+	var out []*api.WebhookDelivery
+	for _, d := range deliveries {
+		if args.IsDue(d) {
+			out = append(out, d)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ti, tj := out[i].GetNextAttemptAt().AsTime(), out[j].GetNextAttemptAt().AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return out[i].GetName() < out[j].GetName()
+	})
+	if len(out) > args.Limit {
+		return out[:args.Limit], true
+	}
+	return out, false
+}
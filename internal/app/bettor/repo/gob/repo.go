@@ -1,18 +1,25 @@
 package gob
 
 import (
+	"bufio"
 	"context"
+	"encoding/binary"
 	"encoding/gob"
 	"errors"
 	"fmt"
+	"hash/crc32"
+	"io"
 	"io/fs"
 	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/bufbuild/connect-go" // too lazy to isolate errors :shrug:
 	api "github.com/elh/bettor/api/bettor/v1alpha"
 	"github.com/elh/bettor/internal/app/bettor/repo"
 	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/go-kit/log"
+	"google.golang.org/protobuf/proto"
 )
 
 var _ repo.Repo = (*Repo)(nil)
@@ -21,73 +28,507 @@ func init() {
 	// interface types need to be registered
 	gob.Register(&api.Market_Pool{})
 	gob.Register(&api.Bet_Outcome{})
+	gob.Register(&api.WebhookEvent_Market{})
+	gob.Register(&api.WebhookEvent_Bet{})
+	gob.Register(&api.DomainEvent_UserCreated{})
+	gob.Register(&api.DomainEvent_UserBalanceChanged{})
+	gob.Register(&api.DomainEvent_MarketCreated{})
+	gob.Register(&api.DomainEvent_MarketLocked{})
+	gob.Register(&api.DomainEvent_MarketSettled{})
+	gob.Register(&api.DomainEvent_MarketCanceled{})
+	gob.Register(&api.DomainEvent_MarketPoolChanged{})
+	gob.Register(&api.DomainEvent_BetPlaced{})
+	gob.Register(&api.DomainEvent_BetSettled{})
 }
 
+// DefaultCompactEvery is the default number of log records after which the log is compacted into a snapshot.
+const DefaultCompactEvery = 1000
+
+// log record ops. Every op is an upsert or a delete so that replaying records already captured in a snapshot is
+// harmless. Delete records hold a resource with only its name set.
+const (
+	opPutUser byte = iota + 1
+	opPutMarket
+	opPutBet
+	opPutWebhook
+	opDeleteWebhook
+	opPutDelivery
+	opDeleteDelivery
+	opPutEvent
+	opPutCursor
+	// a batch record's body is a sequence of op, big-endian uint32 length, and proto. it makes a write and its events
+	// atomic since the record is only applied if it is complete
+	opBatch
+	opPutOddsSnapshot
+	opPutGrant
+	opPutBookSettings
+	opPutSeason
+	opPutTransfer
+	opPutAdjustment
+	// deletes events through the sequence of an event with only its sequence set
+	opDeleteEvents
+)
+
+// record header is the body length followed by the CRC-32C of the body. The body is an op followed by a proto.
+const recordHeaderSize = 8
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
 // Repo is an file-backed gob persistence repository.
+//
+// State is kept in memory. FileName holds a gob-encoded snapshot and every write is appended to a write-ahead log
+// at FileName + ".wal" and fsynced before it is applied in memory. On startup, the snapshot is loaded and the log is
+// replayed. The log is periodically compacted into a new snapshot that is written to a temp file and atomically
+// renamed.
 type Repo struct {
-	Mem      *mem.Repo
-	FileName string
-	writeMtx sync.Mutex
+	Mem          *mem.Repo
+	FileName     string
+	CompactEvery int
+	Logger       log.Logger
+	writeMtx     sync.Mutex
+	log          *os.File
+	logRecords   int
 }
 
 // New initializes a Gob-backed repository.
 func New(fileName string) (*Repo, error) {
+	m, err := loadSnapshot(fileName)
+	if err != nil {
+		return nil, err
+	}
+	r := &Repo{
+		Mem:          m,
+		FileName:     fileName,
+		CompactEvery: DefaultCompactEvery,
+		Logger:       log.NewNopLogger(),
+	}
+	logFile, err := os.OpenFile(r.logFileName(), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be opened: %w", err))
+	}
+	r.log = logFile
+	if err := r.replay(); err != nil {
+		logFile.Close()
+		return nil, err
+	}
+	// start from a fresh snapshot so the log only ever holds writes from this process
+	if err := r.compact(); err != nil {
+		logFile.Close()
+		return nil, err
+	}
+	// set after replay so replayed records are not logged again
+	m.WriteAhead = r.writeAhead
+	return r, nil
+}
+
+// Close closes the write-ahead log.
+func (r *Repo) Close() error {
+	r.writeMtx.Lock()
+	defer r.writeMtx.Unlock()
+	return r.log.Close()
+}
+
+func (r *Repo) logFileName() string {
+	return r.FileName + ".wal"
+}
+
+func loadSnapshot(fileName string) (*mem.Repo, error) {
 	file, err := os.Open(fileName)
 	if errors.Is(err, fs.ErrNotExist) {
-		if _, err := os.Create(fileName); err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("gob file could not be created: %w", err))
-		}
-		r := &Repo{
-			Mem:      &mem.Repo{},
-			FileName: fileName,
-		}
-		return r, r.persist()
+		return &mem.Repo{}, nil
 	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("gob file could not be opened: %w", err))
 	}
 	defer file.Close()
 	m := mem.Repo{}
-	if err := gob.NewDecoder(file).Decode(&m); err != nil {
+	if err := gob.NewDecoder(file).Decode(&m); err != nil && !errors.Is(err, io.EOF) { // empty file is an empty repo
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("gob file could not be decoded: %w", err))
 	}
-
-	return &Repo{
-		Mem:      &m,
-		FileName: fileName,
-	}, nil
+	return &m, nil
 }
 
-func (r *Repo) persist() error {
-	file, err := os.OpenFile(r.FileName, os.O_WRONLY, os.ModeExclusive)
+// replay applies all records in the log to Mem. A torn record at the tail of the log, left by a crash mid-append, is
+// truncated. Any other invalid record is reported as corruption.
+func (r *Repo) replay() error {
+	if _, err := r.log.Seek(0, io.SeekStart); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be read: %w", err))
+	}
+	info, err := r.log.Stat()
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob file could not be opened: %w", err))
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be read: %w", err))
 	}
-	defer file.Close()
-	if err := gob.NewEncoder(file).Encode(r.Mem); err != nil {
-		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob file could not be encoded: %w", err))
+	size := info.Size()
+
+	reader := bufio.NewReader(r.log)
+	var offset int64
+	for offset < size {
+		header := make([]byte, recordHeaderSize)
+		if _, err := io.ReadFull(reader, header); err != nil {
+			break // torn header
+		}
+		length := int64(binary.BigEndian.Uint32(header[0:4]))
+		checksum := binary.BigEndian.Uint32(header[4:8])
+		end := offset + recordHeaderSize + length
+		if end > size {
+			break // torn body
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(reader, body); err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be read: %w", err))
+		}
+		if crc32.Checksum(body, crcTable) != checksum {
+			if end == size {
+				break // torn body. length was written but not all of the data
+			}
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log is corrupt: checksum mismatch at offset %d", offset))
+		}
+		if len(body) == 0 {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log is corrupt: empty record at offset %d", offset))
+		}
+		if err := r.apply(body[0], body[1:]); err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log is corrupt: record at offset %d could not be applied: %w", offset, err))
+		}
+		offset = end
+		r.logRecords++
+	}
+	if offset < size {
+		if err := r.log.Truncate(offset); err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be truncated: %w", err))
+		}
+	}
+	if _, err := r.log.Seek(offset, io.SeekStart); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be read: %w", err))
 	}
 	return nil
 }
 
-// CreateUser creates a new user.
-func (r *Repo) CreateUser(ctx context.Context, user *api.User) error {
+// apply upserts a logged resource into Mem.
+func (r *Repo) apply(op byte, data []byte) error {
+	ctx := context.Background()
+	switch op {
+	case opPutUser:
+		user := &api.User{}
+		if err := proto.Unmarshal(data, user); err != nil {
+			return err
+		}
+		return r.Mem.PutUser(ctx, user)
+	case opPutMarket:
+		market := &api.Market{}
+		if err := proto.Unmarshal(data, market); err != nil {
+			return err
+		}
+		return r.Mem.PutMarket(ctx, market)
+	case opPutBet:
+		bet := &api.Bet{}
+		if err := proto.Unmarshal(data, bet); err != nil {
+			return err
+		}
+		return r.Mem.PutBet(ctx, bet)
+	case opPutWebhook:
+		webhook := &api.Webhook{}
+		if err := proto.Unmarshal(data, webhook); err != nil {
+			return err
+		}
+		return r.Mem.PutWebhook(ctx, webhook)
+	case opDeleteWebhook:
+		webhook := &api.Webhook{}
+		if err := proto.Unmarshal(data, webhook); err != nil {
+			return err
+		}
+		return ignoreNotFound(r.Mem.DeleteWebhook(ctx, webhook.GetName()))
+	case opPutDelivery:
+		delivery := &api.WebhookDelivery{}
+		if err := proto.Unmarshal(data, delivery); err != nil {
+			return err
+		}
+		return r.Mem.PutDelivery(ctx, delivery)
+	case opDeleteDelivery:
+		delivery := &api.WebhookDelivery{}
+		if err := proto.Unmarshal(data, delivery); err != nil {
+			return err
+		}
+		return ignoreNotFound(r.Mem.DeleteDelivery(ctx, delivery.GetName()))
+	case opPutEvent:
+		event := &api.DomainEvent{}
+		if err := proto.Unmarshal(data, event); err != nil {
+			return err
+		}
+		return r.Mem.PutEvent(ctx, event)
+	case opDeleteEvents:
+		event := &api.DomainEvent{}
+		if err := proto.Unmarshal(data, event); err != nil {
+			return err
+		}
+		return r.Mem.DeleteEvents(ctx, event.GetSequence())
+	case opPutCursor:
+		cursor := &api.ConsumerCursor{}
+		if err := proto.Unmarshal(data, cursor); err != nil {
+			return err
+		}
+		return r.Mem.PutCursor(ctx, cursor.GetConsumer(), cursor.GetSequence())
+	case opPutOddsSnapshot:
+		snapshot := &api.OddsSnapshot{}
+		if err := proto.Unmarshal(data, snapshot); err != nil {
+			return err
+		}
+		return r.Mem.PutOddsSnapshot(ctx, snapshot)
+	case opPutGrant:
+		grant := &api.Grant{}
+		if err := proto.Unmarshal(data, grant); err != nil {
+			return err
+		}
+		return r.Mem.PutGrant(ctx, grant)
+	case opPutBookSettings:
+		settings := &api.BookSettings{}
+		if err := proto.Unmarshal(data, settings); err != nil {
+			return err
+		}
+		return r.Mem.PutBookSettings(ctx, settings)
+	case opPutSeason:
+		season := &api.Season{}
+		if err := proto.Unmarshal(data, season); err != nil {
+			return err
+		}
+		return r.Mem.PutSeason(ctx, season)
+	case opPutTransfer:
+		transfer := &api.Transfer{}
+		if err := proto.Unmarshal(data, transfer); err != nil {
+			return err
+		}
+		return r.Mem.PutTransfer(ctx, transfer)
+	case opPutAdjustment:
+		adjustment := &api.Adjustment{}
+		if err := proto.Unmarshal(data, adjustment); err != nil {
+			return err
+		}
+		return r.Mem.PutAdjustment(ctx, adjustment)
+	case opBatch:
+		for len(data) > 0 {
+			if len(data) < 5 || len(data)-5 < int(binary.BigEndian.Uint32(data[1:5])) {
+				return errors.New("truncated batch")
+			}
+			end := 5 + int(binary.BigEndian.Uint32(data[1:5]))
+			if err := r.apply(data[0], data[5:end]); err != nil {
+				return err
+			}
+			data = data[end:]
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown op %d", op)
+	}
+}
+
+// ignoreNotFound makes replaying a delete of a resource that is already gone a no-op.
+func ignoreNotFound(err error) error {
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return nil
+	}
+	return err
+}
+
+// write runs a Mem write, which logs it ahead with writeAhead, then compacts the log if it has grown past CompactEvery
+// records. The write is durable once it is logged, so compaction failures are logged rather than returned and
+// compaction is retried after the next write.
+func (r *Repo) write(fn func() error) error {
 	r.writeMtx.Lock()
 	defer r.writeMtx.Unlock()
-	if err := r.Mem.CreateUser(ctx, user); err != nil {
+	if err := fn(); err != nil {
 		return err
 	}
-	return r.persist()
+	if r.CompactEvery > 0 && r.logRecords >= r.CompactEvery {
+		if err := r.compact(); err != nil {
+			r.Logger.Log("msg", "gob log compaction failed", "err", err)
+		}
+	}
+	return nil
 }
 
-// UpdateUser updates a user.
-func (r *Repo) UpdateUser(ctx context.Context, user *api.User) error {
-	r.writeMtx.Lock()
-	defer r.writeMtx.Unlock()
-	if err := r.Mem.UpdateUser(ctx, user); err != nil {
+// writeAhead durably appends a validated Mem write to the log before Mem applies it. A write of more than one
+// resource is appended as one batch record. If the append fails, the log is truncated back to its previous end so a
+// partial record is never followed by later ones. writeMtx must be held.
+func (r *Repo) writeAhead(w *mem.Write) error {
+	var entries []byte
+	n := 0
+	add := func(op byte, msg proto.Message) error {
+		data, err := proto.Marshal(msg)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log record could not be encoded: %w", err))
+		}
+		entries = batchEntry(entries, op, data)
+		n++
+		return nil
+	}
+	for _, msg := range w.Puts {
+		op, err := putOp(msg)
+		if err != nil {
+			return err
+		}
+		if err := add(op, msg); err != nil {
+			return err
+		}
+	}
+	for _, msg := range w.Deletes {
+		op, err := deleteOp(msg)
+		if err != nil {
+			return err
+		}
+		if err := add(op, msg); err != nil {
+			return err
+		}
+	}
+	for _, e := range w.Events {
+		if err := add(opPutEvent, e); err != nil {
+			return err
+		}
+	}
+	op, data := opBatch, entries
+	if n == 1 {
+		op, data = entries[0], entries[5:]
+	}
+	record := make([]byte, recordHeaderSize+1+len(data))
+	body := record[recordHeaderSize:]
+	body[0] = op
+	copy(body[1:], data)
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(body)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(body, crcTable))
+
+	offset, err := r.log.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be written: %w", err))
+	}
+	if _, err := r.log.Write(record); err != nil {
+		r.rollback(offset)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be written: %w", err))
+	}
+	if err := r.log.Sync(); err != nil {
+		r.rollback(offset)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be synced: %w", err))
+	}
+	r.logRecords++
+	return nil
+}
+
+// rollback truncates the log to offset after a failed append. writeMtx must be held.
+func (r *Repo) rollback(offset int64) {
+	if err := r.log.Truncate(offset); err != nil {
+		r.Logger.Log("msg", "gob log could not be rolled back", "err", err)
+		return
+	}
+	if _, err := r.log.Seek(offset, io.SeekStart); err != nil {
+		r.Logger.Log("msg", "gob log could not be rolled back", "err", err)
+	}
+}
+
+// putOp returns the log op that upserts a resource.
+func putOp(msg proto.Message) (byte, error) {
+	switch msg.(type) {
+	case *api.User:
+		return opPutUser, nil
+	case *api.Market:
+		return opPutMarket, nil
+	case *api.Bet:
+		return opPutBet, nil
+	case *api.Webhook:
+		return opPutWebhook, nil
+	case *api.WebhookDelivery:
+		return opPutDelivery, nil
+	case *api.ConsumerCursor:
+		return opPutCursor, nil
+	case *api.OddsSnapshot:
+		return opPutOddsSnapshot, nil
+	case *api.Grant:
+		return opPutGrant, nil
+	case *api.BookSettings:
+		return opPutBookSettings, nil
+	case *api.Season:
+		return opPutSeason, nil
+	case *api.Transfer:
+		return opPutTransfer, nil
+	case *api.Adjustment:
+		return opPutAdjustment, nil
+	}
+	return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("gob log cannot put %T", msg))
+}
+
+// deleteOp returns the log op that deletes a resource.
+func deleteOp(msg proto.Message) (byte, error) {
+	switch msg.(type) {
+	case *api.Webhook:
+		return opDeleteWebhook, nil
+	case *api.WebhookDelivery:
+		return opDeleteDelivery, nil
+	case *api.DomainEvent:
+		return opDeleteEvents, nil
+	}
+	return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("gob log cannot delete %T", msg))
+}
+
+func batchEntry(batch []byte, op byte, data []byte) []byte {
+	batch = append(batch, op)
+	batch = binary.BigEndian.AppendUint32(batch, uint32(len(data)))
+	return append(batch, data...)
+}
+
+// compact writes a snapshot of Mem via a temp file and atomic rename, then truncates the log. A crash between the
+// rename and the truncate is safe because replaying records over a snapshot that already contains them is a no-op.
+// writeMtx must be held.
+func (r *Repo) compact() error {
+	dir := filepath.Dir(r.FileName)
+	tmp, err := os.CreateTemp(dir, filepath.Base(r.FileName)+".tmp-*")
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob snapshot could not be created: %w", err))
+	}
+	defer os.Remove(tmp.Name()) // no-op after a successful rename
+	if err := gob.NewEncoder(tmp).Encode(r.Mem); err != nil {
+		tmp.Close()
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob snapshot could not be encoded: %w", err))
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob snapshot could not be synced: %w", err))
+	}
+	if err := tmp.Close(); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob snapshot could not be closed: %w", err))
+	}
+	if err := os.Rename(tmp.Name(), r.FileName); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob snapshot could not be renamed: %w", err))
+	}
+	if err := syncDir(dir); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob snapshot directory could not be synced: %w", err))
+	}
+
+	if err := r.log.Truncate(0); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be truncated: %w", err))
+	}
+	if _, err := r.log.Seek(0, io.SeekStart); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be truncated: %w", err))
+	}
+	if err := r.log.Sync(); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("gob log could not be synced: %w", err))
+	}
+	r.logRecords = 0
+	return nil
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
 		return err
 	}
-	return r.persist()
+	defer d.Close()
+	return d.Sync()
+}
+
+// CreateUser creates a new user.
+func (r *Repo) CreateUser(ctx context.Context, user *api.User, events ...*api.DomainEvent) error {
+	return r.write(func() error { return r.Mem.CreateUser(ctx, user, events...) })
+}
+
+// UpdateUser updates a user.
+func (r *Repo) UpdateUser(ctx context.Context, user *api.User, events ...*api.DomainEvent) error {
+	return r.write(func() error { return r.Mem.UpdateUser(ctx, user, events...) })
 }
 
 // GetUser gets a user by ID.
@@ -95,6 +536,11 @@ func (r *Repo) GetUser(ctx context.Context, id string) (*api.User, error) {
 	return r.Mem.GetUser(ctx, id)
 }
 
+// GetUsers gets users by names.
+func (r *Repo) GetUsers(ctx context.Context, names []string) ([]*api.User, error) {
+	return r.Mem.GetUsers(ctx, names)
+}
+
 // GetUserByUsername gets a user by username.
 func (r *Repo) GetUserByUsername(ctx context.Context, book, username string) (*api.User, error) {
 	return r.Mem.GetUserByUsername(ctx, book, username)
@@ -106,23 +552,13 @@ func (r *Repo) ListUsers(ctx context.Context, args *repo.ListUsersArgs) ([]*api.
 }
 
 // CreateMarket creates a new market.
-func (r *Repo) CreateMarket(ctx context.Context, market *api.Market) error {
-	r.writeMtx.Lock()
-	defer r.writeMtx.Unlock()
-	if err := r.Mem.CreateMarket(ctx, market); err != nil {
-		return err
-	}
-	return r.persist()
+func (r *Repo) CreateMarket(ctx context.Context, market *api.Market, events ...*api.DomainEvent) error {
+	return r.write(func() error { return r.Mem.CreateMarket(ctx, market, events...) })
 }
 
 // UpdateMarket updates a market.
-func (r *Repo) UpdateMarket(ctx context.Context, market *api.Market) error {
-	r.writeMtx.Lock()
-	defer r.writeMtx.Unlock()
-	if err := r.Mem.UpdateMarket(ctx, market); err != nil {
-		return err
-	}
-	return r.persist()
+func (r *Repo) UpdateMarket(ctx context.Context, market *api.Market, events ...*api.DomainEvent) error {
+	return r.write(func() error { return r.Mem.UpdateMarket(ctx, market, events...) })
 }
 
 // GetMarket gets a market by ID.
@@ -130,29 +566,24 @@ func (r *Repo) GetMarket(ctx context.Context, id string) (*api.Market, error) {
 	return r.Mem.GetMarket(ctx, id)
 }
 
+// GetMarkets gets markets by names.
+func (r *Repo) GetMarkets(ctx context.Context, names []string) ([]*api.Market, error) {
+	return r.Mem.GetMarkets(ctx, names)
+}
+
 // ListMarkets lists markets.
 func (r *Repo) ListMarkets(ctx context.Context, args *repo.ListMarketsArgs) ([]*api.Market, bool, error) {
 	return r.Mem.ListMarkets(ctx, args)
 }
 
 // CreateBet creates a new user.
-func (r *Repo) CreateBet(ctx context.Context, bet *api.Bet) error {
-	r.writeMtx.Lock()
-	defer r.writeMtx.Unlock()
-	if err := r.Mem.CreateBet(ctx, bet); err != nil {
-		return err
-	}
-	return r.persist()
+func (r *Repo) CreateBet(ctx context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
+	return r.write(func() error { return r.Mem.CreateBet(ctx, bet, events...) })
 }
 
 // UpdateBet updates a bet.
-func (r *Repo) UpdateBet(ctx context.Context, bet *api.Bet) error {
-	r.writeMtx.Lock()
-	defer r.writeMtx.Unlock()
-	if err := r.Mem.UpdateBet(ctx, bet); err != nil {
-		return err
-	}
-	return r.persist()
+func (r *Repo) UpdateBet(ctx context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
+	return r.write(func() error { return r.Mem.UpdateBet(ctx, bet, events...) })
 }
 
 // GetBet gets a bet by ID.
@@ -160,7 +591,137 @@ func (r *Repo) GetBet(ctx context.Context, id string) (*api.Bet, error) {
 	return r.Mem.GetBet(ctx, id)
 }
 
+// GetBets gets bets by names.
+func (r *Repo) GetBets(ctx context.Context, names []string) ([]*api.Bet, error) {
+	return r.Mem.GetBets(ctx, names)
+}
+
 // ListBets lists bets.
 func (r *Repo) ListBets(ctx context.Context, args *repo.ListBetsArgs) ([]*api.Bet, bool, error) {
 	return r.Mem.ListBets(ctx, args)
 }
+
+// CreateWebhook creates a new webhook.
+func (r *Repo) CreateWebhook(ctx context.Context, webhook *api.Webhook) error {
+	return r.write(func() error { return r.Mem.CreateWebhook(ctx, webhook) })
+}
+
+// GetWebhook gets a webhook by name.
+func (r *Repo) GetWebhook(ctx context.Context, name string) (*api.Webhook, error) {
+	return r.Mem.GetWebhook(ctx, name)
+}
+
+// ListWebhooks lists webhooks.
+func (r *Repo) ListWebhooks(ctx context.Context, args *repo.ListWebhooksArgs) ([]*api.Webhook, bool, error) {
+	return r.Mem.ListWebhooks(ctx, args)
+}
+
+// DeleteWebhook deletes a webhook.
+func (r *Repo) DeleteWebhook(ctx context.Context, name string) error {
+	return r.write(func() error { return r.Mem.DeleteWebhook(ctx, name) })
+}
+
+// PutDelivery creates or overwrites a webhook delivery.
+func (r *Repo) PutDelivery(ctx context.Context, delivery *api.WebhookDelivery) error {
+	return r.write(func() error { return r.Mem.PutDelivery(ctx, delivery) })
+}
+
+// DeleteDelivery deletes a webhook delivery.
+func (r *Repo) DeleteDelivery(ctx context.Context, name string) error {
+	return r.write(func() error { return r.Mem.DeleteDelivery(ctx, name) })
+}
+
+// ListDeliveries lists webhook deliveries.
+func (r *Repo) ListDeliveries(ctx context.Context, args *repo.ListDeliveriesArgs) ([]*api.WebhookDelivery, bool, error) {
+	return r.Mem.ListDeliveries(ctx, args)
+}
+
+// ListEvents lists domain events.
+func (r *Repo) ListEvents(ctx context.Context, args *repo.ListEventsArgs) ([]*api.DomainEvent, bool, error) {
+	return r.Mem.ListEvents(ctx, args)
+}
+
+// GetCursor returns a consumer's cursor.
+func (r *Repo) GetCursor(ctx context.Context, consumer string) (uint64, error) {
+	return r.Mem.GetCursor(ctx, consumer)
+}
+
+// PutCursor sets a consumer's cursor.
+func (r *Repo) PutCursor(ctx context.Context, consumer string, sequence uint64) error {
+	return r.write(func() error { return r.Mem.PutCursor(ctx, consumer, sequence) })
+}
+
+// DeleteEvents deletes events through sequence besides the newest event.
+func (r *Repo) DeleteEvents(ctx context.Context, sequence uint64) error {
+	return r.write(func() error { return r.Mem.DeleteEvents(ctx, sequence) })
+}
+
+// PutOddsSnapshot creates or overwrites an odds snapshot.
+func (r *Repo) PutOddsSnapshot(ctx context.Context, snapshot *api.OddsSnapshot) error {
+	return r.write(func() error { return r.Mem.PutOddsSnapshot(ctx, snapshot) })
+}
+
+// ListOddsSnapshots lists a market's odds snapshots.
+func (r *Repo) ListOddsSnapshots(ctx context.Context, args *repo.ListOddsSnapshotsArgs) ([]*api.OddsSnapshot, bool, error) {
+	return r.Mem.ListOddsSnapshots(ctx, args)
+}
+
+// CreateGrant creates a new grant.
+func (r *Repo) CreateGrant(ctx context.Context, grant *api.Grant) error {
+	return r.write(func() error { return r.Mem.CreateGrant(ctx, grant) })
+}
+
+// ListGrants lists grants by filters.
+func (r *Repo) ListGrants(ctx context.Context, args *repo.ListGrantsArgs) ([]*api.Grant, bool, error) {
+	return r.Mem.ListGrants(ctx, args)
+}
+
+// GetBookSettings gets a book's settings by name.
+func (r *Repo) GetBookSettings(ctx context.Context, name string) (*api.BookSettings, error) {
+	return r.Mem.GetBookSettings(ctx, name)
+}
+
+// PutBookSettings creates or overwrites a book's settings.
+func (r *Repo) PutBookSettings(ctx context.Context, settings *api.BookSettings) error {
+	return r.write(func() error { return r.Mem.PutBookSettings(ctx, settings) })
+}
+
+// PutSeason creates or overwrites a season.
+func (r *Repo) PutSeason(ctx context.Context, season *api.Season) error {
+	return r.write(func() error { return r.Mem.PutSeason(ctx, season) })
+}
+
+// GetSeason gets a season by name.
+func (r *Repo) GetSeason(ctx context.Context, name string) (*api.Season, error) {
+	return r.Mem.GetSeason(ctx, name)
+}
+
+// ListSeasons lists a book's seasons.
+func (r *Repo) ListSeasons(ctx context.Context, args *repo.ListSeasonsArgs) ([]*api.Season, bool, error) {
+	return r.Mem.ListSeasons(ctx, args)
+}
+
+// CreateTransfer creates a new transfer.
+func (r *Repo) CreateTransfer(ctx context.Context, transfer *api.Transfer) error {
+	return r.write(func() error { return r.Mem.CreateTransfer(ctx, transfer) })
+}
+
+// ListTransfers lists transfers by filters.
+func (r *Repo) ListTransfers(ctx context.Context, args *repo.ListTransfersArgs) ([]*api.Transfer, bool, error) {
+	return r.Mem.ListTransfers(ctx, args)
+}
+
+// CreateAdjustment creates a new adjustment.
+func (r *Repo) CreateAdjustment(ctx context.Context, adjustment *api.Adjustment) error {
+	return r.write(func() error { return r.Mem.CreateAdjustment(ctx, adjustment) })
+}
+
+// ListAdjustments lists adjustments by filters.
+func (r *Repo) ListAdjustments(ctx context.Context, args *repo.ListAdjustmentsArgs) ([]*api.Adjustment, bool, error) {
+	return r.Mem.ListAdjustments(ctx, args)
+}
+
+// Write applies a batch of writes atomically. It is logged as one record.
+func (r *Repo) Write(ctx context.Context, batch *repo.Batch) error {
+	return r.write(func() error { return r.Mem.Write(ctx, batch) })
+}
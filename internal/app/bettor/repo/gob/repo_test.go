@@ -0,0 +1,243 @@
+package gob_test
+
+import (
+	"context"
+	"encoding/gob"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	bettorgob "github.com/elh/bettor/internal/app/bettor/repo/gob"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/repo/repotest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRepo(t *testing.T) {
+	repotest.Run(t, func() repo.Repo {
+		r, err := bettorgob.New(filepath.Join(t.TempDir(), "bettor.gob"))
+		require.Nil(t, err)
+		t.Cleanup(func() { r.Close() })
+		return r
+	})
+}
+
+func TestRecovery(t *testing.T) {
+	ctx := context.Background()
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	market := &api.Market{Name: entity.MarketN("guild:1", "a"), Status: api.Market_STATUS_OPEN}
+	bet := &api.Bet{Name: entity.BetN("guild:1", "a"), User: user.GetName(), Market: market.GetName(), Centipoints: 10}
+	webhook := &api.Webhook{Name: entity.WebhookN("guild:1", "a"), Url: "http://localhost/a"}
+	delivery := &api.WebhookDelivery{
+		Name:  entity.DeliveryN("guild:1", "a", "a"),
+		Event: &api.WebhookEvent{Id: "a", Type: api.WebhookEvent_TYPE_MARKET_CREATED, Resource: &api.WebhookEvent_Market{Market: market}},
+	}
+	userCreated := &api.DomainEvent{Id: "a", Book: entity.BookN("guild:1"), Payload: &api.DomainEvent_UserCreated{UserCreated: &api.UserCreated{User: user}}}
+	betPlaced := &api.DomainEvent{Id: "b", Book: entity.BookN("guild:1"), Payload: &api.DomainEvent_BetPlaced{BetPlaced: &api.BetPlaced{Bet: bet}}}
+	snapshot := &api.OddsSnapshot{Market: market.GetName(), Revision: 2, TotalCentipoints: 10}
+	grant := &api.Grant{Name: entity.GrantN("guild:1", "a"), User: user.GetName(), Centipoints: 10, Reason: api.Grant_REASON_ALLOWANCE}
+	settings := &api.BookSettings{Name: entity.BookSettingsN("guild:1"), AllowanceCentipoints: 10}
+	season := &api.Season{Name: entity.SeasonN("guild:1", "2"), Number: 2, StartingCentipoints: 10}
+	transfer := &api.Transfer{Name: entity.TransferN("guild:1", "a"), FromUser: user.GetName(), ToUser: entity.UserN("guild:1", "b"), Centipoints: 10}
+	adjustment := &api.Adjustment{Name: entity.AdjustmentN("guild:1", "a"), User: user.GetName(), Actor: entity.UserN("guild:1", "b"), DeltaCentipoints: -10, Reason: "fix"}
+	write := func(t *testing.T, r *bettorgob.Repo) {
+		created := proto.Clone(user).(*api.User)
+		require.Nil(t, r.CreateUser(ctx, created, proto.Clone(userCreated).(*api.DomainEvent)))
+		require.Nil(t, r.CreateMarket(ctx, proto.Clone(market).(*api.Market)))
+		require.Nil(t, r.CreateBet(ctx, proto.Clone(bet).(*api.Bet), proto.Clone(betPlaced).(*api.DomainEvent)))
+		updated := proto.Clone(created).(*api.User)
+		updated.Centipoints = 90
+		require.Nil(t, r.UpdateUser(ctx, updated))
+		require.Nil(t, r.PutCursor(ctx, "webhooks", 1))
+		require.Nil(t, r.DeleteEvents(ctx, 1))
+		require.Nil(t, r.PutOddsSnapshot(ctx, proto.Clone(snapshot).(*api.OddsSnapshot)))
+		require.Nil(t, r.CreateGrant(ctx, proto.Clone(grant).(*api.Grant)))
+		require.Nil(t, r.PutBookSettings(ctx, proto.Clone(settings).(*api.BookSettings)))
+		require.Nil(t, r.PutSeason(ctx, proto.Clone(season).(*api.Season)))
+		require.Nil(t, r.CreateTransfer(ctx, proto.Clone(transfer).(*api.Transfer)))
+		require.Nil(t, r.CreateAdjustment(ctx, proto.Clone(adjustment).(*api.Adjustment)))
+
+		require.Nil(t, r.CreateWebhook(ctx, proto.Clone(webhook).(*api.Webhook)))
+		require.Nil(t, r.CreateWebhook(ctx, &api.Webhook{Name: entity.WebhookN("guild:1", "b")}))
+		require.Nil(t, r.DeleteWebhook(ctx, entity.WebhookN("guild:1", "b")))
+		require.Nil(t, r.PutDelivery(ctx, proto.Clone(delivery).(*api.WebhookDelivery)))
+		require.Nil(t, r.PutDelivery(ctx, &api.WebhookDelivery{Name: entity.DeliveryN("guild:1", "a", "b")}))
+		require.Nil(t, r.DeleteDelivery(ctx, entity.DeliveryN("guild:1", "a", "b")))
+	}
+	assertState := func(t *testing.T, r *bettorgob.Repo) {
+		u, err := r.GetUser(ctx, user.GetName())
+		require.Nil(t, err)
+		assert.Equal(t, uint64(90), u.GetCentipoints())
+		assert.Equal(t, uint64(10), u.GetUnsettledCentipoints())
+		assert.Equal(t, "2", u.GetEtag())
+		_, err = r.GetMarket(ctx, market.GetName())
+		require.Nil(t, err)
+		_, err = r.GetBet(ctx, bet.GetName())
+		require.Nil(t, err)
+
+		webhooks, _, err := r.ListWebhooks(ctx, &repo.ListWebhooksArgs{Book: entity.BookN("guild:1"), Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, webhooks, 1)
+		assert.True(t, proto.Equal(webhook, webhooks[0]))
+		deliveries, _, err := r.ListDeliveries(ctx, &repo.ListDeliveriesArgs{Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, deliveries, 1)
+		assert.True(t, proto.Equal(delivery, deliveries[0]))
+
+		events, _, err := r.ListEvents(ctx, &repo.ListEventsArgs{Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, events, 1) // the first was deleted
+		assert.Equal(t, uint64(2), events[0].GetSequence())
+		assert.True(t, proto.Equal(betPlaced.GetBetPlaced(), events[0].GetBetPlaced()))
+		cursor, err := r.GetCursor(ctx, "webhooks")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), cursor)
+		snapshots, _, err := r.ListOddsSnapshots(ctx, &repo.ListOddsSnapshotsArgs{Market: market.GetName(), Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, snapshots, 1)
+		assert.True(t, proto.Equal(snapshot, snapshots[0]))
+		grants, _, err := r.ListGrants(ctx, &repo.ListGrantsArgs{Book: entity.BookN("guild:1"), Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, grants, 1)
+		assert.True(t, proto.Equal(grant, grants[0]))
+		gotSettings, err := r.GetBookSettings(ctx, settings.GetName())
+		require.Nil(t, err)
+		assert.True(t, proto.Equal(settings, gotSettings))
+		gotSeason, err := r.GetSeason(ctx, season.GetName())
+		require.Nil(t, err)
+		assert.True(t, proto.Equal(season, gotSeason))
+		transfers, _, err := r.ListTransfers(ctx, &repo.ListTransfersArgs{Book: entity.BookN("guild:1"), Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, transfers, 1)
+		assert.True(t, proto.Equal(transfer, transfers[0]))
+		adjustments, _, err := r.ListAdjustments(ctx, &repo.ListAdjustmentsArgs{Book: entity.BookN("guild:1"), Limit: 10})
+		require.Nil(t, err)
+		require.Len(t, adjustments, 1)
+		assert.True(t, proto.Equal(adjustment, adjustments[0]))
+	}
+	testCases := []struct {
+		desc         string
+		compactEvery int
+		corrupt      func(t *testing.T, fileName string)
+		expectErr    bool
+	}{
+		{
+			desc:         "replays log",
+			compactEvery: 0,
+		},
+		{
+			desc:         "replays log over compacted snapshot",
+			compactEvery: 3,
+		},
+		{
+			desc:         "replays log when snapshot is missing",
+			compactEvery: 0,
+			corrupt: func(t *testing.T, fileName string) {
+				require.Nil(t, os.Remove(fileName))
+			},
+		},
+		{
+			desc:         "truncates torn record at tail",
+			compactEvery: 0,
+			corrupt: func(t *testing.T, fileName string) {
+				f, err := os.OpenFile(fileName+".wal", os.O_WRONLY|os.O_APPEND, 0)
+				require.Nil(t, err)
+				defer f.Close()
+				_, err = f.Write([]byte{0, 0, 1, 0, 1, 2, 3, 4, 5})
+				require.Nil(t, err)
+			},
+		},
+		{
+			desc:         "fails on checksum mismatch",
+			compactEvery: 0,
+			corrupt: func(t *testing.T, fileName string) {
+				b, err := os.ReadFile(fileName + ".wal")
+				require.Nil(t, err)
+				b[10] ^= 0xff
+				require.Nil(t, os.WriteFile(fileName+".wal", b, 0o600))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			fileName := filepath.Join(t.TempDir(), "bettor.gob")
+			r, err := bettorgob.New(fileName)
+			require.Nil(t, err)
+			r.CompactEvery = tC.compactEvery
+			write(t, r)
+			require.Nil(t, r.Close())
+			if tC.corrupt != nil {
+				tC.corrupt(t, fileName)
+			}
+
+			r, err = bettorgob.New(fileName)
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			defer r.Close()
+			assertState(t, r)
+
+			// startup compacts the log into the snapshot
+			info, err := os.Stat(fileName + ".wal")
+			require.Nil(t, err)
+			assert.Equal(t, int64(0), info.Size())
+		})
+	}
+}
+
+func TestWriteFailures(t *testing.T) {
+	ctx := context.Background()
+	t.Run("write is not applied if it cannot be logged", func(t *testing.T) {
+		r, err := bettorgob.New(filepath.Join(t.TempDir(), "bettor.gob"))
+		require.Nil(t, err)
+		require.Nil(t, r.Close())
+		user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+		require.NotNil(t, r.CreateUser(ctx, user))
+		assert.Empty(t, user.GetEtag())
+		_, err = r.GetUser(ctx, user.GetName())
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+	t.Run("compaction failure does not fail a logged write", func(t *testing.T) {
+		fileName := filepath.Join(t.TempDir(), "bettor.gob")
+		r, err := bettorgob.New(fileName)
+		require.Nil(t, err)
+		defer r.Close()
+		r.CompactEvery = 1
+		r.FileName = filepath.Join(t.TempDir(), "missing", "bettor.gob") // snapshot cannot be created
+		user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+		require.Nil(t, r.CreateUser(ctx, user))
+		_, err = r.GetUser(ctx, user.GetName())
+		require.Nil(t, err)
+
+		// the write is still in the log
+		info, err := os.Stat(fileName + ".wal")
+		require.Nil(t, err)
+		assert.NotZero(t, info.Size())
+	})
+}
+
+func TestLoadsLegacySnapshot(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "bettor.gob")
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	f, err := os.Create(fileName)
+	require.Nil(t, err)
+	require.Nil(t, gob.NewEncoder(f).Encode(&mem.Repo{Users: []*api.User{user}}))
+	require.Nil(t, f.Close())
+
+	r, err := bettorgob.New(fileName)
+	require.Nil(t, err)
+	defer r.Close()
+	got, err := r.GetUser(context.Background(), user.GetName())
+	require.Nil(t, err)
+	assert.True(t, proto.Equal(user, got))
+}
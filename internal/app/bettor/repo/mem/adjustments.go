@@ -0,0 +1,67 @@
+package mem
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateAdjustment creates a new adjustment.
+func (r *Repo) CreateAdjustment(_ context.Context, adjustment *api.Adjustment) error {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	if _, ok := nameIndex(r.Adjustments, adjustment.GetName()); ok {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("adjustment with id already exists"))
+	}
+	stored := proto.Clone(adjustment).(*api.Adjustment)
+	return r.commit(&Write{Puts: []proto.Message{stored}}, func() { r.Adjustments = insertSorted(r.Adjustments, stored) })
+}
+
+// PutAdjustment creates or overwrites a adjustment. It is used to replay persisted writes.
+func (r *Repo) PutAdjustment(_ context.Context, adjustment *api.Adjustment) error {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	adjustment = proto.Clone(adjustment).(*api.Adjustment)
+	if _, ok := nameIndex(r.Adjustments, adjustment.GetName()); ok {
+		replaceSorted(r.Adjustments, adjustment)
+		return nil
+	}
+	r.Adjustments = insertSorted(r.Adjustments, adjustment)
+	return nil
+}
+
+// ListAdjustments lists adjustments by filters.
+func (r *Repo) ListAdjustments(_ context.Context, args *repo.ListAdjustmentsArgs) (adjustments []*api.Adjustment, hasMore bool, err error) {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	bookID := entity.BooksIDs(args.Book)
+	start := args.GreaterThanName
+	if prefix := entity.AdjustmentN(bookID, ""); start < prefix {
+		start = prefix
+	}
+	var out []*api.Adjustment
+	for _, a := range after(r.Adjustments, start) {
+		if b, _ := entity.AdjustmentIDs(a.GetName()); b != bookID {
+			break
+		}
+		if args.User != "" && a.GetUser() != args.User {
+			continue
+		}
+		out = append(out, proto.Clone(a).(*api.Adjustment))
+		if len(out) >= args.Limit+1 {
+			break
+		}
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
@@ -0,0 +1,57 @@
+package mem
+
+import (
+	"context"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"google.golang.org/protobuf/proto"
+)
+
+// GetUsers gets the users with the given names that exist.
+func (r *Repo) GetUsers(ctx context.Context, names []string) ([]*api.User, error) {
+	r.userMtx.RLock()
+	defer r.userMtx.RUnlock()
+	idx := r.userIndex()
+	var out []*api.User //nolint:prealloc
+	for _, name := range names {
+		u, ok := idx.byName[name]
+		if !ok {
+			continue
+		}
+		u, err := r.hydrateUser(ctx, u)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, u)
+	}
+	return out, nil
+}
+
+// GetMarkets gets the markets with the given names that exist.
+func (r *Repo) GetMarkets(_ context.Context, names []string) ([]*api.Market, error) {
+	r.marketMtx.RLock()
+	defer r.marketMtx.RUnlock()
+	idx := r.marketIndex()
+	var out []*api.Market //nolint:prealloc
+	for _, name := range names {
+		if m, ok := idx.byName[name]; ok {
+			out = append(out, proto.Clone(m).(*api.Market))
+		}
+	}
+	return out, nil
+}
+
+// GetBets gets the bets with the given names that exist.
+func (r *Repo) GetBets(_ context.Context, names []string) ([]*api.Bet, error) {
+	r.betMtx.RLock()
+	defer r.betMtx.RUnlock()
+	idx := r.betIndex()
+	var out []*api.Bet //nolint:prealloc
+	for _, name := range names {
+		if b, ok := idx.byName[name]; ok {
+			out = append(out, proto.Clone(b).(*api.Bet))
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,81 @@
+package mem
+
+import (
+	"context"
+	"sort"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// eventIndex returns the index of the first event with a sequence greater than sequence. eventMtx must be held.
+func (r *Repo) eventIndex(sequence uint64) int {
+	return sort.Search(len(r.Events), func(i int) bool { return r.Events[i].GetSequence() > sequence })
+}
+
+// PutEvent creates or overwrites an event as is, keeping its sequence. It is used to replay persisted writes.
+func (r *Repo) PutEvent(_ context.Context, event *api.DomainEvent) error {
+	r.eventMtx.Lock()
+	defer r.eventMtx.Unlock()
+	event = proto.Clone(event).(*api.DomainEvent)
+	i := r.eventIndex(event.GetSequence() - 1)
+	if i < len(r.Events) && r.Events[i].GetSequence() == event.GetSequence() {
+		r.Events[i] = event
+		return nil
+	}
+	r.Events = append(r.Events, nil)
+	copy(r.Events[i+1:], r.Events[i:])
+	r.Events[i] = event
+	return nil
+}
+
+// ListEvents lists events in sequence order.
+func (r *Repo) ListEvents(_ context.Context, args *repo.ListEventsArgs) (events []*api.DomainEvent, hasMore bool, err error) {
+	r.eventMtx.Lock()
+	defer r.eventMtx.Unlock()
+	var out []*api.DomainEvent //nolint:prealloc
+	for _, e := range r.Events[r.eventIndex(args.AfterSequence):] {
+		if len(out) == args.Limit {
+			return out, true, nil
+		}
+		out = append(out, proto.Clone(e).(*api.DomainEvent))
+	}
+	return out, false, nil
+}
+
+// DeleteEvents deletes events through sequence besides the newest event.
+func (r *Repo) DeleteEvents(_ context.Context, sequence uint64) error {
+	r.eventMtx.Lock()
+	defer r.eventMtx.Unlock()
+	i := r.eventIndex(sequence)
+	if i == len(r.Events) {
+		i-- // keep the newest event so that sequences keep increasing
+	}
+	if i <= 0 {
+		return nil
+	}
+	through := &api.DomainEvent{Sequence: r.Events[i-1].GetSequence()}
+	return r.commit(&Write{Deletes: []proto.Message{through}}, func() {
+		r.Events = append([]*api.DomainEvent(nil), r.Events[i:]...)
+	})
+}
+
+// GetCursor returns a consumer's cursor.
+func (r *Repo) GetCursor(_ context.Context, consumer string) (uint64, error) {
+	r.eventMtx.Lock()
+	defer r.eventMtx.Unlock()
+	return r.Cursors[consumer], nil
+}
+
+// PutCursor sets a consumer's cursor.
+func (r *Repo) PutCursor(_ context.Context, consumer string, sequence uint64) error {
+	r.eventMtx.Lock()
+	defer r.eventMtx.Unlock()
+	return r.commit(&Write{Puts: []proto.Message{&api.ConsumerCursor{Consumer: consumer, Sequence: sequence}}}, func() {
+		if r.Cursors == nil {
+			r.Cursors = map[string]uint64{}
+		}
+		r.Cursors[consumer] = sequence
+	})
+}
@@ -0,0 +1,105 @@
+package mem
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// sortBooks sorts seeded Grants, Settings, Seasons, Transfers, and Adjustments on first use. bookMtx must be held.
+func (r *Repo) sortBooks() {
+	r.bookOnce.Do(func() {
+		sortByName(r.Grants)
+		sortByName(r.Settings)
+		sortByName(r.Seasons)
+		sortByName(r.Transfers)
+		sortByName(r.Adjustments)
+	})
+}
+
+// CreateGrant creates a new grant.
+func (r *Repo) CreateGrant(_ context.Context, grant *api.Grant) error {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	if _, ok := nameIndex(r.Grants, grant.GetName()); ok {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("grant with id already exists"))
+	}
+	stored := proto.Clone(grant).(*api.Grant)
+	return r.commit(&Write{Puts: []proto.Message{stored}}, func() { r.Grants = insertSorted(r.Grants, stored) })
+}
+
+// PutGrant creates or overwrites a grant. It is used to replay persisted writes.
+func (r *Repo) PutGrant(_ context.Context, grant *api.Grant) error {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	grant = proto.Clone(grant).(*api.Grant)
+	if _, ok := nameIndex(r.Grants, grant.GetName()); ok {
+		replaceSorted(r.Grants, grant)
+		return nil
+	}
+	r.Grants = insertSorted(r.Grants, grant)
+	return nil
+}
+
+// ListGrants lists grants by filters.
+func (r *Repo) ListGrants(_ context.Context, args *repo.ListGrantsArgs) (grants []*api.Grant, hasMore bool, err error) {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	bookID := entity.BooksIDs(args.Book)
+	start := args.GreaterThanName
+	if prefix := entity.GrantN(bookID, ""); start < prefix {
+		start = prefix
+	}
+	var out []*api.Grant
+	for _, g := range after(r.Grants, start) {
+		if b, _ := entity.GrantIDs(g.GetName()); b != bookID {
+			break
+		}
+		if args.User != "" && g.GetUser() != args.User {
+			continue
+		}
+		out = append(out, proto.Clone(g).(*api.Grant))
+		if len(out) >= args.Limit+1 {
+			break
+		}
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// GetBookSettings gets a book's settings by name.
+func (r *Repo) GetBookSettings(_ context.Context, name string) (*api.BookSettings, error) {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	i, ok := nameIndex(r.Settings, name)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("book settings not found"))
+	}
+	return proto.Clone(r.Settings[i]).(*api.BookSettings), nil
+}
+
+// PutBookSettings creates or overwrites a book's settings.
+func (r *Repo) PutBookSettings(_ context.Context, settings *api.BookSettings) error {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	settings = proto.Clone(settings).(*api.BookSettings)
+	return r.commit(&Write{Puts: []proto.Message{settings}}, func() {
+		if _, ok := nameIndex(r.Settings, settings.GetName()); ok {
+			replaceSorted(r.Settings, settings)
+			return
+		}
+		r.Settings = insertSorted(r.Settings, settings)
+	})
+}
@@ -0,0 +1,122 @@
+package mem
+
+import (
+	"sort"
+
+	"github.com/elh/bettor/internal/app/bettor/repo"
+)
+
+// leaderboard orders each book's users by total centipoints desc then name asc. It is built from the user and bet
+// indexes the first time it is needed and is maintained on every write after that.
+type leaderboard struct {
+	totals map[bookUser]*userTotal
+	byBook map[string][]boardEntry // book id -> entries in leaderboard order
+}
+
+// userTotal holds the components of a user's total. Unsettled centipoints can be tracked before the user exists.
+type userTotal struct {
+	exists      bool
+	centipoints uint64
+	unsettled   uint64
+}
+
+func (t *userTotal) total() uint64 {
+	return t.centipoints + t.unsettled
+}
+
+type boardEntry struct {
+	name      string
+	total     uint64
+	unsettled uint64
+}
+
+func (e boardEntry) before(o boardEntry) bool {
+	return e.total > o.total || (e.total == o.total && e.name < o.name)
+}
+
+// set applies fn to a user's total and moves them to their new position.
+func (b *leaderboard) set(bu bookUser, fn func(t *userTotal)) {
+	t, ok := b.totals[bu]
+	if !ok {
+		t = &userTotal{}
+		b.totals[bu] = t
+	}
+	entries := b.byBook[bu.book]
+	if t.exists {
+		old := boardEntry{name: bu.user, total: t.total()}
+		i := sort.Search(len(entries), func(i int) bool { return !entries[i].before(old) })
+		if i < len(entries) && entries[i].name == bu.user {
+			entries = append(entries[:i], entries[i+1:]...)
+		}
+	}
+	fn(t)
+	if t.exists {
+		e := boardEntry{name: bu.user, total: t.total(), unsettled: t.unsettled}
+		i := sort.Search(len(entries), func(i int) bool { return !entries[i].before(e) })
+		entries = append(entries, boardEntry{})
+		copy(entries[i+1:], entries[i:])
+		entries[i] = e
+	}
+	b.byBook[bu.book] = entries
+}
+
+// page returns up to limit entries of the book after cursor, only including names in users if it is not empty.
+func (b *leaderboard) page(bookID string, cursor *repo.OrderCursor, users map[string]bool, limit int) []boardEntry {
+	entries := b.byBook[bookID]
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(entries), func(i int) bool { return cursor.After(entries[i].total, entries[i].name) })
+	}
+	var out []boardEntry
+	for _, e := range entries[start:] {
+		if len(out) >= limit {
+			break
+		}
+		if len(users) > 0 && !users[e.name] {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// leaderboardPage returns a page of the leaderboard, building it if needed. userMtx must be held.
+func (r *Repo) leaderboardPage(bookID string, cursor *repo.OrderCursor, users map[string]bool, limit int) []boardEntry {
+	r.betMtx.RLock()
+	defer r.betMtx.RUnlock()
+	r.boardMtx.Lock()
+	defer r.boardMtx.Unlock()
+	if r.board == nil {
+		board := &leaderboard{totals: map[bookUser]*userTotal{}, byBook: map[string][]boardEntry{}}
+		for bu, unsettled := range r.betIndex().unsettled {
+			board.totals[bu] = &userTotal{unsettled: unsettled}
+		}
+		for bookID, users := range r.userIndex().byBook {
+			entries := make([]boardEntry, 0, len(users))
+			for _, u := range users {
+				bu := bookUser{bookID, u.GetName()}
+				t, ok := board.totals[bu]
+				if !ok {
+					t = &userTotal{}
+					board.totals[bu] = t
+				}
+				t.exists = true
+				t.centipoints = u.GetCentipoints()
+				entries = append(entries, boardEntry{name: u.GetName(), total: t.total(), unsettled: t.unsettled})
+			}
+			sort.Slice(entries, func(i, j int) bool { return entries[i].before(entries[j]) })
+			board.byBook[bookID] = entries
+		}
+		r.board = board
+	}
+	return r.board.page(bookID, cursor, users, limit)
+}
+
+// updateLeaderboard applies fn to the leaderboard if it has been built. userMtx or betMtx must be held.
+func (r *Repo) updateLeaderboard(fn func(b *leaderboard)) {
+	r.boardMtx.Lock()
+	defer r.boardMtx.Unlock()
+	if r.board != nil {
+		fn(r.board)
+	}
+}
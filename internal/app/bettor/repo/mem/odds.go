@@ -0,0 +1,58 @@
+package mem
+
+import (
+	"context"
+	"sort"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// oddsIndex returns the index of the first snapshot with a revision greater than revision.
+func oddsIndex(snapshots []*api.OddsSnapshot, revision uint64) int {
+	return sort.Search(len(snapshots), func(i int) bool { return snapshots[i].GetRevision() > revision })
+}
+
+// PutOddsSnapshot creates or overwrites the snapshot of a market at its revision.
+func (r *Repo) PutOddsSnapshot(_ context.Context, snapshot *api.OddsSnapshot) error {
+	r.oddsMtx.Lock()
+	defer r.oddsMtx.Unlock()
+	snapshot = proto.Clone(snapshot).(*api.OddsSnapshot)
+	return r.commit(&Write{Puts: []proto.Message{snapshot}}, func() { r.putOddsSnapshot(snapshot) })
+}
+
+// oddsMtx must be held.
+func (r *Repo) putOddsSnapshot(snapshot *api.OddsSnapshot) {
+	if r.Odds == nil {
+		r.Odds = map[string][]*api.OddsSnapshot{}
+	}
+	snapshots := r.Odds[snapshot.GetMarket()]
+	i := oddsIndex(snapshots, snapshot.GetRevision()-1)
+	if i < len(snapshots) && snapshots[i].GetRevision() == snapshot.GetRevision() {
+		snapshots[i] = snapshot
+		return
+	}
+	snapshots = append(snapshots, nil)
+	copy(snapshots[i+1:], snapshots[i:])
+	snapshots[i] = snapshot
+	if len(snapshots) > repo.MaxOddsSnapshots {
+		snapshots = append([]*api.OddsSnapshot(nil), snapshots[len(snapshots)-repo.MaxOddsSnapshots:]...)
+	}
+	r.Odds[snapshot.GetMarket()] = snapshots
+}
+
+// ListOddsSnapshots lists a market's odds snapshots in revision order.
+func (r *Repo) ListOddsSnapshots(_ context.Context, args *repo.ListOddsSnapshotsArgs) (snapshots []*api.OddsSnapshot, hasMore bool, err error) {
+	r.oddsMtx.Lock()
+	defer r.oddsMtx.Unlock()
+	all := r.Odds[args.Market]
+	var out []*api.OddsSnapshot //nolint:prealloc
+	for _, s := range all[oddsIndex(all, args.AfterRevision):] {
+		if len(out) == args.Limit {
+			return out, true, nil
+		}
+		out = append(out, proto.Clone(s).(*api.OddsSnapshot))
+	}
+	return out, false, nil
+}
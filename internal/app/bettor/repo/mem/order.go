@@ -0,0 +1,64 @@
+package mem
+
+import (
+	"sort"
+
+	"github.com/elh/bettor/internal/app/bettor/repo"
+)
+
+// keyedOrders are the orders kept by orderIndex.
+var keyedOrders = []string{repo.OrderByCreateTime, repo.OrderByTotalCentipoints, repo.OrderBySettledTime}
+
+// orderIndex keeps groups of resources sorted in each keyed order so pages can be read from a cursor without sorting.
+type orderIndex[T named] struct {
+	key     func(orderBy string, x T) uint64
+	byOrder map[string]map[string][]T // order by -> group -> resources in order
+}
+
+func newOrderIndex[T named](key func(orderBy string, x T) uint64) orderIndex[T] {
+	idx := orderIndex[T]{key: key, byOrder: map[string]map[string][]T{}}
+	for _, orderBy := range keyedOrders {
+		idx.byOrder[orderBy] = map[string][]T{}
+	}
+	return idx
+}
+
+// search returns the position of the first resource in group that sorts after key and name, or at them if inclusive.
+func (idx *orderIndex[T]) search(orderBy, group string, key uint64, name string, inclusive bool) int {
+	xs := idx.byOrder[orderBy][group]
+	return sort.Search(len(xs), func(i int) bool {
+		k, n := idx.key(orderBy, xs[i]), xs[i].GetName()
+		return k < key || (k == key && (n > name || (inclusive && n == name)))
+	})
+}
+
+func (idx *orderIndex[T]) add(group string, x T) {
+	for _, orderBy := range keyedOrders {
+		i := idx.search(orderBy, group, idx.key(orderBy, x), x.GetName(), true)
+		xs := idx.byOrder[orderBy][group]
+		var zero T
+		xs = append(xs, zero)
+		copy(xs[i+1:], xs[i:])
+		xs[i] = x
+		idx.byOrder[orderBy][group] = xs
+	}
+}
+
+// remove removes x, which must be the resource as it was indexed.
+func (idx *orderIndex[T]) remove(group string, x T) {
+	for _, orderBy := range keyedOrders {
+		i := idx.search(orderBy, group, idx.key(orderBy, x), x.GetName(), true)
+		xs := idx.byOrder[orderBy][group]
+		if i < len(xs) && xs[i].GetName() == x.GetName() {
+			idx.byOrder[orderBy][group] = append(xs[:i], xs[i+1:]...)
+		}
+	}
+}
+
+// after returns the resources of group in order after cursor.
+func (idx *orderIndex[T]) after(orderBy, group string, cursor *repo.OrderCursor) []T {
+	if cursor == nil {
+		return idx.byOrder[orderBy][group]
+	}
+	return idx.byOrder[orderBy][group][idx.search(orderBy, group, cursor.Value, cursor.Name, false):]
+}
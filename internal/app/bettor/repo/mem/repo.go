@@ -16,166 +16,416 @@ import (
 var _ repo.Repo = (*Repo)(nil)
 
 // Repo is an in-memory persistence repository.
+//
+// Users, Markets, Bets, Webhooks, Deliveries, Grants, Settings, Seasons, Transfers, and Adjustments may be set directly
+// to seed the repo and are what gets persisted by wrapping repos along with Events, Cursors, and Odds. They are kept
+// sorted by name, and Events by sequence. Secondary indexes are built from them lazily on first use and are maintained
+// incrementally after that, so the slices must not be modified directly once the repo is in use. Resources are copied
+// on the way in and out so callers never share state with the repo.
 type Repo struct {
-	Users     []*api.User
-	Markets   []*api.Market
-	Bets      []*api.Bet
-	userMtx   sync.RWMutex
-	marketMtx sync.RWMutex
-	betMtx    sync.RWMutex
+	Users       []*api.User
+	Markets     []*api.Market
+	Bets        []*api.Bet
+	Webhooks    []*api.Webhook
+	Deliveries  []*api.WebhookDelivery
+	Events      []*api.DomainEvent
+	Cursors     map[string]uint64              // consumer -> sequence
+	Odds        map[string][]*api.OddsSnapshot // market -> snapshots sorted by revision
+	Grants      []*api.Grant
+	Settings    []*api.BookSettings
+	Seasons     []*api.Season
+	Transfers   []*api.Transfer
+	Adjustments []*api.Adjustment
+	// WriteAhead, if set, is called with every validated write before it is applied. The write is not applied if it
+	// returns an error. Wrapping repos use it to persist writes before they become visible.
+	WriteAhead func(w *Write) error
+
+	userMtx    sync.RWMutex
+	marketMtx  sync.RWMutex
+	betMtx     sync.RWMutex
+	webhookMtx sync.Mutex // guards Webhooks and Deliveries
+	eventMtx   sync.Mutex // guards Events and Cursors. acquired after userMtx, marketMtx, and betMtx
+	oddsMtx    sync.Mutex // guards Odds
+	bookMtx    sync.Mutex // guards Grants, Settings, Seasons, Transfers, and Adjustments
+
+	userIdxOnce   sync.Once
+	userIdx       userIndex
+	marketIdxOnce sync.Once
+	marketIdx     marketIndex
+	betIdxOnce    sync.Once
+	betIdx        betIndex
+	webhookOnce   sync.Once  // sorts Webhooks and Deliveries
+	bookOnce      sync.Once  // sorts Grants, Settings, Seasons, Transfers, and Adjustments
+	boardMtx      sync.Mutex // acquired after userMtx and betMtx
+	board         *leaderboard
 }
 
-// hydrate virtual fields like unsettled_centipoints.
-func (r *Repo) hydrateUser(ctx context.Context, user *api.User) (*api.User, error) {
-	bookID, _ := entity.UserIDs(user.GetName())
-	bets, _, err := r.ListBets(ctx, &repo.ListBetsArgs{
-		Book:           entity.BookN(bookID),
-		User:           user.GetName(),
-		ExcludeSettled: true,
-		Limit:          1000, // no pagination here
+type userIndex struct {
+	byName         map[string]*api.User
+	byBook         map[string][]*api.User          // book id -> users sorted by name
+	byBookUsername map[string]map[string]*api.User // book id -> username -> user
+	usernames      map[string]string               // name -> indexed username
+}
+
+type marketIndex struct {
+	byName  map[string]*api.Market
+	byBook  map[string][]*api.Market // book id -> markets sorted by name
+	ordered orderIndex[*api.Market]  // grouped by book id
+}
+
+type betIndex struct {
+	byName    map[string]*api.Bet
+	byBook    map[string][]*api.Bet // book id -> bets sorted by name
+	byUser    map[string][]*api.Bet // user -> bets sorted by name
+	byMarket  map[string][]*api.Bet // market -> bets sorted by name
+	ordered   orderIndex[*api.Bet]  // grouped by book id, user, and market
+	unsettled map[bookUser]uint64   // sum of unsettled bet centipoints
+}
+
+// bookUser keys unsettled totals. Only bets in the user's own book count towards their unsettled centipoints.
+type bookUser struct {
+	book string
+	user string
+}
+
+// named is a resource with a name. Resources are stored in slices sorted by name.
+type named interface {
+	GetName() string
+}
+
+func nameIndex[T named](xs []T, name string) (int, bool) {
+	i := sort.Search(len(xs), func(i int) bool { return xs[i].GetName() >= name })
+	return i, i < len(xs) && xs[i].GetName() == name
+}
+
+func insertSorted[T named](xs []T, x T) []T {
+	i, _ := nameIndex(xs, x.GetName())
+	var zero T
+	xs = append(xs, zero)
+	copy(xs[i+1:], xs[i:])
+	xs[i] = x
+	return xs
+}
+
+func replaceSorted[T named](xs []T, x T) {
+	if i, ok := nameIndex(xs, x.GetName()); ok {
+		xs[i] = x
+	}
+}
+
+func removeSorted[T named](xs []T, name string) []T {
+	if i, ok := nameIndex(xs, name); ok {
+		return append(xs[:i], xs[i+1:]...)
+	}
+	return xs
+}
+
+// after returns the elements of xs with names greater than name.
+func after[T named](xs []T, name string) []T {
+	i := sort.Search(len(xs), func(i int) bool { return xs[i].GetName() > name })
+	return xs[i:]
+}
+
+func sortByName[T named](xs []T) {
+	sort.SliceStable(xs, func(i, j int) bool {
+		return xs[i].GetName() < xs[j].GetName()
 	})
-	if err != nil {
-		return nil, err
+}
+
+func (r *Repo) userIndex() *userIndex {
+	r.userIdxOnce.Do(func() {
+		sortByName(r.Users)
+		r.userIdx = userIndex{
+			byName:         map[string]*api.User{},
+			byBook:         map[string][]*api.User{},
+			byBookUsername: map[string]map[string]*api.User{},
+			usernames:      map[string]string{},
+		}
+		for _, u := range r.Users {
+			r.userIdx.add(u)
+		}
+	})
+	return &r.userIdx
+}
+
+func (idx *userIndex) add(u *api.User) {
+	bookID, _ := entity.UserIDs(u.GetName())
+	idx.byName[u.GetName()] = u
+	idx.byBook[bookID] = insertSorted(idx.byBook[bookID], u)
+	if idx.byBookUsername[bookID] == nil {
+		idx.byBookUsername[bookID] = map[string]*api.User{}
 	}
+	idx.byBookUsername[bookID][u.GetUsername()] = u
+	idx.usernames[u.GetName()] = u.GetUsername()
+}
+
+func (idx *userIndex) replace(u *api.User) {
+	bookID, _ := entity.UserIDs(u.GetName())
+	idx.byName[u.GetName()] = u
+	replaceSorted(idx.byBook[bookID], u)
+	delete(idx.byBookUsername[bookID], idx.usernames[u.GetName()])
+	idx.byBookUsername[bookID][u.GetUsername()] = u
+	idx.usernames[u.GetName()] = u.GetUsername()
+}
+
+func (r *Repo) marketIndex() *marketIndex {
+	r.marketIdxOnce.Do(func() {
+		sortByName(r.Markets)
+		r.marketIdx = marketIndex{
+			byName:  map[string]*api.Market{},
+			byBook:  map[string][]*api.Market{},
+			ordered: newOrderIndex(repo.MarketOrderKey),
+		}
+		for _, m := range r.Markets {
+			r.marketIdx.add(m)
+		}
+	})
+	return &r.marketIdx
+}
+
+func (idx *marketIndex) add(m *api.Market) {
+	bookID, _ := entity.MarketIDs(m.GetName())
+	idx.byName[m.GetName()] = m
+	idx.byBook[bookID] = insertSorted(idx.byBook[bookID], m)
+	idx.ordered.add(bookID, m)
+}
+
+func (idx *marketIndex) replace(m *api.Market) {
+	bookID, _ := entity.MarketIDs(m.GetName())
+	idx.ordered.remove(bookID, idx.byName[m.GetName()])
+	idx.ordered.add(bookID, m)
+	idx.byName[m.GetName()] = m
+	replaceSorted(idx.byBook[bookID], m)
+}
 
-	var unsettledCentipoints uint64
-	for _, b := range bets {
-		unsettledCentipoints += b.GetCentipoints()
+func (r *Repo) betIndex() *betIndex {
+	r.betIdxOnce.Do(func() {
+		sortByName(r.Bets)
+		r.betIdx = betIndex{
+			byName:    map[string]*api.Bet{},
+			byBook:    map[string][]*api.Bet{},
+			byUser:    map[string][]*api.Bet{},
+			byMarket:  map[string][]*api.Bet{},
+			ordered:   newOrderIndex(repo.BetOrderKey),
+			unsettled: map[bookUser]uint64{},
+		}
+		for _, b := range r.Bets {
+			r.betIdx.add(b)
+		}
+	})
+	return &r.betIdx
+}
+
+func (idx *betIndex) add(b *api.Bet) {
+	bookID, _ := entity.BetIDs(b.GetName())
+	idx.byName[b.GetName()] = b
+	idx.byBook[bookID] = insertSorted(idx.byBook[bookID], b)
+	idx.byUser[b.GetUser()] = insertSorted(idx.byUser[b.GetUser()], b)
+	idx.byMarket[b.GetMarket()] = insertSorted(idx.byMarket[b.GetMarket()], b)
+	for _, group := range []string{bookID, b.GetUser(), b.GetMarket()} {
+		idx.ordered.add(group, b)
+	}
+	idx.unsettled[bookUser{bookID, b.GetUser()}] += unsettledCentipoints(b)
+}
+
+func (idx *betIndex) replace(b *api.Bet) {
+	bookID, _ := entity.BetIDs(b.GetName())
+	prev := idx.byName[b.GetName()]
+	idx.unsettled[bookUser{bookID, prev.GetUser()}] -= unsettledCentipoints(prev)
+	if prev.GetUser() != b.GetUser() {
+		idx.byUser[prev.GetUser()] = removeSorted(idx.byUser[prev.GetUser()], b.GetName())
+		idx.byUser[b.GetUser()] = insertSorted(idx.byUser[b.GetUser()], b)
+	}
+	if prev.GetMarket() != b.GetMarket() {
+		idx.byMarket[prev.GetMarket()] = removeSorted(idx.byMarket[prev.GetMarket()], b.GetName())
+		idx.byMarket[b.GetMarket()] = insertSorted(idx.byMarket[b.GetMarket()], b)
 	}
+	replaceSorted(idx.byBook[bookID], b)
+	replaceSorted(idx.byUser[b.GetUser()], b)
+	replaceSorted(idx.byMarket[b.GetMarket()], b)
+	for _, group := range []string{bookID, prev.GetUser(), prev.GetMarket()} {
+		idx.ordered.remove(group, prev)
+	}
+	for _, group := range []string{bookID, b.GetUser(), b.GetMarket()} {
+		idx.ordered.add(group, b)
+	}
+	idx.byName[b.GetName()] = b
+	idx.unsettled[bookUser{bookID, b.GetUser()}] += unsettledCentipoints(b)
+}
+
+func unsettledCentipoints(b *api.Bet) uint64 {
+	if b.GetSettledAt() != nil {
+		return 0
+	}
+	return b.GetCentipoints()
+}
+
+// hydrate virtual fields like unsettled_centipoints.
+func (r *Repo) hydrateUser(_ context.Context, user *api.User) (*api.User, error) {
+	r.betMtx.RLock()
+	defer r.betMtx.RUnlock()
+	bookID, _ := entity.UserIDs(user.GetName())
 	userCopy := proto.Clone(user).(*api.User)
-	userCopy.UnsettledCentipoints = unsettledCentipoints
+	userCopy.UnsettledCentipoints = r.betIndex().unsettled[bookUser{bookID, user.GetName()}]
 	return userCopy, nil
 }
 
 // CreateUser creates a new user.
-func (r *Repo) CreateUser(_ context.Context, user *api.User) error {
+func (r *Repo) CreateUser(_ context.Context, user *api.User, events ...*api.DomainEvent) error {
 	r.userMtx.Lock()
 	defer r.userMtx.Unlock()
+	idx := r.userIndex()
 
 	user.UnsettledCentipoints = 0 // defensive
 
+	if _, ok := idx.byName[user.GetName()]; ok {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("user with id already exists"))
+	}
 	bookID, _ := entity.UserIDs(user.GetName())
-	for _, u := range r.Users {
-		if u.GetName() == user.GetName() {
-			return connect.NewError(connect.CodeInvalidArgument, errors.New("user with id already exists"))
-		}
-		uBookID, _ := entity.UserIDs(u.GetName())
-		if bookID == uBookID && u.Username == user.Username {
-			return connect.NewError(connect.CodeInvalidArgument, errors.New("user with username already exists in book"))
-		}
+	if _, ok := idx.byBookUsername[bookID][user.GetUsername()]; ok {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("user with username already exists in book"))
 	}
-	r.Users = append(r.Users, user)
-	sort.SliceStable(r.Users, func(i, j int) bool {
-		return r.Users[i].Name < r.Users[j].Name
-	})
+	stored := proto.Clone(user).(*api.User)
+	stored.Etag = repo.NextEtag("")
+	if err := r.commit(&Write{Puts: []proto.Message{stored}, Events: events}, func() { r.putUser(stored) }); err != nil {
+		return err
+	}
+	user.Etag = stored.GetEtag()
 	return nil
 }
 
 // UpdateUser updates a user.
-func (r *Repo) UpdateUser(_ context.Context, user *api.User) error {
+func (r *Repo) UpdateUser(_ context.Context, user *api.User, events ...*api.DomainEvent) error {
 	r.userMtx.Lock()
 	defer r.userMtx.Unlock()
-	var found bool
-	var idx int
-	for i, u := range r.Users {
-		if u.GetName() == user.GetName() {
-			found = true
-			idx = i
-			break
-		}
-	}
-	if !found {
+	stored, ok := r.userIndex().byName[user.GetName()]
+	if !ok {
 		return connect.NewError(connect.CodeNotFound, errors.New("user not found"))
 	}
-	r.Users[idx] = user
+	if stored.GetEtag() != user.GetEtag() {
+		return connect.NewError(connect.CodeAborted, errors.New("user has been modified"))
+	}
+	stored = proto.Clone(user).(*api.User)
+	stored.Etag = repo.NextEtag(user.GetEtag())
+	if err := r.commit(&Write{Puts: []proto.Message{stored}, Events: events}, func() { r.putUser(stored) }); err != nil {
+		return err
+	}
+	user.Etag = stored.GetEtag()
+	return nil
+}
+
+// PutUser creates or overwrites a user as is, without checking its etag. It is used to replay persisted writes.
+func (r *Repo) PutUser(_ context.Context, user *api.User) error {
+	r.userMtx.Lock()
+	defer r.userMtx.Unlock()
+	r.putUser(proto.Clone(user).(*api.User))
 	return nil
 }
 
+// userMtx must be held.
+func (r *Repo) putUser(user *api.User) {
+	idx := r.userIndex()
+	if _, ok := idx.byName[user.GetName()]; ok {
+		replaceSorted(r.Users, user)
+		idx.replace(user)
+	} else {
+		r.Users = insertSorted(r.Users, user)
+		idx.add(user)
+	}
+	bookID, _ := entity.UserIDs(user.GetName())
+	r.updateLeaderboard(func(b *leaderboard) {
+		b.set(bookUser{bookID, user.GetName()}, func(t *userTotal) {
+			t.exists = true
+			t.centipoints = user.GetCentipoints()
+		})
+	})
+}
+
 // GetUser gets a user by ID.
 func (r *Repo) GetUser(ctx context.Context, name string) (*api.User, error) {
 	r.userMtx.RLock()
 	defer r.userMtx.RUnlock()
-	for _, u := range r.Users {
-		if u.GetName() == name {
-			u, err := r.hydrateUser(ctx, u)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, err)
-			}
-			return u, nil
-		}
+	u, ok := r.userIndex().byName[name]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
 	}
-	return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	u, err := r.hydrateUser(ctx, u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return u, nil
 }
 
 // GetUserByUsername gets a user by username.
 func (r *Repo) GetUserByUsername(ctx context.Context, book, username string) (*api.User, error) {
 	r.userMtx.RLock()
 	defer r.userMtx.RUnlock()
-	bookID := entity.BooksIDs(book)
-	for _, u := range r.Users {
-		uBookID, _ := entity.UserIDs(u.GetName())
-		if uBookID == bookID && u.Username == username {
-			u, err := r.hydrateUser(ctx, u)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, err)
-			}
-			return u, nil
-		}
+	u, ok := r.userIndex().byBookUsername[entity.BooksIDs(book)][username]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
 	}
-	return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	u, err := r.hydrateUser(ctx, u)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return u, nil
 }
 
 // ListUsers lists users by filters.
 func (r *Repo) ListUsers(ctx context.Context, args *repo.ListUsersArgs) (users []*api.User, hasMore bool, err error) {
 	r.userMtx.RLock()
 	defer r.userMtx.RUnlock()
+	idx := r.userIndex()
 	bookID := entity.BooksIDs(args.Book)
 
-	// hydrate
-	var hydratedUsers []*api.User //nolint:prealloc
-	for _, u := range r.Users {
-		u, err := r.hydrateUser(ctx, u)
-		if err != nil {
-			return nil, false, connect.NewError(connect.CodeInternal, err)
-		}
-		hydratedUsers = append(hydratedUsers, u)
-	}
-
-	var orderedUsers []*api.User
+	var out []*api.User
 	switch args.OrderBy {
 	case "", "name":
-		orderedUsers = hydratedUsers
+		var candidates []*api.User
+		if len(args.Users) > 0 {
+			for _, name := range args.Users {
+				if u, ok := idx.byName[name]; ok {
+					if uBookID, _ := entity.UserIDs(name); uBookID == bookID {
+						candidates = insertSorted(candidates, u)
+					}
+				}
+			}
+		} else {
+			candidates = idx.byBook[bookID]
+		}
+		for _, u := range after(candidates, args.GreaterThanName) {
+			if len(out) > args.Limit {
+				break
+			}
+			if len(out) > 0 && out[len(out)-1].GetName() == u.GetName() {
+				continue // duplicate in args.Users
+			}
+			u, err := r.hydrateUser(ctx, u)
+			if err != nil {
+				return nil, false, connect.NewError(connect.CodeInternal, err)
+			}
+			out = append(out, u)
+		}
 	case "total_centipoints":
 		if args.GreaterThanName != "" {
 			return nil, false, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot use GreaterThanName with total_centipoints order"))
 		}
-
-		sort.SliceStable(hydratedUsers, func(i, j int) bool {
-			return hydratedUsers[i].Centipoints+hydratedUsers[i].UnsettledCentipoints > hydratedUsers[j].Centipoints+hydratedUsers[j].UnsettledCentipoints
-		})
-		orderedUsers = hydratedUsers
+		var filter map[string]bool
+		if len(args.Users) > 0 {
+			filter = map[string]bool{}
+			for _, name := range args.Users {
+				filter[name] = true
+			}
+		}
+		for _, e := range r.leaderboardPage(bookID, args.After, filter, args.Limit+1) {
+			u := proto.Clone(idx.byName[e.name]).(*api.User)
+			u.UnsettledCentipoints = e.unsettled
+			out = append(out, u)
+		}
 	default:
 		return nil, false, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid order by"))
 	}
 
-	var out []*api.User //nolint:prealloc
-	for _, u := range orderedUsers {
-		uBookID, _ := entity.UserIDs(u.GetName())
-		if uBookID != bookID {
-			continue
-		}
-		if u.GetName() <= args.GreaterThanName {
-			continue
-		}
-		if len(args.Users) > 0 && !containsStr(args.Users, u.GetName()) {
-			continue
-		}
-
-		out = append(out, u)
-		if len(out) >= args.Limit+1 {
-			break
-		}
-	}
 	if len(out) > args.Limit {
 		return out[:args.Limit], true, nil
 	}
@@ -183,71 +433,94 @@ func (r *Repo) ListUsers(ctx context.Context, args *repo.ListUsersArgs) (users [
 }
 
 // CreateMarket creates a new market.
-func (r *Repo) CreateMarket(_ context.Context, market *api.Market) error {
+func (r *Repo) CreateMarket(_ context.Context, market *api.Market, events ...*api.DomainEvent) error {
 	r.marketMtx.Lock()
 	defer r.marketMtx.Unlock()
-	for _, u := range r.Users {
-		if u.GetName() == market.GetName() {
-			return connect.NewError(connect.CodeInvalidArgument, errors.New("market with id already exists"))
-		}
+	if _, ok := r.marketIndex().byName[market.GetName()]; ok {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("market with id already exists"))
 	}
-	r.Markets = append(r.Markets, market)
-	sort.SliceStable(r.Markets, func(i, j int) bool {
-		return r.Markets[i].Name < r.Markets[j].Name
-	})
+	stored := proto.Clone(market).(*api.Market)
+	stored.Etag = repo.NextEtag("")
+	if err := r.commit(&Write{Puts: []proto.Message{stored}, Events: events}, func() { r.putMarket(stored) }); err != nil {
+		return err
+	}
+	market.Etag = stored.GetEtag()
 	return nil
 }
 
 // UpdateMarket updates a market.
-func (r *Repo) UpdateMarket(_ context.Context, market *api.Market) error {
+func (r *Repo) UpdateMarket(_ context.Context, market *api.Market, events ...*api.DomainEvent) error {
 	r.marketMtx.Lock()
 	defer r.marketMtx.Unlock()
-	var found bool
-	var idx int
-	for i, m := range r.Markets {
-		if m.GetName() == market.GetName() {
-			found = true
-			idx = i
-			break
-		}
-	}
-	if !found {
+	stored, ok := r.marketIndex().byName[market.GetName()]
+	if !ok {
 		return connect.NewError(connect.CodeNotFound, errors.New("market not found"))
 	}
-	r.Markets[idx] = market
+	if stored.GetEtag() != market.GetEtag() {
+		return connect.NewError(connect.CodeAborted, errors.New("market has been modified"))
+	}
+	stored = proto.Clone(market).(*api.Market)
+	stored.Etag = repo.NextEtag(market.GetEtag())
+	if err := r.commit(&Write{Puts: []proto.Message{stored}, Events: events}, func() { r.putMarket(stored) }); err != nil {
+		return err
+	}
+	market.Etag = stored.GetEtag()
 	return nil
 }
 
+// PutMarket creates or overwrites a market as is, without checking its etag. It is used to replay persisted writes.
+func (r *Repo) PutMarket(_ context.Context, market *api.Market) error {
+	r.marketMtx.Lock()
+	defer r.marketMtx.Unlock()
+	r.putMarket(proto.Clone(market).(*api.Market))
+	return nil
+}
+
+// marketMtx must be held.
+func (r *Repo) putMarket(market *api.Market) {
+	idx := r.marketIndex()
+	if _, ok := idx.byName[market.GetName()]; ok {
+		replaceSorted(r.Markets, market)
+		idx.replace(market)
+		return
+	}
+	r.Markets = insertSorted(r.Markets, market)
+	idx.add(market)
+}
+
 // GetMarket gets a market by ID.
 func (r *Repo) GetMarket(_ context.Context, name string) (*api.Market, error) {
 	r.marketMtx.RLock()
 	defer r.marketMtx.RUnlock()
-	for _, m := range r.Markets {
-		if m.GetName() == name {
-			return m, nil
-		}
+	m, ok := r.marketIndex().byName[name]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("market not found"))
 	}
-	return nil, connect.NewError(connect.CodeNotFound, errors.New("market not found"))
+	return proto.Clone(m).(*api.Market), nil
 }
 
 // ListMarkets lists markets by filters.
 func (r *Repo) ListMarkets(_ context.Context, args *repo.ListMarketsArgs) (markets []*api.Market, hasMore bool, err error) {
+	if err := repo.CheckOrder(args.OrderBy, args.GreaterThanName); err != nil {
+		return nil, false, err
+	}
 	r.marketMtx.RLock()
 	defer r.marketMtx.RUnlock()
+	idx := r.marketIndex()
 	bookID := entity.BooksIDs(args.Book)
+	candidates := after(idx.byBook[bookID], args.GreaterThanName)
+	if repo.IsKeyedOrder(args.OrderBy) {
+		candidates = idx.ordered.after(args.OrderBy, bookID, args.After)
+	}
 	var out []*api.Market //nolint:prealloc
-	for _, m := range r.Markets {
-		mBookID, _ := entity.MarketIDs(m.GetName())
-		if mBookID != bookID {
-			continue
-		}
-		if m.GetName() <= args.GreaterThanName {
+	for _, m := range candidates {
+		if args.Status != api.Market_STATUS_UNSPECIFIED && m.Status != args.Status {
 			continue
 		}
-		if args.Status != api.Market_STATUS_UNSPECIFIED && m.Status != args.Status {
+		if !args.Filter.Match(m) {
 			continue
 		}
-		out = append(out, m)
+		out = append(out, proto.Clone(m).(*api.Market))
 		if len(out) >= args.Limit+1 {
 			break
 		}
@@ -259,65 +532,111 @@ func (r *Repo) ListMarkets(_ context.Context, args *repo.ListMarketsArgs) (marke
 }
 
 // CreateBet creates a new bet.
-func (r *Repo) CreateBet(_ context.Context, bet *api.Bet) error {
+func (r *Repo) CreateBet(_ context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
 	r.betMtx.Lock()
 	defer r.betMtx.Unlock()
-	for _, u := range r.Users {
-		if u.GetName() == bet.GetName() {
-			return connect.NewError(connect.CodeInvalidArgument, errors.New("bet with id already exists"))
-		}
+	if _, ok := r.betIndex().byName[bet.GetName()]; ok {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("bet with id already exists"))
 	}
-	r.Bets = append(r.Bets, bet)
-	sort.SliceStable(r.Bets, func(i, j int) bool {
-		return r.Bets[i].Name < r.Bets[j].Name
-	})
+	stored := proto.Clone(bet).(*api.Bet)
+	stored.Etag = repo.NextEtag("")
+	if err := r.commit(&Write{Puts: []proto.Message{stored}, Events: events}, func() { r.putBet(stored) }); err != nil {
+		return err
+	}
+	bet.Etag = stored.GetEtag()
 	return nil
 }
 
 // UpdateBet updates a bet.
-func (r *Repo) UpdateBet(_ context.Context, bet *api.Bet) error {
+func (r *Repo) UpdateBet(_ context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
 	r.betMtx.Lock()
 	defer r.betMtx.Unlock()
-	var found bool
-	var idx int
-	for i, b := range r.Bets {
-		if b.GetName() == bet.GetName() {
-			found = true
-			idx = i
-			break
-		}
-	}
-	if !found {
+	stored, ok := r.betIndex().byName[bet.GetName()]
+	if !ok {
 		return connect.NewError(connect.CodeNotFound, errors.New("bet not found"))
 	}
-	r.Bets[idx] = bet
+	if stored.GetEtag() != bet.GetEtag() {
+		return connect.NewError(connect.CodeAborted, errors.New("bet has been modified"))
+	}
+	stored = proto.Clone(bet).(*api.Bet)
+	stored.Etag = repo.NextEtag(bet.GetEtag())
+	if err := r.commit(&Write{Puts: []proto.Message{stored}, Events: events}, func() { r.putBet(stored) }); err != nil {
+		return err
+	}
+	bet.Etag = stored.GetEtag()
 	return nil
 }
 
+// PutBet creates or overwrites a bet as is, without checking its etag. It is used to replay persisted writes.
+func (r *Repo) PutBet(_ context.Context, bet *api.Bet) error {
+	r.betMtx.Lock()
+	defer r.betMtx.Unlock()
+	r.putBet(proto.Clone(bet).(*api.Bet))
+	return nil
+}
+
+// betMtx must be held.
+func (r *Repo) putBet(bet *api.Bet) {
+	idx := r.betIndex()
+	bookID, _ := entity.BetIDs(bet.GetName())
+	affected := []bookUser{{bookID, bet.GetUser()}}
+	if prev, ok := idx.byName[bet.GetName()]; ok {
+		affected = append(affected, bookUser{bookID, prev.GetUser()})
+		replaceSorted(r.Bets, bet)
+		idx.replace(bet)
+	} else {
+		r.Bets = insertSorted(r.Bets, bet)
+		idx.add(bet)
+	}
+	r.updateLeaderboard(func(b *leaderboard) {
+		for _, bu := range affected {
+			b.set(bu, func(t *userTotal) {
+				t.unsettled = idx.unsettled[bu]
+			})
+		}
+	})
+}
+
 // GetBet gets a bet by ID.
 func (r *Repo) GetBet(_ context.Context, name string) (*api.Bet, error) {
 	r.betMtx.RLock()
 	defer r.betMtx.RUnlock()
-	for _, b := range r.Bets {
-		if b.GetName() == name {
-			return b, nil
-		}
+	b, ok := r.betIndex().byName[name]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("bet not found"))
 	}
-	return nil, connect.NewError(connect.CodeNotFound, errors.New("bet not found"))
+	return proto.Clone(b).(*api.Bet), nil
 }
 
 // ListBets lists bets by filters.
 func (r *Repo) ListBets(_ context.Context, args *repo.ListBetsArgs) (bets []*api.Bet, hasMore bool, err error) {
+	if err := repo.CheckOrder(args.OrderBy, args.GreaterThanName); err != nil {
+		return nil, false, err
+	}
 	r.betMtx.RLock()
 	defer r.betMtx.RUnlock()
+	idx := r.betIndex()
 	bookID := entity.BooksIDs(args.Book)
+
+	// scan the most selective index
+	var candidates []*api.Bet
+	group := bookID
+	switch {
+	case args.Market != "":
+		candidates, group = idx.byMarket[args.Market], args.Market
+	case args.User != "":
+		candidates, group = idx.byUser[args.User], args.User
+	default:
+		candidates = idx.byBook[bookID]
+	}
+	candidates = after(candidates, args.GreaterThanName)
+	if repo.IsKeyedOrder(args.OrderBy) {
+		candidates = idx.ordered.after(args.OrderBy, group, args.After)
+	}
+
 	var out []*api.Bet //nolint:prealloc
-	for _, b := range r.Bets {
-		bBookID, _ := entity.BetIDs(b.GetName())
-		if bBookID != bookID {
-			continue
-		}
-		if b.GetName() <= args.GreaterThanName {
+	for _, b := range candidates {
+		if betBookID, _ := entity.BetIDs(b.GetName()); betBookID != bookID {
 			continue
 		}
 		if args.User != "" && b.User != args.User {
@@ -329,7 +648,10 @@ func (r *Repo) ListBets(_ context.Context, args *repo.ListBetsArgs) (bets []*api
 		if args.ExcludeSettled && b.GetSettledAt() != nil {
 			continue
 		}
-		out = append(out, b)
+		if !args.Filter.Match(b) {
+			continue
+		}
+		out = append(out, proto.Clone(b).(*api.Bet))
 		if len(out) >= args.Limit+1 {
 			break
 		}
@@ -339,12 +661,3 @@ func (r *Repo) ListBets(_ context.Context, args *repo.ListBetsArgs) (bets []*api
 	}
 	return out, false, nil
 }
-
-func containsStr(xs []string, y string) bool {
-	for _, x := range xs {
-		if x == y {
-			return true
-		}
-	}
-	return false
-}
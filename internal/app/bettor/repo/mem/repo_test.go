@@ -0,0 +1,113 @@
+package mem_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/repo/repotest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRepo(t *testing.T) {
+	repotest.Run(t, func() repo.Repo {
+		return &mem.Repo{}
+	})
+}
+
+// benchRepo returns a repo with users in books with bets spread across markets. Half of the bets are settled.
+func benchRepo(b *testing.B, books, usersPerBook, marketsPerBook, betsPerUser int) *mem.Repo {
+	ctx := context.Background()
+	r := &mem.Repo{}
+	for i := 0; i < books; i++ {
+		bookID := fmt.Sprintf("guild:%d", i)
+		for j := 0; j < marketsPerBook; j++ {
+			require.Nil(b, r.CreateMarket(ctx, &api.Market{Name: entity.MarketN(bookID, fmt.Sprintf("%08d", j)), Status: api.Market_STATUS_OPEN}))
+		}
+		for j := 0; j < usersPerBook; j++ {
+			user := &api.User{Name: entity.UserN(bookID, fmt.Sprintf("%08d", j)), Username: fmt.Sprintf("user%d", j), Centipoints: uint64(j)}
+			require.Nil(b, r.CreateUser(ctx, user))
+			for k := 0; k < betsPerUser; k++ {
+				bet := &api.Bet{
+					Name:        entity.BetN(bookID, fmt.Sprintf("%08d-%08d", j, k)),
+					User:        user.GetName(),
+					Market:      entity.MarketN(bookID, fmt.Sprintf("%08d", k%marketsPerBook)),
+					Centipoints: 1,
+				}
+				if k%2 == 0 {
+					bet.SettledAt = timestamppb.Now()
+				}
+				require.Nil(b, r.CreateBet(ctx, bet))
+			}
+		}
+	}
+	return r
+}
+
+func BenchmarkGetUser(b *testing.B) {
+	ctx := context.Background()
+	r := benchRepo(b, 10, 100, 10, 10)
+	name := entity.UserN("guild:5", fmt.Sprintf("%08d", 50))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := r.GetUser(ctx, name); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkListUsersByTotalCentipoints(b *testing.B) {
+	ctx := context.Background()
+	r := benchRepo(b, 10, 100, 10, 10)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := r.ListUsers(ctx, &repo.ListUsersArgs{Book: entity.BookN("guild:5"), OrderBy: "total_centipoints", Limit: 10}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkListUsersPage(b *testing.B) {
+	ctx := context.Background()
+	r := benchRepo(b, 10, 100, 10, 10)
+	cursor := entity.UserN("guild:5", fmt.Sprintf("%08d", 50))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := r.ListUsers(ctx, &repo.ListUsersArgs{Book: entity.BookN("guild:5"), GreaterThanName: cursor, Limit: 10}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkListBetsByMarket(b *testing.B) {
+	ctx := context.Background()
+	r := benchRepo(b, 10, 100, 10, 10)
+	market := entity.MarketN("guild:5", fmt.Sprintf("%08d", 5))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := r.ListBets(ctx, &repo.ListBetsArgs{Book: entity.BookN("guild:5"), Market: market, Limit: 100}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCreateBet(b *testing.B) {
+	ctx := context.Background()
+	r := benchRepo(b, 10, 100, 10, 10)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := r.CreateBet(ctx, &api.Bet{
+			Name:        entity.BetN("guild:5", fmt.Sprintf("new-%08d", i)),
+			User:        entity.UserN("guild:5", fmt.Sprintf("%08d", i%100)),
+			Market:      entity.MarketN("guild:5", fmt.Sprintf("%08d", i%10)),
+			Centipoints: 1,
+		}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
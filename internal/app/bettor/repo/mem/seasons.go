@@ -0,0 +1,62 @@
+package mem
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// PutSeason creates or overwrites a season.
+func (r *Repo) PutSeason(_ context.Context, season *api.Season) error {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	season = proto.Clone(season).(*api.Season)
+	return r.commit(&Write{Puts: []proto.Message{season}}, func() {
+		if _, ok := nameIndex(r.Seasons, season.GetName()); ok {
+			replaceSorted(r.Seasons, season)
+			return
+		}
+		r.Seasons = insertSorted(r.Seasons, season)
+	})
+}
+
+// GetSeason gets a season by name.
+func (r *Repo) GetSeason(_ context.Context, name string) (*api.Season, error) {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	i, ok := nameIndex(r.Seasons, name)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("season not found"))
+	}
+	return proto.Clone(r.Seasons[i]).(*api.Season), nil
+}
+
+// ListSeasons lists a book's seasons in number order. Books have few seasons so they are not indexed.
+func (r *Repo) ListSeasons(_ context.Context, args *repo.ListSeasonsArgs) (seasons []*api.Season, hasMore bool, err error) {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	bookID := entity.BooksIDs(args.Book)
+	var out []*api.Season
+	for _, s := range r.Seasons {
+		if b, _ := entity.SeasonIDs(s.GetName()); b == bookID && s.GetNumber() > args.AfterNumber {
+			out = append(out, s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].GetNumber() < out[j].GetNumber() })
+	if len(out) > args.Limit {
+		out, hasMore = out[:args.Limit], true
+	}
+	for i, s := range out {
+		out[i] = proto.Clone(s).(*api.Season)
+	}
+	return out, hasMore, nil
+}
@@ -0,0 +1,67 @@
+package mem
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateTransfer creates a new transfer.
+func (r *Repo) CreateTransfer(_ context.Context, transfer *api.Transfer) error {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	if _, ok := nameIndex(r.Transfers, transfer.GetName()); ok {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("transfer with id already exists"))
+	}
+	stored := proto.Clone(transfer).(*api.Transfer)
+	return r.commit(&Write{Puts: []proto.Message{stored}}, func() { r.Transfers = insertSorted(r.Transfers, stored) })
+}
+
+// PutTransfer creates or overwrites a transfer. It is used to replay persisted writes.
+func (r *Repo) PutTransfer(_ context.Context, transfer *api.Transfer) error {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	transfer = proto.Clone(transfer).(*api.Transfer)
+	if _, ok := nameIndex(r.Transfers, transfer.GetName()); ok {
+		replaceSorted(r.Transfers, transfer)
+		return nil
+	}
+	r.Transfers = insertSorted(r.Transfers, transfer)
+	return nil
+}
+
+// ListTransfers lists transfers by filters.
+func (r *Repo) ListTransfers(_ context.Context, args *repo.ListTransfersArgs) (transfers []*api.Transfer, hasMore bool, err error) {
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+	bookID := entity.BooksIDs(args.Book)
+	start := args.GreaterThanName
+	if prefix := entity.TransferN(bookID, ""); start < prefix {
+		start = prefix
+	}
+	var out []*api.Transfer
+	for _, t := range after(r.Transfers, start) {
+		if b, _ := entity.TransferIDs(t.GetName()); b != bookID {
+			break
+		}
+		if args.User != "" && t.GetFromUser() != args.User && t.GetToUser() != args.User {
+			continue
+		}
+		out = append(out, proto.Clone(t).(*api.Transfer))
+		if len(out) >= args.Limit+1 {
+			break
+		}
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
@@ -0,0 +1,130 @@
+package mem
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// sortWebhooks sorts seeded Webhooks and Deliveries on first use. There are few of them so they are not indexed.
+// webhookMtx must be held.
+func (r *Repo) sortWebhooks() {
+	r.webhookOnce.Do(func() {
+		sortByName(r.Webhooks)
+		sortByName(r.Deliveries)
+	})
+}
+
+// CreateWebhook creates a new webhook.
+func (r *Repo) CreateWebhook(_ context.Context, webhook *api.Webhook) error {
+	r.webhookMtx.Lock()
+	defer r.webhookMtx.Unlock()
+	r.sortWebhooks()
+	if _, ok := nameIndex(r.Webhooks, webhook.GetName()); ok {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("webhook with id already exists"))
+	}
+	stored := proto.Clone(webhook).(*api.Webhook)
+	return r.commit(&Write{Puts: []proto.Message{stored}}, func() { r.Webhooks = insertSorted(r.Webhooks, stored) })
+}
+
+// PutWebhook creates or overwrites a webhook. It is used to replay persisted writes.
+func (r *Repo) PutWebhook(_ context.Context, webhook *api.Webhook) error {
+	r.webhookMtx.Lock()
+	defer r.webhookMtx.Unlock()
+	r.sortWebhooks()
+	webhook = proto.Clone(webhook).(*api.Webhook)
+	if _, ok := nameIndex(r.Webhooks, webhook.GetName()); ok {
+		replaceSorted(r.Webhooks, webhook)
+		return nil
+	}
+	r.Webhooks = insertSorted(r.Webhooks, webhook)
+	return nil
+}
+
+// GetWebhook gets a webhook by name.
+func (r *Repo) GetWebhook(_ context.Context, name string) (*api.Webhook, error) {
+	r.webhookMtx.Lock()
+	defer r.webhookMtx.Unlock()
+	r.sortWebhooks()
+	i, ok := nameIndex(r.Webhooks, name)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("webhook not found"))
+	}
+	return proto.Clone(r.Webhooks[i]).(*api.Webhook), nil
+}
+
+// ListWebhooks lists webhooks by filters.
+func (r *Repo) ListWebhooks(_ context.Context, args *repo.ListWebhooksArgs) (webhooks []*api.Webhook, hasMore bool, err error) {
+	r.webhookMtx.Lock()
+	defer r.webhookMtx.Unlock()
+	r.sortWebhooks()
+	bookID := entity.BooksIDs(args.Book)
+	var out []*api.Webhook
+	for _, w := range after(r.Webhooks, args.GreaterThanName) {
+		if b, _ := entity.WebhookIDs(w.GetName()); b != bookID {
+			continue
+		}
+		out = append(out, proto.Clone(w).(*api.Webhook))
+		if len(out) >= args.Limit+1 {
+			break
+		}
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// DeleteWebhook deletes a webhook.
+func (r *Repo) DeleteWebhook(_ context.Context, name string) error {
+	r.webhookMtx.Lock()
+	defer r.webhookMtx.Unlock()
+	r.sortWebhooks()
+	if _, ok := nameIndex(r.Webhooks, name); !ok {
+		return connect.NewError(connect.CodeNotFound, errors.New("webhook not found"))
+	}
+	return r.commit(&Write{Deletes: []proto.Message{&api.Webhook{Name: name}}}, func() { r.Webhooks = removeSorted(r.Webhooks, name) })
+}
+
+// PutDelivery creates or overwrites a webhook delivery.
+func (r *Repo) PutDelivery(_ context.Context, delivery *api.WebhookDelivery) error {
+	r.webhookMtx.Lock()
+	defer r.webhookMtx.Unlock()
+	r.sortWebhooks()
+	delivery = proto.Clone(delivery).(*api.WebhookDelivery)
+	return r.commit(&Write{Puts: []proto.Message{delivery}}, func() {
+		if _, ok := nameIndex(r.Deliveries, delivery.GetName()); ok {
+			replaceSorted(r.Deliveries, delivery)
+			return
+		}
+		r.Deliveries = insertSorted(r.Deliveries, delivery)
+	})
+}
+
+// DeleteDelivery deletes a webhook delivery.
+func (r *Repo) DeleteDelivery(_ context.Context, name string) error {
+	r.webhookMtx.Lock()
+	defer r.webhookMtx.Unlock()
+	r.sortWebhooks()
+	if _, ok := nameIndex(r.Deliveries, name); !ok {
+		return connect.NewError(connect.CodeNotFound, errors.New("delivery not found"))
+	}
+	return r.commit(&Write{Deletes: []proto.Message{&api.WebhookDelivery{Name: name}}}, func() { r.Deliveries = removeSorted(r.Deliveries, name) })
+}
+
+// ListDeliveries lists webhook deliveries across all books.
+func (r *Repo) ListDeliveries(_ context.Context, args *repo.ListDeliveriesArgs) (deliveries []*api.WebhookDelivery, hasMore bool, err error) {
+	r.webhookMtx.Lock()
+	defer r.webhookMtx.Unlock()
+	r.sortWebhooks()
+	out, hasMore := repo.PageDeliveries(r.Deliveries, args)
+	for i, d := range out {
+		out[i] = proto.Clone(d).(*api.WebhookDelivery)
+	}
+	return out, hasMore, nil
+}
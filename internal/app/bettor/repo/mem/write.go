@@ -0,0 +1,203 @@
+package mem
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// Write is a validated write passed to WriteAhead. Puts are resources as they will be stored, Deletes are resources
+// with only their names set, and cursors are put as ConsumerCursors. Deleted events are an event with only the sequence
+// they are deleted through set. Events have their sequences assigned.
+type Write struct {
+	Puts    []proto.Message
+	Deletes []proto.Message
+	Events  []*api.DomainEvent
+}
+
+// commit passes a validated write to WriteAhead and then applies it. Events are assigned the next sequences and are
+// appended atomically with the write. The mutexes guarding the state that apply mutates must be held.
+func (r *Repo) commit(w *Write, apply func()) error {
+	if len(w.Events) > 0 {
+		r.eventMtx.Lock()
+		defer r.eventMtx.Unlock()
+		var last uint64
+		if len(r.Events) > 0 {
+			last = r.Events[len(r.Events)-1].GetSequence()
+		}
+		for _, e := range w.Events {
+			last++
+			e.Sequence = last
+		}
+	}
+	if r.WriteAhead != nil {
+		if err := r.WriteAhead(w); err != nil {
+			return err
+		}
+	}
+	apply()
+	for _, e := range w.Events {
+		r.Events = append(r.Events, proto.Clone(e).(*api.DomainEvent))
+	}
+	return nil
+}
+
+// Write applies a batch of writes atomically.
+func (r *Repo) Write(_ context.Context, batch *repo.Batch) error {
+	r.userMtx.Lock()
+	defer r.userMtx.Unlock()
+	r.marketMtx.Lock()
+	defer r.marketMtx.Unlock()
+	r.betMtx.Lock()
+	defer r.betMtx.Unlock()
+	r.oddsMtx.Lock()
+	defer r.oddsMtx.Unlock()
+	r.bookMtx.Lock()
+	defer r.bookMtx.Unlock()
+	r.sortBooks()
+
+	w := &Write{Events: batch.Events}
+	var users []*api.User
+	for _, user := range batch.Users {
+		stored, ok := r.userIndex().byName[user.GetName()]
+		if !ok {
+			return connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+		}
+		if stored.GetEtag() != user.GetEtag() {
+			return connect.NewError(connect.CodeAborted, errors.New("user has been modified"))
+		}
+		stored = proto.Clone(user).(*api.User)
+		stored.Etag = repo.NextEtag(user.GetEtag())
+		users = append(users, stored)
+		w.Puts = append(w.Puts, stored)
+	}
+	var markets []*api.Market
+	for _, market := range batch.Markets {
+		stored, ok := r.marketIndex().byName[market.GetName()]
+		if !ok {
+			return connect.NewError(connect.CodeNotFound, errors.New("market not found"))
+		}
+		if stored.GetEtag() != market.GetEtag() {
+			return connect.NewError(connect.CodeAborted, errors.New("market has been modified"))
+		}
+		stored = proto.Clone(market).(*api.Market)
+		stored.Etag = repo.NextEtag(market.GetEtag())
+		markets = append(markets, stored)
+		w.Puts = append(w.Puts, stored)
+	}
+	var bets []*api.Bet
+	for _, bet := range batch.Bets {
+		stored, ok := r.betIndex().byName[bet.GetName()]
+		if !ok {
+			return connect.NewError(connect.CodeNotFound, errors.New("bet not found"))
+		}
+		if stored.GetEtag() != bet.GetEtag() {
+			return connect.NewError(connect.CodeAborted, errors.New("bet has been modified"))
+		}
+		stored = proto.Clone(bet).(*api.Bet)
+		stored.Etag = repo.NextEtag(bet.GetEtag())
+		bets = append(bets, stored)
+		w.Puts = append(w.Puts, stored)
+	}
+	var newBets []*api.Bet
+	for _, bet := range batch.NewBets {
+		if _, ok := r.betIndex().byName[bet.GetName()]; ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("bet with id already exists"))
+		}
+		stored := proto.Clone(bet).(*api.Bet)
+		stored.Etag = repo.NextEtag("")
+		newBets = append(newBets, stored)
+		w.Puts = append(w.Puts, stored)
+	}
+	snapshots := cloneAll(batch.OddsSnapshots)
+	grants := cloneAll(batch.Grants)
+	for _, grant := range grants {
+		if _, ok := nameIndex(r.Grants, grant.GetName()); ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("grant with id already exists"))
+		}
+	}
+	seasons := cloneAll(batch.Seasons)
+	transfers := cloneAll(batch.Transfers)
+	for _, transfer := range transfers {
+		if _, ok := nameIndex(r.Transfers, transfer.GetName()); ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("transfer with id already exists"))
+		}
+	}
+	adjustments := cloneAll(batch.Adjustments)
+	for _, adjustment := range adjustments {
+		if _, ok := nameIndex(r.Adjustments, adjustment.GetName()); ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("adjustment with id already exists"))
+		}
+	}
+	w.Puts = appendAll(w.Puts, snapshots)
+	w.Puts = appendAll(w.Puts, grants)
+	w.Puts = appendAll(w.Puts, seasons)
+	w.Puts = appendAll(w.Puts, transfers)
+	w.Puts = appendAll(w.Puts, adjustments)
+
+	err := r.commit(w, func() {
+		for _, user := range users {
+			r.putUser(user)
+		}
+		for _, market := range markets {
+			r.putMarket(market)
+		}
+		for _, bet := range append(bets, newBets...) {
+			r.putBet(bet)
+		}
+		for _, snapshot := range snapshots {
+			r.putOddsSnapshot(snapshot)
+		}
+		for _, grant := range grants {
+			r.Grants = insertSorted(r.Grants, grant)
+		}
+		for _, season := range seasons {
+			if _, ok := nameIndex(r.Seasons, season.GetName()); ok {
+				replaceSorted(r.Seasons, season)
+			} else {
+				r.Seasons = insertSorted(r.Seasons, season)
+			}
+		}
+		for _, transfer := range transfers {
+			r.Transfers = insertSorted(r.Transfers, transfer)
+		}
+		for _, adjustment := range adjustments {
+			r.Adjustments = insertSorted(r.Adjustments, adjustment)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	for i, user := range users {
+		batch.Users[i].Etag = user.GetEtag()
+	}
+	for i, market := range markets {
+		batch.Markets[i].Etag = market.GetEtag()
+	}
+	for i, bet := range bets {
+		batch.Bets[i].Etag = bet.GetEtag()
+	}
+	for i, bet := range newBets {
+		batch.NewBets[i].Etag = bet.GetEtag()
+	}
+	return nil
+}
+
+func cloneAll[T proto.Message](xs []T) []T {
+	out := make([]T, 0, len(xs))
+	for _, x := range xs {
+		out = append(out, proto.Clone(x).(T))
+	}
+	return out
+}
+
+func appendAll[T proto.Message](msgs []proto.Message, xs []T) []proto.Message {
+	for _, x := range xs {
+		msgs = append(msgs, x)
+	}
+	return msgs
+}
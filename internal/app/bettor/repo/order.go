@@ -0,0 +1,89 @@
+package repo
+
+import (
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Keyed orders for listing markets and bets. Resources are listed by their order key desc then name asc.
+const (
+	OrderByCreateTime       = "create_time desc"
+	OrderByTotalCentipoints = "total_centipoints desc"
+	// unsettled resources are listed last
+	OrderBySettledTime = "settled_time desc"
+)
+
+// OrderCursor is a position in a keyed order. Resources after it have a lower key or the same key and a greater name.
+type OrderCursor struct {
+	Value uint64
+	Name  string
+}
+
+// After returns whether a resource with the given key and name comes after the cursor.
+func (c *OrderCursor) After(value uint64, name string) bool {
+	return c == nil || value < c.Value || (value == c.Value && name > c.Name)
+}
+
+// IsKeyedOrder returns whether orderBy is one of the keyed orders for markets and bets. Other values besides "" and
+// "name" are invalid.
+func IsKeyedOrder(orderBy string) bool {
+	switch orderBy {
+	case OrderByCreateTime, OrderByTotalCentipoints, OrderBySettledTime:
+		return true
+	}
+	return false
+}
+
+// CheckOrder validates the order and cursor arguments of a market or bet list.
+func CheckOrder(orderBy, greaterThanName string) error {
+	switch {
+	case orderBy == "" || orderBy == "name":
+		return nil
+	case !IsKeyedOrder(orderBy):
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("invalid order by"))
+	case greaterThanName != "":
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("cannot use GreaterThanName with keyed order"))
+	}
+	return nil
+}
+
+// MarketOrderKey returns a market's key in a keyed order.
+func MarketOrderKey(orderBy string, market *api.Market) uint64 {
+	switch orderBy {
+	case OrderByCreateTime:
+		return timeKey(market.GetCreatedAt())
+	case OrderByTotalCentipoints:
+		var total uint64
+		for _, o := range market.GetPool().GetOutcomes() {
+			total += o.GetCentipoints()
+		}
+		return total
+	case OrderBySettledTime:
+		return timeKey(market.GetSettledAt())
+	}
+	return 0
+}
+
+// BetOrderKey returns a bet's key in a keyed order.
+func BetOrderKey(orderBy string, bet *api.Bet) uint64 {
+	switch orderBy {
+	case OrderByCreateTime:
+		return timeKey(bet.GetCreatedAt())
+	case OrderByTotalCentipoints:
+		return bet.GetCentipoints()
+	case OrderBySettledTime:
+		return timeKey(bet.GetSettledAt())
+	}
+	return 0
+}
+
+// timeKey is unix nanos, or 0 if unset.
+func timeKey(ts *timestamppb.Timestamp) uint64 {
+	if ts == nil || ts.AsTime().UnixNano() < 0 {
+		return 0
+	}
+	return uint64(ts.AsTime().UnixNano())
+}
@@ -0,0 +1,86 @@
+// Package pubsub fans out committed repo writes to in-process subscribers.
+package pubsub
+
+import (
+	"errors"
+	"sync"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+)
+
+// bufferSize is the number of events a subscription can fall behind by before it is dropped.
+const bufferSize = 64
+
+// ErrSlowSubscriber is the error of a subscription that was dropped for falling behind.
+var ErrSlowSubscriber = errors.New("subscriber fell behind")
+
+// Hub publishes events to subscribers. Publishing never blocks on subscribers; a subscriber that falls behind is
+// dropped. The zero value is ready to use.
+type Hub struct {
+	mtx  sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+// Subscription receives the events it matches until it is closed.
+type Subscription struct {
+	hub   *Hub
+	match func(e *api.Event) bool
+	ch    chan *api.Event
+	err   error // guarded by hub.mtx
+}
+
+// Subscribe returns a subscription to events that match. The subscription must be closed.
+func (h *Hub) Subscribe(match func(e *api.Event) bool) *Subscription {
+	s := &Subscription{hub: h, match: match, ch: make(chan *api.Event, bufferSize)}
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if h.subs == nil {
+		h.subs = map[*Subscription]struct{}{}
+	}
+	h.subs[s] = struct{}{}
+	return s
+}
+
+// Publish sends an event to all matching subscriptions. Events must not be modified after they are published.
+func (h *Hub) Publish(e *api.Event) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	for s := range h.subs {
+		if !s.match(e) {
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+			s.err = ErrSlowSubscriber
+			h.remove(s)
+		}
+	}
+}
+
+// remove drops a subscription. h.mtx must be held.
+func (h *Hub) remove(s *Subscription) {
+	if _, ok := h.subs[s]; ok {
+		delete(h.subs, s)
+		close(s.ch)
+	}
+}
+
+// Events returns the channel of events. It is closed when the subscription is closed or dropped.
+func (s *Subscription) Events() <-chan *api.Event {
+	return s.ch
+}
+
+// Err returns ErrSlowSubscriber if the subscription was dropped for falling behind.
+func (s *Subscription) Err() error {
+	s.hub.mtx.Lock()
+	defer s.hub.mtx.Unlock()
+	return s.err
+}
+
+// Close unsubscribes. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.hub.mtx.Lock()
+	defer s.hub.mtx.Unlock()
+	s.hub.remove(s)
+}
@@ -0,0 +1,80 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/repo/pubsub"
+	"github.com/elh/bettor/internal/app/bettor/repo/repotest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepo(t *testing.T) {
+	repotest.Run(t, func() repo.Repo {
+		return &pubsub.Repo{Repo: &mem.Repo{}, Hub: &pubsub.Hub{}}
+	})
+}
+
+func TestPublish(t *testing.T) {
+	ctx := context.Background()
+	hub := &pubsub.Hub{}
+	r := &pubsub.Repo{Repo: &mem.Repo{}, Hub: hub}
+	all := hub.Subscribe(func(*api.Event) bool { return true })
+	defer all.Close()
+	bets := hub.Subscribe(func(e *api.Event) bool { return e.GetBet() != nil })
+	defer bets.Close()
+
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	require.Nil(t, r.CreateUser(ctx, user))
+	user.Centipoints = 50
+	require.Nil(t, r.UpdateUser(ctx, user))
+	require.NotNil(t, r.UpdateUser(ctx, &api.User{Name: entity.UserN("guild:1", "b")})) // failed writes are not published
+	bet := &api.Bet{Name: entity.BetN("guild:1", "a"), User: user.GetName(), Market: entity.MarketN("guild:1", "a"), Centipoints: 50}
+	require.Nil(t, r.CreateBet(ctx, bet))
+
+	e := <-all.Events()
+	assert.Equal(t, api.Event_TYPE_CREATED, e.GetType())
+	assert.Equal(t, uint64(100), e.GetUser().GetCentipoints())
+	e = <-all.Events()
+	assert.Equal(t, api.Event_TYPE_UPDATED, e.GetType())
+	assert.Equal(t, uint64(50), e.GetUser().GetCentipoints())
+	assert.Equal(t, "2", e.GetUser().GetEtag())
+	e = <-all.Events()
+	assert.Equal(t, bet.GetName(), e.GetBet().GetName())
+	assert.Len(t, all.Events(), 0)
+
+	e = <-bets.Events()
+	assert.Equal(t, bet.GetName(), e.GetBet().GetName())
+	assert.Len(t, bets.Events(), 0)
+}
+
+func TestSlowSubscriber(t *testing.T) {
+	hub := &pubsub.Hub{}
+	sub := hub.Subscribe(func(*api.Event) bool { return true })
+	defer sub.Close()
+	for i := 0; i < 100; i++ {
+		hub.Publish(&api.Event{})
+	}
+	var n int
+	for range sub.Events() {
+		n++
+	}
+	assert.Equal(t, 64, n)
+	assert.ErrorIs(t, sub.Err(), pubsub.ErrSlowSubscriber)
+}
+
+func TestClose(t *testing.T) {
+	hub := &pubsub.Hub{}
+	sub := hub.Subscribe(func(*api.Event) bool { return true })
+	sub.Close()
+	sub.Close()
+	hub.Publish(&api.Event{})
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+	assert.Nil(t, sub.Err())
+}
@@ -0,0 +1,104 @@
+package pubsub
+
+import (
+	"context"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ repo.Repo = (*Repo)(nil)
+
+// Repo wraps a repo and publishes an event to Hub for every successful write.
+type Repo struct {
+	repo.Repo
+	Hub *Hub
+}
+
+func (r *Repo) publish(t api.Event_Type, msg proto.Message) {
+	e := &api.Event{Type: t}
+	switch m := proto.Clone(msg).(type) {
+	case *api.User:
+		e.Resource = &api.Event_User{User: m}
+	case *api.Market:
+		e.Resource = &api.Event_Market{Market: m}
+	case *api.Bet:
+		e.Resource = &api.Event_Bet{Bet: m}
+	}
+	r.Hub.Publish(e)
+}
+
+// CreateUser creates a new user.
+func (r *Repo) CreateUser(ctx context.Context, user *api.User, events ...*api.DomainEvent) error {
+	if err := r.Repo.CreateUser(ctx, user, events...); err != nil {
+		return err
+	}
+	r.publish(api.Event_TYPE_CREATED, user)
+	return nil
+}
+
+// UpdateUser updates a user.
+func (r *Repo) UpdateUser(ctx context.Context, user *api.User, events ...*api.DomainEvent) error {
+	if err := r.Repo.UpdateUser(ctx, user, events...); err != nil {
+		return err
+	}
+	r.publish(api.Event_TYPE_UPDATED, user)
+	return nil
+}
+
+// CreateMarket creates a new market.
+func (r *Repo) CreateMarket(ctx context.Context, market *api.Market, events ...*api.DomainEvent) error {
+	if err := r.Repo.CreateMarket(ctx, market, events...); err != nil {
+		return err
+	}
+	r.publish(api.Event_TYPE_CREATED, market)
+	return nil
+}
+
+// UpdateMarket updates a market.
+func (r *Repo) UpdateMarket(ctx context.Context, market *api.Market, events ...*api.DomainEvent) error {
+	if err := r.Repo.UpdateMarket(ctx, market, events...); err != nil {
+		return err
+	}
+	r.publish(api.Event_TYPE_UPDATED, market)
+	return nil
+}
+
+// CreateBet creates a new bet.
+func (r *Repo) CreateBet(ctx context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
+	if err := r.Repo.CreateBet(ctx, bet, events...); err != nil {
+		return err
+	}
+	r.publish(api.Event_TYPE_CREATED, bet)
+	return nil
+}
+
+// UpdateBet updates a bet.
+func (r *Repo) UpdateBet(ctx context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
+	if err := r.Repo.UpdateBet(ctx, bet, events...); err != nil {
+		return err
+	}
+	r.publish(api.Event_TYPE_UPDATED, bet)
+	return nil
+}
+
+// Write applies a batch of writes atomically.
+func (r *Repo) Write(ctx context.Context, batch *repo.Batch) error {
+	if err := r.Repo.Write(ctx, batch); err != nil {
+		return err
+	}
+	for _, user := range batch.Users {
+		r.publish(api.Event_TYPE_UPDATED, user)
+	}
+	for _, market := range batch.Markets {
+		r.publish(api.Event_TYPE_UPDATED, market)
+	}
+	for _, bet := range batch.Bets {
+		r.publish(api.Event_TYPE_UPDATED, bet)
+	}
+	for _, bet := range batch.NewBets {
+		r.publish(api.Event_TYPE_CREATED, bet)
+	}
+	return nil
+}
@@ -2,36 +2,106 @@ package repo
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/pkg/filter"
 )
 
 // NOTE: same models E2E from API to repo out of laziness
 
 // Repo is a persistence repository.
+//
+// Writes of users, markets, and bets take the domain events that describe them. Events are persisted atomically with
+// the write and are assigned their sequence.
 type Repo interface {
-	CreateUser(ctx context.Context, user *api.User) error
-	UpdateUser(ctx context.Context, user *api.User) error
+	CreateUser(ctx context.Context, user *api.User, events ...*api.DomainEvent) error
+	UpdateUser(ctx context.Context, user *api.User, events ...*api.DomainEvent) error
 	GetUser(ctx context.Context, name string) (*api.User, error)
+	// GetUsers gets the users with the given names that exist, in no particular order.
+	GetUsers(ctx context.Context, names []string) ([]*api.User, error)
 	GetUserByUsername(ctx context.Context, book, username string) (*api.User, error)
 	ListUsers(ctx context.Context, args *ListUsersArgs) (users []*api.User, hasMore bool, err error)
-	CreateMarket(ctx context.Context, market *api.Market) error
-	UpdateMarket(ctx context.Context, market *api.Market) error
+	CreateMarket(ctx context.Context, market *api.Market, events ...*api.DomainEvent) error
+	UpdateMarket(ctx context.Context, market *api.Market, events ...*api.DomainEvent) error
 	GetMarket(ctx context.Context, name string) (*api.Market, error)
+	// GetMarkets gets the markets with the given names that exist, in no particular order.
+	GetMarkets(ctx context.Context, names []string) ([]*api.Market, error)
 	ListMarkets(ctx context.Context, args *ListMarketsArgs) (markets []*api.Market, hasMore bool, err error)
-	CreateBet(ctx context.Context, bet *api.Bet) error
-	UpdateBet(ctx context.Context, bet *api.Bet) error
+	CreateBet(ctx context.Context, bet *api.Bet, events ...*api.DomainEvent) error
+	UpdateBet(ctx context.Context, bet *api.Bet, events ...*api.DomainEvent) error
 	GetBet(ctx context.Context, name string) (*api.Bet, error)
+	// GetBets gets the bets with the given names that exist, in no particular order.
+	GetBets(ctx context.Context, names []string) ([]*api.Bet, error)
 	ListBets(ctx context.Context, args *ListBetsArgs) (bets []*api.Bet, hasMore bool, err error)
+	CreateWebhook(ctx context.Context, webhook *api.Webhook) error
+	GetWebhook(ctx context.Context, name string) (*api.Webhook, error)
+	ListWebhooks(ctx context.Context, args *ListWebhooksArgs) (webhooks []*api.Webhook, hasMore bool, err error)
+	// DeleteWebhook does not delete the webhook's deliveries. They are dropped when they come due.
+	DeleteWebhook(ctx context.Context, name string) error
+	// PutDelivery creates or overwrites a webhook delivery.
+	PutDelivery(ctx context.Context, delivery *api.WebhookDelivery) error
+	DeleteDelivery(ctx context.Context, name string) error
+	ListDeliveries(ctx context.Context, args *ListDeliveriesArgs) (deliveries []*api.WebhookDelivery, hasMore bool, err error)
+	ListEvents(ctx context.Context, args *ListEventsArgs) (events []*api.DomainEvent, hasMore bool, err error)
+	// GetCursor returns the sequence of the last event a consumer has handled, or 0 if it has not handled any.
+	GetCursor(ctx context.Context, consumer string) (uint64, error)
+	PutCursor(ctx context.Context, consumer string, sequence uint64) error
+	// DeleteEvents deletes events with sequences up to and including sequence. The newest event is always kept so that
+	// sequences keep increasing.
+	DeleteEvents(ctx context.Context, sequence uint64) error
+	// PutOddsSnapshot creates or overwrites the snapshot of a market at its revision. Only the MaxOddsSnapshots most
+	// recent snapshots of a market are retained.
+	PutOddsSnapshot(ctx context.Context, snapshot *api.OddsSnapshot) error
+	ListOddsSnapshots(ctx context.Context, args *ListOddsSnapshotsArgs) (snapshots []*api.OddsSnapshot, hasMore bool, err error)
+	CreateGrant(ctx context.Context, grant *api.Grant) error
+	ListGrants(ctx context.Context, args *ListGrantsArgs) (grants []*api.Grant, hasMore bool, err error)
+	// GetBookSettings returns CodeNotFound if the book's settings have never been put.
+	GetBookSettings(ctx context.Context, name string) (*api.BookSettings, error)
+	// PutBookSettings creates or overwrites a book's settings.
+	PutBookSettings(ctx context.Context, settings *api.BookSettings) error
+	// PutSeason creates or overwrites a season.
+	PutSeason(ctx context.Context, season *api.Season) error
+	GetSeason(ctx context.Context, name string) (*api.Season, error)
+	ListSeasons(ctx context.Context, args *ListSeasonsArgs) (seasons []*api.Season, hasMore bool, err error)
+	CreateTransfer(ctx context.Context, transfer *api.Transfer) error
+	ListTransfers(ctx context.Context, args *ListTransfersArgs) (transfers []*api.Transfer, hasMore bool, err error)
+	CreateAdjustment(ctx context.Context, adjustment *api.Adjustment) error
+	ListAdjustments(ctx context.Context, args *ListAdjustmentsArgs) (adjustments []*api.Adjustment, hasMore bool, err error)
+	// Write applies a batch of writes atomically. If any write fails, none are applied.
+	Write(ctx context.Context, batch *Batch) error
 }
 
+// Batch is a set of writes to a single book that Repo.Write applies atomically. Users, Markets, and Bets are updated
+// and must match the etags of the stored resources. Their etags are advanced once the batch is applied. NewBets,
+// Grants, Transfers, and Adjustments are created, and OddsSnapshots and Seasons are created or overwritten. Each
+// resource may only be written once per batch. Events are persisted with the writes and are assigned their sequence.
+type Batch struct {
+	Users         []*api.User
+	Markets       []*api.Market
+	Bets          []*api.Bet
+	NewBets       []*api.Bet
+	OddsSnapshots []*api.OddsSnapshot
+	Grants        []*api.Grant
+	Seasons       []*api.Season
+	Transfers     []*api.Transfer
+	Adjustments   []*api.Adjustment
+	Events        []*api.DomainEvent
+}
+
+// MaxOddsSnapshots is the number of odds snapshots retained per market.
+const MaxOddsSnapshots = 500
+
 // ListUsersArgs are the arguments for listing users.
 type ListUsersArgs struct {
 	Book            string
 	GreaterThanName string
-	Users           []string
-	Limit           int
-	// valid options: "name" asc (default), "total_centipoints" desc
+	// After is the cursor for "total_centipoints" order, which cannot use GreaterThanName.
+	After *OrderCursor
+	Users []string
+	Limit int
+	// valid options: "name" asc (default), "total_centipoints" desc then name asc
 	OrderBy string
 }
 
@@ -40,7 +110,12 @@ type ListMarketsArgs struct {
 	Book            string
 	GreaterThanName string
 	Status          api.Market_Status
-	Limit           int
+	Filter          *filter.Filter
+	// After is the cursor for keyed orders, which cannot use GreaterThanName.
+	After *OrderCursor
+	Limit int
+	// valid options: "name" asc (default), OrderByCreateTime, OrderByTotalCentipoints, OrderBySettledTime
+	OrderBy string
 }
 
 // ListBetsArgs are the arguments for listing bets.
@@ -50,5 +125,77 @@ type ListBetsArgs struct {
 	User            string
 	Market          string
 	ExcludeSettled  bool
+	Filter          *filter.Filter
+	// After is the cursor for keyed orders, which cannot use GreaterThanName.
+	After *OrderCursor
+	Limit int
+	// valid options: "name" asc (default), OrderByCreateTime, OrderByTotalCentipoints, OrderBySettledTime
+	OrderBy string
+}
+
+// ListWebhooksArgs are the arguments for listing webhooks.
+type ListWebhooksArgs struct {
+	Book            string
+	GreaterThanName string
 	Limit           int
 }
+
+// ListDeliveriesArgs are the arguments for listing webhook deliveries across all books. Deliveries are listed by
+// next_attempt_at asc then name asc.
+type ListDeliveriesArgs struct {
+	// if set, deliveries whose next attempt is after DueBefore are excluded
+	DueBefore time.Time
+	Limit     int
+}
+
+// ListEventsArgs are the arguments for listing domain events in sequence order.
+type ListEventsArgs struct {
+	AfterSequence uint64
+	Limit         int
+}
+
+// ListOddsSnapshotsArgs are the arguments for listing a market's odds snapshots in revision order.
+type ListOddsSnapshotsArgs struct {
+	Market        string
+	AfterRevision uint64
+	Limit         int
+}
+
+// ListGrantsArgs are the arguments for listing grants.
+type ListGrantsArgs struct {
+	Book            string
+	GreaterThanName string
+	User            string
+	Limit           int
+}
+
+// ListSeasonsArgs are the arguments for listing a book's seasons in number order.
+type ListSeasonsArgs struct {
+	Book        string
+	AfterNumber uint32
+	Limit       int
+}
+
+// ListTransfersArgs are the arguments for listing transfers.
+type ListTransfersArgs struct {
+	Book            string
+	GreaterThanName string
+	// if set, only transfers from or to the user are listed
+	User  string
+	Limit int
+}
+
+// ListAdjustmentsArgs are the arguments for listing adjustments.
+type ListAdjustmentsArgs struct {
+	Book            string
+	GreaterThanName string
+	User            string
+	Limit           int
+}
+
+// NextEtag returns the etag for the revision of a resource following the one with the given etag. Repos set etags on
+// every write and reject updates whose etag does not match the stored resource with CodeAborted.
+func NextEtag(etag string) string {
+	revision, _ := strconv.ParseUint(etag, 10, 64)
+	return strconv.FormatUint(revision+1, 10)
+}
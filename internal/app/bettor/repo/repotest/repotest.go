@@ -0,0 +1,1605 @@
+// Package repotest provides a conformance test suite for repo.Repo implementations.
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Run runs the conformance suite against repos returned by newRepo. newRepo must return a new, empty repo on every
+// call.
+func Run(t *testing.T, newRepo func() repo.Repo) {
+	t.Run("Users", func(t *testing.T) { testUsers(t, newRepo) })
+	t.Run("ListUsers", func(t *testing.T) { testListUsers(t, newRepo) })
+	t.Run("ListUsersByTotalAfterWrites", func(t *testing.T) { testListUsersByTotalAfterWrites(t, newRepo) })
+	t.Run("ListByOrderAfterWrites", func(t *testing.T) { testListByOrderAfterWrites(t, newRepo) })
+	t.Run("Markets", func(t *testing.T) { testMarkets(t, newRepo) })
+	t.Run("ListMarkets", func(t *testing.T) { testListMarkets(t, newRepo) })
+	t.Run("Bets", func(t *testing.T) { testBets(t, newRepo) })
+	t.Run("ListBets", func(t *testing.T) { testListBets(t, newRepo) })
+	t.Run("Webhooks", func(t *testing.T) { testWebhooks(t, newRepo) })
+	t.Run("Deliveries", func(t *testing.T) { testDeliveries(t, newRepo) })
+	t.Run("Events", func(t *testing.T) { testEvents(t, newRepo) })
+	t.Run("GetMany", func(t *testing.T) { testGetMany(t, newRepo) })
+	t.Run("OddsSnapshots", func(t *testing.T) { testOddsSnapshots(t, newRepo) })
+	t.Run("Grants", func(t *testing.T) { testGrants(t, newRepo) })
+	t.Run("BookSettings", func(t *testing.T) { testBookSettings(t, newRepo) })
+	t.Run("Seasons", func(t *testing.T) { testSeasons(t, newRepo) })
+	t.Run("Transfers", func(t *testing.T) { testTransfers(t, newRepo) })
+	t.Run("Adjustments", func(t *testing.T) { testAdjustments(t, newRepo) })
+	t.Run("Write", func(t *testing.T) { testWrite(t, newRepo) })
+}
+
+func testUsers(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	testCases := []struct {
+		desc      string
+		user      *api.User
+		expectErr bool
+	}{
+		{
+			desc: "basic case",
+			user: &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 100},
+		},
+		{
+			desc: "same username in a different book",
+			user: &api.User{Name: entity.UserN("guild:2", "a"), Username: "rusty", Centipoints: 100},
+		},
+		{
+			desc: "unsettled_centipoints is not persisted",
+			user: &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 100, UnsettledCentipoints: 100},
+		},
+		{
+			desc:      "fails if name already exists",
+			user:      &api.User{Name: user.GetName(), Username: "danny"},
+			expectErr: true,
+		},
+		{
+			desc:      "fails if username already exists in book",
+			user:      &api.User{Name: entity.UserN("guild:1", "b"), Username: "rusty"},
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			r := newRepo()
+			require.Nil(t, r.CreateUser(ctx, proto.Clone(user).(*api.User)))
+			err := r.CreateUser(ctx, proto.Clone(tC.user).(*api.User))
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+
+			expected := proto.Clone(tC.user).(*api.User)
+			expected.UnsettledCentipoints = 0
+			got, err := r.GetUser(ctx, tC.user.GetName())
+			require.Nil(t, err)
+			assertProtoEqual(t, expected, got)
+			got, err = r.GetUserByUsername(ctx, entity.BookN(bookID(tC.user.GetName())), tC.user.GetUsername())
+			require.Nil(t, err)
+			assertProtoEqual(t, expected, got)
+		})
+	}
+
+	t.Run("get fails if not found", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateUser(ctx, proto.Clone(user).(*api.User)))
+		_, err := r.GetUser(ctx, entity.UserN("guild:1", "other"))
+		require.NotNil(t, err)
+		_, err = r.GetUserByUsername(ctx, entity.BookN("guild:2"), user.GetUsername())
+		require.NotNil(t, err)
+	})
+	t.Run("update", func(t *testing.T) {
+		r := newRepo()
+		created := proto.Clone(user).(*api.User)
+		require.Nil(t, r.CreateUser(ctx, created))
+		updated := proto.Clone(created).(*api.User)
+		updated.Centipoints = 1
+		updated.UpdatedAt = timestamppb.Now()
+		require.Nil(t, r.UpdateUser(ctx, proto.Clone(updated).(*api.User)))
+		got, err := r.GetUser(ctx, user.GetName())
+		require.Nil(t, err)
+		assertProtoEqual(t, updated, got)
+	})
+	t.Run("update fails if not found", func(t *testing.T) {
+		r := newRepo()
+		require.NotNil(t, r.UpdateUser(ctx, proto.Clone(user).(*api.User)))
+	})
+	t.Run("update fails if etag is stale", func(t *testing.T) {
+		r := newRepo()
+		created := proto.Clone(user).(*api.User)
+		require.Nil(t, r.CreateUser(ctx, created))
+		require.NotEmpty(t, created.GetEtag())
+		got, err := r.GetUser(ctx, user.GetName())
+		require.Nil(t, err)
+		assert.Equal(t, created.GetEtag(), got.GetEtag())
+
+		require.Nil(t, r.UpdateUser(ctx, got))
+		assert.NotEqual(t, created.GetEtag(), got.GetEtag())
+		err = r.UpdateUser(ctx, created)
+		assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+
+		// the etag returned by the last write is current
+		latest, err := r.GetUser(ctx, user.GetName())
+		require.Nil(t, err)
+		assert.Equal(t, got.GetEtag(), latest.GetEtag())
+		require.Nil(t, r.UpdateUser(ctx, got))
+	})
+	t.Run("hydrates unsettled_centipoints", func(t *testing.T) {
+		r := newRepo()
+		other := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 100}
+		require.Nil(t, r.CreateUser(ctx, proto.Clone(user).(*api.User)))
+		require.Nil(t, r.CreateUser(ctx, proto.Clone(other).(*api.User)))
+		for _, b := range []*api.Bet{
+			{Name: entity.BetN("guild:1", "a"), User: user.GetName(), Market: "m", Centipoints: 200},
+			{Name: entity.BetN("guild:1", "b"), User: user.GetName(), Market: "m", Centipoints: 50},
+			{Name: entity.BetN("guild:1", "c"), User: user.GetName(), Market: "m", Centipoints: 1000, SettledAt: timestamppb.Now()},
+			{Name: entity.BetN("guild:1", "d"), User: other.GetName(), Market: "m", Centipoints: 1},
+		} {
+			require.Nil(t, r.CreateBet(ctx, b))
+		}
+		expected := proto.Clone(user).(*api.User)
+		expected.UnsettledCentipoints = 250
+
+		got, err := r.GetUser(ctx, user.GetName())
+		require.Nil(t, err)
+		assertProtoEqual(t, expected, got)
+		got, err = r.GetUserByUsername(ctx, entity.BookN("guild:1"), user.GetUsername())
+		require.Nil(t, err)
+		assertProtoEqual(t, expected, got)
+		users, _, err := r.ListUsers(ctx, &repo.ListUsersArgs{Book: entity.BookN("guild:1"), Users: []string{user.GetName()}, Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.User{expected}, users)
+
+		// settling a bet releases it
+		bet, err := r.GetBet(ctx, entity.BetN("guild:1", "a"))
+		require.Nil(t, err)
+		bet.SettledAt = timestamppb.Now()
+		require.Nil(t, r.UpdateBet(ctx, bet))
+		expected.UnsettledCentipoints = 50
+		got, err = r.GetUser(ctx, user.GetName())
+		require.Nil(t, err)
+		assertProtoEqual(t, expected, got)
+	})
+}
+
+func testListUsers(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	user1 := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	user2 := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 200}
+	user3 := &api.User{Name: entity.UserN("guild:1", "c"), Username: "linus", Centipoints: 50}
+	user4 := &api.User{Name: entity.UserN("guild:1", "d"), Username: "basher", Centipoints: 100}
+	otherBookUser := &api.User{Name: entity.UserN("guild:2", "a"), Username: "rusty", Centipoints: 1000}
+	unsettledBet := &api.Bet{Name: entity.BetN("guild:1", "a"), User: user3.GetName(), Market: "m", Centipoints: 100}
+	user3Hydrated := proto.Clone(user3).(*api.User)
+	user3Hydrated.UnsettledCentipoints = 100
+
+	r := newRepo()
+	for _, u := range []*api.User{user4, otherBookUser, user2, user1, user3} {
+		require.Nil(t, r.CreateUser(ctx, proto.Clone(u).(*api.User)))
+	}
+	require.Nil(t, r.CreateBet(ctx, unsettledBet))
+
+	testCases := []struct {
+		desc      string
+		args      *repo.ListUsersArgs
+		expected  []*api.User
+		expectErr bool
+	}{
+		{
+			desc:     "ordered by name",
+			args:     &repo.ListUsersArgs{Book: entity.BookN("guild:1")},
+			expected: []*api.User{user1, user2, user3Hydrated, user4},
+		},
+		{
+			desc:     "ordered by name explicitly",
+			args:     &repo.ListUsersArgs{Book: entity.BookN("guild:1"), OrderBy: "name"},
+			expected: []*api.User{user1, user2, user3Hydrated, user4},
+		},
+		{
+			desc: "searches within book",
+			args: &repo.ListUsersArgs{Book: entity.BookN("other")},
+		},
+		{
+			desc:     "list by user names",
+			args:     &repo.ListUsersArgs{Book: entity.BookN("guild:1"), Users: []string{user4.GetName(), user1.GetName(), otherBookUser.GetName()}},
+			expected: []*api.User{user1, user4},
+		},
+		{
+			desc:     "ordered by total_centipoints desc then name",
+			args:     &repo.ListUsersArgs{Book: entity.BookN("guild:1"), OrderBy: "total_centipoints"},
+			expected: []*api.User{user2, user3Hydrated, user1, user4},
+		},
+		{
+			desc:     "list by user names ordered by total_centipoints",
+			args:     &repo.ListUsersArgs{Book: entity.BookN("guild:1"), OrderBy: "total_centipoints", Users: []string{user4.GetName(), user3.GetName(), user1.GetName()}},
+			expected: []*api.User{user3Hydrated, user1, user4},
+		},
+		{
+			desc:      "fails if total_centipoints with GreaterThanName",
+			args:      &repo.ListUsersArgs{Book: entity.BookN("guild:1"), OrderBy: "total_centipoints", GreaterThanName: user1.GetName()},
+			expectErr: true,
+		},
+		{
+			desc:      "fails if invalid order by",
+			args:      &repo.ListUsersArgs{Book: entity.BookN("guild:1"), OrderBy: "bad"},
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			if tC.expectErr {
+				args := *tC.args
+				args.Limit = 10
+				_, _, err := r.ListUsers(ctx, &args)
+				require.NotNil(t, err)
+				return
+			}
+			if tC.args.OrderBy == "total_centipoints" {
+				for limit := 1; limit <= len(tC.expected)+1; limit++ {
+					all := paginate(t, limit, func(cursor *repo.OrderCursor) ([]*api.User, bool, error) {
+						args := *tC.args
+						args.After = cursor
+						args.Limit = limit
+						return r.ListUsers(ctx, &args)
+					}, totalCursor)
+					assertProtosEqual(t, tC.expected, all)
+				}
+				return
+			}
+			for limit := 1; limit <= len(tC.expected)+1; limit++ {
+				all := paginate(t, limit, func(cursor string) ([]*api.User, bool, error) {
+					args := *tC.args
+					args.GreaterThanName = cursor
+					args.Limit = limit
+					return r.ListUsers(ctx, &args)
+				}, (*api.User).GetName)
+				assertProtosEqual(t, tC.expected, all)
+			}
+		})
+	}
+}
+
+func testListUsersByTotalAfterWrites(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	user1 := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	user2 := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 200}
+	r := newRepo()
+	require.Nil(t, r.CreateUser(ctx, user1))
+	require.Nil(t, r.CreateUser(ctx, user2))
+	list := func() []string {
+		users, _, err := r.ListUsers(ctx, &repo.ListUsersArgs{Book: entity.BookN("guild:1"), OrderBy: "total_centipoints", Limit: 10})
+		require.Nil(t, err)
+		var names []string
+		for _, u := range users {
+			names = append(names, u.GetName())
+		}
+		return names
+	}
+	assert.Equal(t, []string{user2.GetName(), user1.GetName()}, list())
+
+	user1.Centipoints = 300
+	require.Nil(t, r.UpdateUser(ctx, user1))
+	assert.Equal(t, []string{user1.GetName(), user2.GetName()}, list())
+
+	bet := &api.Bet{Name: entity.BetN("guild:1", "a"), User: user2.GetName(), Market: "m", Centipoints: 150}
+	require.Nil(t, r.CreateBet(ctx, bet))
+	assert.Equal(t, []string{user2.GetName(), user1.GetName()}, list())
+
+	bet.SettledAt = timestamppb.Now()
+	require.Nil(t, r.UpdateBet(ctx, bet))
+	assert.Equal(t, []string{user1.GetName(), user2.GetName()}, list())
+
+	user3 := &api.User{Name: entity.UserN("guild:1", "c"), Username: "linus", Centipoints: 300}
+	require.Nil(t, r.CreateUser(ctx, user3))
+	assert.Equal(t, []string{user1.GetName(), user3.GetName(), user2.GetName()}, list())
+}
+
+func testListByOrderAfterWrites(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	pool := func(centipoints ...uint64) *api.Market_Pool {
+		p := &api.Pool{}
+		for _, c := range centipoints {
+			p.Outcomes = append(p.Outcomes, &api.Outcome{Centipoints: c})
+		}
+		return &api.Market_Pool{Pool: p}
+	}
+	market1 := &api.Market{Name: entity.MarketN("guild:1", "a"), Status: api.Market_STATUS_OPEN, Type: pool(100)}
+	market2 := &api.Market{Name: entity.MarketN("guild:1", "b"), Status: api.Market_STATUS_OPEN, Type: pool(200)}
+	bet1 := &api.Bet{Name: entity.BetN("guild:1", "a"), User: "rusty", Market: market1.GetName(), Centipoints: 100}
+	bet2 := &api.Bet{Name: entity.BetN("guild:1", "b"), User: "rusty", Market: market2.GetName(), Centipoints: 200}
+	r := newRepo()
+	require.Nil(t, r.CreateMarket(ctx, market1))
+	require.Nil(t, r.CreateMarket(ctx, market2))
+	require.Nil(t, r.CreateBet(ctx, bet1))
+	require.Nil(t, r.CreateBet(ctx, bet2))
+	listMarkets := func(orderBy string) []string {
+		markets, _, err := r.ListMarkets(ctx, &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), OrderBy: orderBy, Limit: 10})
+		require.Nil(t, err)
+		var names []string
+		for _, m := range markets {
+			names = append(names, m.GetName())
+		}
+		return names
+	}
+	listBets := func(args *repo.ListBetsArgs) []string {
+		args.Book, args.Limit = entity.BookN("guild:1"), 10
+		bets, _, err := r.ListBets(ctx, args)
+		require.Nil(t, err)
+		var names []string
+		for _, b := range bets {
+			names = append(names, b.GetName())
+		}
+		return names
+	}
+	assert.Equal(t, []string{market2.GetName(), market1.GetName()}, listMarkets(repo.OrderByTotalCentipoints))
+	assert.Equal(t, []string{bet2.GetName(), bet1.GetName()}, listBets(&repo.ListBetsArgs{User: "rusty", OrderBy: repo.OrderByTotalCentipoints}))
+
+	market1.Type = pool(100, 150)
+	require.Nil(t, r.UpdateMarket(ctx, market1))
+	assert.Equal(t, []string{market1.GetName(), market2.GetName()}, listMarkets(repo.OrderByTotalCentipoints))
+
+	market2.SettledAt = timestamppb.Now()
+	require.Nil(t, r.UpdateMarket(ctx, market2))
+	assert.Equal(t, []string{market2.GetName(), market1.GetName()}, listMarkets(repo.OrderBySettledTime))
+
+	bet1.SettledAt = timestamppb.Now()
+	require.Nil(t, r.UpdateBet(ctx, bet1))
+	assert.Equal(t, []string{bet1.GetName(), bet2.GetName()}, listBets(&repo.ListBetsArgs{OrderBy: repo.OrderBySettledTime}))
+
+	bet1.User, bet1.Market = "danny", market2.GetName()
+	require.Nil(t, r.UpdateBet(ctx, bet1))
+	assert.Equal(t, []string{bet2.GetName()}, listBets(&repo.ListBetsArgs{User: "rusty", OrderBy: repo.OrderBySettledTime}))
+	assert.Equal(t, []string{bet1.GetName()}, listBets(&repo.ListBetsArgs{User: "danny", OrderBy: repo.OrderBySettledTime}))
+	assert.Equal(t, []string{bet2.GetName(), bet1.GetName()}, listBets(&repo.ListBetsArgs{Market: market2.GetName(), OrderBy: repo.OrderByTotalCentipoints}))
+	assert.Empty(t, listBets(&repo.ListBetsArgs{Market: market1.GetName(), OrderBy: repo.OrderByTotalCentipoints}))
+}
+
+func mustParseFilter(t *testing.T, expr string, msg proto.Message) *filter.Filter {
+	t.Helper()
+	f, err := filter.Parse(expr, msg.ProtoReflect().Descriptor())
+	require.Nil(t, err)
+	return f
+}
+
+func totalCursor(u *api.User) *repo.OrderCursor {
+	return &repo.OrderCursor{Value: u.GetCentipoints() + u.GetUnsettledCentipoints(), Name: u.GetName()}
+}
+
+func testMarkets(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	market := &api.Market{
+		Name:    entity.MarketN("guild:1", "a"),
+		Title:   "Will I PB?",
+		Creator: entity.UserN("guild:1", "a"),
+		Status:  api.Market_STATUS_OPEN,
+		Type: &api.Market_Pool{
+			Pool: &api.Pool{
+				Outcomes: []*api.Outcome{
+					{Name: entity.OutcomeN("guild:1", "a", "0"), Title: "Yes"},
+					{Name: entity.OutcomeN("guild:1", "a", "1"), Title: "No"},
+				},
+			},
+		},
+	}
+	t.Run("create and get", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateMarket(ctx, proto.Clone(market).(*api.Market)))
+		got, err := r.GetMarket(ctx, market.GetName())
+		require.Nil(t, err)
+		assertProtoEqual(t, market, got)
+	})
+	t.Run("create fails if name already exists", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateMarket(ctx, proto.Clone(market).(*api.Market)))
+		require.NotNil(t, r.CreateMarket(ctx, proto.Clone(market).(*api.Market)))
+	})
+	t.Run("get fails if not found", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateMarket(ctx, proto.Clone(market).(*api.Market)))
+		_, err := r.GetMarket(ctx, entity.MarketN("guild:1", "other"))
+		require.NotNil(t, err)
+	})
+	t.Run("update", func(t *testing.T) {
+		r := newRepo()
+		created := proto.Clone(market).(*api.Market)
+		require.Nil(t, r.CreateMarket(ctx, created))
+		updated := proto.Clone(created).(*api.Market)
+		updated.Status = api.Market_STATUS_SETTLED
+		updated.SettledAt = timestamppb.Now()
+		updated.GetPool().GetOutcomes()[0].Centipoints = 100
+		updated.GetPool().Winner = updated.GetPool().GetOutcomes()[0].GetName()
+		require.Nil(t, r.UpdateMarket(ctx, proto.Clone(updated).(*api.Market)))
+		got, err := r.GetMarket(ctx, market.GetName())
+		require.Nil(t, err)
+		assertProtoEqual(t, updated, got)
+	})
+	t.Run("update fails if not found", func(t *testing.T) {
+		r := newRepo()
+		require.NotNil(t, r.UpdateMarket(ctx, proto.Clone(market).(*api.Market)))
+	})
+	t.Run("update fails if etag is stale", func(t *testing.T) {
+		r := newRepo()
+		created := proto.Clone(market).(*api.Market)
+		require.Nil(t, r.CreateMarket(ctx, created))
+		require.NotEmpty(t, created.GetEtag())
+		got, err := r.GetMarket(ctx, market.GetName())
+		require.Nil(t, err)
+		assert.Equal(t, created.GetEtag(), got.GetEtag())
+
+		require.Nil(t, r.UpdateMarket(ctx, got))
+		assert.NotEqual(t, created.GetEtag(), got.GetEtag())
+		err = r.UpdateMarket(ctx, created)
+		assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+
+		// the etag returned by the last write is current
+		latest, err := r.GetMarket(ctx, market.GetName())
+		require.Nil(t, err)
+		assert.Equal(t, got.GetEtag(), latest.GetEtag())
+		require.Nil(t, r.UpdateMarket(ctx, got))
+	})
+}
+
+func testListMarkets(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	at := func(day int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2026, 1, day, 0, 0, 0, 0, time.UTC))
+	}
+	pool := func(centipoints ...uint64) *api.Market_Pool {
+		p := &api.Pool{}
+		for _, c := range centipoints {
+			p.Outcomes = append(p.Outcomes, &api.Outcome{Centipoints: c})
+		}
+		return &api.Market_Pool{Pool: p}
+	}
+	market1 := &api.Market{Name: entity.MarketN("guild:1", "a"), CreatedAt: at(2), Creator: "x", Status: api.Market_STATUS_OPEN, Type: pool(100, 50)}
+	market2 := &api.Market{Name: entity.MarketN("guild:1", "b"), CreatedAt: at(4), Status: api.Market_STATUS_OPEN, Type: pool(300)}
+	market3 := &api.Market{Name: entity.MarketN("guild:1", "c"), CreatedAt: at(2), Creator: "x", Status: api.Market_STATUS_BETS_LOCKED, Type: pool(0, 150)}
+	market4 := &api.Market{Name: entity.MarketN("guild:1", "d"), CreatedAt: at(1), SettledAt: at(5), Status: api.Market_STATUS_SETTLED, Type: pool(10)}
+	otherBookMarket := &api.Market{Name: entity.MarketN("guild:2", "a"), CreatedAt: at(9), Status: api.Market_STATUS_OPEN, Type: pool(1000)}
+
+	r := newRepo()
+	for _, m := range []*api.Market{market3, otherBookMarket, market1, market4, market2} {
+		require.Nil(t, r.CreateMarket(ctx, proto.Clone(m).(*api.Market)))
+	}
+
+	testCases := []struct {
+		desc     string
+		args     *repo.ListMarketsArgs
+		expected []*api.Market
+	}{
+		{
+			desc:     "ordered by name",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1")},
+			expected: []*api.Market{market1, market2, market3, market4},
+		},
+		{
+			desc: "searches within book",
+			args: &repo.ListMarketsArgs{Book: entity.BookN("other")},
+		},
+		{
+			desc:     "list by status",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), Status: api.Market_STATUS_OPEN},
+			expected: []*api.Market{market1, market2},
+		},
+		{
+			desc: "list by status with no matches",
+			args: &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), Status: api.Market_STATUS_CANCELED},
+		},
+		{
+			desc:     "list by filter",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), Filter: mustParseFilter(t, "status != OPEN", &api.Market{})},
+			expected: []*api.Market{market3, market4},
+		},
+		{
+			desc:     "list by filter on creator and create time",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), Filter: mustParseFilter(t, `creator = "x" AND created_at > "2026-01-01T12:00:00Z"`, &api.Market{})},
+			expected: []*api.Market{market1, market3},
+		},
+		{
+			desc:     "list by filter on a nested field",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), Filter: mustParseFilter(t, `creator = "x" AND pool.winner = ""`, &api.Market{})},
+			expected: []*api.Market{market1, market3},
+		},
+		{
+			desc:     "list by negated filter",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), Filter: mustParseFilter(t, `NOT (creator = "x" OR settled_at:*)`, &api.Market{})},
+			expected: []*api.Market{market2},
+		},
+		{
+			desc:     "list by status and filter",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), Status: api.Market_STATUS_OPEN, Filter: mustParseFilter(t, `name != "`+market1.GetName()+`"`, &api.Market{})},
+			expected: []*api.Market{market2},
+		},
+		{
+			desc:     "ordered by create time desc then name",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderByCreateTime},
+			expected: []*api.Market{market2, market1, market3, market4},
+		},
+		{
+			desc:     "ordered by total centipoints desc then name",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderByTotalCentipoints},
+			expected: []*api.Market{market2, market1, market3, market4},
+		},
+		{
+			desc:     "ordered by settled time desc with unsettled last",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderBySettledTime},
+			expected: []*api.Market{market4, market1, market2, market3},
+		},
+		{
+			desc:     "ordered by create time with filter",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderByCreateTime, Filter: mustParseFilter(t, `created_at < "2026-01-03" AND pool.winner = ""`, &api.Market{})},
+			expected: []*api.Market{market1, market3, market4},
+		},
+		{
+			desc:     "ordered by create time with status",
+			args:     &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), Status: api.Market_STATUS_OPEN, OrderBy: repo.OrderByCreateTime},
+			expected: []*api.Market{market2, market1},
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			if repo.IsKeyedOrder(tC.args.OrderBy) {
+				for limit := 1; limit <= len(tC.expected)+1; limit++ {
+					all := paginate(t, limit, func(cursor *repo.OrderCursor) ([]*api.Market, bool, error) {
+						args := *tC.args
+						args.After = cursor
+						args.Limit = limit
+						return r.ListMarkets(ctx, &args)
+					}, func(m *api.Market) *repo.OrderCursor {
+						return &repo.OrderCursor{Value: repo.MarketOrderKey(tC.args.OrderBy, m), Name: m.GetName()}
+					})
+					assertProtosEqual(t, tC.expected, all)
+				}
+				return
+			}
+			for limit := 1; limit <= len(tC.expected)+1; limit++ {
+				all := paginate(t, limit, func(cursor string) ([]*api.Market, bool, error) {
+					args := *tC.args
+					args.GreaterThanName = cursor
+					args.Limit = limit
+					return r.ListMarkets(ctx, &args)
+				}, (*api.Market).GetName)
+				assertProtosEqual(t, tC.expected, all)
+			}
+		})
+	}
+	t.Run("fails if invalid order by", func(t *testing.T) {
+		_, _, err := r.ListMarkets(ctx, &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), OrderBy: "bad", Limit: 10})
+		require.NotNil(t, err)
+		_, _, err = r.ListMarkets(ctx, &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderByCreateTime, GreaterThanName: "a", Limit: 10})
+		require.NotNil(t, err)
+	})
+}
+
+func testBets(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	bet := &api.Bet{
+		Name:        entity.BetN("guild:1", "a"),
+		User:        entity.UserN("guild:1", "a"),
+		Market:      entity.MarketN("guild:1", "a"),
+		Centipoints: 100,
+		Type:        &api.Bet_Outcome{Outcome: entity.OutcomeN("guild:1", "a", "0")},
+	}
+	t.Run("create and get", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateBet(ctx, proto.Clone(bet).(*api.Bet)))
+		got, err := r.GetBet(ctx, bet.GetName())
+		require.Nil(t, err)
+		assertProtoEqual(t, bet, got)
+	})
+	t.Run("create fails if name already exists", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateBet(ctx, proto.Clone(bet).(*api.Bet)))
+		require.NotNil(t, r.CreateBet(ctx, proto.Clone(bet).(*api.Bet)))
+	})
+	t.Run("get fails if not found", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateBet(ctx, proto.Clone(bet).(*api.Bet)))
+		_, err := r.GetBet(ctx, entity.BetN("guild:1", "other"))
+		require.NotNil(t, err)
+	})
+	t.Run("update", func(t *testing.T) {
+		r := newRepo()
+		created := proto.Clone(bet).(*api.Bet)
+		require.Nil(t, r.CreateBet(ctx, created))
+		updated := proto.Clone(created).(*api.Bet)
+		updated.SettledAt = timestamppb.Now()
+		updated.SettledCentipoints = 200
+		require.Nil(t, r.UpdateBet(ctx, proto.Clone(updated).(*api.Bet)))
+		got, err := r.GetBet(ctx, bet.GetName())
+		require.Nil(t, err)
+		assertProtoEqual(t, updated, got)
+	})
+	t.Run("update fails if not found", func(t *testing.T) {
+		r := newRepo()
+		require.NotNil(t, r.UpdateBet(ctx, proto.Clone(bet).(*api.Bet)))
+	})
+	t.Run("update fails if etag is stale", func(t *testing.T) {
+		r := newRepo()
+		created := proto.Clone(bet).(*api.Bet)
+		require.Nil(t, r.CreateBet(ctx, created))
+		require.NotEmpty(t, created.GetEtag())
+		got, err := r.GetBet(ctx, bet.GetName())
+		require.Nil(t, err)
+		assert.Equal(t, created.GetEtag(), got.GetEtag())
+
+		require.Nil(t, r.UpdateBet(ctx, got))
+		assert.NotEqual(t, created.GetEtag(), got.GetEtag())
+		err = r.UpdateBet(ctx, created)
+		assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+
+		// the etag returned by the last write is current
+		latest, err := r.GetBet(ctx, bet.GetName())
+		require.Nil(t, err)
+		assert.Equal(t, got.GetEtag(), latest.GetEtag())
+		require.Nil(t, r.UpdateBet(ctx, got))
+	})
+}
+
+func testListBets(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	at := func(day int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2026, 1, day, 0, 0, 0, 0, time.UTC))
+	}
+	bet1 := &api.Bet{Name: entity.BetN("guild:1", "a"), CreatedAt: at(1), User: "rusty", Market: "one", Centipoints: 1}
+	bet2 := &api.Bet{Name: entity.BetN("guild:1", "b"), CreatedAt: at(3), User: "danny", Market: "two", Centipoints: 5}
+	bet3 := &api.Bet{Name: entity.BetN("guild:1", "c"), CreatedAt: at(2), User: "linus", Market: "three", Centipoints: 5, SettledAt: at(4)}
+	bet4 := &api.Bet{Name: entity.BetN("guild:1", "d"), CreatedAt: at(3), User: "rusty", Market: "two", Centipoints: 2}
+	otherBookBet := &api.Bet{Name: entity.BetN("guild:2", "a"), User: "rusty", Market: "one", Centipoints: 1}
+
+	r := newRepo()
+	for _, b := range []*api.Bet{bet4, otherBookBet, bet2, bet1, bet3} {
+		require.Nil(t, r.CreateBet(ctx, proto.Clone(b).(*api.Bet)))
+	}
+
+	testCases := []struct {
+		desc     string
+		args     *repo.ListBetsArgs
+		expected []*api.Bet
+	}{
+		{
+			desc:     "ordered by name",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1")},
+			expected: []*api.Bet{bet1, bet2, bet3, bet4},
+		},
+		{
+			desc: "searches within book",
+			args: &repo.ListBetsArgs{Book: entity.BookN("other")},
+		},
+		{
+			desc:     "list by user",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), User: "rusty"},
+			expected: []*api.Bet{bet1, bet4},
+		},
+		{
+			desc:     "list by market",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), Market: "two"},
+			expected: []*api.Bet{bet2, bet4},
+		},
+		{
+			desc:     "list by user and market",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), User: "rusty", Market: "two"},
+			expected: []*api.Bet{bet4},
+		},
+		{
+			desc:     "list excluding settled",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), ExcludeSettled: true},
+			expected: []*api.Bet{bet1, bet2, bet4},
+		},
+		{
+			desc: "list by user and market - no match",
+			args: &repo.ListBetsArgs{Book: entity.BookN("guild:1"), User: "linus", Market: "two"},
+		},
+		{
+			desc:     "list by filter",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), Filter: mustParseFilter(t, `market = "two" OR settled_at:*`, &api.Bet{})},
+			expected: []*api.Bet{bet2, bet3, bet4},
+		},
+		{
+			desc:     "list by user and filter",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), User: "rusty", Filter: mustParseFilter(t, `market = "two"`, &api.Bet{})},
+			expected: []*api.Bet{bet4},
+		},
+		{
+			desc:     "ordered by create time desc then name",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderByCreateTime},
+			expected: []*api.Bet{bet2, bet4, bet3, bet1},
+		},
+		{
+			desc:     "ordered by total centipoints desc then name",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderByTotalCentipoints},
+			expected: []*api.Bet{bet2, bet3, bet4, bet1},
+		},
+		{
+			desc:     "ordered by settled time desc with unsettled last",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderBySettledTime},
+			expected: []*api.Bet{bet3, bet1, bet2, bet4},
+		},
+		{
+			desc:     "ordered by total centipoints by user",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), User: "rusty", OrderBy: repo.OrderByTotalCentipoints},
+			expected: []*api.Bet{bet4, bet1},
+		},
+		{
+			desc:     "ordered by create time by market",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), Market: "two", OrderBy: repo.OrderByCreateTime},
+			expected: []*api.Bet{bet2, bet4},
+		},
+		{
+			desc:     "ordered by create time excluding settled",
+			args:     &repo.ListBetsArgs{Book: entity.BookN("guild:1"), ExcludeSettled: true, OrderBy: repo.OrderByCreateTime},
+			expected: []*api.Bet{bet2, bet4, bet1},
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			if repo.IsKeyedOrder(tC.args.OrderBy) {
+				for limit := 1; limit <= len(tC.expected)+1; limit++ {
+					all := paginate(t, limit, func(cursor *repo.OrderCursor) ([]*api.Bet, bool, error) {
+						args := *tC.args
+						args.After = cursor
+						args.Limit = limit
+						return r.ListBets(ctx, &args)
+					}, func(b *api.Bet) *repo.OrderCursor {
+						return &repo.OrderCursor{Value: repo.BetOrderKey(tC.args.OrderBy, b), Name: b.GetName()}
+					})
+					assertProtosEqual(t, tC.expected, all)
+				}
+				return
+			}
+			for limit := 1; limit <= len(tC.expected)+1; limit++ {
+				all := paginate(t, limit, func(cursor string) ([]*api.Bet, bool, error) {
+					args := *tC.args
+					args.GreaterThanName = cursor
+					args.Limit = limit
+					return r.ListBets(ctx, &args)
+				}, (*api.Bet).GetName)
+				assertProtosEqual(t, tC.expected, all)
+			}
+		})
+	}
+	t.Run("fails if invalid order by", func(t *testing.T) {
+		_, _, err := r.ListBets(ctx, &repo.ListBetsArgs{Book: entity.BookN("guild:1"), OrderBy: "bad", Limit: 10})
+		require.NotNil(t, err)
+		_, _, err = r.ListBets(ctx, &repo.ListBetsArgs{Book: entity.BookN("guild:1"), OrderBy: repo.OrderByCreateTime, GreaterThanName: "a", Limit: 10})
+		require.NotNil(t, err)
+	})
+}
+
+func testWebhooks(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	webhook := &api.Webhook{
+		Name:      entity.WebhookN("guild:1", "a"),
+		CreatedAt: timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Url:       "https://example.com/hook",
+		Secret:    "shh",
+	}
+	t.Run("create and get", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateWebhook(ctx, proto.Clone(webhook).(*api.Webhook)))
+		got, err := r.GetWebhook(ctx, webhook.GetName())
+		require.Nil(t, err)
+		assertProtoEqual(t, webhook, got)
+	})
+	t.Run("create fails if name already exists", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateWebhook(ctx, proto.Clone(webhook).(*api.Webhook)))
+		require.NotNil(t, r.CreateWebhook(ctx, proto.Clone(webhook).(*api.Webhook)))
+	})
+	t.Run("get fails if not found", func(t *testing.T) {
+		r := newRepo()
+		_, err := r.GetWebhook(ctx, webhook.GetName())
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+	t.Run("delete", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateWebhook(ctx, proto.Clone(webhook).(*api.Webhook)))
+		require.Nil(t, r.DeleteWebhook(ctx, webhook.GetName()))
+		_, err := r.GetWebhook(ctx, webhook.GetName())
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+		err = r.DeleteWebhook(ctx, webhook.GetName())
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+	t.Run("list", func(t *testing.T) {
+		r := newRepo()
+		var expected []*api.Webhook
+		for _, id := range []string{"c", "a", "b"} {
+			w := &api.Webhook{Name: entity.WebhookN("guild:1", id), Url: "https://example.com/" + id}
+			require.Nil(t, r.CreateWebhook(ctx, proto.Clone(w).(*api.Webhook)))
+			expected = append(expected, w)
+		}
+		require.Nil(t, r.CreateWebhook(ctx, &api.Webhook{Name: entity.WebhookN("guild:2", "a"), Url: "https://example.com/other"}))
+		expected[0], expected[1], expected[2] = expected[1], expected[2], expected[0]
+
+		got := paginate(t, 2, func(cursor string) ([]*api.Webhook, bool, error) {
+			return r.ListWebhooks(ctx, &repo.ListWebhooksArgs{Book: entity.BookN("guild:1"), GreaterThanName: cursor, Limit: 2})
+		}, (*api.Webhook).GetName)
+		assertProtosEqual(t, expected, got)
+	})
+}
+
+func testDeliveries(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	delivery := func(book, id string, nextAttemptAt time.Time) *api.WebhookDelivery {
+		return &api.WebhookDelivery{
+			Name: entity.DeliveryN(book, "a", id),
+			Event: &api.WebhookEvent{
+				Id:       id,
+				Type:     api.WebhookEvent_TYPE_BET_PLACED,
+				Resource: &api.WebhookEvent_Bet{Bet: &api.Bet{Name: entity.BetN(book, id), Centipoints: 100}},
+			},
+			NextAttemptAt: timestamppb.New(nextAttemptAt),
+		}
+	}
+	a := delivery("guild:1", "a", now)
+	b := delivery("guild:2", "b", now)
+	c := delivery("guild:1", "c", now.Add(-time.Minute))
+	d := delivery("guild:2", "d", now.Add(time.Minute))
+	testCases := []struct {
+		desc     string
+		args     *repo.ListDeliveriesArgs
+		expected []*api.WebhookDelivery
+	}{
+		{
+			desc:     "lists all by next attempt then name across books",
+			args:     &repo.ListDeliveriesArgs{Limit: 10},
+			expected: []*api.WebhookDelivery{c, a, b, d},
+		},
+		{
+			desc:     "excludes deliveries that are not due",
+			args:     &repo.ListDeliveriesArgs{DueBefore: now, Limit: 10},
+			expected: []*api.WebhookDelivery{c, a, b},
+		},
+		{
+			desc:     "limit",
+			args:     &repo.ListDeliveriesArgs{Limit: 2},
+			expected: []*api.WebhookDelivery{c, a},
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			r := newRepo()
+			for _, d := range []*api.WebhookDelivery{a, b, c, d} {
+				require.Nil(t, r.PutDelivery(ctx, proto.Clone(d).(*api.WebhookDelivery)))
+			}
+			got, hasMore, err := r.ListDeliveries(ctx, tC.args)
+			require.Nil(t, err)
+			assertProtosEqual(t, tC.expected, got)
+			assert.Equal(t, len(tC.expected) == tC.args.Limit, hasMore)
+		})
+	}
+	t.Run("put overwrites", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.PutDelivery(ctx, proto.Clone(a).(*api.WebhookDelivery)))
+		require.Nil(t, r.PutDelivery(ctx, proto.Clone(c).(*api.WebhookDelivery)))
+		retried := proto.Clone(c).(*api.WebhookDelivery)
+		retried.Attempts = 1
+		retried.NextAttemptAt = timestamppb.New(now.Add(time.Hour))
+		require.Nil(t, r.PutDelivery(ctx, proto.Clone(retried).(*api.WebhookDelivery)))
+
+		got, _, err := r.ListDeliveries(ctx, &repo.ListDeliveriesArgs{Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.WebhookDelivery{a, retried}, got)
+		got, _, err = r.ListDeliveries(ctx, &repo.ListDeliveriesArgs{DueBefore: now, Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.WebhookDelivery{a}, got)
+	})
+	t.Run("delete", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.PutDelivery(ctx, proto.Clone(a).(*api.WebhookDelivery)))
+		require.Nil(t, r.PutDelivery(ctx, proto.Clone(b).(*api.WebhookDelivery)))
+		require.Nil(t, r.DeleteDelivery(ctx, a.GetName()))
+		err := r.DeleteDelivery(ctx, a.GetName())
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		got, _, err := r.ListDeliveries(ctx, &repo.ListDeliveriesArgs{Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.WebhookDelivery{b}, got)
+	})
+}
+
+// paginate lists all pages and asserts that every page respects limit and that only the last page has no more.
+func paginate[T proto.Message, C any](t *testing.T, limit int, list func(cursor C) ([]T, bool, error), next func(T) C) []T {
+	t.Helper()
+	var all []T
+	var cursor C
+	for i := 0; ; i++ {
+		require.Less(t, i, 1000, "pagination did not terminate")
+		page, hasMore, err := list(cursor)
+		require.Nil(t, err)
+		require.LessOrEqual(t, len(page), limit)
+		all = append(all, page...)
+		if !hasMore {
+			break
+		}
+		require.Len(t, page, limit, "only the last page may be short")
+		cursor = next(page[len(page)-1])
+	}
+	return all
+}
+
+// assertProtoEqual asserts that expected and actual are equal ignoring etags, which are covered by the stale etag tests.
+func assertProtoEqual(t *testing.T, expected, actual proto.Message) {
+	t.Helper()
+	expected, actual = withoutEtag(expected), withoutEtag(actual)
+	assert.True(t, proto.Equal(expected, actual), fmt.Sprintf("expected: %v\nactual: %v", expected, actual))
+}
+
+func withoutEtag(m proto.Message) proto.Message {
+	m = proto.Clone(m)
+	switch m := m.(type) {
+	case *api.User:
+		m.Etag = ""
+	case *api.Market:
+		m.Etag = ""
+	case *api.Bet:
+		m.Etag = ""
+	}
+	return m
+}
+
+func assertProtosEqual[T proto.Message](t *testing.T, expected, actual []T) {
+	t.Helper()
+	if !assert.Len(t, actual, len(expected)) {
+		return
+	}
+	for i := range expected {
+		assertProtoEqual(t, expected[i], actual[i])
+	}
+}
+
+func bookID(userName string) string {
+	bookID, _ := entity.UserIDs(userName)
+	return bookID
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func testEvents(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	market := &api.Market{Name: entity.MarketN("guild:1", "a"), Title: "Will I PR?", Status: api.Market_STATUS_OPEN}
+	bet := &api.Bet{Name: entity.BetN("guild:1", "a"), User: user.GetName(), Market: market.GetName(), Centipoints: 100}
+	event := func(id string) *api.DomainEvent {
+		return &api.DomainEvent{Id: id, Book: "books/guild:1", Payload: &api.DomainEvent_BetPlaced{BetPlaced: &api.BetPlaced{Bet: bet}}}
+	}
+	// write creates the user, market, and bet with events a-f, two of them on the market update.
+	write := func(t *testing.T, r repo.Repo) {
+		u, m, b := proto.Clone(user).(*api.User), proto.Clone(market).(*api.Market), proto.Clone(bet).(*api.Bet)
+		require.Nil(t, r.CreateUser(ctx, u, event("a")))
+		require.Nil(t, r.CreateMarket(ctx, m))
+		require.Nil(t, r.UpdateMarket(ctx, m, event("b"), event("c")))
+		require.Nil(t, r.CreateBet(ctx, b, event("d")))
+		require.Nil(t, r.UpdateUser(ctx, u, event("e")))
+		require.Nil(t, r.UpdateBet(ctx, b, event("f")))
+	}
+	sequenced := func(ids ...string) []*api.DomainEvent {
+		var out []*api.DomainEvent
+		for _, id := range ids {
+			e := event(id)
+			e.Sequence = uint64(id[0]-'a') + 1
+			out = append(out, e)
+		}
+		return out
+	}
+	testCases := []struct {
+		desc       string
+		args       *repo.ListEventsArgs
+		expected   []*api.DomainEvent
+		expectMore bool
+	}{
+		{
+			desc:     "lists all in sequence order",
+			args:     &repo.ListEventsArgs{Limit: 10},
+			expected: sequenced("a", "b", "c", "d", "e", "f"),
+		},
+		{
+			desc:       "limit",
+			args:       &repo.ListEventsArgs{Limit: 2},
+			expected:   sequenced("a", "b"),
+			expectMore: true,
+		},
+		{
+			desc:     "after sequence",
+			args:     &repo.ListEventsArgs{AfterSequence: 3, Limit: 10},
+			expected: sequenced("d", "e", "f"),
+		},
+		{
+			desc: "after last sequence",
+			args: &repo.ListEventsArgs{AfterSequence: 6, Limit: 10},
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			r := newRepo()
+			write(t, r)
+			got, hasMore, err := r.ListEvents(ctx, tC.args)
+			require.Nil(t, err)
+			assertProtosEqual(t, tC.expected, got)
+			assert.Equal(t, tC.expectMore, hasMore)
+		})
+	}
+	t.Run("write assigns sequences", func(t *testing.T) {
+		r := newRepo()
+		a, b := event("a"), event("b")
+		require.Nil(t, r.CreateUser(ctx, proto.Clone(user).(*api.User), a, b))
+		assert.Equal(t, uint64(1), a.GetSequence())
+		assert.Equal(t, uint64(2), b.GetSequence())
+	})
+	t.Run("failed write does not persist events", func(t *testing.T) {
+		r := newRepo()
+		created := proto.Clone(user).(*api.User)
+		require.Nil(t, r.CreateUser(ctx, created, event("a")))
+		require.NotNil(t, r.CreateUser(ctx, proto.Clone(user).(*api.User), event("b")))
+		require.NotNil(t, r.UpdateUser(ctx, proto.Clone(user).(*api.User), event("c")))
+		require.NotNil(t, r.UpdateBet(ctx, proto.Clone(bet).(*api.Bet), event("d")))
+		require.Nil(t, r.UpdateUser(ctx, created, event("e")))
+
+		got, _, err := r.ListEvents(ctx, &repo.ListEventsArgs{Limit: 10})
+		require.Nil(t, err)
+		expected := sequenced("a", "b")
+		expected[1].Id = "e"
+		assertProtosEqual(t, expected, got)
+	})
+	t.Run("delete events", func(t *testing.T) {
+		r := newRepo()
+		write(t, r)
+		require.Nil(t, r.DeleteEvents(ctx, 3))
+		got, _, err := r.ListEvents(ctx, &repo.ListEventsArgs{Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, sequenced("d", "e", "f"), got)
+
+		// the newest event is kept so sequences keep increasing
+		require.Nil(t, r.DeleteEvents(ctx, 10))
+		got, _, err = r.ListEvents(ctx, &repo.ListEventsArgs{Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, sequenced("f"), got)
+		g := event("g")
+		require.Nil(t, r.CreateMarket(ctx, &api.Market{Name: entity.MarketN("guild:1", "b")}, g))
+		assert.Equal(t, uint64(7), g.GetSequence())
+	})
+	t.Run("cursors", func(t *testing.T) {
+		r := newRepo()
+		got, err := r.GetCursor(ctx, "webhooks")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), got)
+		require.Nil(t, r.PutCursor(ctx, "webhooks", 3))
+		require.Nil(t, r.PutCursor(ctx, "webhooks", 5))
+		require.Nil(t, r.PutCursor(ctx, "stats", 1))
+		got, err = r.GetCursor(ctx, "webhooks")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(5), got)
+		got, err = r.GetCursor(ctx, "stats")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), got)
+	})
+}
+
+func testGetMany(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	userA := &api.User{Name: entity.UserN("guild:1", "a"), Username: "a", Centipoints: 100}
+	userB := &api.User{Name: entity.UserN("guild:2", "b"), Username: "b", Centipoints: 100}
+	market := &api.Market{Name: entity.MarketN("guild:1", "a"), Status: api.Market_STATUS_OPEN}
+	betA := &api.Bet{Name: entity.BetN("guild:1", "a"), User: userA.GetName(), Market: market.GetName(), Centipoints: 10}
+	betB := &api.Bet{Name: entity.BetN("guild:1", "b"), User: userA.GetName(), Market: market.GetName(), Centipoints: 10}
+	r := newRepo()
+	var users []*api.User
+	for _, u := range []*api.User{userA, userB} {
+		u := proto.Clone(u).(*api.User)
+		require.Nil(t, r.CreateUser(ctx, u))
+		users = append(users, u)
+	}
+	markets := []*api.Market{proto.Clone(market).(*api.Market)}
+	require.Nil(t, r.CreateMarket(ctx, markets[0]))
+	var bets []*api.Bet
+	for _, b := range []*api.Bet{betA, betB} {
+		b := proto.Clone(b).(*api.Bet)
+		require.Nil(t, r.CreateBet(ctx, b))
+		bets = append(bets, b)
+	}
+	users[0].UnsettledCentipoints = 20
+
+	t.Run("users across books", func(t *testing.T) {
+		got, err := r.GetUsers(ctx, []string{userB.GetName(), "books/guild:1/users/missing", userA.GetName(), "bad"})
+		require.Nil(t, err)
+		sort.Slice(got, func(i, j int) bool { return got[i].GetName() < got[j].GetName() })
+		assertProtosEqual(t, users, got)
+	})
+	t.Run("markets", func(t *testing.T) {
+		got, err := r.GetMarkets(ctx, []string{market.GetName(), entity.MarketN("guild:3", "a")})
+		require.Nil(t, err)
+		assertProtosEqual(t, markets, got)
+	})
+	t.Run("bets", func(t *testing.T) {
+		got, err := r.GetBets(ctx, []string{betB.GetName(), betA.GetName(), entity.BetN("guild:1", "c")})
+		require.Nil(t, err)
+		sort.Slice(got, func(i, j int) bool { return got[i].GetName() < got[j].GetName() })
+		assertProtosEqual(t, bets, got)
+	})
+	t.Run("none found", func(t *testing.T) {
+		got, err := r.GetUsers(ctx, []string{entity.UserN("guild:3", "a")})
+		require.Nil(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+func testOddsSnapshots(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	marketA, marketB := entity.MarketN("guild:1", "a"), entity.MarketN("guild:1", "ab")
+	snapshot := func(market string, revision, total uint64) *api.OddsSnapshot {
+		return &api.OddsSnapshot{
+			Market:           market,
+			Revision:         revision,
+			TotalCentipoints: total,
+			Outcomes:         []*api.OutcomeOdds{{Outcome: market + "/outcomes/1", Centipoints: total, ImpliedProbability: 1}},
+		}
+	}
+	// write puts snapshots of market a out of order, overwrites one, and puts one of market b.
+	write := func(t *testing.T, r repo.Repo) {
+		for _, s := range []*api.OddsSnapshot{
+			snapshot(marketA, 3, 300),
+			snapshot(marketA, 1, 100),
+			snapshot(marketB, 1, 100),
+			snapshot(marketA, 2, 100),
+			snapshot(marketA, 2, 200),
+		} {
+			require.Nil(t, r.PutOddsSnapshot(ctx, s))
+		}
+	}
+	testCases := []struct {
+		desc       string
+		args       *repo.ListOddsSnapshotsArgs
+		expected   []*api.OddsSnapshot
+		expectMore bool
+	}{
+		{
+			desc:     "lists a market's snapshots in revision order",
+			args:     &repo.ListOddsSnapshotsArgs{Market: marketA, Limit: 10},
+			expected: []*api.OddsSnapshot{snapshot(marketA, 1, 100), snapshot(marketA, 2, 200), snapshot(marketA, 3, 300)},
+		},
+		{
+			desc:     "other market",
+			args:     &repo.ListOddsSnapshotsArgs{Market: marketB, Limit: 10},
+			expected: []*api.OddsSnapshot{snapshot(marketB, 1, 100)},
+		},
+		{
+			desc:       "limit",
+			args:       &repo.ListOddsSnapshotsArgs{Market: marketA, Limit: 2},
+			expected:   []*api.OddsSnapshot{snapshot(marketA, 1, 100), snapshot(marketA, 2, 200)},
+			expectMore: true,
+		},
+		{
+			desc:     "after revision",
+			args:     &repo.ListOddsSnapshotsArgs{Market: marketA, AfterRevision: 2, Limit: 10},
+			expected: []*api.OddsSnapshot{snapshot(marketA, 3, 300)},
+		},
+		{
+			desc: "market without snapshots",
+			args: &repo.ListOddsSnapshotsArgs{Market: entity.MarketN("guild:2", "a"), Limit: 10},
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			r := newRepo()
+			write(t, r)
+			got, hasMore, err := r.ListOddsSnapshots(ctx, tC.args)
+			require.Nil(t, err)
+			assertProtosEqual(t, tC.expected, got)
+			assert.Equal(t, tC.expectMore, hasMore)
+		})
+	}
+	t.Run("retains the most recent snapshots", func(t *testing.T) {
+		r := newRepo()
+		for i := uint64(1); i <= repo.MaxOddsSnapshots+2; i++ {
+			require.Nil(t, r.PutOddsSnapshot(ctx, snapshot(marketA, i, i)))
+		}
+		require.Nil(t, r.PutOddsSnapshot(ctx, snapshot(marketB, 1, 1)))
+		got, hasMore, err := r.ListOddsSnapshots(ctx, &repo.ListOddsSnapshotsArgs{Market: marketA, Limit: repo.MaxOddsSnapshots + 10})
+		require.Nil(t, err)
+		assert.False(t, hasMore)
+		require.Len(t, got, repo.MaxOddsSnapshots)
+		assert.Equal(t, uint64(3), got[0].GetRevision())
+		assert.Equal(t, uint64(repo.MaxOddsSnapshots+2), got[len(got)-1].GetRevision())
+		got, _, err = r.ListOddsSnapshots(ctx, &repo.ListOddsSnapshotsArgs{Market: marketB, Limit: 10})
+		require.Nil(t, err)
+		assert.Len(t, got, 1)
+	})
+}
+
+func testGrants(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	grant := func(book, id, user string) *api.Grant {
+		return &api.Grant{
+			Name:        entity.GrantN(book, id),
+			CreatedAt:   timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			User:        entity.UserN(book, user),
+			Centipoints: 100,
+			Reason:      api.Grant_REASON_ALLOWANCE,
+		}
+	}
+	t.Run("create fails if name already exists", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateGrant(ctx, grant("guild:1", "a", "a")))
+		require.NotNil(t, r.CreateGrant(ctx, grant("guild:1", "a", "a")))
+	})
+	r := newRepo()
+	a, b, c := grant("guild:1", "a", "x"), grant("guild:1", "b", "y"), grant("guild:1", "c", "x")
+	for _, g := range []*api.Grant{c, a, grant("guild:2", "a", "x"), b} {
+		require.Nil(t, r.CreateGrant(ctx, proto.Clone(g).(*api.Grant)))
+	}
+	testCases := []struct {
+		desc     string
+		args     *repo.ListGrantsArgs
+		expected []*api.Grant
+	}{
+		{
+			desc:     "lists a book's grants by name",
+			args:     &repo.ListGrantsArgs{Book: entity.BookN("guild:1")},
+			expected: []*api.Grant{a, b, c},
+		},
+		{
+			desc:     "filters by user",
+			args:     &repo.ListGrantsArgs{Book: entity.BookN("guild:1"), User: entity.UserN("guild:1", "x")},
+			expected: []*api.Grant{a, c},
+		},
+		{
+			desc:     "empty book",
+			args:     &repo.ListGrantsArgs{Book: entity.BookN("guild:3")},
+			expected: nil,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			got := paginate(t, 1, func(cursor string) ([]*api.Grant, bool, error) {
+				args := *tC.args
+				args.GreaterThanName, args.Limit = cursor, 1
+				return r.ListGrants(ctx, &args)
+			}, (*api.Grant).GetName)
+			assertProtosEqual(t, tC.expected, got)
+		})
+	}
+}
+
+func testBookSettings(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	r := newRepo()
+	name := entity.BookSettingsN("guild:1")
+	_, err := r.GetBookSettings(ctx, name)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	settings := &api.BookSettings{
+		Name:                 name,
+		AllowanceCentipoints: 100,
+		AllowanceCooldown:    durationpb.New(time.Hour),
+	}
+	require.Nil(t, r.PutBookSettings(ctx, proto.Clone(settings).(*api.BookSettings)))
+	got, err := r.GetBookSettings(ctx, name)
+	require.Nil(t, err)
+	assertProtoEqual(t, settings, got)
+
+	settings.BailoutCentipoints = 50
+	require.Nil(t, r.PutBookSettings(ctx, proto.Clone(settings).(*api.BookSettings)))
+	got, err = r.GetBookSettings(ctx, name)
+	require.Nil(t, err)
+	assertProtoEqual(t, settings, got)
+
+	_, err = r.GetBookSettings(ctx, entity.BookSettingsN("guild:2"))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func testSeasons(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	season := func(book string, number uint32) *api.Season {
+		return &api.Season{
+			Name:      entity.SeasonN(book, strconv.FormatUint(uint64(number), 10)),
+			Number:    number,
+			StartTime: timestamppb.New(time.Date(2026, 1, int(number), 0, 0, 0, 0, time.UTC)),
+		}
+	}
+	r := newRepo()
+	// 10 sorts before 2 by name
+	one, two, ten := season("guild:1", 1), season("guild:1", 2), season("guild:1", 10)
+	for _, s := range []*api.Season{ten, season("guild:2", 1), one, two} {
+		require.Nil(t, r.PutSeason(ctx, proto.Clone(s).(*api.Season)))
+	}
+
+	got := paginate(t, 1, func(cursor uint32) ([]*api.Season, bool, error) {
+		return r.ListSeasons(ctx, &repo.ListSeasonsArgs{Book: entity.BookN("guild:1"), AfterNumber: cursor, Limit: 1})
+	}, (*api.Season).GetNumber)
+	assertProtosEqual(t, []*api.Season{one, two, ten}, got)
+
+	two.EndTime = timestamppb.New(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	two.Standings = []*api.Standing{{User: entity.UserN("guild:1", "a"), TotalCentipoints: 100, Rank: 1}}
+	require.Nil(t, r.PutSeason(ctx, proto.Clone(two).(*api.Season)))
+	gotSeason, err := r.GetSeason(ctx, two.GetName())
+	require.Nil(t, err)
+	assertProtoEqual(t, two, gotSeason)
+
+	_, err = r.GetSeason(ctx, entity.SeasonN("guild:1", "3"))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = r.GetSeason(ctx, entity.SeasonN("guild:3", "1"))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func testTransfers(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	transfer := func(book, id, from, to string) *api.Transfer {
+		return &api.Transfer{
+			Name:        entity.TransferN(book, id),
+			CreatedAt:   timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			FromUser:    entity.UserN(book, from),
+			ToUser:      entity.UserN(book, to),
+			Centipoints: 100,
+			Memo:        "pizza",
+		}
+	}
+	t.Run("create fails if name already exists", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateTransfer(ctx, transfer("guild:1", "a", "x", "y")))
+		require.NotNil(t, r.CreateTransfer(ctx, transfer("guild:1", "a", "x", "y")))
+	})
+	r := newRepo()
+	a, b, c := transfer("guild:1", "a", "x", "y"), transfer("guild:1", "b", "y", "z"), transfer("guild:1", "c", "z", "x")
+	for _, tr := range []*api.Transfer{c, a, transfer("guild:2", "a", "x", "y"), b} {
+		require.Nil(t, r.CreateTransfer(ctx, proto.Clone(tr).(*api.Transfer)))
+	}
+	testCases := []struct {
+		desc     string
+		args     *repo.ListTransfersArgs
+		expected []*api.Transfer
+	}{
+		{
+			desc:     "lists a book's transfers by name",
+			args:     &repo.ListTransfersArgs{Book: entity.BookN("guild:1")},
+			expected: []*api.Transfer{a, b, c},
+		},
+		{
+			desc:     "filters by sender or recipient",
+			args:     &repo.ListTransfersArgs{Book: entity.BookN("guild:1"), User: entity.UserN("guild:1", "x")},
+			expected: []*api.Transfer{a, c},
+		},
+		{
+			desc:     "empty book",
+			args:     &repo.ListTransfersArgs{Book: entity.BookN("guild:3")},
+			expected: nil,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			got := paginate(t, 1, func(cursor string) ([]*api.Transfer, bool, error) {
+				args := *tC.args
+				args.GreaterThanName, args.Limit = cursor, 1
+				return r.ListTransfers(ctx, &args)
+			}, (*api.Transfer).GetName)
+			assertProtosEqual(t, tC.expected, got)
+		})
+	}
+}
+
+func testAdjustments(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	adjustment := func(book, id, user string) *api.Adjustment {
+		return &api.Adjustment{
+			Name:             entity.AdjustmentN(book, id),
+			CreatedAt:        timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			User:             entity.UserN(book, user),
+			Actor:            entity.UserN(book, "admin"),
+			DeltaCentipoints: -100,
+			Reason:           "bad settlement",
+		}
+	}
+	t.Run("create fails if name already exists", func(t *testing.T) {
+		r := newRepo()
+		require.Nil(t, r.CreateAdjustment(ctx, adjustment("guild:1", "a", "a")))
+		require.NotNil(t, r.CreateAdjustment(ctx, adjustment("guild:1", "a", "a")))
+	})
+	r := newRepo()
+	a, b, c := adjustment("guild:1", "a", "x"), adjustment("guild:1", "b", "y"), adjustment("guild:1", "c", "x")
+	for _, adj := range []*api.Adjustment{c, a, adjustment("guild:2", "a", "x"), b} {
+		require.Nil(t, r.CreateAdjustment(ctx, proto.Clone(adj).(*api.Adjustment)))
+	}
+	testCases := []struct {
+		desc     string
+		args     *repo.ListAdjustmentsArgs
+		expected []*api.Adjustment
+	}{
+		{
+			desc:     "lists a book's adjustments by name",
+			args:     &repo.ListAdjustmentsArgs{Book: entity.BookN("guild:1")},
+			expected: []*api.Adjustment{a, b, c},
+		},
+		{
+			desc:     "filters by user",
+			args:     &repo.ListAdjustmentsArgs{Book: entity.BookN("guild:1"), User: entity.UserN("guild:1", "x")},
+			expected: []*api.Adjustment{a, c},
+		},
+		{
+			desc:     "empty book",
+			args:     &repo.ListAdjustmentsArgs{Book: entity.BookN("guild:3")},
+			expected: nil,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			got := paginate(t, 1, func(cursor string) ([]*api.Adjustment, bool, error) {
+				args := *tC.args
+				args.GreaterThanName, args.Limit = cursor, 1
+				return r.ListAdjustments(ctx, &args)
+			}, (*api.Adjustment).GetName)
+			assertProtosEqual(t, tC.expected, got)
+		})
+	}
+}
+
+func testWrite(t *testing.T, newRepo func() repo.Repo) {
+	ctx := context.Background()
+	created := timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	market := &api.Market{Name: entity.MarketN("guild:1", "a"), Status: api.Market_STATUS_OPEN}
+	bet := &api.Bet{Name: entity.BetN("guild:1", "a"), User: user.GetName(), Market: market.GetName(), Centipoints: 10}
+	event := func(id string) *api.DomainEvent {
+		return &api.DomainEvent{Id: id, Book: "books/guild:1", Payload: &api.DomainEvent_BetPlaced{BetPlaced: &api.BetPlaced{Bet: bet}}}
+	}
+	// setup creates the user, market, and bet and returns the stored copies.
+	setup := func(t *testing.T, r repo.Repo) (*api.User, *api.Market, *api.Bet) {
+		u, m, b := proto.Clone(user).(*api.User), proto.Clone(market).(*api.Market), proto.Clone(bet).(*api.Bet)
+		require.Nil(t, r.CreateUser(ctx, u))
+		require.Nil(t, r.CreateMarket(ctx, m))
+		require.Nil(t, r.CreateBet(ctx, b))
+		return u, m, b
+	}
+	grant := &api.Grant{Name: entity.GrantN("guild:1", "a"), CreatedAt: created, User: user.GetName(), Centipoints: 100, Reason: api.Grant_REASON_ALLOWANCE}
+	newBet := &api.Bet{Name: entity.BetN("guild:1", "b"), User: user.GetName(), Market: market.GetName(), Centipoints: 50}
+
+	t.Run("applies all writes", func(t *testing.T) {
+		r := newRepo()
+		u, m, b := setup(t, r)
+		u.Centipoints = 150
+		m.Title = "updated"
+		b.Centipoints = 20
+		nb := proto.Clone(newBet).(*api.Bet)
+		snapshot := &api.OddsSnapshot{Market: market.GetName(), Revision: 1, TotalCentipoints: 70}
+		season := &api.Season{Name: entity.SeasonN("guild:1", "2"), Number: 2, StartTime: created}
+		transfer := &api.Transfer{Name: entity.TransferN("guild:1", "a"), CreatedAt: created, FromUser: user.GetName(), ToUser: entity.UserN("guild:1", "b"), Centipoints: 10}
+		adjustment := &api.Adjustment{Name: entity.AdjustmentN("guild:1", "a"), CreatedAt: created, User: user.GetName(), Actor: user.GetName(), DeltaCentipoints: 10}
+		a, e := event("a"), event("b")
+		userEtag := u.GetEtag()
+		require.Nil(t, r.Write(ctx, &repo.Batch{
+			Users:         []*api.User{u},
+			Markets:       []*api.Market{m},
+			Bets:          []*api.Bet{b},
+			NewBets:       []*api.Bet{nb},
+			OddsSnapshots: []*api.OddsSnapshot{snapshot},
+			Grants:        []*api.Grant{proto.Clone(grant).(*api.Grant)},
+			Seasons:       []*api.Season{season},
+			Transfers:     []*api.Transfer{transfer},
+			Adjustments:   []*api.Adjustment{adjustment},
+			Events:        []*api.DomainEvent{a, e},
+		}))
+		assert.NotEqual(t, userEtag, u.GetEtag())
+		assert.Equal(t, uint64(1), a.GetSequence())
+		assert.Equal(t, uint64(2), e.GetSequence())
+
+		gotUser, err := r.GetUser(ctx, user.GetName())
+		require.Nil(t, err)
+		assert.Equal(t, u.GetEtag(), gotUser.GetEtag())
+		assert.Equal(t, uint64(150), gotUser.GetCentipoints())
+		assert.Equal(t, uint64(70), gotUser.GetUnsettledCentipoints())
+		gotMarket, err := r.GetMarket(ctx, market.GetName())
+		require.Nil(t, err)
+		assert.Equal(t, m.GetEtag(), gotMarket.GetEtag())
+		assertProtoEqual(t, m, gotMarket)
+		gotBets, err := r.GetBets(ctx, []string{bet.GetName(), newBet.GetName()})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.Bet{b, nb}, gotBets)
+		assert.Equal(t, b.GetEtag(), gotBets[0].GetEtag())
+		assert.Equal(t, nb.GetEtag(), gotBets[1].GetEtag())
+
+		snapshots, _, err := r.ListOddsSnapshots(ctx, &repo.ListOddsSnapshotsArgs{Market: market.GetName(), Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.OddsSnapshot{snapshot}, snapshots)
+		grants, _, err := r.ListGrants(ctx, &repo.ListGrantsArgs{Book: entity.BookN("guild:1"), Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.Grant{grant}, grants)
+		gotSeason, err := r.GetSeason(ctx, season.GetName())
+		require.Nil(t, err)
+		assertProtoEqual(t, season, gotSeason)
+		transfers, _, err := r.ListTransfers(ctx, &repo.ListTransfersArgs{Book: entity.BookN("guild:1"), Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.Transfer{transfer}, transfers)
+		adjustments, _, err := r.ListAdjustments(ctx, &repo.ListAdjustmentsArgs{Book: entity.BookN("guild:1"), Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.Adjustment{adjustment}, adjustments)
+		events, _, err := r.ListEvents(ctx, &repo.ListEventsArgs{Limit: 10})
+		require.Nil(t, err)
+		assertProtosEqual(t, []*api.DomainEvent{a, e}, events)
+	})
+	testCases := []struct {
+		desc     string
+		batch    func(u *api.User, m *api.Market, b *api.Bet) *repo.Batch
+		expected connect.Code
+	}{
+		{
+			desc: "fails if an etag is stale",
+			batch: func(u *api.User, m *api.Market, b *api.Bet) *repo.Batch {
+				m.Etag = "stale"
+				return &repo.Batch{Users: []*api.User{u}, Markets: []*api.Market{m}}
+			},
+			expected: connect.CodeAborted,
+		},
+		{
+			desc: "fails if an updated resource is not found",
+			batch: func(u *api.User, m *api.Market, b *api.Bet) *repo.Batch {
+				return &repo.Batch{Users: []*api.User{u}, Bets: []*api.Bet{{Name: entity.BetN("guild:1", "missing")}}}
+			},
+			expected: connect.CodeNotFound,
+		},
+		{
+			desc: "fails if a created resource already exists",
+			batch: func(u *api.User, m *api.Market, b *api.Bet) *repo.Batch {
+				return &repo.Batch{Users: []*api.User{u}, NewBets: []*api.Bet{proto.Clone(bet).(*api.Bet)}}
+			},
+			expected: connect.CodeInvalidArgument,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc+" and applies nothing", func(t *testing.T) {
+			r := newRepo()
+			u, m, b := setup(t, r)
+			etag := u.GetEtag()
+			u.Centipoints = 0
+			batch := tC.batch(u, m, b)
+			batch.Grants = []*api.Grant{proto.Clone(grant).(*api.Grant)}
+			batch.Events = []*api.DomainEvent{event("a")}
+			err := r.Write(ctx, batch)
+			require.NotNil(t, err)
+			assert.Equal(t, tC.expected, connect.CodeOf(err))
+			assert.Equal(t, etag, u.GetEtag())
+
+			gotUser, err := r.GetUser(ctx, user.GetName())
+			require.Nil(t, err)
+			assert.Equal(t, uint64(100), gotUser.GetCentipoints())
+			grants, _, err := r.ListGrants(ctx, &repo.ListGrantsArgs{Book: entity.BookN("guild:1"), Limit: 10})
+			require.Nil(t, err)
+			assert.Empty(t, grants)
+			events, _, err := r.ListEvents(ctx, &repo.ListEventsArgs{Limit: 10})
+			require.Nil(t, err)
+			assert.Empty(t, events)
+		})
+	}
+}
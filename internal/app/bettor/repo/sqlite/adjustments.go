@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateAdjustment creates a new adjustment.
+func (r *Repo) CreateAdjustment(ctx context.Context, adjustment *api.Adjustment) error {
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		return insertAdjustment(ctx, tx, adjustment)
+	})
+}
+
+// insertAdjustment inserts a new adjustment.
+func insertAdjustment(ctx context.Context, tx *sql.Tx, adjustment *api.Adjustment) error {
+	bookID, _ := entity.AdjustmentIDs(adjustment.GetName())
+	data, err := proto.Marshal(adjustment)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	var n int
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM adjustments WHERE name = ?`, adjustment.GetName()).Scan(&n); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if n > 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("adjustment with id already exists"))
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO adjustments (name, book, user, data) VALUES (?, ?, ?, ?)`,
+		adjustment.GetName(), bookID, adjustment.GetUser(), data); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// ListAdjustments lists adjustments by filters.
+func (r *Repo) ListAdjustments(ctx context.Context, args *repo.ListAdjustmentsArgs) (adjustments []*api.Adjustment, hasMore bool, err error) {
+	query := `SELECT data FROM adjustments WHERE book = ? AND name > ?`
+	params := []any{entity.BooksIDs(args.Book), args.GreaterThanName}
+	if args.User != "" {
+		query += ` AND user = ?`
+		params = append(params, args.User)
+	}
+	query += ` ORDER BY name ASC LIMIT ?`
+	params = append(params, args.Limit+1)
+	rows, err := r.DB.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.Adjustment
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		a := &api.Adjustment{}
+		if err := proto.Unmarshal(data, a); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"google.golang.org/protobuf/proto"
+)
+
+func namesParams(names []string) []any {
+	params := make([]any, 0, len(names))
+	for _, name := range names {
+		params = append(params, name)
+	}
+	return params
+}
+
+// GetUsers gets the users with the given names that exist.
+func (r *Repo) GetUsers(ctx context.Context, names []string) ([]*api.User, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	rows, err := r.DB.QueryContext(ctx, hydrateUserSelect+` WHERE u.name IN (`+placeholders(len(names))+`)`, namesParams(names)...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return out, nil
+}
+
+// GetMarkets gets the markets with the given names that exist.
+func (r *Repo) GetMarkets(ctx context.Context, names []string) ([]*api.Market, error) {
+	var out []*api.Market
+	if err := r.getMany(ctx, "markets", names, func(data []byte) error {
+		m := &api.Market{}
+		if err := proto.Unmarshal(data, m); err != nil {
+			return err
+		}
+		out = append(out, m)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBets gets the bets with the given names that exist.
+func (r *Repo) GetBets(ctx context.Context, names []string) ([]*api.Bet, error) {
+	var out []*api.Bet
+	if err := r.getMany(ctx, "bets", names, func(data []byte) error {
+		b := &api.Bet{}
+		if err := proto.Unmarshal(data, b); err != nil {
+			return err
+		}
+		out = append(out, b)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// getMany calls fn with the data of each row of table whose name is in names.
+func (r *Repo) getMany(ctx context.Context, table string, names []string, fn func(data []byte) error) error {
+	if len(names) == 0 {
+		return nil
+	}
+	rows, err := r.DB.QueryContext(ctx, `SELECT data FROM `+table+` WHERE name IN (`+placeholders(len(names))+`)`, namesParams(names)...)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if err := fn(data); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
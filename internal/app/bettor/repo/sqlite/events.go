@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// insertEvents assigns events the next sequences and inserts them in the transaction of the write they describe.
+// Sequences are allocated in commit order since there is a single connection.
+func insertEvents(ctx context.Context, tx *sql.Tx, events []*api.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	var last int64
+	if err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(sequence), 0) FROM events`).Scan(&last); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	for _, e := range events {
+		last++
+		e.Sequence = uint64(last)
+		data, err := proto.Marshal(e)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO events (sequence, data) VALUES (?, ?)`, last, data); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return nil
+}
+
+// ListEvents lists domain events in sequence order.
+func (r *Repo) ListEvents(ctx context.Context, args *repo.ListEventsArgs) (events []*api.DomainEvent, hasMore bool, err error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT data FROM events WHERE sequence > ? ORDER BY sequence ASC LIMIT ?`, int64(args.AfterSequence), args.Limit+1)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.DomainEvent
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		e := &api.DomainEvent{}
+		if err := proto.Unmarshal(data, e); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// DeleteEvents deletes events through sequence besides the newest event, which next sequences are derived from.
+func (r *Repo) DeleteEvents(ctx context.Context, sequence uint64) error {
+	if _, err := r.DB.ExecContext(ctx, `DELETE FROM events WHERE sequence <= ? AND sequence < (SELECT MAX(sequence) FROM events)`, int64(sequence)); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// GetCursor returns a consumer's cursor.
+func (r *Repo) GetCursor(ctx context.Context, consumer string) (uint64, error) {
+	var sequence int64
+	err := r.DB.QueryRowContext(ctx, `SELECT sequence FROM cursors WHERE consumer = ?`, consumer).Scan(&sequence)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInternal, err)
+	}
+	return uint64(sequence), nil
+}
+
+// PutCursor sets a consumer's cursor.
+func (r *Repo) PutCursor(ctx context.Context, consumer string, sequence uint64) error {
+	if _, err := r.DB.ExecContext(ctx, `INSERT INTO cursors (consumer, sequence) VALUES (?, ?)
+		ON CONFLICT (consumer) DO UPDATE SET sequence = excluded.sequence`, consumer, int64(sequence)); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
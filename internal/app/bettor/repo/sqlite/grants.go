@@ -0,0 +1,107 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateGrant creates a new grant.
+func (r *Repo) CreateGrant(ctx context.Context, grant *api.Grant) error {
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		return insertGrant(ctx, tx, grant)
+	})
+}
+
+// insertGrant inserts a new grant.
+func insertGrant(ctx context.Context, tx *sql.Tx, grant *api.Grant) error {
+	bookID, _ := entity.GrantIDs(grant.GetName())
+	data, err := proto.Marshal(grant)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	var n int
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM grants WHERE name = ?`, grant.GetName()).Scan(&n); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if n > 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("grant with id already exists"))
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO grants (name, book, user, data) VALUES (?, ?, ?, ?)`,
+		grant.GetName(), bookID, grant.GetUser(), data); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// ListGrants lists grants by filters.
+func (r *Repo) ListGrants(ctx context.Context, args *repo.ListGrantsArgs) (grants []*api.Grant, hasMore bool, err error) {
+	query := `SELECT data FROM grants WHERE book = ? AND name > ?`
+	params := []any{entity.BooksIDs(args.Book), args.GreaterThanName}
+	if args.User != "" {
+		query += ` AND user = ?`
+		params = append(params, args.User)
+	}
+	query += ` ORDER BY name ASC LIMIT ?`
+	params = append(params, args.Limit+1)
+	rows, err := r.DB.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.Grant
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		g := &api.Grant{}
+		if err := proto.Unmarshal(data, g); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// GetBookSettings gets a book's settings by name.
+func (r *Repo) GetBookSettings(ctx context.Context, name string) (*api.BookSettings, error) {
+	var data []byte
+	err := r.DB.QueryRowContext(ctx, `SELECT data FROM book_settings WHERE name = ?`, name).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("book settings not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	settings := &api.BookSettings{}
+	if err := proto.Unmarshal(data, settings); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return settings, nil
+}
+
+// PutBookSettings creates or overwrites a book's settings.
+func (r *Repo) PutBookSettings(ctx context.Context, settings *api.BookSettings) error {
+	data, err := proto.Marshal(settings)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err := r.DB.ExecContext(ctx, `INSERT INTO book_settings (name, data) VALUES (?, ?)
+		ON CONFLICT (name) DO UPDATE SET data = excluded.data`, settings.GetName(), data); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"google.golang.org/protobuf/proto"
+)
+
+// migrations are applied in order and tracked with SQLite's user_version pragma. Never edit or reorder an existing
+// migration; append a new one instead.
+var migrations = [][]string{
+	// 1: initial schema
+	{
+		`CREATE TABLE users (
+			name        TEXT PRIMARY KEY,
+			book        TEXT NOT NULL,
+			username    TEXT NOT NULL,
+			centipoints INTEGER NOT NULL,
+			data        BLOB NOT NULL
+		)`,
+		`CREATE UNIQUE INDEX users_book_username ON users (book, username)`,
+		`CREATE INDEX users_book_name ON users (book, name)`,
+		`CREATE TABLE markets (
+			name   TEXT PRIMARY KEY,
+			book   TEXT NOT NULL,
+			status INTEGER NOT NULL,
+			data   BLOB NOT NULL
+		)`,
+		`CREATE INDEX markets_book_name ON markets (book, name)`,
+		`CREATE INDEX markets_book_status_name ON markets (book, status, name)`,
+		`CREATE TABLE bets (
+			name        TEXT PRIMARY KEY,
+			book        TEXT NOT NULL,
+			user        TEXT NOT NULL,
+			market      TEXT NOT NULL,
+			settled     INTEGER NOT NULL,
+			centipoints INTEGER NOT NULL,
+			data        BLOB NOT NULL
+		)`,
+		`CREATE INDEX bets_book_name ON bets (book, name)`,
+		`CREATE INDEX bets_user_settled ON bets (user, settled)`,
+		`CREATE INDEX bets_market ON bets (market)`,
+	},
+	// 2: etags for optimistic concurrency
+	{
+		`ALTER TABLE users ADD COLUMN etag TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE markets ADD COLUMN etag TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE bets ADD COLUMN etag TEXT NOT NULL DEFAULT ''`,
+	},
+	// 3: denormalized unsettled centipoints for keyset pagination of users by total centipoints
+	{
+		`ALTER TABLE users ADD COLUMN unsettled INTEGER NOT NULL DEFAULT 0`,
+		`UPDATE users SET unsettled = COALESCE((SELECT SUM(b.centipoints) FROM bets b WHERE b.user = users.name AND b.settled = 0), 0)`,
+		`CREATE INDEX users_book_total_name ON users (book, centipoints + unsettled DESC, name)`,
+	},
+	// 4: webhooks and their delivery outbox
+	{
+		`CREATE TABLE webhooks (
+			name TEXT PRIMARY KEY,
+			book TEXT NOT NULL,
+			data BLOB NOT NULL
+		)`,
+		`CREATE INDEX webhooks_book_name ON webhooks (book, name)`,
+		`CREATE TABLE deliveries (
+			name            TEXT PRIMARY KEY,
+			next_attempt_at INTEGER NOT NULL,
+			data            BLOB NOT NULL
+		)`,
+		`CREATE INDEX deliveries_next_attempt_at_name ON deliveries (next_attempt_at, name)`,
+	},
+	// 5: domain event outbox and consumer cursors
+	{
+		`CREATE TABLE events (
+			sequence INTEGER PRIMARY KEY,
+			data     BLOB NOT NULL
+		)`,
+		`CREATE TABLE cursors (
+			consumer TEXT PRIMARY KEY,
+			sequence INTEGER NOT NULL
+		)`,
+	},
+	// 6: odds history
+	{
+		`CREATE TABLE odds_snapshots (
+			market   TEXT NOT NULL,
+			revision INTEGER NOT NULL,
+			data     BLOB NOT NULL,
+			PRIMARY KEY (market, revision)
+		)`,
+	},
+	// 7: grants and book settings
+	{
+		`CREATE TABLE grants (
+			name TEXT PRIMARY KEY,
+			book TEXT NOT NULL,
+			user TEXT NOT NULL,
+			data BLOB NOT NULL
+		)`,
+		`CREATE INDEX grants_book_name ON grants (book, name)`,
+		`CREATE INDEX grants_user_name ON grants (user, name)`,
+		`CREATE TABLE book_settings (
+			name TEXT PRIMARY KEY,
+			data BLOB NOT NULL
+		)`,
+	},
+	// 8: seasons
+	{
+		`CREATE TABLE seasons (
+			name   TEXT PRIMARY KEY,
+			book   TEXT NOT NULL,
+			number INTEGER NOT NULL,
+			data   BLOB NOT NULL
+		)`,
+		`CREATE INDEX seasons_book_number ON seasons (book, number)`,
+	},
+	// 9: transfers
+	{
+		`CREATE TABLE transfers (
+			name      TEXT PRIMARY KEY,
+			book      TEXT NOT NULL,
+			from_user TEXT NOT NULL,
+			to_user   TEXT NOT NULL,
+			data      BLOB NOT NULL
+		)`,
+		`CREATE INDEX transfers_book_name ON transfers (book, name)`,
+		`CREATE INDEX transfers_from_user_name ON transfers (from_user, name)`,
+		`CREATE INDEX transfers_to_user_name ON transfers (to_user, name)`,
+	},
+	// 10: adjustments
+	{
+		`CREATE TABLE adjustments (
+			name TEXT PRIMARY KEY,
+			book TEXT NOT NULL,
+			user TEXT NOT NULL,
+			data BLOB NOT NULL
+		)`,
+		`CREATE INDEX adjustments_book_name ON adjustments (book, name)`,
+		`CREATE INDEX adjustments_user_name ON adjustments (user, name)`,
+	},
+	// 11: order keys for keyed pagination of markets and bets. Rows are backfilled by backfillOrderKeys.
+	{
+		`ALTER TABLE markets ADD COLUMN created_at INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE markets ADD COLUMN total INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE markets ADD COLUMN settled_at INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE bets ADD COLUMN created_at INTEGER NOT NULL DEFAULT 0`,
+		`ALTER TABLE bets ADD COLUMN settled_at INTEGER NOT NULL DEFAULT 0`,
+		`CREATE INDEX markets_book_created_at_name ON markets (book, created_at DESC, name)`,
+		`CREATE INDEX markets_book_total_name ON markets (book, total DESC, name)`,
+		`CREATE INDEX markets_book_settled_at_name ON markets (book, settled_at DESC, name)`,
+		`CREATE INDEX bets_book_created_at_name ON bets (book, created_at DESC, name)`,
+		`CREATE INDEX bets_book_centipoints_name ON bets (book, centipoints DESC, name)`,
+		`CREATE INDEX bets_book_settled_at_name ON bets (book, settled_at DESC, name)`,
+	},
+	// 12: market creators for filtering lists in SQL. Rows are backfilled by backfillCreators.
+	{
+		`ALTER TABLE markets ADD COLUMN creator TEXT NOT NULL DEFAULT ''`,
+		`CREATE INDEX markets_book_creator_name ON markets (book, creator, name)`,
+	},
+}
+
+// backfills populate columns that cannot be derived in SQL. They run in a migration's transaction after its
+// statements, keyed by migration number.
+var backfills = map[int]func(ctx context.Context, tx *sql.Tx) error{
+	11: backfillOrderKeys,
+	12: backfillCreators,
+}
+
+// backfillOrderKeys sets the order key columns of existing markets and bets from their data.
+func backfillOrderKeys(ctx context.Context, tx *sql.Tx) error {
+	markets, err := scanAll(ctx, tx, `SELECT data FROM markets`, func() *api.Market { return &api.Market{} })
+	if err != nil {
+		return err
+	}
+	for _, m := range markets {
+		created, total, settled := marketOrderKeys(m)
+		if _, err := tx.ExecContext(ctx, `UPDATE markets SET created_at = ?, total = ?, settled_at = ? WHERE name = ?`,
+			created, total, settled, m.GetName()); err != nil {
+			return err
+		}
+	}
+	bets, err := scanAll(ctx, tx, `SELECT data FROM bets`, func() *api.Bet { return &api.Bet{} })
+	if err != nil {
+		return err
+	}
+	for _, b := range bets {
+		created, settled := betOrderKeys(b)
+		if _, err := tx.ExecContext(ctx, `UPDATE bets SET created_at = ?, settled_at = ? WHERE name = ?`, created, settled, b.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillCreators sets the creator column of existing markets from their data.
+func backfillCreators(ctx context.Context, tx *sql.Tx) error {
+	markets, err := scanAll(ctx, tx, `SELECT data FROM markets`, func() *api.Market { return &api.Market{} })
+	if err != nil {
+		return err
+	}
+	for _, m := range markets {
+		if _, err := tx.ExecContext(ctx, `UPDATE markets SET creator = ? WHERE name = ?`, m.GetCreator(), m.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanAll decodes the data column of every row a query returns.
+func scanAll[T proto.Message](ctx context.Context, tx *sql.Tx, query string, newT func() T) ([]T, error) {
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []T
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		msg := newT()
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return nil, err
+		}
+		out = append(out, msg)
+	}
+	return out, rows.Err()
+}
+
+// migrate applies all pending migrations. Each migration is applied in its own transaction.
+func migrate(ctx context.Context, db *sql.DB) error {
+	var version int
+	if err := db.QueryRowContext(ctx, `PRAGMA user_version`).Scan(&version); err != nil {
+		return err
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("db schema version %d is newer than supported version %d", version, len(migrations))
+	}
+	for i := version; i < len(migrations); i++ {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		for _, stmt := range migrations[i] {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				_ = tx.Rollback()
+				return fmt.Errorf("migration %d failed: %w", i+1, err)
+			}
+		}
+		if backfill, ok := backfills[i+1]; ok {
+			if err := backfill(ctx, tx); err != nil {
+				_ = tx.Rollback()
+				return fmt.Errorf("migration %d backfill failed: %w", i+1, err)
+			}
+		}
+		// user_version cannot be bound as a parameter
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`PRAGMA user_version = %d`, i+1)); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// PutOddsSnapshot creates or overwrites the snapshot of a market at its revision.
+func (r *Repo) PutOddsSnapshot(ctx context.Context, snapshot *api.OddsSnapshot) error {
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		return putOddsSnapshot(ctx, tx, snapshot)
+	})
+}
+
+// putOddsSnapshot upserts a snapshot and drops all but the most recent snapshots of its market.
+func putOddsSnapshot(ctx context.Context, tx *sql.Tx, snapshot *api.OddsSnapshot) error {
+	data, err := proto.Marshal(snapshot)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO odds_snapshots (market, revision, data) VALUES (?, ?, ?)
+		ON CONFLICT (market, revision) DO UPDATE SET data = excluded.data`,
+		snapshot.GetMarket(), int64(snapshot.GetRevision()), data); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM odds_snapshots WHERE market = ? AND revision <= (
+		SELECT revision FROM odds_snapshots WHERE market = ? ORDER BY revision DESC LIMIT 1 OFFSET ?)`,
+		snapshot.GetMarket(), snapshot.GetMarket(), repo.MaxOddsSnapshots); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// ListOddsSnapshots lists a market's odds snapshots in revision order.
+func (r *Repo) ListOddsSnapshots(ctx context.Context, args *repo.ListOddsSnapshotsArgs) (snapshots []*api.OddsSnapshot, hasMore bool, err error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT data FROM odds_snapshots WHERE market = ? AND revision > ? ORDER BY revision ASC LIMIT ?`,
+		args.Market, int64(args.AfterRevision), args.Limit+1)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.OddsSnapshot
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		s := &api.OddsSnapshot{}
+		if err := proto.Unmarshal(data, s); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
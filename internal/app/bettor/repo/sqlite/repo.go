@@ -0,0 +1,624 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/connect-go" // too lazy to isolate errors. repo pkgs will return connect errors
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/filter"
+	"google.golang.org/protobuf/proto"
+	_ "modernc.org/sqlite" // registers the pure Go "sqlite" driver
+)
+
+var _ repo.Repo = (*Repo)(nil)
+
+// Repo is a SQLite-backed persistence repository.
+//
+// Resources are stored as serialized protos alongside the columns needed for filtering, ordering, and indexing.
+type Repo struct {
+	DB *sql.DB
+}
+
+// New initializes a SQLite-backed repository and applies any pending migrations.
+func New(fileName string) (*Repo, error) {
+	db, err := sql.Open("sqlite", fileName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("sqlite db could not be opened: %w", err))
+	}
+	// SQLite only supports a single writer. Serializing on one connection avoids SQLITE_BUSY errors.
+	db.SetMaxOpenConns(1)
+	for _, pragma := range []string{"PRAGMA journal_mode = WAL", "PRAGMA synchronous = NORMAL", "PRAGMA busy_timeout = 5000"} {
+		if _, err := db.Exec(pragma); err != nil {
+			db.Close()
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("sqlite pragma could not be set: %w", err))
+		}
+	}
+	if err := migrate(context.Background(), db); err != nil {
+		db.Close()
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("sqlite db could not be migrated: %w", err))
+	}
+	return &Repo{DB: db}, nil
+}
+
+// Close closes the underlying database.
+func (r *Repo) Close() error {
+	return r.DB.Close()
+}
+
+// withTx runs fn in a transaction, committing if fn returns nil and rolling back otherwise.
+func (r *Repo) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// hydrateUserSelect selects a user's data and virtual fields like unsettled_centipoints.
+const hydrateUserSelect = `SELECT u.data, u.unsettled FROM users u`
+
+// refreshUnsettled recomputes the denormalized unsettled centipoints of users from their bets.
+func refreshUnsettled(ctx context.Context, tx *sql.Tx, users ...string) error {
+	_, err := tx.ExecContext(ctx, `UPDATE users SET unsettled = COALESCE((SELECT SUM(b.centipoints) FROM bets b WHERE b.user = users.name AND b.settled = 0), 0) WHERE name IN (`+placeholders(len(users))+`)`, //nolint:gosec // only placeholders are interpolated
+		toAny(users)...)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+func toAny(ss []string) []any {
+	out := make([]any, len(ss))
+	for i, s := range ss {
+		out[i] = s
+	}
+	return out
+}
+
+func scanUser(row interface{ Scan(dest ...any) error }) (*api.User, error) {
+	var data []byte
+	var unsettled int64
+	if err := row.Scan(&data, &unsettled); err != nil {
+		return nil, err
+	}
+	user := &api.User{}
+	if err := proto.Unmarshal(data, user); err != nil {
+		return nil, err
+	}
+	user.UnsettledCentipoints = uint64(unsettled)
+	return user, nil
+}
+
+// CreateUser creates a new user.
+func (r *Repo) CreateUser(ctx context.Context, user *api.User, events ...*api.DomainEvent) error {
+	user.UnsettledCentipoints = 0 // defensive
+	user.Etag = repo.NextEtag("")
+
+	bookID, _ := entity.UserIDs(user.GetName())
+	data, err := proto.Marshal(user)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		var n int
+		if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM users WHERE name = ?`, user.GetName()).Scan(&n); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if n > 0 {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("user with id already exists"))
+		}
+		if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM users WHERE book = ? AND username = ?`, bookID, user.GetUsername()).Scan(&n); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if n > 0 {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("user with username already exists in book"))
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO users (name, book, username, centipoints, etag, data) VALUES (?, ?, ?, ?, ?, ?)`,
+			user.GetName(), bookID, user.GetUsername(), int64(user.GetCentipoints()), user.GetEtag(), data); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if err := insertEvents(ctx, tx, events); err != nil {
+			return err
+		}
+		return refreshUnsettled(ctx, tx, user.GetName())
+	})
+}
+
+// UpdateUser updates a user.
+func (r *Repo) UpdateUser(ctx context.Context, user *api.User, events ...*api.DomainEvent) error {
+	var etag string
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		if etag, err = updateUser(ctx, tx, user); err != nil {
+			return err
+		}
+		return insertEvents(ctx, tx, events)
+	})
+	if err != nil {
+		return err
+	}
+	user.Etag = etag
+	return nil
+}
+
+// updateUser updates a user if its etag matches and returns the user's new etag.
+func updateUser(ctx context.Context, tx *sql.Tx, user *api.User) (string, error) {
+	userCopy := proto.Clone(user).(*api.User)
+	userCopy.UnsettledCentipoints = 0 // virtual field is not persisted
+	userCopy.Etag = repo.NextEtag(user.GetEtag())
+	data, err := proto.Marshal(userCopy)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	res, err := tx.ExecContext(ctx, `UPDATE users SET username = ?, centipoints = ?, etag = ?, data = ? WHERE name = ? AND etag = ?`,
+		user.GetUsername(), int64(user.GetCentipoints()), userCopy.GetEtag(), data, user.GetName(), user.GetEtag())
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	if err := requireUpdated(ctx, tx, res, "users", user.GetName(), "user"); err != nil {
+		return "", err
+	}
+	return userCopy.GetEtag(), nil
+}
+
+// GetUser gets a user by ID.
+func (r *Repo) GetUser(ctx context.Context, name string) (*api.User, error) {
+	user, err := scanUser(r.DB.QueryRowContext(ctx, hydrateUserSelect+` WHERE u.name = ?`, name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return user, nil
+}
+
+// GetUserByUsername gets a user by username.
+func (r *Repo) GetUserByUsername(ctx context.Context, book, username string) (*api.User, error) {
+	user, err := scanUser(r.DB.QueryRowContext(ctx, hydrateUserSelect+` WHERE u.book = ? AND u.username = ?`, entity.BooksIDs(book), username))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return user, nil
+}
+
+// ListUsers lists users by filters.
+func (r *Repo) ListUsers(ctx context.Context, args *repo.ListUsersArgs) (users []*api.User, hasMore bool, err error) {
+	where := []string{"u.book = ?"}
+	params := []any{entity.BooksIDs(args.Book)}
+	if len(args.Users) > 0 {
+		where = append(where, "u.name IN ("+placeholders(len(args.Users))+")")
+		for _, u := range args.Users {
+			params = append(params, u)
+		}
+	}
+
+	var orderBy string
+	switch args.OrderBy {
+	case "", "name":
+		where = append(where, "u.name > ?")
+		params = append(params, args.GreaterThanName)
+		orderBy = "u.name ASC"
+	case "total_centipoints":
+		if args.GreaterThanName != "" {
+			return nil, false, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot use GreaterThanName with total_centipoints order"))
+		}
+		if c := args.After; c != nil {
+			where = append(where, "(u.centipoints + u.unsettled < ? OR (u.centipoints + u.unsettled = ? AND u.name > ?))")
+			params = append(params, int64(c.Value), int64(c.Value), c.Name)
+		}
+		orderBy = "u.centipoints + u.unsettled DESC, u.name ASC"
+	default:
+		return nil, false, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid order by"))
+	}
+
+	query := hydrateUserSelect + " WHERE " + strings.Join(where, " AND ") + " ORDER BY " + orderBy + " LIMIT ?"
+	params = append(params, args.Limit+1)
+	rows, err := r.DB.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// CreateMarket creates a new market.
+func (r *Repo) CreateMarket(ctx context.Context, market *api.Market, events ...*api.DomainEvent) error {
+	market.Etag = repo.NextEtag("")
+	bookID, _ := entity.MarketIDs(market.GetName())
+	data, err := proto.Marshal(market)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		var n int
+		if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM markets WHERE name = ?`, market.GetName()).Scan(&n); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if n > 0 {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("market with id already exists"))
+		}
+		created, total, settled := marketOrderKeys(market)
+		if _, err := tx.ExecContext(ctx, `INSERT INTO markets (name, book, status, creator, created_at, total, settled_at, etag, data) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			market.GetName(), bookID, int32(market.GetStatus()), market.GetCreator(), created, total, settled, market.GetEtag(), data); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return insertEvents(ctx, tx, events)
+	})
+}
+
+// UpdateMarket updates a market.
+func (r *Repo) UpdateMarket(ctx context.Context, market *api.Market, events ...*api.DomainEvent) error {
+	var etag string
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		if etag, err = updateMarket(ctx, tx, market); err != nil {
+			return err
+		}
+		return insertEvents(ctx, tx, events)
+	})
+	if err != nil {
+		return err
+	}
+	market.Etag = etag
+	return nil
+}
+
+// updateMarket updates a market if its etag matches and returns the market's new etag.
+func updateMarket(ctx context.Context, tx *sql.Tx, market *api.Market) (string, error) {
+	marketCopy := proto.Clone(market).(*api.Market)
+	marketCopy.Etag = repo.NextEtag(market.GetEtag())
+	data, err := proto.Marshal(marketCopy)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	created, total, settled := marketOrderKeys(market)
+	res, err := tx.ExecContext(ctx, `UPDATE markets SET status = ?, creator = ?, created_at = ?, total = ?, settled_at = ?, etag = ?, data = ? WHERE name = ? AND etag = ?`,
+		int32(market.GetStatus()), market.GetCreator(), created, total, settled, marketCopy.GetEtag(), data, market.GetName(), market.GetEtag())
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	if err := requireUpdated(ctx, tx, res, "markets", market.GetName(), "market"); err != nil {
+		return "", err
+	}
+	return marketCopy.GetEtag(), nil
+}
+
+// GetMarket gets a market by ID.
+func (r *Repo) GetMarket(ctx context.Context, name string) (*api.Market, error) {
+	var data []byte
+	err := r.DB.QueryRowContext(ctx, `SELECT data FROM markets WHERE name = ?`, name).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("market not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	market := &api.Market{}
+	if err := proto.Unmarshal(data, market); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return market, nil
+}
+
+// ListMarkets lists markets by filters.
+func (r *Repo) ListMarkets(ctx context.Context, args *repo.ListMarketsArgs) (markets []*api.Market, hasMore bool, err error) {
+	if err := repo.CheckOrder(args.OrderBy, args.GreaterThanName); err != nil {
+		return nil, false, err
+	}
+	where := []string{"book = ?"}
+	params := []any{entity.BooksIDs(args.Book)}
+	if args.Status != api.Market_STATUS_UNSPECIFIED {
+		where = append(where, "status = ?")
+		params = append(params, int32(args.Status))
+	}
+	return listPage(ctx, r.DB, "markets", where, params, args.Filter, marketFilterColumns, marketOrderColumns[args.OrderBy],
+		args.GreaterThanName, args.After, func(m *api.Market) uint64 { return repo.MarketOrderKey(args.OrderBy, m) }, args.Limit,
+		func() *api.Market { return &api.Market{} })
+}
+
+// CreateBet creates a new bet.
+func (r *Repo) CreateBet(ctx context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
+	betCopy := proto.Clone(bet).(*api.Bet)
+	betCopy.Etag = repo.NextEtag("")
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		if err := insertBet(ctx, tx, betCopy); err != nil {
+			return err
+		}
+		return insertEvents(ctx, tx, events)
+	})
+	if err != nil {
+		return err
+	}
+	bet.Etag = betCopy.GetEtag()
+	return nil
+}
+
+// insertBet inserts a new bet as is.
+func insertBet(ctx context.Context, tx *sql.Tx, bet *api.Bet) error {
+	bookID, _ := entity.BetIDs(bet.GetName())
+	data, err := proto.Marshal(bet)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	var n int
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM bets WHERE name = ?`, bet.GetName()).Scan(&n); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if n > 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("bet with id already exists"))
+	}
+	created, settled := betOrderKeys(bet)
+	if _, err := tx.ExecContext(ctx, `INSERT INTO bets (name, book, user, market, settled, centipoints, created_at, settled_at, etag, data) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		bet.GetName(), bookID, bet.GetUser(), bet.GetMarket(), bet.GetSettledAt() != nil, int64(bet.GetCentipoints()), created, settled, bet.GetEtag(), data); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return refreshUnsettled(ctx, tx, bet.GetUser())
+}
+
+// UpdateBet updates a bet.
+func (r *Repo) UpdateBet(ctx context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
+	var etag string
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		if etag, err = updateBet(ctx, tx, bet); err != nil {
+			return err
+		}
+		return insertEvents(ctx, tx, events)
+	})
+	if err != nil {
+		return err
+	}
+	bet.Etag = etag
+	return nil
+}
+
+// updateBet updates a bet if its etag matches and returns the bet's new etag.
+func updateBet(ctx context.Context, tx *sql.Tx, bet *api.Bet) (string, error) {
+	betCopy := proto.Clone(bet).(*api.Bet)
+	betCopy.Etag = repo.NextEtag(bet.GetEtag())
+	data, err := proto.Marshal(betCopy)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	var prevUser string
+	if err := tx.QueryRowContext(ctx, `SELECT user FROM bets WHERE name = ?`, bet.GetName()).Scan(&prevUser); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	created, settled := betOrderKeys(bet)
+	res, err := tx.ExecContext(ctx, `UPDATE bets SET user = ?, market = ?, settled = ?, centipoints = ?, created_at = ?, settled_at = ?, etag = ?, data = ? WHERE name = ? AND etag = ?`,
+		bet.GetUser(), bet.GetMarket(), bet.GetSettledAt() != nil, int64(bet.GetCentipoints()), created, settled, betCopy.GetEtag(), data, bet.GetName(), bet.GetEtag())
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	if err := requireUpdated(ctx, tx, res, "bets", bet.GetName(), "bet"); err != nil {
+		return "", err
+	}
+	if err := refreshUnsettled(ctx, tx, prevUser, bet.GetUser()); err != nil {
+		return "", err
+	}
+	return betCopy.GetEtag(), nil
+}
+
+// GetBet gets a bet by ID.
+func (r *Repo) GetBet(ctx context.Context, name string) (*api.Bet, error) {
+	var data []byte
+	err := r.DB.QueryRowContext(ctx, `SELECT data FROM bets WHERE name = ?`, name).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("bet not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	bet := &api.Bet{}
+	if err := proto.Unmarshal(data, bet); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return bet, nil
+}
+
+// ListBets lists bets by filters.
+func (r *Repo) ListBets(ctx context.Context, args *repo.ListBetsArgs) (bets []*api.Bet, hasMore bool, err error) {
+	if err := repo.CheckOrder(args.OrderBy, args.GreaterThanName); err != nil {
+		return nil, false, err
+	}
+	where := []string{"book = ?"}
+	params := []any{entity.BooksIDs(args.Book)}
+	if args.User != "" {
+		where = append(where, "user = ?")
+		params = append(params, args.User)
+	}
+	if args.Market != "" {
+		where = append(where, "market = ?")
+		params = append(params, args.Market)
+	}
+	if args.ExcludeSettled {
+		where = append(where, "settled = 0")
+	}
+	return listPage(ctx, r.DB, "bets", where, params, args.Filter, betFilterColumns, betOrderColumns[args.OrderBy],
+		args.GreaterThanName, args.After, func(b *api.Bet) uint64 { return repo.BetOrderKey(args.OrderBy, b) }, args.Limit,
+		func() *api.Bet { return &api.Bet{} })
+}
+
+// Order key columns of markets and bets by keyed order. They hold repo.MarketOrderKey and repo.BetOrderKey.
+var (
+	marketOrderColumns = map[string]string{
+		repo.OrderByCreateTime:       "created_at",
+		repo.OrderByTotalCentipoints: "total",
+		repo.OrderBySettledTime:      "settled_at",
+	}
+	betOrderColumns = map[string]string{
+		repo.OrderByCreateTime:       "created_at",
+		repo.OrderByTotalCentipoints: "centipoints",
+		repo.OrderBySettledTime:      "settled_at",
+	}
+)
+
+// Columns that hold market and bet fields for translating filters to SQL. Filters on other fields are matched on
+// decoded rows.
+var (
+	marketFilterColumns = map[string]string{
+		"name":       "name",
+		"status":     "status",
+		"creator":    "creator",
+		"created_at": "created_at",
+		"settled_at": "settled_at",
+	}
+	betFilterColumns = map[string]string{
+		"name":        "name",
+		"user":        "user",
+		"market":      "market",
+		"centipoints": "centipoints",
+		"created_at":  "created_at",
+		"settled_at":  "settled_at",
+	}
+)
+
+// minScanRows is the fewest rows read per query when a filter must be matched on decoded rows.
+const minScanRows = 100
+
+// listPage returns up to limit rows of table matching where and f after the cursor, and whether there are more. The
+// parts of f that can be are evaluated in SQL. If f cannot be evaluated in SQL exactly, rows are read in chunks from
+// the last row read and matched until the page is full, so a page never reads past the rows it needs. key returns a
+// row's order key for column and newT returns an empty row to decode into.
+func listPage[T interface {
+	proto.Message
+	GetName() string
+}](ctx context.Context, db *sql.DB, table string, where []string, params []any, f *filter.Filter, filterColumns map[string]string,
+	column, greaterThanName string, after *repo.OrderCursor, key func(T) uint64, limit int, newT func() T,
+) ([]T, bool, error) {
+	filterWhere, filterParams, exact := f.SQL(filterColumns)
+	if filterWhere != "" {
+		where = append(append([]string{}, where...), filterWhere)
+		params = append(append([]any{}, params...), filterParams...)
+	}
+	chunk := limit + 1
+	if !exact && chunk < minScanRows {
+		chunk = minScanRows
+	}
+	var out []T
+	for {
+		clause, queryParams := keysetClause(append([]string{}, where...), append([]any{}, params...), column, greaterThanName, after)
+		rows, err := db.QueryContext(ctx, `SELECT data FROM `+table+` WHERE `+clause+` LIMIT ?`, append(queryParams, chunk)...) //nolint:gosec // table is a constant
+		if err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		var read int
+		var last T
+		for len(out) <= limit && rows.Next() {
+			var data []byte
+			if err := rows.Scan(&data); err != nil {
+				rows.Close()
+				return nil, false, connect.NewError(connect.CodeInternal, err)
+			}
+			t := newT()
+			if err := proto.Unmarshal(data, t); err != nil {
+				rows.Close()
+				return nil, false, connect.NewError(connect.CodeInternal, err)
+			}
+			read++
+			last = t
+			if exact || f.Match(t) {
+				out = append(out, t)
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		if len(out) > limit {
+			return out[:limit], true, nil
+		}
+		if read < chunk {
+			return out, false, nil
+		}
+		// continue from the last row read
+		if column == "" {
+			greaterThanName = last.GetName()
+		} else {
+			after = &repo.OrderCursor{Value: key(last), Name: last.GetName()}
+		}
+	}
+}
+
+// keysetClause returns the WHERE predicates and ORDER BY of a list query and its params. Lists are by name asc unless
+// column is set, in which case they are by column desc then name asc and start after the cursor.
+func keysetClause(where []string, params []any, column, greaterThanName string, after *repo.OrderCursor) (string, []any) {
+	if column == "" {
+		where = append(where, "name > ?")
+		params = append(params, greaterThanName)
+		return strings.Join(where, " AND ") + ` ORDER BY name ASC`, params
+	}
+	if after != nil {
+		where = append(where, "("+column+" < ? OR ("+column+" = ? AND name > ?))")
+		params = append(params, int64(after.Value), int64(after.Value), after.Name)
+	}
+	return strings.Join(where, " AND ") + ` ORDER BY ` + column + ` DESC, name ASC`, params
+}
+
+// marketOrderKeys returns the values of a market's order key columns.
+func marketOrderKeys(market *api.Market) (created, total, settled int64) {
+	return int64(repo.MarketOrderKey(repo.OrderByCreateTime, market)),
+		int64(repo.MarketOrderKey(repo.OrderByTotalCentipoints, market)),
+		int64(repo.MarketOrderKey(repo.OrderBySettledTime, market))
+}
+
+// betOrderKeys returns the values of a bet's order key columns. Its total is the centipoints column.
+func betOrderKeys(bet *api.Bet) (created, settled int64) {
+	return int64(repo.BetOrderKey(repo.OrderByCreateTime, bet)), int64(repo.BetOrderKey(repo.OrderBySettledTime, bet))
+}
+
+// requireUpdated checks that a conditional update of a resource by name and etag updated a row. If it did not, the
+// resource either does not exist or has been modified since it was read.
+func requireUpdated(ctx context.Context, tx *sql.Tx, res sql.Result, table, name, resource string) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if n > 0 {
+		return nil
+	}
+	var exists int
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM `+table+` WHERE name = ?`, name).Scan(&exists); err != nil { //nolint:gosec // table is a constant
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if exists == 0 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("%s not found", resource))
+	}
+	return connect.NewError(connect.CodeAborted, fmt.Errorf("%s has been modified", resource))
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
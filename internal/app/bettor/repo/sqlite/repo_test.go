@@ -0,0 +1,87 @@
+package sqlite_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/repotest"
+	"github.com/elh/bettor/internal/app/bettor/repo/sqlite"
+	"github.com/elh/bettor/internal/pkg/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newRepo(t *testing.T) *sqlite.Repo {
+	r, err := sqlite.New(filepath.Join(t.TempDir(), "bettor.db"))
+	require.Nil(t, err)
+	t.Cleanup(func() { r.Close() })
+	return r
+}
+
+func TestRepo(t *testing.T) {
+	repotest.Run(t, func() repo.Repo {
+		return newRepo(t)
+	})
+}
+
+func TestReopen(t *testing.T) {
+	ctx := context.Background()
+	fileName := filepath.Join(t.TempDir(), "bettor.db")
+	r, err := sqlite.New(fileName)
+	require.Nil(t, err)
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	require.Nil(t, r.CreateUser(ctx, user))
+	snapshot := &api.OddsSnapshot{Market: entity.MarketN("guild:1", "a"), Revision: 2, TotalCentipoints: 10}
+	require.Nil(t, r.PutOddsSnapshot(ctx, snapshot))
+	require.Nil(t, r.Close())
+
+	// migrations are not reapplied to an up-to-date db
+	r, err = sqlite.New(fileName)
+	require.Nil(t, err)
+	defer r.Close()
+	got, err := r.GetUser(ctx, user.GetName())
+	require.Nil(t, err)
+	assert.True(t, proto.Equal(user, got))
+	snapshots, _, err := r.ListOddsSnapshots(ctx, &repo.ListOddsSnapshotsArgs{Market: snapshot.GetMarket(), Limit: 10})
+	require.Nil(t, err)
+	require.Len(t, snapshots, 1)
+	assert.True(t, proto.Equal(snapshot, snapshots[0]))
+}
+
+func TestListMarketsMatchesFilterInChunks(t *testing.T) {
+	ctx := context.Background()
+	r := newRepo(t)
+	// titles are not columns so the filter is matched on rows, which are read in more than one chunk
+	var expected []string
+	for i := 0; i < 250; i++ {
+		market := &api.Market{Name: entity.MarketN("guild:1", fmt.Sprintf("%03d", i)), Title: "other", Status: api.Market_STATUS_OPEN}
+		if i%7 == 0 {
+			market.Title = "match"
+			expected = append(expected, market.GetName())
+		}
+		require.Nil(t, r.CreateMarket(ctx, market))
+	}
+	f, err := filter.Parse(`status = OPEN AND title = "match"`, (&api.Market{}).ProtoReflect().Descriptor())
+	require.Nil(t, err)
+
+	var got []string
+	var cursor string
+	for {
+		markets, hasMore, err := r.ListMarkets(ctx, &repo.ListMarketsArgs{Book: entity.BookN("guild:1"), GreaterThanName: cursor, Filter: f, Limit: 10})
+		require.Nil(t, err)
+		for _, m := range markets {
+			got = append(got, m.GetName())
+		}
+		if !hasMore {
+			break
+		}
+		cursor = markets[len(markets)-1].GetName()
+	}
+	assert.Equal(t, expected, got)
+}
@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// PutSeason creates or overwrites a season.
+func (r *Repo) PutSeason(ctx context.Context, season *api.Season) error {
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		return putSeason(ctx, tx, season)
+	})
+}
+
+// putSeason upserts a season.
+func putSeason(ctx context.Context, tx *sql.Tx, season *api.Season) error {
+	bookID, _ := entity.SeasonIDs(season.GetName())
+	data, err := proto.Marshal(season)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO seasons (name, book, number, data) VALUES (?, ?, ?, ?)
+		ON CONFLICT (name) DO UPDATE SET number = excluded.number, data = excluded.data`,
+		season.GetName(), bookID, season.GetNumber(), data); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// GetSeason gets a season by name.
+func (r *Repo) GetSeason(ctx context.Context, name string) (*api.Season, error) {
+	var data []byte
+	err := r.DB.QueryRowContext(ctx, `SELECT data FROM seasons WHERE name = ?`, name).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("season not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	season := &api.Season{}
+	if err := proto.Unmarshal(data, season); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return season, nil
+}
+
+// ListSeasons lists a book's seasons in number order.
+func (r *Repo) ListSeasons(ctx context.Context, args *repo.ListSeasonsArgs) (seasons []*api.Season, hasMore bool, err error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT data FROM seasons WHERE book = ? AND number > ? ORDER BY number ASC LIMIT ?`,
+		entity.BooksIDs(args.Book), args.AfterNumber, args.Limit+1)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.Season
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		s := &api.Season{}
+		if err := proto.Unmarshal(data, s); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateTransfer creates a new transfer.
+func (r *Repo) CreateTransfer(ctx context.Context, transfer *api.Transfer) error {
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		return insertTransfer(ctx, tx, transfer)
+	})
+}
+
+// insertTransfer inserts a new transfer.
+func insertTransfer(ctx context.Context, tx *sql.Tx, transfer *api.Transfer) error {
+	bookID, _ := entity.TransferIDs(transfer.GetName())
+	data, err := proto.Marshal(transfer)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	var n int
+	if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM transfers WHERE name = ?`, transfer.GetName()).Scan(&n); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if n > 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("transfer with id already exists"))
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO transfers (name, book, from_user, to_user, data) VALUES (?, ?, ?, ?, ?)`,
+		transfer.GetName(), bookID, transfer.GetFromUser(), transfer.GetToUser(), data); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// ListTransfers lists transfers by filters.
+func (r *Repo) ListTransfers(ctx context.Context, args *repo.ListTransfersArgs) (transfers []*api.Transfer, hasMore bool, err error) {
+	query := `SELECT data FROM transfers WHERE book = ? AND name > ?`
+	params := []any{entity.BooksIDs(args.Book), args.GreaterThanName}
+	if args.User != "" {
+		query += ` AND (from_user = ? OR to_user = ?)`
+		params = append(params, args.User, args.User)
+	}
+	query += ` ORDER BY name ASC LIMIT ?`
+	params = append(params, args.Limit+1)
+	rows, err := r.DB.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.Transfer
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		t := &api.Transfer{}
+		if err := proto.Unmarshal(data, t); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
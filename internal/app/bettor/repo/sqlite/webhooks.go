@@ -0,0 +1,158 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateWebhook creates a new webhook.
+func (r *Repo) CreateWebhook(ctx context.Context, webhook *api.Webhook) error {
+	bookID, _ := entity.WebhookIDs(webhook.GetName())
+	data, err := proto.Marshal(webhook)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return r.withTx(ctx, func(tx *sql.Tx) error {
+		var n int
+		if err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM webhooks WHERE name = ?`, webhook.GetName()).Scan(&n); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if n > 0 {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("webhook with id already exists"))
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO webhooks (name, book, data) VALUES (?, ?, ?)`, webhook.GetName(), bookID, data); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+}
+
+// GetWebhook gets a webhook by name.
+func (r *Repo) GetWebhook(ctx context.Context, name string) (*api.Webhook, error) {
+	var data []byte
+	err := r.DB.QueryRowContext(ctx, `SELECT data FROM webhooks WHERE name = ?`, name).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("webhook not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	webhook := &api.Webhook{}
+	if err := proto.Unmarshal(data, webhook); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return webhook, nil
+}
+
+// ListWebhooks lists webhooks by filters.
+func (r *Repo) ListWebhooks(ctx context.Context, args *repo.ListWebhooksArgs) (webhooks []*api.Webhook, hasMore bool, err error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT data FROM webhooks WHERE book = ? AND name > ? ORDER BY name ASC LIMIT ?`,
+		entity.BooksIDs(args.Book), args.GreaterThanName, args.Limit+1)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.Webhook
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		w := &api.Webhook{}
+		if err := proto.Unmarshal(data, w); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// DeleteWebhook deletes a webhook.
+func (r *Repo) DeleteWebhook(ctx context.Context, name string) error {
+	return r.deleteByName(ctx, "webhooks", name, "webhook")
+}
+
+// PutDelivery creates or overwrites a webhook delivery.
+func (r *Repo) PutDelivery(ctx context.Context, delivery *api.WebhookDelivery) error {
+	data, err := proto.Marshal(delivery)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err := r.DB.ExecContext(ctx, `INSERT INTO deliveries (name, next_attempt_at, data) VALUES (?, ?, ?)
+		ON CONFLICT (name) DO UPDATE SET next_attempt_at = excluded.next_attempt_at, data = excluded.data`,
+		delivery.GetName(), delivery.GetNextAttemptAt().AsTime().UnixNano(), data); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// DeleteDelivery deletes a webhook delivery.
+func (r *Repo) DeleteDelivery(ctx context.Context, name string) error {
+	return r.deleteByName(ctx, "deliveries", name, "delivery")
+}
+
+// ListDeliveries lists webhook deliveries across all books.
+func (r *Repo) ListDeliveries(ctx context.Context, args *repo.ListDeliveriesArgs) (deliveries []*api.WebhookDelivery, hasMore bool, err error) {
+	query := `SELECT data FROM deliveries`
+	var params []any
+	if !args.DueBefore.IsZero() {
+		query += ` WHERE next_attempt_at <= ?`
+		params = append(params, args.DueBefore.UnixNano())
+	}
+	query += ` ORDER BY next_attempt_at ASC, name ASC LIMIT ?`
+	params = append(params, args.Limit+1)
+	rows, err := r.DB.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+	var out []*api.WebhookDelivery
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		d := &api.WebhookDelivery{}
+		if err := proto.Unmarshal(data, d); err != nil {
+			return nil, false, connect.NewError(connect.CodeInternal, err)
+		}
+		out = append(out, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(out) > args.Limit {
+		return out[:args.Limit], true, nil
+	}
+	return out, false, nil
+}
+
+// deleteByName deletes a resource by name, failing with CodeNotFound if it does not exist.
+func (r *Repo) deleteByName(ctx context.Context, table, name, resource string) error {
+	res, err := r.DB.ExecContext(ctx, `DELETE FROM `+table+` WHERE name = ?`, name) //nolint:gosec // table is a constant
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if n == 0 {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("%s not found", resource))
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/proto"
+)
+
+// Write applies a batch of writes atomically in one transaction.
+func (r *Repo) Write(ctx context.Context, batch *repo.Batch) error {
+	userEtags := make([]string, len(batch.Users))
+	marketEtags := make([]string, len(batch.Markets))
+	betEtags := make([]string, len(batch.Bets))
+	newBets := make([]*api.Bet, len(batch.NewBets))
+	for i, bet := range batch.NewBets {
+		newBets[i] = proto.Clone(bet).(*api.Bet)
+		newBets[i].Etag = repo.NextEtag("")
+	}
+	err := r.withTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		for i, user := range batch.Users {
+			if userEtags[i], err = updateUser(ctx, tx, user); err != nil {
+				return err
+			}
+		}
+		for i, market := range batch.Markets {
+			if marketEtags[i], err = updateMarket(ctx, tx, market); err != nil {
+				return err
+			}
+		}
+		for i, bet := range batch.Bets {
+			if betEtags[i], err = updateBet(ctx, tx, bet); err != nil {
+				return err
+			}
+		}
+		for _, bet := range newBets {
+			if err := insertBet(ctx, tx, bet); err != nil {
+				return err
+			}
+		}
+		for _, snapshot := range batch.OddsSnapshots {
+			if err := putOddsSnapshot(ctx, tx, snapshot); err != nil {
+				return err
+			}
+		}
+		for _, grant := range batch.Grants {
+			if err := insertGrant(ctx, tx, grant); err != nil {
+				return err
+			}
+		}
+		for _, season := range batch.Seasons {
+			if err := putSeason(ctx, tx, season); err != nil {
+				return err
+			}
+		}
+		for _, transfer := range batch.Transfers {
+			if err := insertTransfer(ctx, tx, transfer); err != nil {
+				return err
+			}
+		}
+		for _, adjustment := range batch.Adjustments {
+			if err := insertAdjustment(ctx, tx, adjustment); err != nil {
+				return err
+			}
+		}
+		return insertEvents(ctx, tx, batch.Events)
+	})
+	if err != nil {
+		return err
+	}
+	for i, user := range batch.Users {
+		user.Etag = userEtags[i]
+	}
+	for i, market := range batch.Markets {
+		market.Etag = marketEtags[i]
+	}
+	for i, bet := range batch.Bets {
+		bet.Etag = betEtags[i]
+	}
+	for i, bet := range batch.NewBets {
+		bet.Etag = newBets[i].GetEtag()
+	}
+	return nil
+}
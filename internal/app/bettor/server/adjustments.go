@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/pagination"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdjustUserBalance credits or debits a user's balance to correct mistakes. The actor must be a book admin.
+// Adjustments may not make a balance negative.
+func (s *Server) AdjustUserBalance(ctx context.Context, in *connect.Request[api.AdjustUserBalanceRequest]) (*connect.Response[api.AdjustUserBalanceResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	bookID, _ := entity.UserIDs(in.Msg.GetUser())
+	if actorBookID, _ := entity.UserIDs(in.Msg.GetActor()); bookID != actorBookID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("actor must be a member of the book"))
+	}
+	defer s.locks.Lock(in.Msg.GetUser())()
+
+	if _, err := s.Repo.GetUser(ctx, in.Msg.GetActor()); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeAdmin(ctx, bookID, in.Msg.GetActor()); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.Now()
+	adjustment := &api.Adjustment{
+		Name:             entity.AdjustmentN(bookID, uuid.NewString()),
+		CreatedAt:        now,
+		User:             in.Msg.GetUser(),
+		Actor:            in.Msg.GetActor(),
+		DeltaCentipoints: in.Msg.GetDeltaCentipoints(),
+		Reason:           in.Msg.GetReason(),
+	}
+	var user *api.User
+	err := s.write(ctx, func() (*repo.Batch, error) {
+		var err error
+		if user, err = s.Repo.GetUser(ctx, adjustment.GetUser()); err != nil {
+			return nil, err
+		}
+		if int64(user.GetCentipoints())+adjustment.GetDeltaCentipoints() < 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("adjustment would make balance negative"))
+		}
+		adjustment.DebtRepaidCentipoints = 0
+		if adjustment.GetDeltaCentipoints() > 0 {
+			adjustment.DebtRepaidCentipoints = credit(user, uint64(adjustment.GetDeltaCentipoints()))
+		} else {
+			user.Centipoints -= uint64(-adjustment.GetDeltaCentipoints())
+		}
+		adjustment.BalanceDeltaCentipoints = adjustment.GetDeltaCentipoints() - int64(adjustment.GetDebtRepaidCentipoints())
+		user.UpdatedAt = now
+		return &repo.Batch{
+			Users:       []*api.User{user},
+			Adjustments: []*api.Adjustment{adjustment},
+			Events:      []*api.DomainEvent{userAdjusted(user, adjustment)},
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.AdjustUserBalanceResponse{
+		Adjustment: adjustment,
+		User:       user,
+	}), nil
+}
+
+// ListAdjustments lists admin adjustments to user balances in a book.
+func (s *Server) ListAdjustments(ctx context.Context, in *connect.Request[api.ListAdjustmentsRequest]) (*connect.Response[api.ListAdjustmentsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := defaultPageSize
+	if in.Msg.GetPageSize() > 0 && in.Msg.GetPageSize() <= maxPageSize {
+		pageSize = int(in.Msg.GetPageSize())
+	}
+
+	var cursor string
+	if in.Msg.GetPageToken() != "" {
+		p, err := s.PageTokens.FromToken(in.Msg.GetPageToken(), api.StripListAdjustmentsPagination(in.Msg))
+		if err != nil {
+			return nil, err
+		}
+		cursor = p.Cursor
+	}
+
+	adjustments, hasMore, err := s.Repo.ListAdjustments(ctx, &repo.ListAdjustmentsArgs{
+		Book:            in.Msg.GetBook(),
+		GreaterThanName: cursor,
+		User:            in.Msg.GetUser(),
+		Limit:           pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if hasMore {
+		nextPageToken, err = s.PageTokens.ToToken(pagination.Pagination{
+			Cursor:      adjustments[len(adjustments)-1].GetName(),
+			ListRequest: api.StripListAdjustmentsPagination(in.Msg),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&api.ListAdjustmentsResponse{
+		Adjustments:   adjustments,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// listAdjustments lists all adjustments matching args.
+func (s *Server) listAdjustments(ctx context.Context, args *repo.ListAdjustmentsArgs) ([]*api.Adjustment, error) {
+	args.Limit = 100
+	var adjustments []*api.Adjustment
+	for {
+		as, hasMore, err := s.Repo.ListAdjustments(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, as...)
+		if !hasMore {
+			return adjustments, nil
+		}
+		args.GreaterThanName = as[len(as)-1].GetName()
+	}
+}
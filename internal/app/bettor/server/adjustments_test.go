@@ -0,0 +1,166 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdjustUserBalance(t *testing.T) {
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000}
+	admin := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 500}
+	otherBook := &api.User{Name: entity.UserN("guild:2", "c"), Username: "linus", Centipoints: 500}
+	debtor := &api.User{Name: entity.UserN("guild:1", "d"), Username: "basher", Centipoints: 100, DebtCentipoints: 200}
+	settings := &api.BookSettings{Name: entity.BookSettingsN("guild:1"), Admins: []string{admin.GetName()}}
+	testCases := []struct {
+		desc             string
+		req              *api.AdjustUserBalanceRequest
+		expectDebtRepaid uint64
+		expectErrCode    connect.Code
+	}{
+		{
+			desc: "credits user",
+			req:  &api.AdjustUserBalanceRequest{User: user.GetName(), Actor: admin.GetName(), DeltaCentipoints: 300, Reason: "bad settlement"},
+		},
+		{
+			desc: "debits user",
+			req:  &api.AdjustUserBalanceRequest{User: user.GetName(), Actor: admin.GetName(), DeltaCentipoints: -300, Reason: "bad settlement"},
+		},
+		{
+			desc: "debits entire balance",
+			req:  &api.AdjustUserBalanceRequest{User: user.GetName(), Actor: admin.GetName(), DeltaCentipoints: -1000, Reason: "bad settlement"},
+		},
+		{
+			desc:             "credit repays debt first",
+			req:              &api.AdjustUserBalanceRequest{User: debtor.GetName(), Actor: admin.GetName(), DeltaCentipoints: 150, Reason: "bad settlement"},
+			expectDebtRepaid: 150,
+		},
+		{
+			desc:             "credit beyond debt is added to balance",
+			req:              &api.AdjustUserBalanceRequest{User: debtor.GetName(), Actor: admin.GetName(), DeltaCentipoints: 300, Reason: "bad settlement"},
+			expectDebtRepaid: 200,
+		},
+		{
+			desc: "debit does not change debt",
+			req:  &api.AdjustUserBalanceRequest{User: debtor.GetName(), Actor: admin.GetName(), DeltaCentipoints: -100, Reason: "bad settlement"},
+		},
+		{
+			desc: "admins can adjust themselves",
+			req:  &api.AdjustUserBalanceRequest{User: admin.GetName(), Actor: admin.GetName(), DeltaCentipoints: 100, Reason: "bad settlement"},
+		},
+		{
+			desc:          "fails if balance would be negative",
+			req:           &api.AdjustUserBalanceRequest{User: user.GetName(), Actor: admin.GetName(), DeltaCentipoints: -1001, Reason: "bad settlement"},
+			expectErrCode: connect.CodeFailedPrecondition,
+		},
+		{
+			desc:          "fails if reason is empty",
+			req:           &api.AdjustUserBalanceRequest{User: user.GetName(), Actor: admin.GetName(), DeltaCentipoints: 300},
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if delta is 0",
+			req:           &api.AdjustUserBalanceRequest{User: user.GetName(), Actor: admin.GetName(), Reason: "bad settlement"},
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if actor is in a different book",
+			req:           &api.AdjustUserBalanceRequest{User: user.GetName(), Actor: otherBook.GetName(), DeltaCentipoints: 300, Reason: "bad settlement"},
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if actor is not an admin",
+			req:           &api.AdjustUserBalanceRequest{User: admin.GetName(), Actor: user.GetName(), DeltaCentipoints: 300, Reason: "bad settlement"},
+			expectErrCode: connect.CodePermissionDenied,
+		},
+		{
+			desc:          "fails if actor does not exist",
+			req:           &api.AdjustUserBalanceRequest{User: user.GetName(), Actor: entity.UserN("guild:1", uuid.NewString()), DeltaCentipoints: 300, Reason: "bad settlement"},
+			expectErrCode: connect.CodeNotFound,
+		},
+		{
+			desc:          "fails if user does not exist",
+			req:           &api.AdjustUserBalanceRequest{User: entity.UserN("guild:1", uuid.NewString()), Actor: admin.GetName(), DeltaCentipoints: 300, Reason: "bad settlement"},
+			expectErrCode: connect.CodeNotFound,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			r := &mem.Repo{Users: []*api.User{user, admin, otherBook, debtor}, Settings: []*api.BookSettings{settings}}
+			s, err := server.New(server.WithRepo(r))
+			require.Nil(t, err)
+			before, _ := r.GetUser(context.Background(), tC.req.GetUser())
+			out, err := s.AdjustUserBalance(context.Background(), connect.NewRequest(tC.req))
+			if tC.expectErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tC.expectErrCode, connect.CodeOf(err))
+				got, _ := r.GetUser(context.Background(), tC.req.GetUser())
+				assert.Equal(t, before.GetCentipoints(), got.GetCentipoints())
+				adjustments, _, err := r.ListAdjustments(context.Background(), &repo.ListAdjustmentsArgs{Book: entity.BookN("guild:1"), Limit: 10})
+				require.Nil(t, err)
+				assert.Empty(t, adjustments)
+				return
+			}
+			require.Nil(t, err)
+			adjustment := out.Msg.GetAdjustment()
+			assert.Equal(t, tC.expectDebtRepaid, adjustment.GetDebtRepaidCentipoints())
+			assert.Equal(t, tC.req.GetDeltaCentipoints()-int64(tC.expectDebtRepaid), adjustment.GetBalanceDeltaCentipoints())
+			assert.Equal(t, int64(before.GetCentipoints())+adjustment.GetBalanceDeltaCentipoints(), int64(out.Msg.GetUser().GetCentipoints()))
+			assert.Equal(t, before.GetDebtCentipoints()-tC.expectDebtRepaid, out.Msg.GetUser().GetDebtCentipoints())
+			assert.Equal(t, tC.req.GetActor(), out.Msg.GetAdjustment().GetActor())
+			assert.Equal(t, tC.req.GetReason(), out.Msg.GetAdjustment().GetReason())
+			assert.NotNil(t, out.Msg.GetAdjustment().GetCreatedAt())
+
+			adjustments, _, err := r.ListAdjustments(context.Background(), &repo.ListAdjustmentsArgs{Book: entity.BookN("guild:1"), User: tC.req.GetUser(), Limit: 10})
+			require.Nil(t, err)
+			require.Len(t, adjustments, 1)
+			assert.Equal(t, out.Msg.GetAdjustment().GetName(), adjustments[0].GetName())
+
+			// the event records the change to the balance and the debt repaid
+			events, _, err := r.ListEvents(context.Background(), &repo.ListEventsArgs{Limit: 10})
+			require.Nil(t, err)
+			require.Len(t, events, 1)
+			changed := events[0].GetUserBalanceChanged()
+			assert.Equal(t, adjustment.GetBalanceDeltaCentipoints(), changed.GetDeltaCentipoints())
+			assert.Equal(t, -int64(tC.expectDebtRepaid), changed.GetDebtDeltaCentipoints())
+			assert.Equal(t, adjustment.GetName(), changed.GetAdjustment())
+		})
+	}
+}
+
+func TestListAdjustments(t *testing.T) {
+	user := entity.UserN("guild:1", "a")
+	var adjustments []*api.Adjustment
+	for i := 0; i < 3; i++ {
+		adjustments = append(adjustments, &api.Adjustment{Name: entity.AdjustmentN("guild:1", uuid.NewString()), User: user, Actor: entity.UserN("guild:1", "b"), DeltaCentipoints: 100, Reason: "fix"})
+	}
+	adjustments = append(adjustments, &api.Adjustment{Name: entity.AdjustmentN("guild:1", uuid.NewString()), User: entity.UserN("guild:1", "b"), Actor: entity.UserN("guild:1", "b"), DeltaCentipoints: 100, Reason: "fix"})
+	s, err := server.New(server.WithRepo(&mem.Repo{Adjustments: adjustments}))
+	require.Nil(t, err)
+
+	var got []*api.Adjustment
+	var pageToken string
+	for {
+		out, err := s.ListAdjustments(context.Background(), connect.NewRequest(&api.ListAdjustmentsRequest{Book: entity.BookN("guild:1"), User: user, PageSize: 2, PageToken: pageToken}))
+		require.Nil(t, err)
+		got = append(got, out.Msg.GetAdjustments()...)
+		if out.Msg.GetNextPageToken() == "" {
+			break
+		}
+		pageToken = out.Msg.GetNextPageToken()
+	}
+	require.Len(t, got, 3)
+	for _, a := range got {
+		assert.Equal(t, user, a.GetUser())
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetUserBalanceHistory returns a user's total points over time.
+func (s *Server) GetUserBalanceHistory(ctx context.Context, in *connect.Request[api.GetUserBalanceHistoryRequest]) (*connect.Response[api.GetUserBalanceHistoryResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	user, err := s.Repo.GetUser(ctx, in.Msg.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	bookID, _ := entity.UserIDs(user.GetName())
+	bets, err := s.listBets(ctx, &repo.ListBetsArgs{Book: entity.BookN(bookID), User: user.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	grants, err := s.listGrants(ctx, &repo.ListGrantsArgs{Book: entity.BookN(bookID), User: user.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	transfers, err := s.listTransfers(ctx, &repo.ListTransfersArgs{Book: entity.BookN(bookID), User: user.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	adjustments, err := s.listAdjustments(ctx, &repo.ListAdjustmentsArgs{Book: entity.BookN(bookID), User: user.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	seasons, err := s.listSeasons(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetUserBalanceHistoryResponse{
+		Points: balanceHistory(user, bets, grants, transfers, adjustments, seasons, time.Now()),
+	}), nil
+}
+
+// balanceChange is a change to a user's total points.
+type balanceChange struct {
+	time  *timestamppb.Timestamp
+	delta int64
+}
+
+// balanceHistory walks back from a user's current total points through the profit of each of their settled bets, each
+// of their grants, transfers, and adjustments, and their resets at the start of each season. Placing a bet moves points
+// into unsettled bets so it does not change the total. Debts count against the total so bets that were clawed back
+// beyond the user's balance change it by their full profit.
+func balanceHistory(user *api.User, bets []*api.Bet, grants []*api.Grant, transfers []*api.Transfer, adjustments []*api.Adjustment, seasons []*api.Season, now time.Time) []*api.BalancePoint {
+	var changes []balanceChange
+	for _, bet := range bets {
+		if bet.GetSettledAt() != nil && bet.GetSettledCentipoints() != bet.GetCentipoints() {
+			changes = append(changes, balanceChange{bet.GetSettledAt(), int64(bet.GetSettledCentipoints()) - int64(bet.GetCentipoints())})
+		}
+	}
+	for _, grant := range grants {
+		changes = append(changes, balanceChange{grant.GetCreatedAt(), int64(grant.GetCentipoints())})
+	}
+	for _, transfer := range transfers {
+		delta := int64(transfer.GetCentipoints())
+		if transfer.GetFromUser() == user.GetName() {
+			delta = -delta
+		}
+		changes = append(changes, balanceChange{transfer.GetCreatedAt(), delta})
+	}
+	for _, adjustment := range adjustments {
+		changes = append(changes, balanceChange{adjustment.GetCreatedAt(), adjustment.GetDeltaCentipoints()})
+	}
+	for _, season := range seasons {
+		for _, reset := range season.GetResets() {
+			if reset.GetUser() == user.GetName() {
+				changes = append(changes, balanceChange{season.GetStartTime(), reset.GetDeltaCentipoints()})
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].time.AsTime().Before(changes[j].time.AsTime())
+	})
+
+	total := int64(user.GetCentipoints()+user.GetUnsettledCentipoints()) - int64(user.GetDebtCentipoints())
+	points := []*api.BalancePoint{{Time: timestamppb.New(now), TotalCentipoints: total}}
+	for i := len(changes) - 1; i >= 0; i-- {
+		change := changes[i]
+		last := points[len(points)-1]
+		// changes at the same time are one point
+		if !change.time.AsTime().Equal(last.GetTime().AsTime()) {
+			points = append(points, &api.BalancePoint{Time: change.time, TotalCentipoints: total})
+		}
+		total -= change.delta
+	}
+	points = append(points, &api.BalancePoint{Time: user.GetCreatedAt(), TotalCentipoints: total})
+
+	for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+		points[i], points[j] = points[j], points[i]
+	}
+	return points
+}
@@ -0,0 +1,175 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetUserBalanceHistory(t *testing.T) {
+	day := func(d int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC))
+	}
+	user := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "rusty", Centipoints: 1000, CreatedAt: day(1)}
+	newUser := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "linus", Centipoints: 1000, CreatedAt: day(1)}
+	grantedUser := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "danny", Centipoints: 600, CreatedAt: day(1)}
+	grants := []*api.Grant{
+		{Name: entity.GrantN("guild:1", uuid.NewString()), User: grantedUser.GetName(), Centipoints: 500, CreatedAt: day(2), Reason: api.Grant_REASON_ALLOWANCE},
+	}
+	sender := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "basher", Centipoints: 700, CreatedAt: day(1)}
+	recipient := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "livingston", Centipoints: 1300, CreatedAt: day(1)}
+	transfers := []*api.Transfer{
+		{Name: entity.TransferN("guild:1", uuid.NewString()), FromUser: sender.GetName(), ToUser: recipient.GetName(), Centipoints: 300, CreatedAt: day(3)},
+	}
+	adjustedUser := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "saul", Centipoints: 800, CreatedAt: day(1)}
+	adjustments := []*api.Adjustment{
+		{Name: entity.AdjustmentN("guild:1", uuid.NewString()), User: adjustedUser.GetName(), Actor: user.GetName(), DeltaCentipoints: -200, Reason: "bad settlement", CreatedAt: day(2)},
+	}
+	market := entity.MarketN("guild:1", uuid.NewString())
+	// a winning bet was resettled as a loss after the winnings were sent away
+	indebtedUser := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "turk", DebtCentipoints: 150, CreatedAt: day(1)}
+	transfers = append(transfers, &api.Transfer{Name: entity.TransferN("guild:1", uuid.NewString()), FromUser: indebtedUser.GetName(), ToUser: entity.UserN("guild:1", uuid.NewString()), Centipoints: 150, CreatedAt: day(2)})
+	resettledBet := &api.Bet{Name: entity.BetN("guild:1", "resettled"), User: indebtedUser.GetName(), Market: market, Centipoints: 100, SettledAt: day(3), Type: &api.Bet_Outcome{Outcome: market + "/outcomes/1"}}
+	bet := func(id string, centipoints, settledCentipoints uint64, settledAt *timestamppb.Timestamp) *api.Bet {
+		return &api.Bet{
+			Name:               entity.BetN("guild:1", id),
+			User:               user.GetName(),
+			Market:             market,
+			Centipoints:        centipoints,
+			SettledCentipoints: settledCentipoints,
+			SettledAt:          settledAt,
+			Type:               &api.Bet_Outcome{Outcome: market + "/outcomes/1"},
+		}
+	}
+	bets := []*api.Bet{
+		bet("1", 100, 300, day(2)), // +200
+		bet("2", 100, 0, day(2)),   // -100
+		bet("3", 200, 0, day(3)),   // -200
+		bet("4", 100, 100, day(4)), // canceled
+		bet("5", 50, 0, nil),       // unsettled
+		resettledBet,
+	}
+	type point struct {
+		time  *timestamppb.Timestamp
+		total int64
+	}
+	testCases := []struct {
+		desc      string
+		user      string
+		expected  []point
+		expectErr bool
+	}{
+		{
+			desc:     "walks back from the current total through settled bets",
+			user:     user.GetName(),
+			expected: []point{{day(1), 1150}, {day(2), 1250}, {day(3), 1050}, {nil, 1050}},
+		},
+		{
+			desc:     "no bets",
+			user:     newUser.GetName(),
+			expected: []point{{day(1), 1000}, {nil, 1000}},
+		},
+		{
+			desc:     "walks back through grants",
+			user:     grantedUser.GetName(),
+			expected: []point{{day(1), 100}, {day(2), 600}, {nil, 600}},
+		},
+		{
+			desc:     "walks back through sent transfers",
+			user:     sender.GetName(),
+			expected: []point{{day(1), 1000}, {day(3), 700}, {nil, 700}},
+		},
+		{
+			desc:     "walks back through received transfers",
+			user:     recipient.GetName(),
+			expected: []point{{day(1), 1000}, {day(3), 1300}, {nil, 1300}},
+		},
+		{
+			desc:     "walks back through adjustments",
+			user:     adjustedUser.GetName(),
+			expected: []point{{day(1), 1000}, {day(2), 800}, {nil, 800}},
+		},
+		{
+			desc:     "walks back through debts",
+			user:     indebtedUser.GetName(),
+			expected: []point{{day(1), 100}, {day(2), -50}, {day(3), -150}, {nil, -150}},
+		},
+		{
+			desc:      "fails if user does not exist",
+			user:      entity.UserN("guild:1", uuid.NewString()),
+			expectErr: true,
+		},
+		{
+			desc:      "fails if user is empty",
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{user, newUser, grantedUser, sender, recipient, adjustedUser, indebtedUser}, Bets: bets, Grants: grants, Transfers: transfers, Adjustments: adjustments}))
+			require.Nil(t, err)
+			out, err := s.GetUserBalanceHistory(context.Background(), connect.NewRequest(&api.GetUserBalanceHistoryRequest{User: tC.user}))
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			points := out.Msg.GetPoints()
+			require.Len(t, points, len(tC.expected))
+			for i, p := range tC.expected {
+				assert.Equal(t, p.total, points[i].GetTotalCentipoints())
+				if p.time != nil {
+					assert.True(t, p.time.AsTime().Equal(points[i].GetTime().AsTime()))
+				}
+			}
+			// the last point is now
+			assert.WithinDuration(t, time.Now(), points[len(points)-1].GetTime().AsTime(), time.Minute)
+		})
+	}
+}
+
+func TestGetUserBalanceHistoryAcrossSeasons(t *testing.T) {
+	ctx := context.Background()
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000, CreatedAt: timestamppb.New(time.Now().Add(-time.Hour))}
+	settings := &api.BookSettings{
+		Name:                 entity.BookSettingsN("guild:1"),
+		AllowanceCentipoints: 100,
+		AllowanceCooldown:    durationpb.New(0),
+		Admins:               []string{user.GetName()},
+	}
+	s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{user}, Settings: []*api.BookSettings{settings}}))
+	require.Nil(t, err)
+
+	_, err = s.ClaimAllowance(ctx, connect.NewRequest(&api.ClaimAllowanceRequest{User: user.GetName()}))
+	require.Nil(t, err)
+	_, err = s.StartSeason(ctx, connect.NewRequest(&api.StartSeasonRequest{
+		Book:                entity.BookN("guild:1"),
+		StartingCentipoints: 500,
+		OpenBetPolicy:       api.Season_OPEN_BET_POLICY_REFUND,
+		Actor:               user.GetName(),
+	}))
+	require.Nil(t, err)
+	_, err = s.ClaimAllowance(ctx, connect.NewRequest(&api.ClaimAllowanceRequest{User: user.GetName()}))
+	require.Nil(t, err)
+
+	out, err := s.GetUserBalanceHistory(ctx, connect.NewRequest(&api.GetUserBalanceHistoryRequest{User: user.GetName()}))
+	require.Nil(t, err)
+	var totals []int64
+	for _, p := range out.Msg.GetPoints() {
+		totals = append(totals, p.GetTotalCentipoints())
+	}
+	// created, allowance, season reset, allowance, now
+	assert.Equal(t, []int64{1000, 1100, 500, 600, 600}, totals)
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+)
+
+// inOrder orders resources found by a batch get in the order of the requested names and returns the names that were
+// not found. Repeated names repeat their resource.
+func inOrder[T interface{ GetName() string }](names []string, found []T) (ordered []T, notFound []string) {
+	byName := make(map[string]T, len(found))
+	for _, x := range found {
+		byName[x.GetName()] = x
+	}
+	for _, name := range names {
+		x, ok := byName[name]
+		if !ok {
+			notFound = append(notFound, name)
+			continue
+		}
+		ordered = append(ordered, x)
+	}
+	return ordered, notFound
+}
+
+// BatchGetUsers gets up to 100 users by name.
+func (s *Server) BatchGetUsers(ctx context.Context, in *connect.Request[api.BatchGetUsersRequest]) (*connect.Response[api.BatchGetUsersResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	found, err := s.Repo.GetUsers(ctx, in.Msg.GetNames())
+	if err != nil {
+		return nil, err
+	}
+	users, notFound := inOrder(in.Msg.GetNames(), found)
+
+	return connect.NewResponse(&api.BatchGetUsersResponse{
+		Users:    users,
+		NotFound: notFound,
+	}), nil
+}
+
+// BatchGetMarkets gets up to 100 markets by name.
+func (s *Server) BatchGetMarkets(ctx context.Context, in *connect.Request[api.BatchGetMarketsRequest]) (*connect.Response[api.BatchGetMarketsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	found, err := s.Repo.GetMarkets(ctx, in.Msg.GetNames())
+	if err != nil {
+		return nil, err
+	}
+	markets, notFound := inOrder(in.Msg.GetNames(), found)
+
+	return connect.NewResponse(&api.BatchGetMarketsResponse{
+		Markets:  markets,
+		NotFound: notFound,
+	}), nil
+}
+
+// BatchGetBets gets up to 100 bets by name.
+func (s *Server) BatchGetBets(ctx context.Context, in *connect.Request[api.BatchGetBetsRequest]) (*connect.Response[api.BatchGetBetsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	found, err := s.Repo.GetBets(ctx, in.Msg.GetNames())
+	if err != nil {
+		return nil, err
+	}
+	bets, notFound := inOrder(in.Msg.GetNames(), found)
+
+	return connect.NewResponse(&api.BatchGetBetsResponse{
+		Bets:     bets,
+		NotFound: notFound,
+	}), nil
+}
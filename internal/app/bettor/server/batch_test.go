@@ -0,0 +1,128 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBatchGetUsers(t *testing.T) {
+	userA := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "rusty", Centipoints: 100}
+	userB := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "linus", Centipoints: 100}
+	userC := &api.User{Name: entity.UserN("guild:2", uuid.NewString()), Username: "rusty", Centipoints: 100}
+	unsettledBet := &api.Bet{Name: entity.BetN("guild:1", "a"), User: userB.GetName(), Centipoints: 20}
+	hydratedB := proto.Clone(userB).(*api.User)
+	hydratedB.UnsettledCentipoints = 20
+	missing := entity.UserN("guild:1", uuid.NewString())
+	var tooMany []string
+	for i := 0; i < 101; i++ {
+		tooMany = append(tooMany, entity.UserN("guild:1", fmt.Sprint(i)))
+	}
+	testCases := []struct {
+		desc             string
+		names            []string
+		expected         []*api.User
+		expectedNotFound []string
+		expectErr        bool
+	}{
+		{
+			desc:     "returns users in order",
+			names:    []string{userC.GetName(), userA.GetName(), userB.GetName()},
+			expected: []*api.User{userC, userA, hydratedB},
+		},
+		{
+			desc:             "returns missing names as not found",
+			names:            []string{missing, userA.GetName(), "does-not-exist"},
+			expected:         []*api.User{userA},
+			expectedNotFound: []string{missing, "does-not-exist"},
+		},
+		{
+			desc:     "repeated names",
+			names:    []string{userA.GetName(), userA.GetName()},
+			expected: []*api.User{userA, userA},
+		},
+		{
+			desc:      "fails if no names",
+			expectErr: true,
+		},
+		{
+			desc:      "fails if more than 100 names",
+			names:     tooMany,
+			expectErr: true,
+		},
+		{
+			desc:      "fails if a name is empty",
+			names:     []string{userA.GetName(), ""},
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{userA, userB, userC}, Bets: []*api.Bet{unsettledBet}}))
+			require.Nil(t, err)
+			out, err := s.BatchGetUsers(context.Background(), connect.NewRequest(&api.BatchGetUsersRequest{Names: tC.names}))
+			if tC.expectErr {
+				require.NotNil(t, err)
+				assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				return
+			}
+			require.Nil(t, err)
+			require.Len(t, out.Msg.GetUsers(), len(tC.expected))
+			for i := range tC.expected {
+				assert.True(t, proto.Equal(tC.expected[i], out.Msg.GetUsers()[i]))
+			}
+			assert.Equal(t, tC.expectedNotFound, out.Msg.GetNotFound())
+		})
+	}
+}
+
+func TestBatchGetMarkets(t *testing.T) {
+	marketA := &api.Market{Name: entity.MarketN("guild:1", uuid.NewString()), Title: "a", Status: api.Market_STATUS_OPEN}
+	marketB := &api.Market{Name: entity.MarketN("guild:2", uuid.NewString()), Title: "b", Status: api.Market_STATUS_SETTLED}
+	missing := entity.MarketN("guild:1", uuid.NewString())
+	s, err := server.New(server.WithRepo(&mem.Repo{Markets: []*api.Market{marketA, marketB}}))
+	require.Nil(t, err)
+
+	out, err := s.BatchGetMarkets(context.Background(), connect.NewRequest(&api.BatchGetMarketsRequest{
+		Names: []string{marketB.GetName(), missing, marketA.GetName()},
+	}))
+	require.Nil(t, err)
+	require.Len(t, out.Msg.GetMarkets(), 2)
+	assert.True(t, proto.Equal(marketB, out.Msg.GetMarkets()[0]))
+	assert.True(t, proto.Equal(marketA, out.Msg.GetMarkets()[1]))
+	assert.Equal(t, []string{missing}, out.Msg.GetNotFound())
+
+	_, err = s.BatchGetMarkets(context.Background(), connect.NewRequest(&api.BatchGetMarketsRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestBatchGetBets(t *testing.T) {
+	betA := &api.Bet{Name: entity.BetN("guild:1", uuid.NewString()), Centipoints: 100}
+	betB := &api.Bet{Name: entity.BetN("guild:1", uuid.NewString()), Centipoints: 200}
+	missing := entity.BetN("guild:1", uuid.NewString())
+	s, err := server.New(server.WithRepo(&mem.Repo{Bets: []*api.Bet{betA, betB}}))
+	require.Nil(t, err)
+
+	out, err := s.BatchGetBets(context.Background(), connect.NewRequest(&api.BatchGetBetsRequest{
+		Names: []string{betB.GetName(), betA.GetName(), missing},
+	}))
+	require.Nil(t, err)
+	require.Len(t, out.Msg.GetBets(), 2)
+	assert.True(t, proto.Equal(betB, out.Msg.GetBets()[0]))
+	assert.True(t, proto.Equal(betA, out.Msg.GetBets()[1]))
+	assert.Equal(t, []string{missing}, out.Msg.GetNotFound())
+
+	_, err = s.BatchGetBets(context.Background(), connect.NewRequest(&api.BatchGetBetsRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
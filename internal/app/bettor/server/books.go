@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultAllowanceCentipoints is the allowance of books that have not been configured.
+	DefaultAllowanceCentipoints = 10000
+	// DefaultAllowanceCooldown is the allowance cooldown of books that have not been configured.
+	DefaultAllowanceCooldown = 24 * time.Hour
+)
+
+// GetBookSettings returns the settings of a book. Books that have not been configured have default settings.
+func (s *Server) GetBookSettings(ctx context.Context, in *connect.Request[api.GetBookSettingsRequest]) (*connect.Response[api.GetBookSettingsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	settings, err := s.bookSettings(ctx, entity.BooksIDs(in.Msg.GetBook()))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetBookSettingsResponse{
+		Settings: settings,
+	}), nil
+}
+
+// UpdateBookSettings replaces the settings of a book. The actor must be an admin of the book unless the book has no
+// admins yet.
+func (s *Server) UpdateBookSettings(ctx context.Context, in *connect.Request[api.UpdateBookSettingsRequest]) (*connect.Response[api.UpdateBookSettingsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	bookID := entity.BookSettingsIDs(in.Msg.GetSettings().GetName())
+	if actorBookID, _ := entity.UserIDs(in.Msg.GetActor()); bookID != actorBookID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("actor must be a member of the book"))
+	}
+	defer s.locks.Lock(entity.BookN(bookID))()
+
+	if _, err := s.Repo.GetUser(ctx, in.Msg.GetActor()); err != nil {
+		return nil, err
+	}
+	current, err := s.bookSettings(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if len(current.GetAdmins()) > 0 && !isAdmin(current, in.Msg.GetActor()) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("actor is not a book admin"))
+	}
+
+	settings := proto.Clone(in.Msg.GetSettings()).(*api.BookSettings)
+	settings.UpdatedAt = timestamppb.Now()
+	if err := s.Repo.PutBookSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.UpdateBookSettingsResponse{
+		Settings: settings,
+	}), nil
+}
+
+// bookSettings returns the settings of a book or the default settings if it has not been configured.
+func (s *Server) bookSettings(ctx context.Context, bookID string) (*api.BookSettings, error) {
+	settings, err := s.Repo.GetBookSettings(ctx, entity.BookSettingsN(bookID))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return &api.BookSettings{
+			Name:                 entity.BookSettingsN(bookID),
+			AllowanceCentipoints: DefaultAllowanceCentipoints,
+			AllowanceCooldown:    durationpb.New(DefaultAllowanceCooldown),
+		}, nil
+	}
+	return settings, err
+}
+
+// authorizeAdmin returns a PermissionDenied error unless actor is an admin of the book.
+func (s *Server) authorizeAdmin(ctx context.Context, bookID, actor string) error {
+	settings, err := s.bookSettings(ctx, bookID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin(settings, actor) {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("actor is not a book admin"))
+	}
+	return nil
+}
+
+func isAdmin(settings *api.BookSettings, user string) bool {
+	for _, admin := range settings.GetAdmins() {
+		if admin == user {
+			return true
+		}
+	}
+	return false
+}
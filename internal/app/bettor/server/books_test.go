@@ -0,0 +1,75 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestBookSettings(t *testing.T) {
+	ctx := context.Background()
+	r := &mem.Repo{}
+	admin := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty"}
+	member := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny"}
+	other := &api.User{Name: entity.UserN("guild:2", "a"), Username: "linus"}
+	for _, u := range []*api.User{admin, member, other} {
+		require.Nil(t, r.CreateUser(ctx, u))
+	}
+	s, err := server.New(server.WithRepo(r))
+	require.Nil(t, err)
+
+	out, err := s.GetBookSettings(ctx, connect.NewRequest(&api.GetBookSettingsRequest{Book: entity.BookN("guild:1")}))
+	require.Nil(t, err)
+	assert.Equal(t, entity.BookSettingsN("guild:1"), out.Msg.GetSettings().GetName())
+	assert.Equal(t, uint64(server.DefaultAllowanceCentipoints), out.Msg.GetSettings().GetAllowanceCentipoints())
+	assert.Equal(t, server.DefaultAllowanceCooldown, out.Msg.GetSettings().GetAllowanceCooldown().AsDuration())
+
+	settings := &api.BookSettings{
+		Name:                 entity.BookSettingsN("guild:1"),
+		AllowanceCentipoints: 500,
+		AllowanceCooldown:    durationpb.New(time.Hour),
+		BailoutCentipoints:   1000,
+		Admins:               []string{admin.GetName()},
+	}
+	// any member can bootstrap the settings of a book without admins
+	updated, err := s.UpdateBookSettings(ctx, connect.NewRequest(&api.UpdateBookSettingsRequest{Settings: settings, Actor: admin.GetName()}))
+	require.Nil(t, err)
+	assert.NotNil(t, updated.Msg.GetSettings().GetUpdatedAt())
+
+	// only admins can update them after that
+	_, err = s.UpdateBookSettings(ctx, connect.NewRequest(&api.UpdateBookSettingsRequest{Settings: settings, Actor: member.GetName()}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = s.UpdateBookSettings(ctx, connect.NewRequest(&api.UpdateBookSettingsRequest{Settings: settings, Actor: other.GetName()}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = s.UpdateBookSettings(ctx, connect.NewRequest(&api.UpdateBookSettingsRequest{Settings: settings, Actor: entity.UserN("guild:1", "missing")}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	out, err = s.GetBookSettings(ctx, connect.NewRequest(&api.GetBookSettingsRequest{Book: entity.BookN("guild:1")}))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(500), out.Msg.GetSettings().GetAllowanceCentipoints())
+	assert.Equal(t, uint64(1000), out.Msg.GetSettings().GetBailoutCentipoints())
+	assert.Equal(t, []string{admin.GetName()}, out.Msg.GetSettings().GetAdmins())
+
+	// other books are unaffected
+	out, err = s.GetBookSettings(ctx, connect.NewRequest(&api.GetBookSettingsRequest{Book: entity.BookN("guild:2")}))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(server.DefaultAllowanceCentipoints), out.Msg.GetSettings().GetAllowanceCentipoints())
+
+	for _, invalid := range []*api.BookSettings{
+		nil,
+		{Name: "settings"},
+		{Name: settings.GetName(), AllowanceCooldown: durationpb.New(-time.Hour)},
+	} {
+		_, err = s.UpdateBookSettings(ctx, connect.NewRequest(&api.UpdateBookSettingsRequest{Settings: invalid, Actor: admin.GetName()}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	}
+}
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/pagination"
+)
+
+// orderCursor is the page token cursor for keyed orders: "<order key>/<resource name>".
+func orderCursor(value uint64, name string) string {
+	return fmt.Sprintf("%d/%s", value, name)
+}
+
+func parseOrderCursor(cursor string) (*repo.OrderCursor, error) {
+	value, name, ok := strings.Cut(cursor, "/")
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, pagination.ErrInvalidToken)
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, pagination.ErrInvalidToken)
+	}
+	return &repo.OrderCursor{Value: n, Name: name}, nil
+}
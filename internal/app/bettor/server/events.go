@@ -0,0 +1,147 @@
+package server
+
+import (
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Domain events are built before the write they describe so that they are persisted atomically with it. Their
+// payloads are copies of resources as they will be after the write.
+
+func newEvent(bookID string) *api.DomainEvent {
+	return &api.DomainEvent{
+		Id:        uuid.NewString(),
+		CreatedAt: timestamppb.Now(),
+		Book:      entity.BookN(bookID),
+	}
+}
+
+// written returns a copy of a resource with the etag it will have after it is written. A user's unsettled_centipoints
+// is derived on read so it is cleared.
+func written[T proto.Message](resource T) T {
+	clone := proto.Clone(resource).(T)
+	switch r := any(clone).(type) {
+	case *api.User:
+		r.Etag = repo.NextEtag(r.GetEtag())
+		r.UnsettledCentipoints = 0
+	case *api.Market:
+		r.Etag = repo.NextEtag(r.GetEtag())
+	case *api.Bet:
+		r.Etag = repo.NextEtag(r.GetEtag())
+	}
+	return clone
+}
+
+func userCreated(user *api.User) *api.DomainEvent {
+	bookID, _ := entity.UserIDs(user.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_UserCreated{UserCreated: &api.UserCreated{User: written(user)}}
+	return e
+}
+
+// userBalanceChanged returns an event for a change to a user's balance by delta centipoints and to their debt by
+// debtDelta centipoints because of a bet.
+func userBalanceChanged(user *api.User, delta, debtDelta int64, bet string) *api.DomainEvent {
+	bookID, _ := entity.UserIDs(user.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_UserBalanceChanged{UserBalanceChanged: &api.UserBalanceChanged{User: written(user), DeltaCentipoints: delta, DebtDeltaCentipoints: debtDelta, Bet: bet}}
+	return e
+}
+
+// userGranted returns an event for a change to a user's balance because of a grant.
+func userGranted(user *api.User, grant *api.Grant) *api.DomainEvent {
+	bookID, _ := entity.UserIDs(user.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_UserBalanceChanged{UserBalanceChanged: &api.UserBalanceChanged{
+		User:                 written(user),
+		DeltaCentipoints:     int64(grant.GetCentipoints() - grant.GetDebtRepaidCentipoints()),
+		DebtDeltaCentipoints: -int64(grant.GetDebtRepaidCentipoints()),
+		Grant:                grant.GetName(),
+	}}
+	return e
+}
+
+// userSeasonReset returns an event for a change to a user's balance by delta centipoints and to their debt by debtDelta
+// centipoints because a season started.
+func userSeasonReset(user *api.User, delta, debtDelta int64, season string) *api.DomainEvent {
+	bookID, _ := entity.UserIDs(user.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_UserBalanceChanged{UserBalanceChanged: &api.UserBalanceChanged{User: written(user), DeltaCentipoints: delta, DebtDeltaCentipoints: debtDelta, Season: season}}
+	return e
+}
+
+// userTransferred returns an event for a change to a user's balance by delta centipoints and to their debt by debtDelta
+// centipoints because of a transfer.
+func userTransferred(user *api.User, delta, debtDelta int64, transfer string) *api.DomainEvent {
+	bookID, _ := entity.UserIDs(user.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_UserBalanceChanged{UserBalanceChanged: &api.UserBalanceChanged{User: written(user), DeltaCentipoints: delta, DebtDeltaCentipoints: debtDelta, Transfer: transfer}}
+	return e
+}
+
+// userAdjusted returns an event for a change to a user's balance and debt because of an admin adjustment.
+func userAdjusted(user *api.User, adjustment *api.Adjustment) *api.DomainEvent {
+	bookID, _ := entity.UserIDs(user.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_UserBalanceChanged{UserBalanceChanged: &api.UserBalanceChanged{
+		User:                 written(user),
+		DeltaCentipoints:     adjustment.GetBalanceDeltaCentipoints(),
+		DebtDeltaCentipoints: -int64(adjustment.GetDebtRepaidCentipoints()),
+		Adjustment:           adjustment.GetName(),
+	}}
+	return e
+}
+
+func marketCreated(market *api.Market) *api.DomainEvent {
+	bookID, _ := entity.MarketIDs(market.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_MarketCreated{MarketCreated: &api.MarketCreated{Market: written(market)}}
+	return e
+}
+
+func marketLocked(market *api.Market) *api.DomainEvent {
+	bookID, _ := entity.MarketIDs(market.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_MarketLocked{MarketLocked: &api.MarketLocked{Market: written(market)}}
+	return e
+}
+
+func marketSettled(market *api.Market) *api.DomainEvent {
+	bookID, _ := entity.MarketIDs(market.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_MarketSettled{MarketSettled: &api.MarketSettled{Market: written(market)}}
+	return e
+}
+
+func marketCanceled(market *api.Market) *api.DomainEvent {
+	bookID, _ := entity.MarketIDs(market.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_MarketCanceled{MarketCanceled: &api.MarketCanceled{Market: written(market)}}
+	return e
+}
+
+// marketPoolChanged returns an event for a change to a market's pool because of a bet.
+func marketPoolChanged(market *api.Market, bet string) *api.DomainEvent {
+	bookID, _ := entity.MarketIDs(market.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_MarketPoolChanged{MarketPoolChanged: &api.MarketPoolChanged{Market: written(market), Bet: bet}}
+	return e
+}
+
+func betPlaced(bet *api.Bet) *api.DomainEvent {
+	bookID, _ := entity.BetIDs(bet.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_BetPlaced{BetPlaced: &api.BetPlaced{Bet: written(bet)}}
+	return e
+}
+
+func betSettled(bet *api.Bet) *api.DomainEvent {
+	bookID, _ := entity.BetIDs(bet.GetName())
+	e := newEvent(bookID)
+	e.Payload = &api.DomainEvent_BetSettled{BetSettled: &api.BetSettled{Bet: written(bet)}}
+	return e
+}
@@ -0,0 +1,249 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDomainEvents(t *testing.T) {
+	ctx := context.Background()
+	s, err := server.New(server.WithRepo(&mem.Repo{}))
+	require.Nil(t, err)
+
+	createdUser, err := s.CreateUser(ctx, connect.NewRequest(&api.CreateUserRequest{
+		Book: entity.BookN("guild:1"),
+		User: &api.User{Username: "rusty", Centipoints: 1000},
+	}))
+	require.Nil(t, err)
+	user := createdUser.Msg.GetUser()
+	createdMarket, err := s.CreateMarket(ctx, connect.NewRequest(&api.CreateMarketRequest{
+		Book: entity.BookN("guild:1"),
+		Market: &api.Market{
+			Title:   "Will I PB?",
+			Creator: user.GetName(),
+			Type:    &api.Market_Pool{Pool: &api.Pool{Outcomes: []*api.Outcome{{Title: "Yes"}, {Title: "No"}}}},
+		},
+	}))
+	require.Nil(t, err)
+	market := createdMarket.Msg.GetMarket()
+	createdBet, err := s.CreateBet(ctx, connect.NewRequest(&api.CreateBetRequest{
+		Book: entity.BookN("guild:1"),
+		Bet: &api.Bet{
+			User:        user.GetName(),
+			Market:      market.GetName(),
+			Centipoints: 100,
+			Type:        &api.Bet_Outcome{Outcome: market.GetPool().GetOutcomes()[0].GetName()},
+		},
+	}))
+	require.Nil(t, err)
+	bet := createdBet.Msg.GetBet()
+	_, err = s.LockMarket(ctx, connect.NewRequest(&api.LockMarketRequest{Name: market.GetName()}))
+	require.Nil(t, err)
+	_, err = s.SettleMarket(ctx, connect.NewRequest(&api.SettleMarketRequest{
+		Name: market.GetName(),
+		Type: &api.SettleMarketRequest_Winner{Winner: market.GetPool().GetOutcomes()[0].GetName()},
+	}))
+	require.Nil(t, err)
+
+	events, _, err := s.Repo.ListEvents(ctx, &repo.ListEventsArgs{Limit: 100})
+	require.Nil(t, err)
+	var payloads []string
+	for i, e := range events {
+		assert.Equal(t, uint64(i+1), e.GetSequence())
+		assert.NotEmpty(t, e.GetId())
+		assert.NotNil(t, e.GetCreatedAt())
+		assert.Equal(t, entity.BookN("guild:1"), e.GetBook())
+		payloads = append(payloads, string(e.ProtoReflect().WhichOneof(e.ProtoReflect().Descriptor().Oneofs().ByName("payload")).Name()))
+	}
+	assert.Equal(t, []string{
+		"user_created",
+		"market_created",
+		"user_balance_changed", // bet debit
+		"market_pool_changed",
+		"bet_placed",
+		"market_locked",
+		"market_settled",
+		"user_balance_changed", // payout
+		"bet_settled",
+	}, payloads)
+
+	assert.Equal(t, int64(-100), events[2].GetUserBalanceChanged().GetDeltaCentipoints())
+	assert.Equal(t, bet.GetName(), events[2].GetUserBalanceChanged().GetBet())
+	assert.Equal(t, bet.GetName(), events[3].GetMarketPoolChanged().GetBet())
+	assert.Equal(t, int64(100), events[7].GetUserBalanceChanged().GetDeltaCentipoints())
+
+	// payloads are the resources as written
+	gotUser, err := s.Repo.GetUser(ctx, user.GetName())
+	require.Nil(t, err)
+	assert.True(t, proto.Equal(gotUser, events[7].GetUserBalanceChanged().GetUser()))
+	gotMarket, err := s.Repo.GetMarket(ctx, market.GetName())
+	require.Nil(t, err)
+	assert.True(t, proto.Equal(gotMarket, events[6].GetMarketSettled().GetMarket()))
+	gotBet, err := s.Repo.GetBet(ctx, bet.GetName())
+	require.Nil(t, err)
+	assert.True(t, proto.Equal(gotBet, events[8].GetBetSettled().GetBet()))
+}
+
+func TestDomainEventsNotPersistedOnFailedWrite(t *testing.T) {
+	ctx := context.Background()
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000}
+	s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{user}}))
+	require.Nil(t, err)
+
+	_, err = s.CreateUser(ctx, connect.NewRequest(&api.CreateUserRequest{
+		Book: entity.BookN("guild:1"),
+		User: &api.User{Username: "rusty"},
+	}))
+	require.NotNil(t, err)
+
+	events, _, err := s.Repo.ListEvents(ctx, &repo.ListEventsArgs{Limit: 100})
+	require.Nil(t, err)
+	assert.Empty(t, events)
+}
+
+func TestDomainEventsWrittenWithPayouts(t *testing.T) {
+	ctx := context.Background()
+	users := []*api.User{
+		{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000},
+		{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 1000},
+	}
+	market := &api.Market{
+		Name:   entity.MarketN("guild:1", "a"),
+		Status: api.Market_STATUS_BETS_LOCKED,
+		Type: &api.Market_Pool{Pool: &api.Pool{
+			Outcomes: []*api.Outcome{{Name: "outcome-1", Centipoints: 100}, {Name: "outcome-2", Centipoints: 100}},
+		}},
+	}
+	bets := []*api.Bet{
+		{Name: entity.BetN("guild:1", "a"), User: users[0].GetName(), Market: market.GetName(), Centipoints: 100, Type: &api.Bet_Outcome{Outcome: "outcome-1"}},
+		{Name: entity.BetN("guild:1", "b"), User: users[1].GetName(), Market: market.GetName(), Centipoints: 100, Type: &api.Bet_Outcome{Outcome: "outcome-2"}},
+	}
+	s, err := server.New(server.WithRepo(&failOnceWriteRepo{Repo: &mem.Repo{Users: users, Markets: []*api.Market{market}, Bets: bets}}))
+	require.Nil(t, err)
+
+	settle := connect.NewRequest(&api.SettleMarketRequest{Name: market.GetName(), Type: &api.SettleMarketRequest_Winner{Winner: "outcome-1"}})
+	_, err = s.SettleMarket(ctx, settle)
+	require.NotNil(t, err)
+	events, _, err := s.Repo.ListEvents(ctx, &repo.ListEventsArgs{Limit: 100})
+	require.Nil(t, err)
+	assert.Empty(t, events)
+
+	_, err = s.SettleMarket(ctx, settle)
+	require.Nil(t, err)
+	events, _, err = s.Repo.ListEvents(ctx, &repo.ListEventsArgs{Limit: 100})
+	require.Nil(t, err)
+	var payloads []string
+	for _, e := range events {
+		payloads = append(payloads, string(e.ProtoReflect().WhichOneof(e.ProtoReflect().Descriptor().Oneofs().ByName("payload")).Name()))
+	}
+	assert.Equal(t, []string{
+		"market_settled",
+		"user_balance_changed",
+		"bet_settled",
+		"user_balance_changed",
+		"bet_settled",
+	}, payloads)
+	assert.Equal(t, int64(200), events[1].GetUserBalanceChanged().GetDeltaCentipoints())
+	assert.Equal(t, int64(0), events[3].GetUserBalanceChanged().GetDeltaCentipoints())
+}
+
+func TestDomainEventsRecordDebtRepaid(t *testing.T) {
+	ctx := context.Background()
+	debtor := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100, DebtCentipoints: 200}
+	other := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 1000}
+	settings := &api.BookSettings{Name: entity.BookSettingsN("guild:1"), AllowanceCentipoints: 300}
+	market := &api.Market{
+		Name:   entity.MarketN("guild:1", "a"),
+		Status: api.Market_STATUS_BETS_LOCKED,
+		Type: &api.Market_Pool{Pool: &api.Pool{
+			Outcomes: []*api.Outcome{{Name: "outcome-1", Centipoints: 100}, {Name: "outcome-2", Centipoints: 50}},
+		}},
+	}
+	bets := []*api.Bet{
+		{Name: entity.BetN("guild:1", "a"), User: debtor.GetName(), Market: market.GetName(), Centipoints: 100, Type: &api.Bet_Outcome{Outcome: "outcome-1"}},
+		{Name: entity.BetN("guild:1", "b"), User: other.GetName(), Market: market.GetName(), Centipoints: 50, Type: &api.Bet_Outcome{Outcome: "outcome-2"}},
+	}
+	testCases := []struct {
+		desc   string
+		credit func(s *server.Server) error
+		// the changes to the debtor's balance and debt
+		expectDelta     int64
+		expectDebtDelta int64
+	}{
+		{
+			desc: "grant",
+			credit: func(s *server.Server) error {
+				out, err := s.ClaimAllowance(ctx, connect.NewRequest(&api.ClaimAllowanceRequest{User: debtor.GetName()}))
+				if err == nil {
+					assert.Equal(t, uint64(200), out.Msg.GetGrant().GetDebtRepaidCentipoints())
+				}
+				return err
+			},
+			expectDelta:     100,
+			expectDebtDelta: -200,
+		},
+		{
+			desc: "transfer",
+			credit: func(s *server.Server) error {
+				out, err := s.TransferPoints(ctx, connect.NewRequest(&api.TransferPointsRequest{FromUser: other.GetName(), ToUser: debtor.GetName(), Centipoints: 150}))
+				if err == nil {
+					assert.Equal(t, uint64(150), out.Msg.GetTransfer().GetDebtRepaidCentipoints())
+				}
+				return err
+			},
+			expectDelta:     0,
+			expectDebtDelta: -150,
+		},
+		{
+			desc: "payout",
+			credit: func(s *server.Server) error {
+				_, err := s.SettleMarket(ctx, connect.NewRequest(&api.SettleMarketRequest{Name: market.GetName(), Type: &api.SettleMarketRequest_Winner{Winner: "outcome-1"}}))
+				return err
+			},
+			expectDelta:     0,
+			expectDebtDelta: -150,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			r := &mem.Repo{
+				Users:    []*api.User{proto.Clone(debtor).(*api.User), proto.Clone(other).(*api.User)},
+				Settings: []*api.BookSettings{settings},
+				Markets:  []*api.Market{proto.Clone(market).(*api.Market)},
+				Bets:     []*api.Bet{proto.Clone(bets[0]).(*api.Bet), proto.Clone(bets[1]).(*api.Bet)},
+			}
+			s, err := server.New(server.WithRepo(r))
+			require.Nil(t, err)
+			require.Nil(t, tC.credit(s))
+
+			events, _, err := r.ListEvents(ctx, &repo.ListEventsArgs{Limit: 100})
+			require.Nil(t, err)
+			var changed *api.UserBalanceChanged
+			for _, e := range events {
+				if c := e.GetUserBalanceChanged(); c != nil && c.GetUser().GetName() == debtor.GetName() {
+					changed = c
+				}
+			}
+			require.NotNil(t, changed)
+			assert.Equal(t, tC.expectDelta, changed.GetDeltaCentipoints())
+			assert.Equal(t, tC.expectDebtDelta, changed.GetDebtDeltaCentipoints())
+
+			// the event's deltas are the changes to the user
+			got, err := r.GetUser(ctx, debtor.GetName())
+			require.Nil(t, err)
+			assert.Equal(t, int64(debtor.GetCentipoints())+tC.expectDelta, int64(got.GetCentipoints()))
+			assert.Equal(t, int64(debtor.GetDebtCentipoints())+tC.expectDebtDelta, int64(got.GetDebtCentipoints()))
+		})
+	}
+}
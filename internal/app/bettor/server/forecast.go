@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+)
+
+const (
+	// MinForecastsForLeaderboard is the number of scored bets a user needs to be ranked on the forecast leaderboard.
+	MinForecastsForLeaderboard = 3
+	defaultLeaderboardSize     = 10
+	calibrationBins            = 10
+	// implied probabilities are clamped for log scores so that a certain forecast that was wrong has a finite score
+	minLogScoreProbability = 0.01
+)
+
+// GetUserForecastScore returns the forecast score of a user.
+func (s *Server) GetUserForecastScore(ctx context.Context, in *connect.Request[api.GetUserForecastScoreRequest]) (*connect.Response[api.GetUserForecastScoreResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	user, err := s.Repo.GetUser(ctx, in.Msg.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	bookID, _ := entity.UserIDs(user.GetName())
+	bets, err := s.listBets(ctx, &repo.ListBetsArgs{Book: entity.BookN(bookID), User: user.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	markets, err := s.getMarkets(ctx, bets)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetUserForecastScoreResponse{
+		Score: forecastScore(user.GetName(), bets, markets),
+	}), nil
+}
+
+// GetForecastLeaderboard returns the users of a book with the best Brier scores.
+func (s *Server) GetForecastLeaderboard(ctx context.Context, in *connect.Request[api.GetForecastLeaderboardRequest]) (*connect.Response[api.GetForecastLeaderboardResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	pageSize := defaultLeaderboardSize
+	if in.Msg.GetPageSize() > 0 {
+		pageSize = int(in.Msg.GetPageSize())
+	}
+
+	bets, err := s.listBets(ctx, &repo.ListBetsArgs{Book: in.Msg.GetBook()})
+	if err != nil {
+		return nil, err
+	}
+	markets, err := s.getMarkets(ctx, bets)
+	if err != nil {
+		return nil, err
+	}
+	userBets := map[string][]*api.Bet{}
+	for _, bet := range bets {
+		userBets[bet.GetUser()] = append(userBets[bet.GetUser()], bet)
+	}
+	var scores []*api.ForecastScore
+	for user, bets := range userBets {
+		score := forecastScore(user, bets, markets)
+		if score.GetForecasts() < MinForecastsForLeaderboard {
+			continue
+		}
+		scores = append(scores, score)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		a, b := scores[i], scores[j]
+		if a.GetBrierScore() != b.GetBrierScore() {
+			return a.GetBrierScore() < b.GetBrierScore()
+		}
+		if a.GetForecasts() != b.GetForecasts() {
+			return a.GetForecasts() > b.GetForecasts()
+		}
+		return a.GetUser() < b.GetUser()
+	})
+	if len(scores) > pageSize {
+		scores = scores[:pageSize]
+	}
+
+	return connect.NewResponse(&api.GetForecastLeaderboardResponse{
+		Scores: scores,
+	}), nil
+}
+
+// forecastScore scores a user's bets on settled markets. Each bet is a forecast that its outcome wins with the bet's
+// implied probability. Bets with an implied probability of 0, placed before implied probabilities were recorded or on an
+// outcome nothing had been bet on yet, are not scored.
+func forecastScore(user string, bets []*api.Bet, markets map[string]*api.Market) *api.ForecastScore {
+	score := &api.ForecastScore{User: user}
+	bins := make([]*api.CalibrationBin, calibrationBins)
+	var brierSum, logSum float64
+	for _, bet := range bets {
+		market := markets[bet.GetMarket()]
+		p := bet.GetImpliedProbability()
+		if market.GetStatus() != api.Market_STATUS_SETTLED || p <= 0 {
+			continue
+		}
+		var won float64
+		if bet.GetOutcome() == market.GetPool().GetWinner() {
+			won = 1
+		}
+		score.Forecasts++
+		brierSum += (p - won) * (p - won)
+		clamped := math.Min(math.Max(p, minLogScoreProbability), 1-minLogScoreProbability)
+		if won == 1 {
+			logSum += math.Log(clamped)
+		} else {
+			logSum += math.Log(1 - clamped)
+		}
+
+		i := int(p * calibrationBins)
+		if i >= calibrationBins {
+			i = calibrationBins - 1 // p == 1 goes in the last bin
+		}
+		if bins[i] == nil {
+			bins[i] = &api.CalibrationBin{LowerBound: float64(i) / calibrationBins, UpperBound: float64(i+1) / calibrationBins}
+		}
+		bins[i].Forecasts++
+		bins[i].MeanImpliedProbability += p
+		bins[i].ObservedFrequency += won
+	}
+	if score.GetForecasts() == 0 {
+		return score
+	}
+	score.BrierScore = brierSum / float64(score.GetForecasts())
+	score.LogScore = logSum / float64(score.GetForecasts())
+	for _, bin := range bins {
+		if bin == nil {
+			continue
+		}
+		bin.MeanImpliedProbability /= float64(bin.GetForecasts())
+		bin.ObservedFrequency /= float64(bin.GetForecasts())
+		score.Calibration = append(score.Calibration, bin)
+	}
+	return score
+}
@@ -0,0 +1,203 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func forecastFixtures() ([]*api.User, []*api.Market, []*api.Bet) {
+	userA := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 100}
+	userB := &api.User{Name: entity.UserN("guild:1", "b"), Username: "linus", Centipoints: 100}
+	userC := &api.User{Name: entity.UserN("guild:1", "c"), Username: "ken", Centipoints: 100}
+	market := func(id string, status api.Market_Status, winner int) *api.Market {
+		m := &api.Market{Name: entity.MarketN("guild:1", id), Status: status, Type: &api.Market_Pool{Pool: &api.Pool{}}}
+		for i := 0; i < 2; i++ {
+			m.GetPool().Outcomes = append(m.GetPool().Outcomes, &api.Outcome{Name: fmt.Sprintf("%s/outcomes/%d", m.GetName(), i)})
+		}
+		if status == api.Market_STATUS_SETTLED {
+			m.GetPool().Winner = m.GetPool().GetOutcomes()[winner].GetName()
+		}
+		return m
+	}
+	markets := []*api.Market{
+		market("1", api.Market_STATUS_SETTLED, 0),
+		market("2", api.Market_STATUS_SETTLED, 0),
+		market("3", api.Market_STATUS_SETTLED, 1),
+		market("4", api.Market_STATUS_CANCELED, 0),
+		market("5", api.Market_STATUS_OPEN, 0),
+	}
+	var n int
+	bet := func(user *api.User, market *api.Market, outcome int, p float64) *api.Bet {
+		n++
+		return &api.Bet{
+			Name:               entity.BetN("guild:1", fmt.Sprint(n)),
+			User:               user.GetName(),
+			Market:             market.GetName(),
+			Centipoints:        100,
+			ImpliedProbability: p,
+			Type:               &api.Bet_Outcome{Outcome: market.GetPool().GetOutcomes()[outcome].GetName()},
+		}
+	}
+	bets := []*api.Bet{
+		// userA: 0.8 won, 0.75 won, 0.3 lost
+		bet(userA, markets[0], 0, 0.8),
+		bet(userA, markets[1], 0, 0.75),
+		bet(userA, markets[2], 0, 0.3),
+		bet(userA, markets[3], 0, 0.5), // canceled
+		bet(userA, markets[4], 0, 0.5), // open
+		bet(userA, markets[0], 1, 0),   // no odds snapshot
+		// userB: 0.2 lost, 0.25 lost, 0.8 won
+		bet(userB, markets[0], 1, 0.2),
+		bet(userB, markets[1], 1, 0.25),
+		bet(userB, markets[2], 1, 0.8),
+		// userC: 0.5 won, 0.5 lost, 0.5 lost
+		bet(userC, markets[0], 0, 0.5),
+		bet(userC, markets[1], 1, 0.5),
+		bet(userC, markets[2], 0, 0.5),
+	}
+	return []*api.User{userA, userB, userC}, markets, bets
+}
+
+func TestGetUserForecastScore(t *testing.T) {
+	users, markets, bets := forecastFixtures()
+	testCases := []struct {
+		desc      string
+		user      string
+		expected  *api.ForecastScore
+		expectErr bool
+	}{
+		{
+			desc: "scores bets on settled markets",
+			user: users[0].GetName(),
+			expected: &api.ForecastScore{
+				User:       users[0].GetName(),
+				Forecasts:  3,
+				BrierScore: (0.2*0.2 + 0.25*0.25 + 0.3*0.3) / 3,
+				LogScore:   (math.Log(0.8) + math.Log(0.75) + math.Log(0.7)) / 3,
+				Calibration: []*api.CalibrationBin{
+					{LowerBound: 0.3, UpperBound: 0.4, Forecasts: 1, MeanImpliedProbability: 0.3, ObservedFrequency: 0},
+					{LowerBound: 0.7, UpperBound: 0.8, Forecasts: 1, MeanImpliedProbability: 0.75, ObservedFrequency: 1},
+					{LowerBound: 0.8, UpperBound: 0.9, Forecasts: 1, MeanImpliedProbability: 0.8, ObservedFrequency: 1},
+				},
+			},
+		},
+		{
+			desc: "groups forecasts into calibration bins",
+			user: users[2].GetName(),
+			expected: &api.ForecastScore{
+				User:       users[2].GetName(),
+				Forecasts:  3,
+				BrierScore: 0.25,
+				LogScore:   math.Log(0.5),
+				Calibration: []*api.CalibrationBin{
+					{LowerBound: 0.5, UpperBound: 0.6, Forecasts: 3, MeanImpliedProbability: 0.5, ObservedFrequency: 1.0 / 3},
+				},
+			},
+		},
+		{
+			desc:      "fails if user does not exist",
+			user:      entity.UserN("guild:1", uuid.NewString()),
+			expectErr: true,
+		},
+		{
+			desc:      "fails if user is empty",
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			s, err := server.New(server.WithRepo(&mem.Repo{Users: users, Markets: markets, Bets: bets}))
+			require.Nil(t, err)
+			out, err := s.GetUserForecastScore(context.Background(), connect.NewRequest(&api.GetUserForecastScoreRequest{User: tC.user}))
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			score := out.Msg.GetScore()
+			assert.Equal(t, tC.expected.GetUser(), score.GetUser())
+			assert.Equal(t, tC.expected.GetForecasts(), score.GetForecasts())
+			assert.InDelta(t, tC.expected.GetBrierScore(), score.GetBrierScore(), 1e-9)
+			assert.InDelta(t, tC.expected.GetLogScore(), score.GetLogScore(), 1e-9)
+			require.Len(t, score.GetCalibration(), len(tC.expected.GetCalibration()))
+			for i, bin := range tC.expected.GetCalibration() {
+				assert.InDelta(t, bin.GetLowerBound(), score.GetCalibration()[i].GetLowerBound(), 1e-9)
+				assert.InDelta(t, bin.GetUpperBound(), score.GetCalibration()[i].GetUpperBound(), 1e-9)
+				assert.Equal(t, bin.GetForecasts(), score.GetCalibration()[i].GetForecasts())
+				assert.InDelta(t, bin.GetMeanImpliedProbability(), score.GetCalibration()[i].GetMeanImpliedProbability(), 1e-9)
+				assert.InDelta(t, bin.GetObservedFrequency(), score.GetCalibration()[i].GetObservedFrequency(), 1e-9)
+			}
+		})
+	}
+}
+
+func TestGetForecastLeaderboard(t *testing.T) {
+	users, markets, bets := forecastFixtures()
+	// a user without enough forecasts is not ranked
+	userD := &api.User{Name: entity.UserN("guild:1", "d"), Username: "rob", Centipoints: 100}
+	users = append(users, userD)
+	bets = append(bets, &api.Bet{
+		Name:               entity.BetN("guild:1", uuid.NewString()),
+		User:               userD.GetName(),
+		Market:             markets[0].GetName(),
+		Centipoints:        100,
+		ImpliedProbability: 0.9,
+		Type:               &api.Bet_Outcome{Outcome: markets[0].GetPool().GetOutcomes()[0].GetName()},
+	})
+	testCases := []struct {
+		desc      string
+		req       *api.GetForecastLeaderboardRequest
+		expected  []string
+		expectErr bool
+	}{
+		{
+			desc:     "ranks by brier score",
+			req:      &api.GetForecastLeaderboardRequest{Book: entity.BookN("guild:1")},
+			expected: []string{users[1].GetName(), users[0].GetName(), users[2].GetName()},
+		},
+		{
+			desc:     "page size",
+			req:      &api.GetForecastLeaderboardRequest{Book: entity.BookN("guild:1"), PageSize: 1},
+			expected: []string{users[1].GetName()},
+		},
+		{
+			desc: "empty book",
+			req:  &api.GetForecastLeaderboardRequest{Book: entity.BookN("guild:2")},
+		},
+		{
+			desc:      "fails if page size is too large",
+			req:       &api.GetForecastLeaderboardRequest{Book: entity.BookN("guild:1"), PageSize: 51},
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			s, err := server.New(server.WithRepo(&mem.Repo{Users: users, Markets: markets, Bets: bets}))
+			require.Nil(t, err)
+			out, err := s.GetForecastLeaderboard(context.Background(), connect.NewRequest(tC.req))
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			var got []string
+			for _, score := range out.Msg.GetScores() {
+				got = append(got, score.GetUser())
+			}
+			assert.Equal(t, tC.expected, got)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/pagination"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ClaimAllowance grants a user their book's allowance, or tops them up to the book's bailout if that grants more. It
+// can be claimed once per the book's allowance cooldown.
+func (s *Server) ClaimAllowance(ctx context.Context, in *connect.Request[api.ClaimAllowanceRequest]) (*connect.Response[api.ClaimAllowanceResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	bookID, _ := entity.UserIDs(in.Msg.GetUser())
+	settings, err := s.bookSettings(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if settings.GetAllowanceCentipoints() == 0 && settings.GetBailoutCentipoints() == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("book does not have an allowance"))
+	}
+	cooldown := settings.GetAllowanceCooldown().AsDuration()
+
+	defer s.locks.Lock(in.Msg.GetUser())()
+
+	now := time.Now()
+	var user *api.User
+	var grant *api.Grant
+	err = s.write(ctx, func() (*repo.Batch, error) {
+		var err error
+		if user, err = s.Repo.GetUser(ctx, in.Msg.GetUser()); err != nil {
+			return nil, err
+		}
+		if claimedAt := user.GetAllowanceClaimedAt(); claimedAt != nil {
+			if next := claimedAt.AsTime().Add(cooldown); now.Before(next) {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("allowance can next be claimed at %s", next.Format(time.RFC3339)))
+			}
+		}
+		grant = &api.Grant{
+			Name:      entity.GrantN(bookID, uuid.NewString()),
+			CreatedAt: timestamppb.New(now),
+			User:      in.Msg.GetUser(),
+		}
+		grant.Centipoints, grant.Reason = allowance(settings, user)
+		if grant.GetCentipoints() == 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("user is not eligible for a bailout"))
+		}
+		grant.DebtRepaidCentipoints = credit(user, grant.GetCentipoints())
+		user.AllowanceClaimedAt = timestamppb.New(now)
+		// the grant is written with the credit so a claim is never paid without a record of it
+		return &repo.Batch{
+			Users:  []*api.User{user},
+			Grants: []*api.Grant{grant},
+			Events: []*api.DomainEvent{userGranted(user, grant)},
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.ClaimAllowanceResponse{
+		User:          user,
+		Grant:         grant,
+		NextClaimTime: timestamppb.New(now.Add(cooldown)),
+	}), nil
+}
+
+// allowance returns the points a claim grants a user. A user whose total points are below the bailout is topped up to
+// it if that is more than the allowance.
+func allowance(settings *api.BookSettings, user *api.User) (uint64, api.Grant_Reason) {
+	total := user.GetCentipoints() + user.GetUnsettledCentipoints()
+	if bailout := settings.GetBailoutCentipoints(); total < bailout && bailout-total > settings.GetAllowanceCentipoints() {
+		return bailout - total, api.Grant_REASON_BAILOUT
+	}
+	return settings.GetAllowanceCentipoints(), api.Grant_REASON_ALLOWANCE
+}
+
+// ListGrants lists grants of points to users of a book.
+func (s *Server) ListGrants(ctx context.Context, in *connect.Request[api.ListGrantsRequest]) (*connect.Response[api.ListGrantsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := defaultPageSize
+	if in.Msg.GetPageSize() > 0 && in.Msg.GetPageSize() <= maxPageSize {
+		pageSize = int(in.Msg.GetPageSize())
+	}
+
+	var cursor string
+	if in.Msg.GetPageToken() != "" {
+		p, err := s.PageTokens.FromToken(in.Msg.GetPageToken(), api.StripListGrantsPagination(in.Msg))
+		if err != nil {
+			return nil, err
+		}
+		cursor = p.Cursor
+	}
+
+	grants, hasMore, err := s.Repo.ListGrants(ctx, &repo.ListGrantsArgs{
+		Book:            in.Msg.GetBook(),
+		GreaterThanName: cursor,
+		User:            in.Msg.GetUser(),
+		Limit:           pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if hasMore {
+		nextPageToken, err = s.PageTokens.ToToken(pagination.Pagination{
+			Cursor:      grants[len(grants)-1].GetName(),
+			ListRequest: api.StripListGrantsPagination(in.Msg),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&api.ListGrantsResponse{
+		Grants:        grants,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// listGrants lists all grants matching args.
+func (s *Server) listGrants(ctx context.Context, args *repo.ListGrantsArgs) ([]*api.Grant, error) {
+	args.Limit = 100
+	var grants []*api.Grant
+	for {
+		gs, hasMore, err := s.Repo.ListGrants(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, gs...)
+		if !hasMore {
+			return grants, nil
+		}
+		args.GreaterThanName = gs[len(gs)-1].GetName()
+	}
+}
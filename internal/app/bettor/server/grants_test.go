@@ -0,0 +1,215 @@
+package server_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestClaimAllowance(t *testing.T) {
+	settings := &api.BookSettings{
+		Name:                 entity.BookSettingsN("guild:1"),
+		AllowanceCentipoints: 100,
+		AllowanceCooldown:    durationpb.New(time.Hour),
+		BailoutCentipoints:   1000,
+	}
+	user := func(centipoints uint64, claimedAt time.Duration) *api.User {
+		u := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "rusty", Centipoints: centipoints}
+		if claimedAt != 0 {
+			u.AllowanceClaimedAt = timestamppb.New(time.Now().Add(-claimedAt))
+		}
+		return u
+	}
+	testCases := []struct {
+		desc          string
+		settings      *api.BookSettings
+		user          *api.User
+		bets          []*api.Bet
+		expectGrant   uint64
+		expectReason  api.Grant_Reason
+		expectErrCode connect.Code
+	}{
+		{
+			desc:         "grants the allowance",
+			settings:     settings,
+			user:         user(5000, 0),
+			expectGrant:  100,
+			expectReason: api.Grant_REASON_ALLOWANCE,
+		},
+		{
+			desc:         "grants the allowance after the cooldown",
+			settings:     settings,
+			user:         user(5000, 2*time.Hour),
+			expectGrant:  100,
+			expectReason: api.Grant_REASON_ALLOWANCE,
+		},
+		{
+			desc:         "tops up to the bailout if it grants more",
+			settings:     settings,
+			user:         user(300, 0),
+			expectGrant:  700,
+			expectReason: api.Grant_REASON_BAILOUT,
+		},
+		{
+			desc:         "grants the allowance if it is more than the top up",
+			settings:     settings,
+			user:         user(950, 0),
+			expectGrant:  100,
+			expectReason: api.Grant_REASON_ALLOWANCE,
+		},
+		{
+			desc:         "unsettled bets count towards the bailout",
+			settings:     settings,
+			user:         &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 300},
+			bets:         []*api.Bet{{Name: entity.BetN("guild:1", "a"), User: entity.UserN("guild:1", "a"), Centipoints: 500}},
+			expectGrant:  200,
+			expectReason: api.Grant_REASON_BAILOUT,
+		},
+		{
+			desc:         "unconfigured books use default settings",
+			user:         user(0, 0),
+			expectGrant:  server.DefaultAllowanceCentipoints,
+			expectReason: api.Grant_REASON_ALLOWANCE,
+		},
+		{
+			desc:          "fails during the cooldown",
+			settings:      settings,
+			user:          user(0, time.Minute),
+			expectErrCode: connect.CodeFailedPrecondition,
+		},
+		{
+			desc:          "fails if the book has no allowance",
+			settings:      &api.BookSettings{Name: settings.GetName()},
+			user:          user(0, 0),
+			expectErrCode: connect.CodeFailedPrecondition,
+		},
+		{
+			desc:          "fails if only bailouts are enabled and the user does not need one",
+			settings:      &api.BookSettings{Name: settings.GetName(), BailoutCentipoints: 1000},
+			user:          user(5000, 0),
+			expectErrCode: connect.CodeFailedPrecondition,
+		},
+		{
+			desc:          "fails if user does not exist",
+			settings:      settings,
+			user:          &api.User{Name: entity.UserN("guild:1", uuid.NewString())},
+			expectErrCode: connect.CodeNotFound,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			r := &mem.Repo{Bets: tC.bets}
+			if tC.settings != nil {
+				r.Settings = []*api.BookSettings{tC.settings}
+			}
+			if tC.expectErrCode != connect.CodeNotFound {
+				r.Users = []*api.User{tC.user}
+			}
+			s, err := server.New(server.WithRepo(r))
+			require.Nil(t, err)
+			out, err := s.ClaimAllowance(context.Background(), connect.NewRequest(&api.ClaimAllowanceRequest{User: tC.user.GetName()}))
+			if tC.expectErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tC.expectErrCode, connect.CodeOf(err))
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tC.expectGrant, out.Msg.GetGrant().GetCentipoints())
+			assert.Equal(t, tC.expectReason, out.Msg.GetGrant().GetReason())
+			assert.Equal(t, tC.user.GetCentipoints()+tC.expectGrant, out.Msg.GetUser().GetCentipoints())
+			assert.True(t, out.Msg.GetNextClaimTime().AsTime().After(time.Now()))
+
+			grants, _, err := r.ListGrants(context.Background(), &repo.ListGrantsArgs{Book: entity.BookN("guild:1"), User: tC.user.GetName(), Limit: 10})
+			require.Nil(t, err)
+			require.Len(t, grants, 1)
+			assert.Equal(t, out.Msg.GetGrant().GetName(), grants[0].GetName())
+
+			// cannot claim again during the cooldown
+			_, err = s.ClaimAllowance(context.Background(), connect.NewRequest(&api.ClaimAllowanceRequest{User: tC.user.GetName()}))
+			assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		})
+	}
+}
+
+func TestClaimAllowanceConcurrently(t *testing.T) {
+	ctx := context.Background()
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty"}
+	r := &mem.Repo{Users: []*api.User{user}}
+	s, err := server.New(server.WithRepo(r))
+	require.Nil(t, err)
+
+	const claims = 10
+	errs := make(chan error, claims)
+	var wg sync.WaitGroup
+	for i := 0; i < claims; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.ClaimAllowance(ctx, connect.NewRequest(&api.ClaimAllowanceRequest{User: user.GetName()}))
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	var succeeded int
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	}
+	assert.Equal(t, 1, succeeded)
+
+	// exactly one grant is recorded for the one credit
+	got, err := r.GetUser(ctx, user.GetName())
+	require.Nil(t, err)
+	assert.Equal(t, uint64(server.DefaultAllowanceCentipoints), got.GetCentipoints())
+	grants, _, err := r.ListGrants(ctx, &repo.ListGrantsArgs{Book: entity.BookN("guild:1"), Limit: 10})
+	require.Nil(t, err)
+	assert.Len(t, grants, 1)
+}
+
+func TestListGrants(t *testing.T) {
+	user := entity.UserN("guild:1", "a")
+	var grants []*api.Grant
+	for i := 0; i < 3; i++ {
+		grants = append(grants, &api.Grant{Name: entity.GrantN("guild:1", uuid.NewString()), User: user, Centipoints: 100, Reason: api.Grant_REASON_ALLOWANCE})
+	}
+	grants = append(grants, &api.Grant{Name: entity.GrantN("guild:1", uuid.NewString()), User: entity.UserN("guild:1", "b"), Centipoints: 100, Reason: api.Grant_REASON_ALLOWANCE})
+	s, err := server.New(server.WithRepo(&mem.Repo{Grants: grants}))
+	require.Nil(t, err)
+
+	var got []*api.Grant
+	var pageToken string
+	for {
+		out, err := s.ListGrants(context.Background(), connect.NewRequest(&api.ListGrantsRequest{Book: entity.BookN("guild:1"), User: user, PageSize: 2, PageToken: pageToken}))
+		require.Nil(t, err)
+		got = append(got, out.Msg.GetGrants()...)
+		if out.Msg.GetNextPageToken() == "" {
+			break
+		}
+		pageToken = out.Msg.GetNextPageToken()
+	}
+	require.Len(t, got, 3)
+	for _, g := range got {
+		assert.Equal(t, user, g.GetUser())
+	}
+
+	_, err = s.ListGrants(context.Background(), connect.NewRequest(&api.ListGrantsRequest{Book: entity.BookN("guild:1"), User: entity.UserN("guild:1", "b"), PageToken: pageToken}))
+	assert.NotNil(t, err, "page token is bound to its request")
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"sort"
+	"sync"
+)
+
+// lockManager hands out mutexes keyed by resource name so that writes to unrelated markets and users do not block
+// each other. The zero value is ready to use.
+//
+// Deadlocks are avoided by always acquiring locks in increasing key order. Lock sorts the keys it is given, and a
+// caller that locks in several calls must only lock keys greater than the ones it already holds. Keys are resource
+// names, so a book sorts before its markets and its markets sort before its users.
+type lockManager struct {
+	mtx   sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	mtx  sync.Mutex
+	refs int // number of holders and waiters. the lock is dropped from the map when there are none
+}
+
+// Lock locks all keys in sorted order and returns a function that unlocks them.
+func (l *lockManager) Lock(keys ...string) (unlock func()) {
+	keys = sortedUnique(keys)
+	held := make([]*keyLock, 0, len(keys))
+	for _, key := range keys {
+		kl := l.acquire(key)
+		kl.mtx.Lock()
+		held = append(held, kl)
+	}
+	return func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			held[i].mtx.Unlock()
+			l.release(keys[i])
+		}
+	}
+}
+
+func (l *lockManager) acquire(key string) *keyLock {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.locks == nil {
+		l.locks = map[string]*keyLock{}
+	}
+	kl, ok := l.locks[key]
+	if !ok {
+		kl = &keyLock{}
+		l.locks[key] = kl
+	}
+	kl.refs++
+	return kl
+}
+
+func (l *lockManager) release(key string) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	kl := l.locks[key]
+	kl.refs--
+	if kl.refs == 0 {
+		delete(l.locks, key)
+	}
+}
+
+func sortedUnique(keys []string) []string {
+	out := make([]string, 0, len(keys))
+	out = append(out, keys...)
+	sort.Strings(out)
+	for i := len(out) - 1; i > 0; i-- {
+		if out[i] == out[i-1] {
+			out = append(out[:i], out[i+1:]...)
+		}
+	}
+	return out
+}
@@ -2,14 +2,16 @@ package server
 
 import (
 	"context"
-	"encoding/gob"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/bufbuild/connect-go"
 	api "github.com/elh/bettor/api/bettor/v1alpha"
 	"github.com/elh/bettor/internal/app/bettor/entity"
 	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/filter"
 	"github.com/elh/bettor/internal/pkg/pagination"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
@@ -19,15 +21,11 @@ import (
 // MaxNumberOfOpenMarkets is the maximum number of open markets allowed.
 const MaxNumberOfOpenMarkets = 25
 
-func init() {
-	gob.Register(&api.ListMarketsRequest{})
-	gob.Register(&api.ListBetsRequest{})
-}
+// ResettleWindow is how long after a market is first settled that it can be resettled.
+const ResettleWindow = 24 * time.Hour
 
 // CreateMarket creates a new betting market.
 func (s *Server) CreateMarket(ctx context.Context, in *connect.Request[api.CreateMarketRequest]) (*connect.Response[api.CreateMarketResponse], error) {
-	s.marketMtx.RLock()
-	defer s.marketMtx.RUnlock()
 	if in.Msg == nil || in.Msg.GetMarket() == nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("market is required"))
 	}
@@ -41,6 +39,15 @@ func (s *Server) CreateMarket(ctx context.Context, in *connect.Request[api.Creat
 	market.SettledAt = nil
 	market.Status = api.Market_STATUS_OPEN
 
+	// serialize market creation per book to enforce the open market limit and tag markets with the current season
+	defer s.locks.Lock(entity.BookN(bookID))()
+
+	season, err := s.currentSeason(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	market.Season = season.GetName()
+
 	creatorBookID, _ := entity.UserIDs(market.GetCreator())
 	if bookID != creatorBookID {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("creator must be a member of the book"))
@@ -79,7 +86,7 @@ func (s *Server) CreateMarket(ctx context.Context, in *connect.Request[api.Creat
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := s.Repo.CreateMarket(ctx, market); err != nil {
+	if err := s.Repo.CreateMarket(ctx, market, marketCreated(market)); err != nil {
 		return nil, err
 	}
 
@@ -90,8 +97,6 @@ func (s *Server) CreateMarket(ctx context.Context, in *connect.Request[api.Creat
 
 // GetMarket returns a market by ID.
 func (s *Server) GetMarket(ctx context.Context, in *connect.Request[api.GetMarketRequest]) (*connect.Response[api.GetMarketResponse], error) {
-	s.marketMtx.RLock()
-	defer s.marketMtx.RUnlock()
 	if err := in.Msg.Validate(); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -119,35 +124,48 @@ func (s *Server) ListMarkets(ctx context.Context, in *connect.Request[api.ListMa
 
 	var cursor string
 	if in.Msg.GetPageToken() != "" {
-		p, err := pagination.FromToken(in.Msg.GetPageToken())
+		p, err := s.PageTokens.FromToken(in.Msg.GetPageToken(), api.StripListMarketsPagination(in.Msg))
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			return nil, err
 		}
 		cursor = p.Cursor
-		fromToken, ok := proto.Clone(p.ListRequest).(*api.ListMarketsRequest)
-		if !ok {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
-		}
-		if !proto.Equal(api.StripListMarketsPagination(in.Msg), api.StripListMarketsPagination(fromToken)) {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
-		}
 	}
 
-	markets, hasMore, err := s.Repo.ListMarkets(ctx, &repo.ListMarketsArgs{
-		Book:            in.Msg.GetBook(),
-		GreaterThanName: cursor,
-		Status:          in.Msg.GetStatus(),
-		Limit:           pageSize,
-	})
+	f, err := filter.Parse(in.Msg.GetFilter(), (&api.Market{}).ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	args := &repo.ListMarketsArgs{
+		Book:    in.Msg.GetBook(),
+		Status:  in.Msg.GetStatus(),
+		Filter:  f,
+		Limit:   pageSize,
+		OrderBy: in.Msg.GetOrderBy(),
+	}
+	keyed := repo.IsKeyedOrder(in.Msg.GetOrderBy())
+	if keyed && cursor != "" {
+		if args.After, err = parseOrderCursor(cursor); err != nil {
+			return nil, err
+		}
+	} else {
+		args.GreaterThanName = cursor
+	}
+	markets, hasMore, err := s.Repo.ListMarkets(ctx, args)
 	if err != nil {
 		return nil, err
 	}
 
 	var nextPageToken string
 	if hasMore {
-		nextPageToken, err = pagination.ToToken(pagination.Pagination{
-			Cursor:      markets[len(markets)-1].GetName(),
-			ListRequest: in.Msg,
+		last := markets[len(markets)-1]
+		next := last.GetName()
+		if keyed {
+			next = orderCursor(repo.MarketOrderKey(in.Msg.GetOrderBy(), last), last.GetName())
+		}
+		nextPageToken, err = s.PageTokens.ToToken(pagination.Pagination{
+			Cursor:      next,
+			ListRequest: api.StripListMarketsPagination(in.Msg),
 		})
 		if err != nil {
 			return nil, err
@@ -161,220 +179,375 @@ func (s *Server) ListMarkets(ctx context.Context, in *connect.Request[api.ListMa
 
 // SettleMarket settles a betting market and pays out bets.
 func (s *Server) SettleMarket(ctx context.Context, in *connect.Request[api.SettleMarketRequest]) (*connect.Response[api.SettleMarketResponse], error) {
-	s.marketMtx.Lock()
-	defer s.marketMtx.Unlock()
 	if err := in.Msg.Validate(); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	defer s.locks.Lock(in.Msg.GetName())()
 
-	market, err := s.Repo.GetMarket(ctx, in.Msg.GetName())
-	if err != nil {
-		return nil, err
-	}
-	if market.GetStatus() != api.Market_STATUS_BETS_LOCKED {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("market is not locked"))
-	}
-	market.Status = api.Market_STATUS_SETTLED
-	market.UpdatedAt = timestamppb.Now()
-	market.SettledAt = timestamppb.Now()
+	// the status transition is written with the payouts and guards against paying out bets more than once
+	market, err := s.payOut(ctx, in.Msg.GetName(), in.Msg.GetEtag(), func(market *api.Market) error {
+		if market.GetStatus() != api.Market_STATUS_BETS_LOCKED {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("market is not locked"))
+		}
+		market.Status = api.Market_STATUS_SETTLED
+		market.UpdatedAt = timestamppb.Now()
+		market.SettledAt = timestamppb.Now()
 
-	// NOTE: only Pool is supported right now
-	if in.Msg.GetWinner() == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("winner is required"))
-	}
+		// NOTE: only Pool is supported right now
+		if in.Msg.GetWinner() == "" {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("winner is required"))
+		}
 
-	if market.GetPool() == nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("market has no pool"))
-	}
-	var found bool
-	for _, outcome := range market.GetPool().GetOutcomes() {
-		if outcome.GetName() == in.Msg.GetWinner() {
-			found = true
-			break
+		if market.GetPool() == nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("market has no pool"))
 		}
+		var found bool
+		for _, outcome := range market.GetPool().GetOutcomes() {
+			if outcome.GetName() == in.Msg.GetWinner() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("winner is not in pool"))
+		}
+		market.GetPool().Winner = in.Msg.GetWinner()
+		market.Settlements = append(market.Settlements, &api.Settlement{Winner: in.Msg.GetWinner(), SettledAt: market.GetSettledAt()})
+		return nil
+	}, marketSettled, settleBets)
+	if err != nil {
+		return nil, err
 	}
-	if !found {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("winner is not in pool"))
-	}
-	market.GetPool().Winner = in.Msg.GetWinner()
 
-	// compute return ratio
+	return connect.NewResponse(&api.SettleMarketResponse{Market: market}), nil
+}
+
+// settleBets sets the settled centipoints of a market's bets for the market's winner. Winners split the pool in
+// proportion to their bets. If no one bet on the winner, all bets are refunded.
+func settleBets(market *api.Market, bets []*api.Bet) {
 	var totalCentipointsBet, winnerCentipointsBet uint64
 	for _, outcome := range market.GetPool().GetOutcomes() {
 		totalCentipointsBet += outcome.GetCentipoints()
-		if outcome.GetName() == in.Msg.GetWinner() {
+		if outcome.GetName() == market.GetPool().GetWinner() {
 			winnerCentipointsBet = outcome.GetCentipoints()
 		}
 	}
-	if totalCentipointsBet > 0 {
-		winnerRatio := float64(totalCentipointsBet) / float64(winnerCentipointsBet)
-
-		bookID, _ := entity.MarketIDs(in.Msg.GetName())
-		var bets []*api.Bet
-		var greaterThanName string
-		for {
-			bs, hasMore, err := s.Repo.ListBets(ctx, &repo.ListBetsArgs{Book: entity.BookN(bookID), GreaterThanName: greaterThanName, Market: market.GetName(), Limit: 100})
-			if err != nil {
-				return nil, err
-			}
-			bets = append(bets, bs...)
-			if !hasMore {
-				break
-			}
-			greaterThanName = bs[len(bs)-1].GetName()
-		}
-		var hasWinner bool
-		for _, bet := range bets {
-			if bet.GetOutcome() == market.GetPool().GetWinner() {
-				hasWinner = true
-				break
-			}
+	var hasWinner bool
+	for _, bet := range bets {
+		if bet.GetOutcome() == market.GetPool().GetWinner() {
+			hasWinner = true
+			break
 		}
+	}
 
-		for _, bet := range bets {
-			bet.UpdatedAt = timestamppb.Now()
-			bet.SettledAt = timestamppb.Now()
-			if hasWinner {
-				if bet.GetOutcome() == market.GetPool().GetWinner() {
-					bet.SettledCentipoints = uint64(float64(bet.GetCentipoints()) * winnerRatio)
-				}
-			} else {
-				bet.SettledCentipoints = bet.GetCentipoints()
-			}
+	now := timestamppb.Now()
+	for _, bet := range bets {
+		bet.UpdatedAt = now
+		bet.SettledAt = now
+		switch {
+		case !hasWinner:
+			bet.SettledCentipoints = bet.GetCentipoints()
+		case bet.GetOutcome() == market.GetPool().GetWinner():
+			winnerRatio := float64(totalCentipointsBet) / float64(winnerCentipointsBet)
+			bet.SettledCentipoints = uint64(float64(bet.GetCentipoints()) * winnerRatio)
+		default:
+			bet.SettledCentipoints = 0
 		}
+	}
+}
 
-		for _, bet := range bets {
-			user, err := s.Repo.GetUser(ctx, bet.GetUser())
-			if err != nil {
-				return nil, err
-			}
-			user.UpdatedAt = timestamppb.Now()
-			user.Centipoints += bet.GetSettledCentipoints()
+// payOut writes a market changed by update together with its bets settled by settle and their users credited with the
+// settled centipoints in one batch, so a market is never settled or canceled without paying out its bets. If etag is
+// set, the market must still have that etag. The lock of the market must be held.
+func (s *Server) payOut(ctx context.Context, name, etag string, update func(market *api.Market) error, event func(market *api.Market) *api.DomainEvent, settle func(market *api.Market, bets []*api.Bet)) (*api.Market, error) {
+	// no bets are placed on a market while its lock is held so its bettors are known before locking them
+	bets, err := s.listMarketBets(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	userNames := make([]string, 0, len(bets))
+	for _, bet := range bets {
+		userNames = append(userNames, bet.GetUser())
+	}
+	userNames = sortedUnique(userNames)
+	defer s.locks.Lock(userNames...)() // users sort after markets
 
-			if err := s.Repo.UpdateUser(ctx, user); err != nil {
-				return nil, err
-			}
+	var market *api.Market
+	err = s.write(ctx, func() (*repo.Batch, error) {
+		var err error
+		if market, err = s.Repo.GetMarket(ctx, name); err != nil {
+			return nil, err
+		}
+		if etag != "" && market.GetEtag() != etag {
+			return nil, connect.NewError(connect.CodeAborted, errors.New("market has been modified"))
+		}
+		if err := update(market); err != nil {
+			return nil, err
+		}
+		if bets, err = s.listMarketBets(ctx, name); err != nil {
+			return nil, err
+		}
+		users, err := s.Repo.GetUsers(ctx, userNames)
+		if err != nil {
+			return nil, err
+		}
+		usersByName := map[string]*api.User{}
+		for _, user := range users {
+			usersByName[user.GetName()] = user
+		}
 
-			if err := s.Repo.UpdateBet(ctx, bet); err != nil {
-				return nil, err
+		settle(market, bets)
+		repaid := make([]int64, len(bets))
+		for i, bet := range bets {
+			user, ok := usersByName[bet.GetUser()]
+			if !ok {
+				return nil, connect.NewError(connect.CodeInternal, errors.New("bet user not found"))
 			}
+			repaid[i] = int64(credit(user, bet.GetSettledCentipoints()))
+			user.UpdatedAt = bet.GetSettledAt()
 		}
-	}
-
-	if err := s.Repo.UpdateMarket(ctx, market); err != nil {
+		batch := &repo.Batch{Users: users, Markets: []*api.Market{market}, Bets: bets, Events: []*api.DomainEvent{event(market)}}
+		for i, bet := range bets {
+			batch.Events = append(batch.Events,
+				userBalanceChanged(usersByName[bet.GetUser()], int64(bet.GetSettledCentipoints())-repaid[i], -repaid[i], bet.GetName()),
+				betSettled(bet))
+		}
+		return batch, nil
+	})
+	if err != nil {
 		return nil, err
 	}
-
-	return connect.NewResponse(&api.SettleMarketResponse{Market: market}), nil
+	return market, nil
 }
 
-// LockMarket locks a betting market preventing further bets.
-func (s *Server) LockMarket(ctx context.Context, in *connect.Request[api.LockMarketRequest]) (*connect.Response[api.LockMarketResponse], error) {
-	s.marketMtx.Lock()
-	defer s.marketMtx.Unlock()
+// ResettleMarket corrects the winner of a recently settled market. Previous payouts are clawed back and bets are paid
+// out again for the new winner. Points that cannot be clawed back are added to the users' debts, which are repaid from
+// their future credits, and recorded on the new settlement. The actor must be the market's creator or a book admin.
+func (s *Server) ResettleMarket(ctx context.Context, in *connect.Request[api.ResettleMarketRequest]) (*connect.Response[api.ResettleMarketResponse], error) {
 	if err := in.Msg.Validate(); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	bookID, _ := entity.MarketIDs(in.Msg.GetName())
+	if actorBookID, _ := entity.UserIDs(in.Msg.GetActor()); bookID != actorBookID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("actor must be a member of the book"))
+	}
+	defer s.locks.Lock(entity.BookN(bookID), in.Msg.GetName())()
 
+	if _, err := s.Repo.GetUser(ctx, in.Msg.GetActor()); err != nil {
+		return nil, err
+	}
 	market, err := s.Repo.GetMarket(ctx, in.Msg.GetName())
 	if err != nil {
 		return nil, err
 	}
-	if market.GetStatus() != api.Market_STATUS_OPEN {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("market is not open"))
+	if market.GetCreator() != in.Msg.GetActor() {
+		if err := s.authorizeAdmin(ctx, bookID, in.Msg.GetActor()); err != nil {
+			return nil, err
+		}
 	}
-	market.Status = api.Market_STATUS_BETS_LOCKED
-	market.UpdatedAt = timestamppb.Now()
-
-	if err := s.Repo.UpdateMarket(ctx, market); err != nil {
+	season, err := s.currentSeason(ctx, bookID)
+	if err != nil {
 		return nil, err
 	}
-
-	return connect.NewResponse(&api.LockMarketResponse{Market: market}), nil
-}
-
-// CancelMarket cancels a betting market and redunds all bettors.
-func (s *Server) CancelMarket(ctx context.Context, in *connect.Request[api.CancelMarketRequest]) (*connect.Response[api.CancelMarketResponse], error) {
-	s.marketMtx.Lock()
-	defer s.marketMtx.Unlock()
-	if err := in.Msg.Validate(); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	now := timestamppb.Now()
+	if err := checkResettle(market, in.Msg.GetWinner(), season, now.AsTime()); err != nil {
+		return nil, err
 	}
 
-	market, err := s.Repo.GetMarket(ctx, in.Msg.GetName())
+	// no bets are placed on a settled market so its bettors are known before locking them
+	bets, err := s.listMarketBets(ctx, market.GetName())
 	if err != nil {
 		return nil, err
 	}
-	if market.GetStatus() == api.Market_STATUS_SETTLED {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("market is already settled"))
+	userNames := make([]string, 0, len(bets))
+	for _, bet := range bets {
+		userNames = append(userNames, bet.GetUser())
 	}
-	if market.GetStatus() == api.Market_STATUS_CANCELED {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("market is already canceled"))
-	}
-	market.Status = api.Market_STATUS_CANCELED
-	market.UpdatedAt = timestamppb.Now()
-	market.SettledAt = timestamppb.Now()
+	userNames = sortedUnique(userNames)
+	defer s.locks.Lock(userNames...)() // users sort after markets
 
-	if market.GetPool() == nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("market has no pool"))
-	}
+	err = s.write(ctx, func() (*repo.Batch, error) {
+		var err error
+		if market, err = s.Repo.GetMarket(ctx, in.Msg.GetName()); err != nil {
+			return nil, err
+		}
+		if in.Msg.GetEtag() != "" && market.GetEtag() != in.Msg.GetEtag() {
+			return nil, connect.NewError(connect.CodeAborted, errors.New("market has been modified"))
+		}
+		if err := checkResettle(market, in.Msg.GetWinner(), season, now.AsTime()); err != nil {
+			return nil, err
+		}
+		if bets, err = s.listMarketBets(ctx, market.GetName()); err != nil {
+			return nil, err
+		}
+		users, err := s.Repo.GetUsers(ctx, userNames)
+		if err != nil {
+			return nil, err
+		}
+		usersByName := map[string]*api.User{}
+		debtsBefore := map[string]uint64{}
+		for _, user := range users {
+			user.UpdatedAt = now
+			usersByName[user.GetName()] = user
+			debtsBefore[user.GetName()] = user.GetDebtCentipoints()
+		}
+		previous := map[string]uint64{}
+		for _, bet := range bets {
+			previous[bet.GetName()] = bet.GetSettledCentipoints()
+		}
 
-	// refund all bets
-	var totalCentipointsBet uint64
-	for _, outcome := range market.GetPool().GetOutcomes() {
-		totalCentipointsBet += outcome.GetCentipoints()
-	}
-	if totalCentipointsBet > 0 {
-		bookID, _ := entity.MarketIDs(in.Msg.GetName())
-		var bets []*api.Bet
-		var greaterThanName string
-		for {
-			bs, hasMore, err := s.Repo.ListBets(ctx, &repo.ListBetsArgs{Book: entity.BookN(bookID), GreaterThanName: greaterThanName, Market: market.GetName(), Limit: 100})
-			if err != nil {
-				return nil, err
+		if len(market.GetSettlements()) == 0 {
+			// markets settled before settlements were recorded
+			market.Settlements = []*api.Settlement{{Winner: market.GetPool().GetWinner(), SettledAt: market.GetSettledAt()}}
+		}
+		market.GetPool().Winner = in.Msg.GetWinner()
+		market.UpdatedAt = now
+		market.SettledAt = now
+		settleBets(market, bets)
+
+		// deltas are the changes to the users' payouts. debtDeltas are the parts of them that went to or came from debt
+		deltas, debtDeltas := make([]int64, len(bets)), make([]int64, len(bets))
+		for i, bet := range bets {
+			user, ok := usersByName[bet.GetUser()]
+			if !ok {
+				return nil, connect.NewError(connect.CodeInternal, errors.New("bet user not found"))
 			}
-			bets = append(bets, bs...)
-			if !hasMore {
-				break
+			deltas[i] = int64(bet.GetSettledCentipoints()) - int64(previous[bet.GetName()])
+			if deltas[i] > 0 {
+				debtDeltas[i] = -int64(credit(user, uint64(deltas[i])))
+			} else if deltas[i] < 0 {
+				debtDeltas[i] = int64(clawBack(user, uint64(-deltas[i])))
 			}
-			greaterThanName = bs[len(bs)-1].GetName()
 		}
-
-		for _, bet := range bets {
-			bet.UpdatedAt = timestamppb.Now()
-			bet.SettledAt = timestamppb.Now()
-			bet.SettledCentipoints = bet.GetCentipoints()
+		settlement := &api.Settlement{
+			Winner:    in.Msg.GetWinner(),
+			SettledAt: now,
+			Actor:     in.Msg.GetActor(),
+			Reason:    in.Msg.GetReason(),
 		}
-
-		for _, bet := range bets {
-			user, err := s.Repo.GetUser(ctx, bet.GetUser())
-			if err != nil {
-				return nil, err
+		for _, user := range users {
+			if owed := user.GetDebtCentipoints(); owed > debtsBefore[user.GetName()] {
+				settlement.Debts = append(settlement.Debts, &api.Debt{User: user.GetName(), Centipoints: owed - debtsBefore[user.GetName()]})
 			}
-			user.UpdatedAt = timestamppb.Now()
-			user.Centipoints += bet.GetSettledCentipoints()
+		}
+		sort.Slice(settlement.Debts, func(i, j int) bool { return settlement.Debts[i].GetUser() < settlement.Debts[j].GetUser() })
+		market.Settlements = append(market.Settlements, settlement)
 
-			if err := s.Repo.UpdateUser(ctx, user); err != nil {
-				return nil, err
+		batch := &repo.Batch{Users: users, Markets: []*api.Market{market}, Bets: bets, Events: []*api.DomainEvent{marketSettled(market)}}
+		for i, bet := range bets {
+			if deltas[i] != 0 {
+				batch.Events = append(batch.Events, userBalanceChanged(usersByName[bet.GetUser()], deltas[i]+debtDeltas[i], debtDeltas[i], bet.GetName()))
 			}
+			batch.Events = append(batch.Events, betSettled(bet))
+		}
+		return batch, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			if err := s.Repo.UpdateBet(ctx, bet); err != nil {
-				return nil, err
-			}
+	return connect.NewResponse(&api.ResettleMarketResponse{Market: market}), nil
+}
+
+// checkResettle returns an error if a market cannot be resettled with winner.
+func checkResettle(market *api.Market, winner string, season *api.Season, now time.Time) error {
+	if market.GetStatus() != api.Market_STATUS_SETTLED {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("market is not settled"))
+	}
+	if market.GetPool() == nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("market has no pool"))
+	}
+	settledAt := market.GetSettledAt()
+	if len(market.GetSettlements()) > 0 {
+		settledAt = market.GetSettlements()[0].GetSettledAt()
+	}
+	if now.Sub(settledAt.AsTime()) > ResettleWindow {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("market was settled too long ago to resettle"))
+	}
+	marketSeason := market.GetSeason()
+	if marketSeason == "" {
+		bookID, _ := entity.MarketIDs(market.GetName())
+		marketSeason = firstSeason(bookID).GetName()
+	}
+	if marketSeason != season.GetName() {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("market is from an ended season"))
+	}
+	if winner == market.GetPool().GetWinner() {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("market is already settled with winner"))
+	}
+	for _, outcome := range market.GetPool().GetOutcomes() {
+		if outcome.GetName() == winner {
+			return nil
 		}
 	}
+	return connect.NewError(connect.CodeInvalidArgument, errors.New("winner is not in pool"))
+}
+
+// LockMarket locks a betting market preventing further bets.
+func (s *Server) LockMarket(ctx context.Context, in *connect.Request[api.LockMarketRequest]) (*connect.Response[api.LockMarketResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	defer s.locks.Lock(in.Msg.GetName())()
+
+	market, err := s.updateMarket(ctx, in.Msg.GetName(), in.Msg.GetEtag(), func(market *api.Market) error {
+		if market.GetStatus() != api.Market_STATUS_OPEN {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("market is not open"))
+		}
+		market.Status = api.Market_STATUS_BETS_LOCKED
+		market.UpdatedAt = timestamppb.Now()
+		return nil
+	}, marketLocked)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.LockMarketResponse{Market: market}), nil
+}
+
+// CancelMarket cancels a betting market and redunds all bettors.
+func (s *Server) CancelMarket(ctx context.Context, in *connect.Request[api.CancelMarketRequest]) (*connect.Response[api.CancelMarketResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	defer s.locks.Lock(in.Msg.GetName())()
 
-	if err := s.Repo.UpdateMarket(ctx, market); err != nil {
+	// the status transition is written with the refunds and guards against refunding bets more than once
+	market, err := s.payOut(ctx, in.Msg.GetName(), in.Msg.GetEtag(), func(market *api.Market) error {
+		if market.GetStatus() == api.Market_STATUS_SETTLED {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("market is already settled"))
+		}
+		if market.GetStatus() == api.Market_STATUS_CANCELED {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("market is already canceled"))
+		}
+		market.Status = api.Market_STATUS_CANCELED
+		market.UpdatedAt = timestamppb.Now()
+		market.SettledAt = timestamppb.Now()
+
+		if market.GetPool() == nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("market has no pool"))
+		}
+		return nil
+	}, marketCanceled, refundBets)
+	if err != nil {
 		return nil, err
 	}
 
 	return connect.NewResponse(&api.CancelMarketResponse{Market: market}), nil
 }
 
+// refundBets sets the settled centipoints of a canceled market's bets to refund them.
+func refundBets(_ *api.Market, bets []*api.Bet) {
+	now := timestamppb.Now()
+	for _, bet := range bets {
+		bet.UpdatedAt = now
+		bet.SettledAt = now
+		bet.SettledCentipoints = bet.GetCentipoints()
+	}
+}
+
 // CreateBet places a bet on an open betting market.
 func (s *Server) CreateBet(ctx context.Context, in *connect.Request[api.CreateBetRequest]) (*connect.Response[api.CreateBetResponse], error) {
-	s.marketMtx.Lock()
-	defer s.marketMtx.Unlock()
 	if in.Msg == nil || in.Msg.GetBet() == nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("bet is required"))
 	}
@@ -386,6 +559,7 @@ func (s *Server) CreateBet(ctx context.Context, in *connect.Request[api.CreateBe
 	bet.UpdatedAt = timestamppb.Now()
 	bet.SettledAt = nil
 	bet.SettledCentipoints = 0
+	bet.ImpliedProbability = 0
 
 	userBookID, _ := entity.UserIDs(bet.GetUser())
 	if bookID != userBookID {
@@ -395,6 +569,7 @@ func (s *Server) CreateBet(ctx context.Context, in *connect.Request[api.CreateBe
 	if bookID != marketBookID {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("bet book does not match market book"))
 	}
+	defer s.locks.Lock(bet.GetMarket(), bet.GetUser())()
 
 	user, err := s.Repo.GetUser(ctx, bet.GetUser())
 	if err != nil {
@@ -411,6 +586,7 @@ func (s *Server) CreateBet(ctx context.Context, in *connect.Request[api.CreateBe
 	if market.GetStatus() != api.Market_STATUS_OPEN {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("market is not open"))
 	}
+	bet.Season = market.GetSeason()
 	if bet.Type == nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("bet type is required"))
 	}
@@ -434,23 +610,49 @@ func (s *Server) CreateBet(ctx context.Context, in *connect.Request[api.CreateBe
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	// writes
-	if err := s.Repo.CreateBet(ctx, bet); err != nil {
-		return nil, err
-	}
-	user.Centipoints -= bet.GetCentipoints()
-	if err := s.Repo.UpdateUser(ctx, user); err != nil {
-		return nil, err
-	}
-	if bet.GetOutcome() != "" && market.GetPool() != nil {
-		for _, outcome := range market.GetPool().GetOutcomes() {
-			if outcome.GetName() == bet.GetOutcome() {
-				outcome.Centipoints += bet.GetCentipoints()
-				break
+	// the debit, the pool update, the odds snapshot, and the bet are written together. balance and market status are
+	// checked again against fresh reads since they may have changed
+	err = s.write(ctx, func() (*repo.Batch, error) {
+		user, err := s.Repo.GetUser(ctx, bet.GetUser())
+		if err != nil {
+			return nil, err
+		}
+		if user.GetCentipoints() < bet.GetCentipoints() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user does not have enough balance"))
+		}
+		user.Centipoints -= bet.GetCentipoints()
+		market, err := s.Repo.GetMarket(ctx, bet.GetMarket())
+		if err != nil {
+			return nil, err
+		}
+		if market.GetStatus() != api.Market_STATUS_OPEN {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("market is not open"))
+		}
+		batch := &repo.Batch{
+			Users:   []*api.User{user},
+			Markets: []*api.Market{market},
+			NewBets: []*api.Bet{bet},
+		}
+		if bet.GetOutcome() != "" && market.GetPool() != nil {
+			// snapshot the odds the bet was placed at, before its own stake, for forecast scoring
+			bet.ImpliedProbability = impliedProbability(market.GetPool(), bet.GetOutcome())
+			for _, outcome := range market.GetPool().GetOutcomes() {
+				if outcome.GetName() == bet.GetOutcome() {
+					outcome.Centipoints += bet.GetCentipoints()
+				}
 			}
 		}
-	}
-	if err := s.Repo.UpdateMarket(ctx, market); err != nil {
+		if market.GetPool() != nil {
+			batch.OddsSnapshots = []*api.OddsSnapshot{oddsSnapshot(written(market))}
+		}
+		batch.Events = []*api.DomainEvent{
+			userBalanceChanged(user, -int64(bet.GetCentipoints()), 0, bet.GetName()),
+			marketPoolChanged(market, bet.GetName()),
+			betPlaced(bet),
+		}
+		return batch, nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -459,6 +661,21 @@ func (s *Server) CreateBet(ctx context.Context, in *connect.Request[api.CreateBe
 	}), nil
 }
 
+// impliedProbability returns the share of the pool bet on outcome. Outcomes of an empty pool are equally likely.
+func impliedProbability(pool *api.Pool, outcome string) float64 {
+	var totalCentipoints, outcomeCentipoints uint64
+	for _, o := range pool.GetOutcomes() {
+		if o.GetName() == outcome {
+			outcomeCentipoints = o.GetCentipoints()
+		}
+		totalCentipoints += o.GetCentipoints()
+	}
+	if totalCentipoints == 0 {
+		return 1 / float64(len(pool.GetOutcomes()))
+	}
+	return float64(outcomeCentipoints) / float64(totalCentipoints)
+}
+
 // GetBet returns a bet by ID.
 func (s *Server) GetBet(ctx context.Context, in *connect.Request[api.GetBetRequest]) (*connect.Response[api.GetBetResponse], error) {
 	if err := in.Msg.Validate(); err != nil {
@@ -488,37 +705,50 @@ func (s *Server) ListBets(ctx context.Context, in *connect.Request[api.ListBetsR
 
 	var cursor string
 	if in.Msg.GetPageToken() != "" {
-		p, err := pagination.FromToken(in.Msg.GetPageToken())
+		p, err := s.PageTokens.FromToken(in.Msg.GetPageToken(), api.StripListBetsPagination(in.Msg))
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			return nil, err
 		}
 		cursor = p.Cursor
-		fromToken, ok := proto.Clone(p.ListRequest).(*api.ListBetsRequest)
-		if !ok {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
-		}
-		if !proto.Equal(api.StripListBetsPagination(in.Msg), api.StripListBetsPagination(fromToken)) {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
-		}
 	}
 
-	bets, hasMore, err := s.Repo.ListBets(ctx, &repo.ListBetsArgs{
-		Book:            in.Msg.GetBook(),
-		GreaterThanName: cursor,
-		User:            in.Msg.GetUser(),
-		Market:          in.Msg.GetMarket(),
-		ExcludeSettled:  in.Msg.GetExcludeSettled(),
-		Limit:           pageSize,
-	})
+	f, err := filter.Parse(in.Msg.GetFilter(), (&api.Bet{}).ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	args := &repo.ListBetsArgs{
+		Book:           in.Msg.GetBook(),
+		User:           in.Msg.GetUser(),
+		Market:         in.Msg.GetMarket(),
+		ExcludeSettled: in.Msg.GetExcludeSettled(),
+		Filter:         f,
+		Limit:          pageSize,
+		OrderBy:        in.Msg.GetOrderBy(),
+	}
+	keyed := repo.IsKeyedOrder(in.Msg.GetOrderBy())
+	if keyed && cursor != "" {
+		if args.After, err = parseOrderCursor(cursor); err != nil {
+			return nil, err
+		}
+	} else {
+		args.GreaterThanName = cursor
+	}
+	bets, hasMore, err := s.Repo.ListBets(ctx, args)
 	if err != nil {
 		return nil, err
 	}
 
 	var nextPageToken string
 	if hasMore {
-		nextPageToken, err = pagination.ToToken(pagination.Pagination{
-			Cursor:      bets[len(bets)-1].GetName(),
-			ListRequest: in.Msg,
+		last := bets[len(bets)-1]
+		next := last.GetName()
+		if keyed {
+			next = orderCursor(repo.BetOrderKey(in.Msg.GetOrderBy(), last), last.GetName())
+		}
+		nextPageToken, err = s.PageTokens.ToToken(pagination.Pagination{
+			Cursor:      next,
+			ListRequest: api.StripListBetsPagination(in.Msg),
 		})
 		if err != nil {
 			return nil, err
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/bufbuild/connect-go"
 	api "github.com/elh/bettor/api/bettor/v1alpha"
 	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
 	"github.com/elh/bettor/internal/app/bettor/repo/mem"
 	"github.com/elh/bettor/internal/app/bettor/server"
 	"github.com/google/uuid"
@@ -287,16 +290,19 @@ func TestListMarkets(t *testing.T) {
 	// tests pagination until all markets are returned
 	// alphabetically ordered ids
 	market1 := &api.Market{
-		Name:   entity.MarketN("guild:1", "a"),
-		Status: api.Market_STATUS_OPEN,
+		Name:      entity.MarketN("guild:1", "a"),
+		CreatedAt: timestamppb.New(time.Unix(1, 0)),
+		Status:    api.Market_STATUS_OPEN,
 	}
 	market2 := &api.Market{
-		Name:   entity.MarketN("guild:1", "b"),
-		Status: api.Market_STATUS_OPEN,
+		Name:      entity.MarketN("guild:1", "b"),
+		CreatedAt: timestamppb.New(time.Unix(3, 0)),
+		Status:    api.Market_STATUS_OPEN,
 	}
 	market3 := &api.Market{
-		Name:   entity.MarketN("guild:1", "c"),
-		Status: api.Market_STATUS_BETS_LOCKED,
+		Name:      entity.MarketN("guild:1", "c"),
+		CreatedAt: timestamppb.New(time.Unix(2, 0)),
+		Status:    api.Market_STATUS_BETS_LOCKED,
 	}
 	testCases := []struct {
 		desc          string
@@ -347,6 +353,39 @@ func TestListMarkets(t *testing.T) {
 			expected:      []*api.Market{market1, market2},
 			expectedCalls: 1,
 		},
+		{
+			desc:          "list by filter",
+			req:           &api.ListMarketsRequest{Book: entity.BookN("guild:1"), Filter: "status = BETS_LOCKED OR name = \"" + market1.Name + "\"", PageSize: 1},
+			expected:      []*api.Market{market1, market3},
+			expectedCalls: 2,
+		},
+		{
+			desc:          "list by filter with AIP field names",
+			req:           &api.ListMarketsRequest{Book: entity.BookN("guild:1"), Filter: `status = OPEN AND create_time > "1970-01-01T00:00:02Z"`},
+			expected:      []*api.Market{market2},
+			expectedCalls: 1,
+		},
+		{
+			desc:      "fails if filter has unknown field",
+			req:       &api.ListMarketsRequest{Book: entity.BookN("guild:1"), Filter: "foo = 1"},
+			expectErr: true,
+		},
+		{
+			desc:      "fails if filter is malformed",
+			req:       &api.ListMarketsRequest{Book: entity.BookN("guild:1"), Filter: "status = (OPEN"},
+			expectErr: true,
+		},
+		{
+			desc:          "order by create time desc",
+			req:           &api.ListMarketsRequest{Book: entity.BookN("guild:1"), OrderBy: "create_time desc", PageSize: 2},
+			expected:      []*api.Market{market2, market3, market1},
+			expectedCalls: 2,
+		},
+		{
+			desc:      "fails if order by is invalid",
+			req:       &api.ListMarketsRequest{Book: entity.BookN("guild:1"), OrderBy: "create_time asc"},
+			expectErr: true,
+		},
 	}
 	for _, tC := range testCases {
 		tC := tC
@@ -373,7 +412,10 @@ func TestListMarkets(t *testing.T) {
 				}
 				pageToken = out.Msg.GetNextPageToken()
 			}
-			assert.Equal(t, tC.expected, all)
+			require.Len(t, all, len(tC.expected))
+			for i := range tC.expected {
+				assert.True(t, proto.Equal(tC.expected[i], all[i]))
+			}
 			assert.Equal(t, tC.expectedCalls, calls)
 		})
 	}
@@ -390,6 +432,7 @@ func TestLockMarket(t *testing.T) {
 		Title:   "Will I PB?",
 		Creator: user.GetName(),
 		Status:  api.Market_STATUS_OPEN,
+		Etag:    "3",
 		Type: &api.Market_Pool{
 			Pool: &api.Pool{
 				Outcomes: []*api.Outcome{
@@ -416,12 +459,24 @@ func TestLockMarket(t *testing.T) {
 	testCases := []struct {
 		desc      string
 		market    string
+		etag      string
 		expectErr bool
 	}{
 		{
 			desc:   "basic case",
 			market: market.GetName(),
 		},
+		{
+			desc:   "succeeds if etag matches",
+			market: market.GetName(),
+			etag:   "3",
+		},
+		{
+			desc:      "fails if etag is stale",
+			market:    market.GetName(),
+			etag:      "2",
+			expectErr: true,
+		},
 		{
 			desc:      "fails if market does not exist",
 			market:    "other",
@@ -438,13 +493,14 @@ func TestLockMarket(t *testing.T) {
 		t.Run(tC.desc, func(t *testing.T) {
 			s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{user}, Markets: []*api.Market{market, lockedMarket}}))
 			require.Nil(t, err)
-			out, err := s.LockMarket(context.Background(), connect.NewRequest(&api.LockMarketRequest{Name: tC.market}))
+			out, err := s.LockMarket(context.Background(), connect.NewRequest(&api.LockMarketRequest{Name: tC.market, Etag: tC.etag}))
 			if tC.expectErr {
 				require.NotNil(t, err)
 				return
 			}
 			require.Nil(t, err)
 			assert.Equal(t, api.Market_STATUS_BETS_LOCKED, out.Msg.GetMarket().GetStatus())
+			assert.Equal(t, "4", out.Msg.GetMarket().GetEtag())
 
 			got, err := s.GetMarket(context.Background(), connect.NewRequest(&api.GetMarketRequest{Name: tC.market}))
 			require.Nil(t, err)
@@ -707,6 +763,8 @@ func TestSettleMarket(t *testing.T) {
 			assert.Equal(t, api.Market_STATUS_SETTLED, out.Msg.GetMarket().GetStatus())
 			assert.NotEmpty(t, out.Msg.GetMarket().GetSettledAt())
 			assert.Equal(t, tC.winner, out.Msg.GetMarket().GetPool().GetWinner())
+			require.Len(t, out.Msg.GetMarket().GetSettlements(), 1)
+			assert.Equal(t, tC.winner, out.Msg.GetMarket().GetSettlements()[0].GetWinner())
 
 			got, err := s.GetMarket(context.Background(), connect.NewRequest(&api.GetMarketRequest{Name: tC.market}))
 			require.Nil(t, err)
@@ -730,6 +788,224 @@ func TestSettleMarket(t *testing.T) {
 	}
 }
 
+func TestResettleMarket(t *testing.T) {
+	user := func(id string, centipoints uint64) *api.User {
+		return &api.User{Name: entity.UserN("guild:1", id), Username: id, Centipoints: centipoints}
+	}
+	creator := user("creator", 1000)
+	admin := user("admin", 1000)
+	settings := &api.BookSettings{Name: entity.BookSettingsN("guild:1"), Admins: []string{admin.GetName()}}
+	marketName := entity.MarketN("guild:1", uuid.NewString())
+	market := func(status api.Market_Status, settledAgo time.Duration, season string) *api.Market {
+		return &api.Market{
+			Name:      marketName,
+			Title:     "Will I PB?",
+			Creator:   creator.GetName(),
+			Status:    status,
+			SettledAt: timestamppb.New(time.Now().Add(-settledAgo)),
+			Season:    season,
+			Type: &api.Market_Pool{
+				Pool: &api.Pool{
+					Outcomes: []*api.Outcome{
+						{Name: "outcome-1", Title: "Yes", Centipoints: 200},
+						{Name: "outcome-2", Title: "No", Centipoints: 100},
+						{Name: "outcome-3", Title: "Maybe"},
+					},
+					Winner: "outcome-1",
+				},
+			},
+		}
+	}
+	settled := market(api.Market_STATUS_SETTLED, time.Hour, "")
+	// settled with outcome-1 as the winner
+	bets := []*api.Bet{
+		{Name: entity.BetN("guild:1", "a"), User: entity.UserN("guild:1", "a"), Market: marketName, Centipoints: 100, SettledCentipoints: 150, Type: &api.Bet_Outcome{Outcome: "outcome-1"}},
+		{Name: entity.BetN("guild:1", "b"), User: entity.UserN("guild:1", "b"), Market: marketName, Centipoints: 100, SettledCentipoints: 150, Type: &api.Bet_Outcome{Outcome: "outcome-1"}},
+		{Name: entity.BetN("guild:1", "c"), User: entity.UserN("guild:1", "c"), Market: marketName, Centipoints: 100, Type: &api.Bet_Outcome{Outcome: "outcome-2"}},
+	}
+	testCases := []struct {
+		desc                          string
+		market                        *api.Market
+		users                         []*api.User
+		seasons                       []*api.Season
+		winner                        string
+		actor                         string
+		expectedBetSettledCentipoints map[string]uint64
+		expectedUserCentipoints       map[string]uint64
+		expectedUserDebtCentipoints   map[string]uint64
+		expectedDebts                 []*api.Debt
+		expectErrCode                 connect.Code
+	}{
+		{
+			desc:                          "claws back payouts and pays out the new winner",
+			market:                        settled,
+			users:                         []*api.User{user("a", 1000), user("b", 1000), user("c", 1000)},
+			winner:                        "outcome-2",
+			expectedBetSettledCentipoints: map[string]uint64{"a": 0, "b": 0, "c": 300},
+			expectedUserCentipoints:       map[string]uint64{"a": 850, "b": 850, "c": 1300},
+		},
+		{
+			desc:                          "refunds bets if no one bet on the new winner",
+			market:                        settled,
+			users:                         []*api.User{user("a", 1000), user("b", 1000), user("c", 1000)},
+			winner:                        "outcome-3",
+			expectedBetSettledCentipoints: map[string]uint64{"a": 100, "b": 100, "c": 100},
+			expectedUserCentipoints:       map[string]uint64{"a": 950, "b": 950, "c": 1100},
+		},
+		{
+			desc:                          "records debts that cannot be clawed back",
+			market:                        settled,
+			users:                         []*api.User{user("a", 1000), user("b", 100), user("c", 1000)},
+			winner:                        "outcome-2",
+			expectedBetSettledCentipoints: map[string]uint64{"a": 0, "b": 0, "c": 300},
+			expectedUserCentipoints:       map[string]uint64{"a": 850, "b": 0, "c": 1300},
+			expectedUserDebtCentipoints:   map[string]uint64{"a": 0, "b": 50, "c": 0},
+			expectedDebts:                 []*api.Debt{{User: entity.UserN("guild:1", "b"), Centipoints: 50}},
+		},
+		{
+			desc:                          "repays debts from new payouts",
+			market:                        settled,
+			users:                         []*api.User{user("a", 1000), user("b", 1000), {Name: entity.UserN("guild:1", "c"), Username: "c", DebtCentipoints: 100}},
+			winner:                        "outcome-2",
+			expectedBetSettledCentipoints: map[string]uint64{"a": 0, "b": 0, "c": 300},
+			expectedUserCentipoints:       map[string]uint64{"a": 850, "b": 850, "c": 200},
+			expectedUserDebtCentipoints:   map[string]uint64{"c": 0},
+		},
+		{
+			desc:                          "can be resettled by a book admin",
+			market:                        settled,
+			users:                         []*api.User{user("a", 1000), user("b", 1000), user("c", 1000)},
+			winner:                        "outcome-2",
+			actor:                         admin.GetName(),
+			expectedBetSettledCentipoints: map[string]uint64{"a": 0, "b": 0, "c": 300},
+			expectedUserCentipoints:       map[string]uint64{"a": 850, "b": 850, "c": 1300},
+		},
+		{
+			desc:          "fails if actor is not the creator or a book admin",
+			market:        settled,
+			users:         []*api.User{user("a", 1000), user("b", 1000), user("c", 1000)},
+			winner:        "outcome-2",
+			actor:         entity.UserN("guild:1", "a"),
+			expectErrCode: connect.CodePermissionDenied,
+		},
+		{
+			desc:          "fails if market is not settled",
+			market:        market(api.Market_STATUS_BETS_LOCKED, 0, ""),
+			winner:        "outcome-2",
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if winner is unchanged",
+			market:        settled,
+			winner:        "outcome-1",
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if winner is not in pool",
+			market:        settled,
+			winner:        "other",
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if market was settled too long ago",
+			market:        market(api.Market_STATUS_SETTLED, server.ResettleWindow+time.Hour, ""),
+			winner:        "outcome-2",
+			expectErrCode: connect.CodeFailedPrecondition,
+		},
+		{
+			desc:          "fails if market is from an ended season",
+			market:        settled,
+			seasons:       []*api.Season{{Name: entity.SeasonN("guild:1", "2"), Number: 2}},
+			winner:        "outcome-2",
+			expectErrCode: connect.CodeFailedPrecondition,
+		},
+		{
+			desc:          "fails if actor is in a different book",
+			market:        settled,
+			winner:        "outcome-2",
+			actor:         entity.UserN("guild:2", "creator"),
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if actor does not exist",
+			market:        settled,
+			winner:        "outcome-2",
+			actor:         entity.UserN("guild:1", uuid.NewString()),
+			expectErrCode: connect.CodeNotFound,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			actor := tC.actor
+			if actor == "" {
+				actor = creator.GetName()
+			}
+			var bs []*api.Bet
+			for _, bet := range bets {
+				bs = append(bs, proto.Clone(bet).(*api.Bet))
+			}
+			us := []*api.User{proto.Clone(creator).(*api.User), proto.Clone(admin).(*api.User)}
+			for _, user := range tC.users {
+				us = append(us, proto.Clone(user).(*api.User))
+			}
+			r := &mem.Repo{
+				Users:    us,
+				Markets:  []*api.Market{proto.Clone(tC.market).(*api.Market)},
+				Bets:     bs,
+				Seasons:  tC.seasons,
+				Settings: []*api.BookSettings{settings},
+			}
+			s, err := server.New(server.WithRepo(r))
+			require.Nil(t, err)
+			out, err := s.ResettleMarket(context.Background(), connect.NewRequest(&api.ResettleMarketRequest{Name: marketName, Winner: tC.winner, Actor: actor, Reason: "wrong winner"}))
+			if tC.expectErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tC.expectErrCode, connect.CodeOf(err))
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tC.winner, out.Msg.GetMarket().GetPool().GetWinner())
+			settlements := out.Msg.GetMarket().GetSettlements()
+			require.Len(t, settlements, 2)
+			assert.Equal(t, "outcome-1", settlements[0].GetWinner())
+			assert.Equal(t, tC.winner, settlements[1].GetWinner())
+			assert.Equal(t, actor, settlements[1].GetActor())
+			assert.Equal(t, "wrong winner", settlements[1].GetReason())
+			require.Len(t, settlements[1].GetDebts(), len(tC.expectedDebts))
+			for i, debt := range tC.expectedDebts {
+				assert.True(t, proto.Equal(debt, settlements[1].GetDebts()[i]))
+			}
+
+			for id, cp := range tC.expectedBetSettledCentipoints {
+				got, err := r.GetBet(context.Background(), entity.BetN("guild:1", id))
+				require.Nil(t, err)
+				assert.Equal(t, cp, got.GetSettledCentipoints(), id)
+			}
+			for id, cp := range tC.expectedUserCentipoints {
+				got, err := r.GetUser(context.Background(), entity.UserN("guild:1", id))
+				require.Nil(t, err)
+				assert.Equal(t, cp, got.GetCentipoints(), id)
+			}
+			for id, cp := range tC.expectedUserDebtCentipoints {
+				got, err := r.GetUser(context.Background(), entity.UserN("guild:1", id))
+				require.Nil(t, err)
+				assert.Equal(t, cp, got.GetDebtCentipoints(), id)
+			}
+
+			// can be resettled again within the window, restoring balances and debts
+			_, err = s.ResettleMarket(context.Background(), connect.NewRequest(&api.ResettleMarketRequest{Name: marketName, Winner: "outcome-1", Actor: actor}))
+			require.Nil(t, err)
+			for _, user := range tC.users {
+				got, err := r.GetUser(context.Background(), user.GetName())
+				require.Nil(t, err)
+				assert.Equal(t, user.GetCentipoints(), got.GetCentipoints(), user.GetName())
+				assert.Equal(t, user.GetDebtCentipoints(), got.GetDebtCentipoints(), user.GetName())
+			}
+		})
+	}
+}
+
 func TestCancelMarket(t *testing.T) {
 	marketName := entity.MarketN("guild:1", uuid.NewString())
 	user1 := &api.User{
@@ -1154,6 +1430,8 @@ func TestCreateBet(t *testing.T) {
 			require.Nil(t, err)
 
 			assert.NotEmpty(t, out)
+			// the first bet on the market sees both outcomes as equally likely
+			assert.Equal(t, 0.5, out.Msg.GetBet().GetImpliedProbability())
 
 			u, err := s.GetUser(context.Background(), connect.NewRequest(&api.GetUserRequest{Name: tC.bet.GetUser()}))
 			require.Nil(t, err)
@@ -1212,20 +1490,23 @@ func TestListBets(t *testing.T) {
 	// tests pagination until all bets are returned
 	// alphabetically ordered ids
 	bet1 := &api.Bet{
-		Name:   entity.BetN("guild:1", "a"),
-		User:   "rusty",
-		Market: "one",
+		Name:        entity.BetN("guild:1", "a"),
+		User:        "rusty",
+		Market:      "one",
+		Centipoints: 10,
 	}
 	bet2 := &api.Bet{
-		Name:   entity.BetN("guild:1", "b"),
-		User:   "danny",
-		Market: "two",
+		Name:        entity.BetN("guild:1", "b"),
+		User:        "danny",
+		Market:      "two",
+		Centipoints: 30,
 	}
 	bet3 := &api.Bet{
-		Name:      entity.BetN("guild:1", "c"),
-		User:      "linus",
-		Market:    "three",
-		SettledAt: timestamppb.Now(),
+		Name:        entity.BetN("guild:1", "c"),
+		User:        "linus",
+		Market:      "three",
+		Centipoints: 20,
+		SettledAt:   timestamppb.Now(),
 	}
 	testCases := []struct {
 		desc          string
@@ -1300,6 +1581,29 @@ func TestListBets(t *testing.T) {
 			expected:      nil,
 			expectedCalls: 1,
 		},
+		{
+			desc:          "list by filter",
+			req:           &api.ListBetsRequest{Book: entity.BookN("guild:1"), Filter: `NOT settled_at:* AND (user = "rusty" OR market = "two")`, PageSize: 1},
+			expected:      []*api.Bet{bet1, bet2},
+			expectedCalls: 2,
+		},
+		{
+			desc:      "fails if filter has unknown field",
+			req:       &api.ListBetsRequest{Book: entity.BookN("guild:1"), Filter: "foo = 1"},
+			expectErr: true,
+		},
+		{
+			desc:          "order by total centipoints desc",
+			req:           &api.ListBetsRequest{Book: entity.BookN("guild:1"), OrderBy: "total_centipoints desc", PageSize: 1},
+			expected:      []*api.Bet{bet2, bet3, bet1},
+			expectedCalls: 3,
+		},
+		{
+			desc:          "order by settled time desc",
+			req:           &api.ListBetsRequest{Book: entity.BookN("guild:1"), OrderBy: "settled_time desc", PageSize: 2},
+			expected:      []*api.Bet{bet3, bet1, bet2},
+			expectedCalls: 2,
+		},
 	}
 	for _, tC := range testCases {
 		tC := tC
@@ -1332,6 +1636,52 @@ func TestListBets(t *testing.T) {
 	}
 }
 
+func TestCreateBetImpliedProbability(t *testing.T) {
+	user := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000}
+	yes, no, maybe := entity.OutcomeN("guild:1", "a", "0"), entity.OutcomeN("guild:1", "a", "1"), entity.OutcomeN("guild:1", "a", "2")
+	testCases := []struct {
+		desc     string
+		outcomes []*api.Outcome
+		expected float64
+	}{
+		{
+			desc:     "empty pool",
+			outcomes: []*api.Outcome{{Name: yes, Title: "Yes"}, {Name: no, Title: "No"}, {Name: maybe, Title: "Maybe"}},
+			expected: 1.0 / 3,
+		},
+		{
+			desc:     "excludes the bet's own stake",
+			outcomes: []*api.Outcome{{Name: yes, Title: "Yes", Centipoints: 100}, {Name: no, Title: "No", Centipoints: 300}},
+			expected: 0.25,
+		},
+		{
+			desc:     "outcome nothing has been bet on",
+			outcomes: []*api.Outcome{{Name: yes, Title: "Yes"}, {Name: no, Title: "No", Centipoints: 300}},
+			expected: 0,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			market := &api.Market{
+				Name:    entity.MarketN("guild:1", "a"),
+				Title:   "Will I PB?",
+				Creator: user.GetName(),
+				Status:  api.Market_STATUS_OPEN,
+				Type:    &api.Market_Pool{Pool: &api.Pool{Outcomes: tC.outcomes}},
+			}
+			s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{user}, Markets: []*api.Market{market}}))
+			require.Nil(t, err)
+			out, err := s.CreateBet(context.Background(), connect.NewRequest(&api.CreateBetRequest{
+				Book: entity.BookN("guild:1"),
+				Bet:  &api.Bet{User: user.GetName(), Market: market.GetName(), Centipoints: 200, Type: &api.Bet_Outcome{Outcome: yes}},
+			}))
+			require.Nil(t, err)
+			assert.InDelta(t, tC.expected, out.Msg.GetBet().GetImpliedProbability(), 1e-9)
+		})
+	}
+}
+
 func TestCreateBetConcurrency(t *testing.T) {
 	user := &api.User{
 		Name:        entity.UserN("guild:1", uuid.NewString()),
@@ -1379,6 +1729,113 @@ func TestCreateBetConcurrency(t *testing.T) {
 	assert.Equal(t, uint64(0), u.Msg.GetUser().GetCentipoints())
 }
 
+// racingRepo modifies a user right before the first batch that writes that user to simulate a concurrent write.
+type racingRepo struct {
+	*mem.Repo
+	raced bool
+}
+
+func (r *racingRepo) Write(ctx context.Context, batch *repo.Batch) error {
+	if !r.raced && len(batch.Users) > 0 {
+		r.raced = true
+		other, err := r.Repo.GetUser(ctx, batch.Users[0].GetName())
+		if err != nil {
+			return err
+		}
+		other.Centipoints += 100
+		if err := r.Repo.UpdateUser(ctx, other); err != nil {
+			return err
+		}
+	}
+	return r.Repo.Write(ctx, batch)
+}
+
+func TestCreateBetRetriesConcurrentUserUpdate(t *testing.T) {
+	user := &api.User{
+		Name:        entity.UserN("guild:1", uuid.NewString()),
+		Centipoints: 1000,
+		Username:    "rusty",
+	}
+	poolMarket := &api.Market{
+		Name:   entity.MarketN("guild:1", uuid.NewString()),
+		Status: api.Market_STATUS_OPEN,
+		Type: &api.Market_Pool{
+			Pool: &api.Pool{
+				Outcomes: []*api.Outcome{
+					{Name: uuid.NewString()},
+					{Name: uuid.NewString()},
+				},
+			},
+		},
+	}
+	r := &racingRepo{Repo: &mem.Repo{Users: []*api.User{user}, Markets: []*api.Market{poolMarket}}}
+	s, err := server.New(server.WithRepo(r))
+	require.Nil(t, err)
+	_, err = s.CreateBet(context.Background(), connect.NewRequest(&api.CreateBetRequest{
+		Book: entity.BookN("guild:1"),
+		Bet: &api.Bet{
+			User:        user.GetName(),
+			Market:      poolMarket.GetName(),
+			Centipoints: 10,
+			Type:        &api.Bet_Outcome{Outcome: poolMarket.GetPool().Outcomes[0].GetName()},
+		},
+	}))
+	require.Nil(t, err)
+	require.True(t, r.raced)
+
+	// neither the concurrent write nor the bet is lost
+	u, err := s.GetUser(context.Background(), connect.NewRequest(&api.GetUserRequest{Name: user.GetName()}))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1090), u.Msg.GetUser().GetCentipoints())
+	assert.Equal(t, uint64(10), u.Msg.GetUser().GetUnsettledCentipoints())
+}
+
+// failingWriteRepo fails every batch write.
+type failingWriteRepo struct {
+	*mem.Repo
+}
+
+func (r *failingWriteRepo) Write(_ context.Context, _ *repo.Batch) error {
+	return connect.NewError(connect.CodeInternal, errors.New("write failed"))
+}
+
+func TestCreateBetFailedWriteChangesNothing(t *testing.T) {
+	ctx := context.Background()
+	user := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Centipoints: 1000, Username: "rusty"}
+	poolMarket := &api.Market{
+		Name:   entity.MarketN("guild:1", uuid.NewString()),
+		Status: api.Market_STATUS_OPEN,
+		Type:   &api.Market_Pool{Pool: &api.Pool{Outcomes: []*api.Outcome{{Name: uuid.NewString()}, {Name: uuid.NewString()}}}},
+	}
+	r := &failingWriteRepo{Repo: &mem.Repo{Users: []*api.User{user}, Markets: []*api.Market{poolMarket}}}
+	s, err := server.New(server.WithRepo(r))
+	require.Nil(t, err)
+	_, err = s.CreateBet(ctx, connect.NewRequest(&api.CreateBetRequest{
+		Book: entity.BookN("guild:1"),
+		Bet: &api.Bet{
+			User:        user.GetName(),
+			Market:      poolMarket.GetName(),
+			Centipoints: 10,
+			Type:        &api.Bet_Outcome{Outcome: poolMarket.GetPool().Outcomes[0].GetName()},
+		},
+	}))
+	assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+
+	// the user is not debited and the pool, odds history, and bets are unchanged
+	u, err := r.GetUser(ctx, user.GetName())
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1000), u.GetCentipoints())
+	m, err := r.GetMarket(ctx, poolMarket.GetName())
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), m.GetPool().GetOutcomes()[0].GetCentipoints())
+	snapshots, _, err := r.ListOddsSnapshots(ctx, &repo.ListOddsSnapshotsArgs{Market: poolMarket.GetName(), Limit: 10})
+	require.Nil(t, err)
+	assert.Empty(t, snapshots)
+	bets, _, err := r.ListBets(ctx, &repo.ListBetsArgs{Book: entity.BookN("guild:1"), Limit: 10})
+	require.Nil(t, err)
+	assert.Empty(t, bets)
+}
+
 func TestCreateBetLockMarketConcurrency(t *testing.T) {
 	for i := 0; i < 50; i++ {
 		user := &api.User{
@@ -1438,3 +1895,306 @@ func TestCreateBetLockMarketConcurrency(t *testing.T) {
 		assert.Equal(t, uint64(1000), u.Msg.GetUser().GetCentipoints()+m.Msg.GetMarket().GetPool().GetOutcomes()[0].GetCentipoints())
 	}
 }
+
+// yieldingRepo yields to other goroutines before every write to shake out interleavings of read-modify-writes.
+type yieldingRepo struct {
+	repo.Repo
+}
+
+func (r *yieldingRepo) UpdateUser(ctx context.Context, user *api.User, events ...*api.DomainEvent) error {
+	runtime.Gosched()
+	return r.Repo.UpdateUser(ctx, user, events...)
+}
+
+func (r *yieldingRepo) UpdateMarket(ctx context.Context, market *api.Market, events ...*api.DomainEvent) error {
+	runtime.Gosched()
+	return r.Repo.UpdateMarket(ctx, market, events...)
+}
+
+func (r *yieldingRepo) UpdateBet(ctx context.Context, bet *api.Bet, events ...*api.DomainEvent) error {
+	runtime.Gosched()
+	return r.Repo.UpdateBet(ctx, bet, events...)
+}
+
+// TestMarketConcurrencyStress places bets across books, markets, and users while markets are concurrently locked and
+// canceled, then checks that no centipoints were lost. Run with -race.
+func TestMarketConcurrencyStress(t *testing.T) {
+	ctx := context.Background()
+	const (
+		books          = 2
+		usersPerBook   = 5
+		marketsPerBook = 4
+		workersPerUser = 4
+		betsPerWorker  = 5
+	)
+	r := &mem.Repo{}
+	var users []*api.User
+	var markets []*api.Market
+	for b := 0; b < books; b++ {
+		bookID := fmt.Sprintf("guild:%d", b)
+		for u := 0; u < usersPerBook; u++ {
+			user := &api.User{Name: entity.UserN(bookID, uuid.NewString()), Username: fmt.Sprintf("user%d", u), Centipoints: 1000}
+			require.Nil(t, r.CreateUser(ctx, user))
+			users = append(users, user)
+		}
+		for m := 0; m < marketsPerBook; m++ {
+			marketID := uuid.NewString()
+			market := &api.Market{
+				Name:   entity.MarketN(bookID, marketID),
+				Status: api.Market_STATUS_OPEN,
+				Type: &api.Market_Pool{
+					Pool: &api.Pool{
+						Outcomes: []*api.Outcome{
+							{Name: entity.OutcomeN(bookID, marketID, "0")},
+							{Name: entity.OutcomeN(bookID, marketID, "1")},
+						},
+					},
+				},
+			}
+			require.Nil(t, r.CreateMarket(ctx, market))
+			markets = append(markets, market)
+		}
+	}
+	s, err := server.New(server.WithRepo(&yieldingRepo{Repo: r}))
+	require.Nil(t, err)
+
+	// goroutines report errors here so failures are asserted on the test goroutine
+	errs := make(chan error, len(users)*workersPerUser*betsPerWorker+2*len(markets))
+	var wg sync.WaitGroup
+	for i, user := range users {
+		for w := 0; w < workersPerUser; w++ {
+			i, user := i, user
+			bookID, _ := entity.UserIDs(user.GetName())
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < betsPerWorker; j++ {
+					market := markets[(i/usersPerBook)*marketsPerBook+j%marketsPerBook]
+					_, err := s.CreateBet(ctx, connect.NewRequest(&api.CreateBetRequest{
+						Book: entity.BookN(bookID),
+						Bet: &api.Bet{
+							User:        user.GetName(),
+							Market:      market.GetName(),
+							Centipoints: 10,
+							Type:        &api.Bet_Outcome{Outcome: market.GetPool().GetOutcomes()[j%2].GetName()},
+						},
+					}))
+					errs <- err
+				}
+			}()
+		}
+	}
+	// markets are locked and then either canceled, settled, or left locked
+	for i, market := range markets {
+		i, market := i, market
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.LockMarket(ctx, connect.NewRequest(&api.LockMarketRequest{Name: market.GetName()}))
+			errs <- err
+			switch i % 3 {
+			case 0:
+				_, err := s.CancelMarket(ctx, connect.NewRequest(&api.CancelMarketRequest{Name: market.GetName()}))
+				errs <- err
+			case 1:
+				_, err := s.SettleMarket(ctx, connect.NewRequest(&api.SettleMarketRequest{
+					Name: market.GetName(),
+					Type: &api.SettleMarketRequest_Winner{Winner: market.GetPool().GetOutcomes()[0].GetName()},
+				}))
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			require.NotEqual(t, connect.CodeInternal, connect.CodeOf(err), err)
+		}
+	}
+
+	// pools match their bets and every user's points are either in their balance, in an unsettled bet, or were won or
+	// lost in a settled bet
+	for _, market := range markets {
+		bookID, _ := entity.MarketIDs(market.GetName())
+		m, err := s.GetMarket(ctx, connect.NewRequest(&api.GetMarketRequest{Name: market.GetName()}))
+		require.Nil(t, err)
+		var pool uint64
+		for _, outcome := range m.Msg.GetMarket().GetPool().GetOutcomes() {
+			pool += outcome.GetCentipoints()
+		}
+		bets, _, err := r.ListBets(ctx, &repo.ListBetsArgs{Book: entity.BookN(bookID), Market: market.GetName(), Limit: usersPerBook * workersPerUser * betsPerWorker})
+		require.Nil(t, err)
+		var bet uint64
+		for _, b := range bets {
+			bet += b.GetCentipoints()
+			assert.Equal(t, m.Msg.GetMarket().GetStatus() != api.Market_STATUS_BETS_LOCKED, b.GetSettledAt() != nil)
+		}
+		assert.Equal(t, bet, pool)
+	}
+	settledProfit := func(user *api.User) int64 {
+		bookID, _ := entity.UserIDs(user.GetName())
+		bets, _, err := r.ListBets(ctx, &repo.ListBetsArgs{Book: entity.BookN(bookID), User: user.GetName(), Limit: workersPerUser * betsPerWorker})
+		require.Nil(t, err)
+		var profit int64
+		for _, b := range bets {
+			if b.GetSettledAt() != nil {
+				profit += int64(b.GetSettledCentipoints()) - int64(b.GetCentipoints())
+			}
+		}
+		return profit
+	}
+	for _, user := range users {
+		u, err := s.GetUser(ctx, connect.NewRequest(&api.GetUserRequest{Name: user.GetName()}))
+		require.Nil(t, err)
+		assert.Equal(t, int64(1000)+settledProfit(user), int64(u.Msg.GetUser().GetCentipoints()+u.Msg.GetUser().GetUnsettledCentipoints()))
+	}
+
+	// canceling the locked markets refunds everything besides settled winnings and losses
+	for i, market := range markets {
+		if i%3 == 2 {
+			_, err := s.CancelMarket(ctx, connect.NewRequest(&api.CancelMarketRequest{Name: market.GetName()}))
+			require.Nil(t, err)
+		}
+	}
+	for _, user := range users {
+		u, err := s.GetUser(ctx, connect.NewRequest(&api.GetUserRequest{Name: user.GetName()}))
+		require.Nil(t, err)
+		assert.Equal(t, int64(1000)+settledProfit(user), int64(u.Msg.GetUser().GetCentipoints()))
+		assert.Equal(t, uint64(0), u.Msg.GetUser().GetUnsettledCentipoints())
+	}
+}
+
+func TestResettleMarketFailedWriteChangesNothing(t *testing.T) {
+	ctx := context.Background()
+	creator := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Centipoints: 1000, Username: "rusty"}
+	bettor := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Centipoints: 100, Username: "danny"}
+	market := &api.Market{
+		Name:      entity.MarketN("guild:1", uuid.NewString()),
+		Creator:   creator.GetName(),
+		Status:    api.Market_STATUS_SETTLED,
+		SettledAt: timestamppb.Now(),
+		Type: &api.Market_Pool{Pool: &api.Pool{
+			Outcomes: []*api.Outcome{{Name: "outcome-1", Centipoints: 100}, {Name: "outcome-2", Centipoints: 100}},
+			Winner:   "outcome-1",
+		}},
+	}
+	// the bettor cannot cover the clawback of their winnings
+	bet := &api.Bet{Name: entity.BetN("guild:1", uuid.NewString()), User: bettor.GetName(), Market: market.GetName(), Centipoints: 100, SettledCentipoints: 200, SettledAt: market.GetSettledAt(), Type: &api.Bet_Outcome{Outcome: "outcome-1"}}
+	other := &api.Bet{Name: entity.BetN("guild:1", uuid.NewString()), User: creator.GetName(), Market: market.GetName(), Centipoints: 100, SettledAt: market.GetSettledAt(), Type: &api.Bet_Outcome{Outcome: "outcome-2"}}
+	r := &failingWriteRepo{Repo: &mem.Repo{Users: []*api.User{creator, bettor}, Markets: []*api.Market{market}, Bets: []*api.Bet{bet, other}}}
+	s, err := server.New(server.WithRepo(r))
+	require.Nil(t, err)
+	_, err = s.ResettleMarket(ctx, connect.NewRequest(&api.ResettleMarketRequest{Name: market.GetName(), Winner: "outcome-2", Actor: creator.GetName()}))
+	assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+
+	gotMarket, err := r.GetMarket(ctx, market.GetName())
+	require.Nil(t, err)
+	assert.Equal(t, "outcome-1", gotMarket.GetPool().GetWinner())
+	assert.Empty(t, gotMarket.GetSettlements())
+	gotUser, err := r.GetUser(ctx, bettor.GetName())
+	require.Nil(t, err)
+	assert.Equal(t, uint64(100), gotUser.GetCentipoints())
+	assert.Zero(t, gotUser.GetDebtCentipoints())
+	gotBet, err := r.GetBet(ctx, bet.GetName())
+	require.Nil(t, err)
+	assert.Equal(t, uint64(200), gotBet.GetSettledCentipoints())
+	gotUser, err = r.GetUser(ctx, creator.GetName())
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1000), gotUser.GetCentipoints())
+}
+
+// failOnceWriteRepo fails the first batch write and applies later ones.
+type failOnceWriteRepo struct {
+	*mem.Repo
+	failed bool
+}
+
+func (r *failOnceWriteRepo) Write(ctx context.Context, batch *repo.Batch) error {
+	if !r.failed {
+		r.failed = true
+		return connect.NewError(connect.CodeInternal, errors.New("write failed"))
+	}
+	return r.Repo.Write(ctx, batch)
+}
+
+func TestSettleAndCancelMarketFailedWriteChangesNothing(t *testing.T) {
+	ctx := context.Background()
+	testCases := []struct {
+		desc                    string
+		close                   func(s *server.Server, market string) error
+		expectedStatus          api.Market_Status
+		expectedUserCentipoints []uint64
+	}{
+		{
+			desc: "settle",
+			close: func(s *server.Server, market string) error {
+				_, err := s.SettleMarket(ctx, connect.NewRequest(&api.SettleMarketRequest{Name: market, Type: &api.SettleMarketRequest_Winner{Winner: "outcome-1"}}))
+				return err
+			},
+			expectedStatus:          api.Market_STATUS_SETTLED,
+			expectedUserCentipoints: []uint64{1300, 1000, 900},
+		},
+		{
+			desc: "cancel",
+			close: func(s *server.Server, market string) error {
+				_, err := s.CancelMarket(ctx, connect.NewRequest(&api.CancelMarketRequest{Name: market}))
+				return err
+			},
+			expectedStatus:          api.Market_STATUS_CANCELED,
+			expectedUserCentipoints: []uint64{1100, 1100, 1000},
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			users := []*api.User{
+				{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000},
+				{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 1000},
+				{Name: entity.UserN("guild:1", "c"), Username: "linus", Centipoints: 900},
+			}
+			market := &api.Market{
+				Name:   entity.MarketN("guild:1", uuid.NewString()),
+				Status: api.Market_STATUS_BETS_LOCKED,
+				Type: &api.Market_Pool{Pool: &api.Pool{
+					Outcomes: []*api.Outcome{{Name: "outcome-1", Centipoints: 100}, {Name: "outcome-2", Centipoints: 200}},
+				}},
+			}
+			bets := []*api.Bet{
+				{Name: entity.BetN("guild:1", "a"), User: users[0].GetName(), Market: market.GetName(), Centipoints: 100, Type: &api.Bet_Outcome{Outcome: "outcome-1"}},
+				{Name: entity.BetN("guild:1", "b"), User: users[1].GetName(), Market: market.GetName(), Centipoints: 100, Type: &api.Bet_Outcome{Outcome: "outcome-2"}},
+				{Name: entity.BetN("guild:1", "c"), User: users[2].GetName(), Market: market.GetName(), Centipoints: 100, Type: &api.Bet_Outcome{Outcome: "outcome-2"}},
+			}
+			r := &failOnceWriteRepo{Repo: &mem.Repo{Users: users, Markets: []*api.Market{market}, Bets: bets}}
+			s, err := server.New(server.WithRepo(r))
+			require.Nil(t, err)
+
+			err = tC.close(s, market.GetName())
+			assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+			gotMarket, err := r.GetMarket(ctx, market.GetName())
+			require.Nil(t, err)
+			assert.Equal(t, api.Market_STATUS_BETS_LOCKED, gotMarket.GetStatus())
+			for i, user := range users {
+				got, err := r.GetUser(ctx, user.GetName())
+				require.Nil(t, err)
+				assert.Equal(t, []uint64{1000, 1000, 900}[i], got.GetCentipoints())
+			}
+			for _, bet := range bets {
+				got, err := r.GetBet(ctx, bet.GetName())
+				require.Nil(t, err)
+				assert.Nil(t, got.GetSettledAt())
+			}
+
+			// the market can be closed again after the failure
+			require.Nil(t, tC.close(s, market.GetName()))
+			gotMarket, err = r.GetMarket(ctx, market.GetName())
+			require.Nil(t, err)
+			assert.Equal(t, tC.expectedStatus, gotMarket.GetStatus())
+			for i, user := range users {
+				got, err := r.GetUser(ctx, user.GetName())
+				require.Nil(t, err)
+				assert.Equal(t, tC.expectedUserCentipoints[i], got.GetCentipoints())
+			}
+		})
+	}
+}
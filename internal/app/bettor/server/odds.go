@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/pagination"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListMarketOddsHistory lists snapshots of a market's pool, oldest first.
+func (s *Server) ListMarketOddsHistory(ctx context.Context, in *connect.Request[api.ListMarketOddsHistoryRequest]) (*connect.Response[api.ListMarketOddsHistoryResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := defaultPageSize
+	if in.Msg.GetPageSize() > 0 && in.Msg.GetPageSize() <= maxPageSize {
+		pageSize = int(in.Msg.GetPageSize())
+	}
+
+	afterRevision := in.Msg.GetAfterRevision()
+	if in.Msg.GetPageToken() != "" {
+		p, err := s.PageTokens.FromToken(in.Msg.GetPageToken(), api.StripListMarketOddsHistoryPagination(in.Msg))
+		if err != nil {
+			return nil, err
+		}
+		if afterRevision, err = strconv.ParseUint(p.Cursor, 10, 64); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	if _, err := s.Repo.GetMarket(ctx, in.Msg.GetMarket()); err != nil {
+		return nil, err
+	}
+	snapshots, hasMore, err := s.Repo.ListOddsSnapshots(ctx, &repo.ListOddsSnapshotsArgs{
+		Market:        in.Msg.GetMarket(),
+		AfterRevision: afterRevision,
+		Limit:         pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if hasMore {
+		nextPageToken, err = s.PageTokens.ToToken(pagination.Pagination{
+			Cursor:      strconv.FormatUint(snapshots[len(snapshots)-1].GetRevision(), 10),
+			ListRequest: api.StripListMarketOddsHistoryPagination(in.Msg),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&api.ListMarketOddsHistoryResponse{
+		Snapshots:     snapshots,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// oddsSnapshot returns a snapshot of a written pool market's pool.
+func oddsSnapshot(market *api.Market) *api.OddsSnapshot {
+	revision, _ := strconv.ParseUint(market.GetEtag(), 10, 64)
+	snapshot := &api.OddsSnapshot{
+		Market:    market.GetName(),
+		Revision:  revision,
+		CreatedAt: timestamppb.Now(),
+	}
+	for _, outcome := range market.GetPool().GetOutcomes() {
+		snapshot.TotalCentipoints += outcome.GetCentipoints()
+	}
+	for _, outcome := range market.GetPool().GetOutcomes() {
+		odds := &api.OutcomeOdds{Outcome: outcome.GetName(), Centipoints: outcome.GetCentipoints()}
+		if snapshot.GetTotalCentipoints() > 0 {
+			odds.ImpliedProbability = float64(outcome.GetCentipoints()) / float64(snapshot.GetTotalCentipoints())
+		}
+		snapshot.Outcomes = append(snapshot.Outcomes, odds)
+	}
+	return snapshot
+}
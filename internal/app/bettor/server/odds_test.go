@@ -0,0 +1,99 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestListMarketOddsHistory(t *testing.T) {
+	ctx := context.Background()
+	user := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "rusty", Centipoints: 1000}
+	marketName := entity.MarketN("guild:1", uuid.NewString())
+	yes, no := marketName+"/outcomes/1", marketName+"/outcomes/2"
+	market := &api.Market{
+		Name:   marketName,
+		Status: api.Market_STATUS_OPEN,
+		Type:   &api.Market_Pool{Pool: &api.Pool{Outcomes: []*api.Outcome{{Name: yes, Title: "Yes"}, {Name: no, Title: "No"}}}},
+	}
+	s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{user}, Markets: []*api.Market{market}}))
+	require.Nil(t, err)
+	for _, b := range []struct {
+		outcome     string
+		centipoints uint64
+	}{{yes, 100}, {no, 300}, {yes, 400}} {
+		_, err := s.CreateBet(ctx, connect.NewRequest(&api.CreateBetRequest{
+			Book: entity.BookN("guild:1"),
+			Bet:  &api.Bet{User: user.GetName(), Market: marketName, Centipoints: b.centipoints, Type: &api.Bet_Outcome{Outcome: b.outcome}},
+		}))
+		require.Nil(t, err)
+	}
+	expected := []*api.OddsSnapshot{
+		{Market: marketName, Revision: 1, TotalCentipoints: 100, Outcomes: []*api.OutcomeOdds{
+			{Outcome: yes, Centipoints: 100, ImpliedProbability: 1},
+			{Outcome: no},
+		}},
+		{Market: marketName, Revision: 2, TotalCentipoints: 400, Outcomes: []*api.OutcomeOdds{
+			{Outcome: yes, Centipoints: 100, ImpliedProbability: 0.25},
+			{Outcome: no, Centipoints: 300, ImpliedProbability: 0.75},
+		}},
+		{Market: marketName, Revision: 3, TotalCentipoints: 800, Outcomes: []*api.OutcomeOdds{
+			{Outcome: yes, Centipoints: 500, ImpliedProbability: 0.625},
+			{Outcome: no, Centipoints: 300, ImpliedProbability: 0.375},
+		}},
+	}
+	assertSnapshots := func(t *testing.T, expected, got []*api.OddsSnapshot) {
+		require.Len(t, got, len(expected))
+		for i := range expected {
+			assert.NotNil(t, got[i].GetCreatedAt())
+			got[i].CreatedAt = nil
+			assert.True(t, proto.Equal(expected[i], got[i]), "expected %v, got %v", expected[i], got[i])
+		}
+	}
+
+	t.Run("paginates oldest first", func(t *testing.T) {
+		out, err := s.ListMarketOddsHistory(ctx, connect.NewRequest(&api.ListMarketOddsHistoryRequest{Market: marketName, PageSize: 2}))
+		require.Nil(t, err)
+		assertSnapshots(t, expected[:2], out.Msg.GetSnapshots())
+		require.NotEmpty(t, out.Msg.GetNextPageToken())
+
+		out, err = s.ListMarketOddsHistory(ctx, connect.NewRequest(&api.ListMarketOddsHistoryRequest{Market: marketName, PageSize: 2, PageToken: out.Msg.GetNextPageToken()}))
+		require.Nil(t, err)
+		assertSnapshots(t, expected[2:], out.Msg.GetSnapshots())
+		assert.Empty(t, out.Msg.GetNextPageToken())
+	})
+	t.Run("lists after a revision", func(t *testing.T) {
+		out, err := s.ListMarketOddsHistory(ctx, connect.NewRequest(&api.ListMarketOddsHistoryRequest{Market: marketName, AfterRevision: 1, PageSize: 1}))
+		require.Nil(t, err)
+		assertSnapshots(t, expected[1:2], out.Msg.GetSnapshots())
+		require.NotEmpty(t, out.Msg.GetNextPageToken())
+
+		out, err = s.ListMarketOddsHistory(ctx, connect.NewRequest(&api.ListMarketOddsHistoryRequest{Market: marketName, AfterRevision: 1, PageSize: 1, PageToken: out.Msg.GetNextPageToken()}))
+		require.Nil(t, err)
+		assertSnapshots(t, expected[2:], out.Msg.GetSnapshots())
+		assert.Empty(t, out.Msg.GetNextPageToken())
+	})
+	t.Run("fails if page token is for another market", func(t *testing.T) {
+		out, err := s.ListMarketOddsHistory(ctx, connect.NewRequest(&api.ListMarketOddsHistoryRequest{Market: marketName, PageSize: 2}))
+		require.Nil(t, err)
+		_, err = s.ListMarketOddsHistory(ctx, connect.NewRequest(&api.ListMarketOddsHistoryRequest{Market: entity.MarketN("guild:1", uuid.NewString()), PageToken: out.Msg.GetNextPageToken()}))
+		require.NotNil(t, err)
+	})
+	t.Run("fails if market does not exist", func(t *testing.T) {
+		_, err := s.ListMarketOddsHistory(ctx, connect.NewRequest(&api.ListMarketOddsHistoryRequest{Market: entity.MarketN("guild:1", uuid.NewString())}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+	t.Run("fails if market is empty", func(t *testing.T) {
+		_, err := s.ListMarketOddsHistory(ctx, connect.NewRequest(&api.ListMarketOddsHistoryRequest{}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
@@ -0,0 +1,340 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/pagination"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StartSeason ends a book's current season and starts a new one. Unsettled markets are canceled first if the open bet
+// policy is to refund them. The final standings of the ended season are archived and every user's balance is reset so
+// that their total points, including points in carried unsettled bets, are the starting balance. Carried markets and
+// their bets are moved to the new season. The seasons, the resets, and the carried markets are written together, and a
+// failed request can be retried since canceled markets are not canceled again.
+func (s *Server) StartSeason(ctx context.Context, in *connect.Request[api.StartSeasonRequest]) (*connect.Response[api.StartSeasonResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	bookID := entity.BooksIDs(in.Msg.GetBook())
+	if actorBookID, _ := entity.UserIDs(in.Msg.GetActor()); bookID != actorBookID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("actor must be a member of the book"))
+	}
+
+	// serialize with market creation so that new markets are tagged with the new season
+	defer s.locks.Lock(entity.BookN(bookID))()
+
+	if _, err := s.Repo.GetUser(ctx, in.Msg.GetActor()); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeAdmin(ctx, bookID, in.Msg.GetActor()); err != nil {
+		return nil, err
+	}
+
+	current, err := s.currentSeason(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if number := in.Msg.GetNumber(); number != 0 {
+		if number == current.GetNumber() && current.GetStartTime() != nil {
+			// the season was already started by an earlier attempt of this request
+			ended, err := s.Repo.GetSeason(ctx, entity.SeasonN(bookID, strconv.FormatUint(uint64(number-1), 10)))
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&api.StartSeasonResponse{
+				EndedSeason: ended,
+				Season:      current,
+			}), nil
+		}
+		if number != current.GetNumber()+1 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("season %d does not follow the current season %d", number, current.GetNumber()))
+		}
+	}
+
+	if in.Msg.GetOpenBetPolicy() == api.Season_OPEN_BET_POLICY_REFUND {
+		for _, status := range []api.Market_Status{api.Market_STATUS_OPEN, api.Market_STATUS_BETS_LOCKED} {
+			markets, err := s.listMarkets(ctx, &repo.ListMarketsArgs{Book: in.Msg.GetBook(), Status: status})
+			if err != nil {
+				return nil, err
+			}
+			for _, market := range markets {
+				if _, err := s.CancelMarket(ctx, connect.NewRequest(&api.CancelMarketRequest{Name: market.GetName()})); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	// markets that are still unsettled are carried into the new season so they can be bet on, settled, and resettled
+	// like the season's own markets
+	var carriedNames []string
+	for _, status := range []api.Market_Status{api.Market_STATUS_OPEN, api.Market_STATUS_BETS_LOCKED} {
+		markets, err := s.listMarkets(ctx, &repo.ListMarketsArgs{Book: in.Msg.GetBook(), Status: status})
+		if err != nil {
+			return nil, err
+		}
+		for _, market := range markets {
+			carriedNames = append(carriedNames, market.GetName())
+		}
+	}
+	defer s.locks.Lock(carriedNames...)() // markets sort after their book
+
+	users, err := s.listUsers(ctx, &repo.ListUsersArgs{Book: in.Msg.GetBook()})
+	if err != nil {
+		return nil, err
+	}
+	userNames := make([]string, 0, len(users))
+	for _, user := range users {
+		userNames = append(userNames, user.GetName())
+	}
+	defer s.locks.Lock(userNames...)() // users sort after their markets
+
+	now := timestamppb.Now()
+	var ended, season *api.Season
+	err = s.write(ctx, func() (*repo.Batch, error) {
+		users, err := s.listUsers(ctx, &repo.ListUsersArgs{Book: in.Msg.GetBook()})
+		if err != nil {
+			return nil, err
+		}
+		ended = proto.Clone(current).(*api.Season)
+		ended.EndTime = now
+		ended.Standings = standings(users)
+		season = &api.Season{
+			Name:                entity.SeasonN(bookID, strconv.FormatUint(uint64(current.GetNumber()+1), 10)),
+			Number:              current.GetNumber() + 1,
+			StartTime:           now,
+			StartingCentipoints: in.Msg.GetStartingCentipoints(),
+			OpenBetPolicy:       in.Msg.GetOpenBetPolicy(),
+		}
+		batch := &repo.Batch{Users: users, Seasons: []*api.Season{ended, season}}
+		carried, err := s.Repo.GetMarkets(ctx, carriedNames)
+		if err != nil {
+			return nil, err
+		}
+		for _, market := range carried {
+			if market.GetStatus() != api.Market_STATUS_OPEN && market.GetStatus() != api.Market_STATUS_BETS_LOCKED {
+				continue // settled or canceled before it was locked
+			}
+			market.Season = season.GetName()
+			market.UpdatedAt = now
+			batch.Markets = append(batch.Markets, market)
+			bets, err := s.listMarketBets(ctx, market.GetName())
+			if err != nil {
+				return nil, err
+			}
+			for _, bet := range bets {
+				bet.Season = season.GetName()
+				bet.UpdatedAt = now
+				batch.Bets = append(batch.Bets, bet)
+			}
+		}
+		for _, user := range users {
+			centipoints := uint64(0)
+			if user.GetUnsettledCentipoints() < season.GetStartingCentipoints() {
+				centipoints = season.GetStartingCentipoints() - user.GetUnsettledCentipoints()
+			}
+			// debts are forgiven with the rest of the old season's balance
+			balanceDelta, debtDelta := int64(centipoints)-int64(user.GetCentipoints()), -int64(user.GetDebtCentipoints())
+			delta := balanceDelta - debtDelta
+			user.Centipoints = centipoints
+			user.DebtCentipoints = 0
+			user.UpdatedAt = now
+			if delta != 0 {
+				season.Resets = append(season.Resets, &api.SeasonReset{User: user.GetName(), DeltaCentipoints: delta})
+			}
+			batch.Events = append(batch.Events, userSeasonReset(user, balanceDelta, debtDelta, season.GetName()))
+		}
+		return batch, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.StartSeasonResponse{
+		EndedSeason: ended,
+		Season:      season,
+	}), nil
+}
+
+// GetSeason returns a season by name.
+func (s *Server) GetSeason(ctx context.Context, in *connect.Request[api.GetSeasonRequest]) (*connect.Response[api.GetSeasonResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	season, err := s.Repo.GetSeason(ctx, in.Msg.GetName())
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		// a book's first season has no record until it ends
+		bookID, _ := entity.SeasonIDs(in.Msg.GetName())
+		current, currentErr := s.currentSeason(ctx, bookID)
+		if currentErr != nil {
+			return nil, currentErr
+		}
+		if current.GetName() != in.Msg.GetName() {
+			return nil, err
+		}
+		season, err = current, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetSeasonResponse{
+		Season: season,
+	}), nil
+}
+
+// ListSeasons lists a book's seasons in number order.
+func (s *Server) ListSeasons(ctx context.Context, in *connect.Request[api.ListSeasonsRequest]) (*connect.Response[api.ListSeasonsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := defaultPageSize
+	if in.Msg.GetPageSize() > 0 && in.Msg.GetPageSize() <= maxPageSize {
+		pageSize = int(in.Msg.GetPageSize())
+	}
+
+	var afterNumber uint32
+	if in.Msg.GetPageToken() != "" {
+		p, err := s.PageTokens.FromToken(in.Msg.GetPageToken(), api.StripListSeasonsPagination(in.Msg))
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.ParseUint(p.Cursor, 10, 32)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		afterNumber = uint32(n)
+	}
+
+	seasons, hasMore, err := s.Repo.ListSeasons(ctx, &repo.ListSeasonsArgs{
+		Book:        in.Msg.GetBook(),
+		AfterNumber: afterNumber,
+		Limit:       pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(seasons) == 0 && afterNumber == 0 {
+		seasons = []*api.Season{firstSeason(entity.BooksIDs(in.Msg.GetBook()))}
+	}
+
+	var nextPageToken string
+	if hasMore {
+		nextPageToken, err = s.PageTokens.ToToken(pagination.Pagination{
+			Cursor:      strconv.FormatUint(uint64(seasons[len(seasons)-1].GetNumber()), 10),
+			ListRequest: api.StripListSeasonsPagination(in.Msg),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&api.ListSeasonsResponse{
+		Seasons:       seasons,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// firstSeason returns the season a book starts in. It has no record until it ends.
+func firstSeason(bookID string) *api.Season {
+	return &api.Season{Name: entity.SeasonN(bookID, "1"), Number: 1}
+}
+
+// currentSeason returns a book's current season, which is the season with the highest number.
+func (s *Server) currentSeason(ctx context.Context, bookID string) (*api.Season, error) {
+	seasons, err := s.listSeasons(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if len(seasons) == 0 {
+		return firstSeason(bookID), nil
+	}
+	return seasons[len(seasons)-1], nil
+}
+
+// listSeasons lists all of a book's recorded seasons in number order.
+func (s *Server) listSeasons(ctx context.Context, bookID string) ([]*api.Season, error) {
+	args := &repo.ListSeasonsArgs{Book: entity.BookN(bookID), Limit: 100}
+	var seasons []*api.Season
+	for {
+		ss, hasMore, err := s.Repo.ListSeasons(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		seasons = append(seasons, ss...)
+		if !hasMore {
+			return seasons, nil
+		}
+		args.AfterNumber = ss[len(ss)-1].GetNumber()
+	}
+}
+
+// standings ranks users by total points. Users with equal totals share a rank.
+func standings(users []*api.User) []*api.Standing {
+	out := make([]*api.Standing, 0, len(users))
+	for _, user := range users {
+		out = append(out, &api.Standing{
+			User:             user.GetName(),
+			Username:         user.GetUsername(),
+			TotalCentipoints: user.GetCentipoints() + user.GetUnsettledCentipoints(),
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].GetTotalCentipoints() != out[j].GetTotalCentipoints() {
+			return out[i].GetTotalCentipoints() > out[j].GetTotalCentipoints()
+		}
+		return out[i].GetUser() < out[j].GetUser()
+	})
+	for i, standing := range out {
+		standing.Rank = uint32(i + 1)
+		if i > 0 && standing.GetTotalCentipoints() == out[i-1].GetTotalCentipoints() {
+			standing.Rank = out[i-1].GetRank()
+		}
+	}
+	return out
+}
+
+// listUsers lists all users matching args.
+func (s *Server) listUsers(ctx context.Context, args *repo.ListUsersArgs) ([]*api.User, error) {
+	args.Limit = 100
+	var users []*api.User
+	for {
+		us, hasMore, err := s.Repo.ListUsers(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, us...)
+		if !hasMore {
+			return users, nil
+		}
+		args.GreaterThanName = us[len(us)-1].GetName()
+	}
+}
+
+// listMarkets lists all markets matching args.
+func (s *Server) listMarkets(ctx context.Context, args *repo.ListMarketsArgs) ([]*api.Market, error) {
+	args.Limit = 100
+	var markets []*api.Market
+	for {
+		ms, hasMore, err := s.Repo.ListMarkets(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		markets = append(markets, ms...)
+		if !hasMore {
+			return markets, nil
+		}
+		args.GreaterThanName = ms[len(ms)-1].GetName()
+	}
+}
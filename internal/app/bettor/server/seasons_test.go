@@ -0,0 +1,362 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStartSeason(t *testing.T) {
+	testCases := []struct {
+		desc                    string
+		policy                  api.Season_OpenBetPolicy
+		expectedMarketStatus    api.Market_Status
+		expectedUserCentipoints []uint64
+		expectedUserUnsettled   []uint64
+		expectedResets          []int64
+	}{
+		{
+			desc:                    "refunds open bets",
+			policy:                  api.Season_OPEN_BET_POLICY_REFUND,
+			expectedMarketStatus:    api.Market_STATUS_CANCELED,
+			expectedUserCentipoints: []uint64{300, 300, 300, 300},
+			expectedUserUnsettled:   []uint64{0, 0, 0, 0},
+			expectedResets:          []int64{-700, -200, -200, 400},
+		},
+		{
+			desc:                    "carries open bets",
+			policy:                  api.Season_OPEN_BET_POLICY_CARRY,
+			expectedMarketStatus:    api.Market_STATUS_OPEN,
+			expectedUserCentipoints: []uint64{0, 100, 300, 300},
+			expectedUserUnsettled:   []uint64{400, 200, 0, 0},
+			expectedResets:          []int64{-600, -200, -200, 400},
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			ctx := context.Background()
+			users := []*api.User{
+				{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000},
+				{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 500},
+				{Name: entity.UserN("guild:1", "c"), Username: "linus", Centipoints: 500},
+				{Name: entity.UserN("guild:1", "d"), Username: "turk", DebtCentipoints: 100}, // debts are forgiven
+			}
+			settings := &api.BookSettings{Name: entity.BookSettingsN("guild:1"), Admins: []string{users[0].GetName()}}
+			s, err := server.New(server.WithRepo(&mem.Repo{Users: users, Settings: []*api.BookSettings{settings}}))
+			require.Nil(t, err)
+
+			marketResp, err := s.CreateMarket(ctx, connect.NewRequest(&api.CreateMarketRequest{
+				Book: entity.BookN("guild:1"),
+				Market: &api.Market{
+					Title:   "Will I PB?",
+					Creator: users[0].GetName(),
+					Type:    &api.Market_Pool{Pool: &api.Pool{Outcomes: []*api.Outcome{{Title: "Yes"}, {Title: "No"}}}},
+				},
+			}))
+			require.Nil(t, err)
+			market := marketResp.Msg.GetMarket()
+			assert.Equal(t, entity.SeasonN("guild:1", "1"), market.GetSeason())
+			for i, centipoints := range []uint64{400, 200} {
+				betResp, err := s.CreateBet(ctx, connect.NewRequest(&api.CreateBetRequest{
+					Book: entity.BookN("guild:1"),
+					Bet: &api.Bet{
+						User:        users[i].GetName(),
+						Market:      market.GetName(),
+						Centipoints: centipoints,
+						Type:        &api.Bet_Outcome{Outcome: market.GetPool().GetOutcomes()[i].GetName()},
+					},
+				}))
+				require.Nil(t, err)
+				assert.Equal(t, market.GetSeason(), betResp.Msg.GetBet().GetSeason())
+			}
+
+			out, err := s.StartSeason(ctx, connect.NewRequest(&api.StartSeasonRequest{
+				Book:                entity.BookN("guild:1"),
+				StartingCentipoints: 300,
+				OpenBetPolicy:       tC.policy,
+				Actor:               users[0].GetName(),
+			}))
+			require.Nil(t, err)
+			ended, season := out.Msg.GetEndedSeason(), out.Msg.GetSeason()
+			assert.Equal(t, uint32(1), ended.GetNumber())
+			assert.NotNil(t, ended.GetEndTime())
+			assert.Equal(t, entity.SeasonN("guild:1", "2"), season.GetName())
+			assert.Equal(t, uint32(2), season.GetNumber())
+			assert.Nil(t, season.GetEndTime())
+			require.Len(t, season.GetResets(), len(users))
+			for i, reset := range season.GetResets() {
+				assert.Equal(t, users[i].GetName(), reset.GetUser())
+				assert.Equal(t, tC.expectedResets[i], reset.GetDeltaCentipoints())
+			}
+
+			// standings include unsettled bets and ties share a rank
+			require.Len(t, ended.GetStandings(), 4)
+			for i, expected := range []struct {
+				user  string
+				total uint64
+				rank  uint32
+			}{
+				{users[0].GetName(), 1000, 1},
+				{users[1].GetName(), 500, 2},
+				{users[2].GetName(), 500, 2},
+				{users[3].GetName(), 0, 4},
+			} {
+				assert.Equal(t, expected.user, ended.GetStandings()[i].GetUser())
+				assert.Equal(t, expected.total, ended.GetStandings()[i].GetTotalCentipoints())
+				assert.Equal(t, expected.rank, ended.GetStandings()[i].GetRank())
+			}
+
+			gotMarket, err := s.GetMarket(ctx, connect.NewRequest(&api.GetMarketRequest{Name: market.GetName()}))
+			require.Nil(t, err)
+			assert.Equal(t, tC.expectedMarketStatus, gotMarket.Msg.GetMarket().GetStatus())
+			for i, user := range users {
+				got, err := s.GetUser(ctx, connect.NewRequest(&api.GetUserRequest{Name: user.GetName()}))
+				require.Nil(t, err)
+				assert.Equal(t, tC.expectedUserCentipoints[i], got.Msg.GetUser().GetCentipoints())
+				assert.Equal(t, tC.expectedUserUnsettled[i], got.Msg.GetUser().GetUnsettledCentipoints())
+				assert.Zero(t, got.Msg.GetUser().GetDebtCentipoints())
+			}
+
+			// the ended season is archived and new markets are tagged with the new season
+			gotSeason, err := s.GetSeason(ctx, connect.NewRequest(&api.GetSeasonRequest{Name: ended.GetName()}))
+			require.Nil(t, err)
+			assert.Len(t, gotSeason.Msg.GetSeason().GetStandings(), len(users))
+			marketResp, err = s.CreateMarket(ctx, connect.NewRequest(&api.CreateMarketRequest{
+				Book: entity.BookN("guild:1"),
+				Market: &api.Market{
+					Title:   "Will I PB again?",
+					Creator: users[0].GetName(),
+					Type:    &api.Market_Pool{Pool: &api.Pool{Outcomes: []*api.Outcome{{Title: "Yes"}, {Title: "No"}}}},
+				},
+			}))
+			require.Nil(t, err)
+			assert.Equal(t, season.GetName(), marketResp.Msg.GetMarket().GetSeason())
+		})
+	}
+}
+
+func TestStartSeasonCarriedMarket(t *testing.T) {
+	ctx := context.Background()
+	users := []*api.User{
+		{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000},
+		{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 500},
+		{Name: entity.UserN("guild:1", "c"), Username: "linus", Centipoints: 500},
+	}
+	settings := &api.BookSettings{Name: entity.BookSettingsN("guild:1"), Admins: []string{users[0].GetName()}}
+	s, err := server.New(server.WithRepo(&mem.Repo{Users: users, Settings: []*api.BookSettings{settings}}))
+	require.Nil(t, err)
+	marketResp, err := s.CreateMarket(ctx, connect.NewRequest(&api.CreateMarketRequest{
+		Book: entity.BookN("guild:1"),
+		Market: &api.Market{
+			Title:   "Will I PB?",
+			Creator: users[0].GetName(),
+			Type:    &api.Market_Pool{Pool: &api.Pool{Outcomes: []*api.Outcome{{Title: "Yes"}, {Title: "No"}}}},
+		},
+	}))
+	require.Nil(t, err)
+	market := marketResp.Msg.GetMarket()
+	yes, no := market.GetPool().GetOutcomes()[0].GetName(), market.GetPool().GetOutcomes()[1].GetName()
+	placeBet := func(user *api.User, centipoints uint64, outcome string) *api.Bet {
+		betResp, err := s.CreateBet(ctx, connect.NewRequest(&api.CreateBetRequest{
+			Book: entity.BookN("guild:1"),
+			Bet:  &api.Bet{User: user.GetName(), Market: market.GetName(), Centipoints: centipoints, Type: &api.Bet_Outcome{Outcome: outcome}},
+		}))
+		require.Nil(t, err)
+		return betResp.Msg.GetBet()
+	}
+	placeBet(users[0], 400, yes)
+	placeBet(users[1], 200, no)
+
+	out, err := s.StartSeason(ctx, connect.NewRequest(&api.StartSeasonRequest{
+		Book:                entity.BookN("guild:1"),
+		StartingCentipoints: 300,
+		OpenBetPolicy:       api.Season_OPEN_BET_POLICY_CARRY,
+		Actor:               users[0].GetName(),
+	}))
+	require.Nil(t, err)
+	season := out.Msg.GetSeason()
+
+	// the carried market and its bets are moved to the new season
+	gotMarket, err := s.GetMarket(ctx, connect.NewRequest(&api.GetMarketRequest{Name: market.GetName()}))
+	require.Nil(t, err)
+	assert.Equal(t, api.Market_STATUS_OPEN, gotMarket.Msg.GetMarket().GetStatus())
+	assert.Equal(t, season.GetName(), gotMarket.Msg.GetMarket().GetSeason())
+	bet := placeBet(users[2], 200, yes)
+	assert.Equal(t, season.GetName(), bet.GetSeason())
+	bets, err := s.ListBets(ctx, connect.NewRequest(&api.ListBetsRequest{Book: entity.BookN("guild:1"), Market: market.GetName()}))
+	require.Nil(t, err)
+	require.Len(t, bets.Msg.GetBets(), 3)
+	for _, b := range bets.Msg.GetBets() {
+		assert.Equal(t, season.GetName(), b.GetSeason())
+	}
+
+	assertCentipoints := func(expected ...uint64) {
+		t.Helper()
+		for i, user := range users {
+			got, err := s.GetUser(ctx, connect.NewRequest(&api.GetUserRequest{Name: user.GetName()}))
+			require.Nil(t, err)
+			assert.Equal(t, expected[i], got.Msg.GetUser().GetCentipoints(), user.GetName())
+		}
+	}
+	assertCentipoints(0, 100, 100)
+
+	_, err = s.LockMarket(ctx, connect.NewRequest(&api.LockMarketRequest{Name: market.GetName()}))
+	require.Nil(t, err)
+	_, err = s.SettleMarket(ctx, connect.NewRequest(&api.SettleMarketRequest{Name: market.GetName(), Type: &api.SettleMarketRequest_Winner{Winner: no}}))
+	require.Nil(t, err)
+	assertCentipoints(0, 900, 100)
+
+	_, err = s.ResettleMarket(ctx, connect.NewRequest(&api.ResettleMarketRequest{Name: market.GetName(), Winner: yes, Actor: users[0].GetName()}))
+	require.Nil(t, err)
+	assertCentipoints(533, 100, 366)
+}
+
+func TestStartSeasonValidation(t *testing.T) {
+	admin := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty"}
+	member := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny"}
+	settings := &api.BookSettings{Name: entity.BookSettingsN("guild:1"), Admins: []string{admin.GetName()}}
+	s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{admin, member}, Settings: []*api.BookSettings{settings}}))
+	require.Nil(t, err)
+	testCases := []struct {
+		desc     string
+		in       *api.StartSeasonRequest
+		expected connect.Code
+	}{
+		{
+			desc:     "fails if book is empty",
+			in:       &api.StartSeasonRequest{StartingCentipoints: 100, OpenBetPolicy: api.Season_OPEN_BET_POLICY_REFUND, Actor: admin.GetName()},
+			expected: connect.CodeInvalidArgument,
+		},
+		{
+			desc:     "fails if starting centipoints is 0",
+			in:       &api.StartSeasonRequest{Book: entity.BookN("guild:1"), OpenBetPolicy: api.Season_OPEN_BET_POLICY_REFUND, Actor: admin.GetName()},
+			expected: connect.CodeInvalidArgument,
+		},
+		{
+			desc:     "fails if open bet policy is unspecified",
+			in:       &api.StartSeasonRequest{Book: entity.BookN("guild:1"), StartingCentipoints: 100, Actor: admin.GetName()},
+			expected: connect.CodeInvalidArgument,
+		},
+		{
+			desc:     "fails if actor is empty",
+			in:       &api.StartSeasonRequest{Book: entity.BookN("guild:1"), StartingCentipoints: 100, OpenBetPolicy: api.Season_OPEN_BET_POLICY_REFUND},
+			expected: connect.CodeInvalidArgument,
+		},
+		{
+			desc:     "fails if actor is in another book",
+			in:       &api.StartSeasonRequest{Book: entity.BookN("guild:1"), StartingCentipoints: 100, OpenBetPolicy: api.Season_OPEN_BET_POLICY_REFUND, Actor: entity.UserN("guild:2", "a")},
+			expected: connect.CodeInvalidArgument,
+		},
+		{
+			desc:     "fails if actor is not an admin",
+			in:       &api.StartSeasonRequest{Book: entity.BookN("guild:1"), StartingCentipoints: 100, OpenBetPolicy: api.Season_OPEN_BET_POLICY_REFUND, Actor: member.GetName()},
+			expected: connect.CodePermissionDenied,
+		},
+		{
+			desc:     "fails if number does not follow the current season",
+			in:       &api.StartSeasonRequest{Book: entity.BookN("guild:1"), StartingCentipoints: 100, OpenBetPolicy: api.Season_OPEN_BET_POLICY_REFUND, Actor: admin.GetName(), Number: 3},
+			expected: connect.CodeFailedPrecondition,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			_, err := s.StartSeason(context.Background(), connect.NewRequest(tC.in))
+			assert.Equal(t, tC.expected, connect.CodeOf(err))
+		})
+	}
+}
+
+func TestStartSeasonRetry(t *testing.T) {
+	ctx := context.Background()
+	admin := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000}
+	settings := &api.BookSettings{Name: entity.BookSettingsN("guild:1"), Admins: []string{admin.GetName()}}
+	r := &mem.Repo{Users: []*api.User{admin}, Settings: []*api.BookSettings{settings}}
+	s, err := server.New(server.WithRepo(r))
+	require.Nil(t, err)
+	in := &api.StartSeasonRequest{
+		Book:                entity.BookN("guild:1"),
+		StartingCentipoints: 100,
+		OpenBetPolicy:       api.Season_OPEN_BET_POLICY_CARRY,
+		Actor:               admin.GetName(),
+		Number:              2,
+	}
+	first, err := s.StartSeason(ctx, connect.NewRequest(in))
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), first.Msg.GetSeason().GetNumber())
+
+	// a retry returns the started season instead of starting season 3
+	retry, err := s.StartSeason(ctx, connect.NewRequest(in))
+	require.Nil(t, err)
+	assert.True(t, proto.Equal(first.Msg.GetSeason(), retry.Msg.GetSeason()))
+	assert.True(t, proto.Equal(first.Msg.GetEndedSeason(), retry.Msg.GetEndedSeason()))
+	seasons, _, err := r.ListSeasons(ctx, &repo.ListSeasonsArgs{Book: entity.BookN("guild:1"), Limit: 10})
+	require.Nil(t, err)
+	assert.Len(t, seasons, 2)
+
+	in.Number = 3
+	out, err := s.StartSeason(ctx, connect.NewRequest(in))
+	require.Nil(t, err)
+	assert.Equal(t, uint32(3), out.Msg.GetSeason().GetNumber())
+}
+
+func TestListSeasons(t *testing.T) {
+	ctx := context.Background()
+	admin := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty"}
+	settings := &api.BookSettings{Name: entity.BookSettingsN("guild:1"), Admins: []string{admin.GetName()}}
+	s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{admin}, Settings: []*api.BookSettings{settings}}))
+	require.Nil(t, err)
+
+	// books start in season 1
+	out, err := s.ListSeasons(ctx, connect.NewRequest(&api.ListSeasonsRequest{Book: entity.BookN("guild:1")}))
+	require.Nil(t, err)
+	require.Len(t, out.Msg.GetSeasons(), 1)
+	assert.Equal(t, uint32(1), out.Msg.GetSeasons()[0].GetNumber())
+	got, err := s.GetSeason(ctx, connect.NewRequest(&api.GetSeasonRequest{Name: entity.SeasonN("guild:1", "1")}))
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1), got.Msg.GetSeason().GetNumber())
+	_, err = s.GetSeason(ctx, connect.NewRequest(&api.GetSeasonRequest{Name: entity.SeasonN("guild:1", "2")}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	for i := 0; i < 11; i++ {
+		_, err := s.StartSeason(ctx, connect.NewRequest(&api.StartSeasonRequest{
+			Book:                entity.BookN("guild:1"),
+			StartingCentipoints: 100,
+			OpenBetPolicy:       api.Season_OPEN_BET_POLICY_CARRY,
+			Actor:               admin.GetName(),
+		}))
+		require.Nil(t, err)
+	}
+
+	var numbers []uint32
+	var pageToken string
+	for {
+		out, err := s.ListSeasons(ctx, connect.NewRequest(&api.ListSeasonsRequest{Book: entity.BookN("guild:1"), PageSize: 5, PageToken: pageToken}))
+		require.Nil(t, err)
+		for _, season := range out.Msg.GetSeasons() {
+			numbers = append(numbers, season.GetNumber())
+		}
+		if out.Msg.GetNextPageToken() == "" {
+			break
+		}
+		pageToken = out.Msg.GetNextPageToken()
+	}
+	assert.Equal(t, []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, numbers)
+
+	// other books are unaffected
+	out, err = s.ListSeasons(ctx, connect.NewRequest(&api.ListSeasonsRequest{Book: entity.BookN(uuid.NewString())}))
+	require.Nil(t, err)
+	require.Len(t, out.Msg.GetSeasons(), 1)
+	assert.Equal(t, uint32(1), out.Msg.GetSeasons()[0].GetNumber())
+}
@@ -1,11 +1,13 @@
 package server
 
 import (
+	"crypto/rand"
 	"fmt"
-	"sync"
 
 	"github.com/elh/bettor/api/bettor/v1alpha/bettorv1alphaconnect"
 	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/pubsub"
+	"github.com/elh/bettor/internal/pkg/pagination"
 	"github.com/go-kit/log"
 )
 
@@ -14,9 +16,11 @@ var _ bettorv1alphaconnect.BettorServiceHandler = (*Server)(nil)
 // Server is an implementation of the Bettor service.
 type Server struct {
 	bettorv1alphaconnect.UnimplementedBettorServiceHandler
-	Repo      repo.Repo
-	Logger    log.Logger
-	marketMtx sync.RWMutex // this is conservative and inelegant
+	Repo       repo.Repo
+	Logger     log.Logger
+	PageTokens *pagination.Codec
+	Hub        *pubsub.Hub // receives an event for every write made through Repo
+	locks      lockManager
 }
 
 // New initializes a new Server.
@@ -30,16 +34,27 @@ func New(args ...Arg) (*Server, error) {
 	if serverArgs.repo == nil || serverArgs.logger == nil {
 		return nil, fmt.Errorf("missing required arguments")
 	}
+	if serverArgs.pageTokenKey == nil {
+		// page tokens will not survive restarts
+		serverArgs.pageTokenKey = make([]byte, 32)
+		if _, err := rand.Read(serverArgs.pageTokenKey); err != nil {
+			return nil, err
+		}
+	}
 
+	hub := &pubsub.Hub{}
 	return &Server{
-		Repo:   serverArgs.repo,
-		Logger: serverArgs.logger,
+		Repo:       &pubsub.Repo{Repo: serverArgs.repo, Hub: hub},
+		Logger:     serverArgs.logger,
+		PageTokens: pagination.NewCodec(serverArgs.pageTokenKey),
+		Hub:        hub,
 	}, nil
 }
 
 type serverArgs struct {
-	repo   repo.Repo
-	logger log.Logger
+	repo         repo.Repo
+	logger       log.Logger
+	pageTokenKey []byte
 }
 
 // Arg is an argument for constructing a Server.
@@ -58,3 +73,10 @@ func WithLogger(logger log.Logger) Arg {
 		a.logger = logger
 	})
 }
+
+// WithPageTokenKey provides the key used to sign page tokens. If not provided, a random key is generated.
+func WithPageTokenKey(key []byte) Arg {
+	return Arg(func(a *serverArgs) {
+		a.pageTokenKey = key
+	})
+}
@@ -0,0 +1,242 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+)
+
+// maxFavoriteOutcomes is the number of favorite outcomes returned in user stats.
+const maxFavoriteOutcomes = 3
+
+// GetMarketStats returns aggregate stats of a market.
+func (s *Server) GetMarketStats(ctx context.Context, in *connect.Request[api.GetMarketStatsRequest]) (*connect.Response[api.GetMarketStatsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	market, err := s.Repo.GetMarket(ctx, in.Msg.GetName())
+	if err != nil {
+		return nil, err
+	}
+	bets, err := s.listMarketBets(ctx, market.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetMarketStatsResponse{
+		Stats: marketStats(market, bets),
+	}), nil
+}
+
+// listMarketBets lists all bets of a market.
+func (s *Server) listMarketBets(ctx context.Context, market string) ([]*api.Bet, error) {
+	bookID, _ := entity.MarketIDs(market)
+	return s.listBets(ctx, &repo.ListBetsArgs{Book: entity.BookN(bookID), Market: market})
+}
+
+// listBets lists all bets matching args, paging through them.
+func (s *Server) listBets(ctx context.Context, args *repo.ListBetsArgs) ([]*api.Bet, error) {
+	args.Limit = 100
+	var bets []*api.Bet
+	for {
+		bs, hasMore, err := s.Repo.ListBets(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		bets = append(bets, bs...)
+		if !hasMore {
+			return bets, nil
+		}
+		args.GreaterThanName = bs[len(bs)-1].GetName()
+	}
+}
+
+// marketStats computes the stats of a market from its pool and bets. Payout multipliers match how SettleMarket pays out
+// winners.
+func marketStats(market *api.Market, bets []*api.Bet) *api.MarketStats {
+	stats := &api.MarketStats{Market: market.GetName()}
+	for _, outcome := range market.GetPool().GetOutcomes() {
+		stats.TotalCentipoints += outcome.GetCentipoints()
+	}
+
+	bettors := map[string]bool{}
+	outcomeBettors := map[string]map[string]bool{}
+	for _, bet := range bets {
+		bettors[bet.GetUser()] = true
+		if outcomeBettors[bet.GetOutcome()] == nil {
+			outcomeBettors[bet.GetOutcome()] = map[string]bool{}
+		}
+		outcomeBettors[bet.GetOutcome()][bet.GetUser()] = true
+		if bet.GetCentipoints() > stats.GetLargestBet().GetCentipoints() {
+			stats.LargestBet = bet
+		}
+	}
+	stats.BettorCount = uint32(len(bettors))
+
+	for _, outcome := range market.GetPool().GetOutcomes() {
+		o := &api.OutcomeStats{
+			Outcome:     outcome.GetName(),
+			Centipoints: outcome.GetCentipoints(),
+			BettorCount: uint32(len(outcomeBettors[outcome.GetName()])),
+		}
+		if stats.GetTotalCentipoints() > 0 {
+			o.ImpliedProbability = float64(outcome.GetCentipoints()) / float64(stats.GetTotalCentipoints())
+		}
+		if outcome.GetCentipoints() > 0 {
+			o.PayoutMultiplier = float64(stats.GetTotalCentipoints()) / float64(outcome.GetCentipoints())
+		}
+		stats.Outcomes = append(stats.Outcomes, o)
+	}
+	return stats
+}
+
+// GetUserStats returns betting performance of a user.
+func (s *Server) GetUserStats(ctx context.Context, in *connect.Request[api.GetUserStatsRequest]) (*connect.Response[api.GetUserStatsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	start, end := in.Msg.GetStartTime(), in.Msg.GetEndTime()
+	if start != nil && end != nil && !start.AsTime().Before(end.AsTime()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start_time must be before end_time"))
+	}
+
+	user, err := s.Repo.GetUser(ctx, in.Msg.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	bookID, _ := entity.UserIDs(user.GetName())
+	userBets, err := s.listBets(ctx, &repo.ListBetsArgs{Book: entity.BookN(bookID), User: user.GetName()})
+	if err != nil {
+		return nil, err
+	}
+	var bets []*api.Bet
+	for _, bet := range userBets {
+		if bet.GetSettledAt() == nil {
+			continue
+		}
+		if start != nil && bet.GetSettledAt().AsTime().Before(start.AsTime()) {
+			continue
+		}
+		if end != nil && !bet.GetSettledAt().AsTime().Before(end.AsTime()) {
+			continue
+		}
+		bets = append(bets, bet)
+	}
+
+	markets, err := s.getMarkets(ctx, bets)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetUserStatsResponse{
+		Stats: userStats(user, bets, markets),
+	}), nil
+}
+
+// getMarkets gets the markets of bets by name.
+func (s *Server) getMarkets(ctx context.Context, bets []*api.Bet) (map[string]*api.Market, error) {
+	var names []string
+	seen := map[string]bool{}
+	for _, bet := range bets {
+		if !seen[bet.GetMarket()] {
+			seen[bet.GetMarket()] = true
+			names = append(names, bet.GetMarket())
+		}
+	}
+	markets := map[string]*api.Market{}
+	for i := 0; i < len(names); i += 100 {
+		ms, err := s.Repo.GetMarkets(ctx, names[i:minInt(i+100, len(names))])
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range ms {
+			markets[m.GetName()] = m
+		}
+	}
+	return markets, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// userStats computes the stats of a user from their settled bets and the markets of those bets.
+func userStats(user *api.User, bets []*api.Bet, markets map[string]*api.Market) *api.UserStats {
+	bets = append([]*api.Bet(nil), bets...)
+	sort.Slice(bets, func(i, j int) bool {
+		a, b := bets[i].GetSettledAt().AsTime(), bets[j].GetSettledAt().AsTime()
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return bets[i].GetName() < bets[j].GetName()
+	})
+
+	stats := &api.UserStats{User: user.GetName()}
+	marketsBetOn := map[string]bool{}
+	outcomeCounts := map[string]uint32{} // outcome titles
+	var streak uint32
+	var biggestWin int64
+	for _, bet := range bets {
+		market := markets[bet.GetMarket()]
+		if bet.GetSettledAt() == nil || market.GetStatus() != api.Market_STATUS_SETTLED {
+			continue
+		}
+		marketsBetOn[bet.GetMarket()] = true
+		stats.SettledBets++
+		stats.TotalStakedCentipoints += bet.GetCentipoints()
+		profit := int64(bet.GetSettledCentipoints()) - int64(bet.GetCentipoints())
+		stats.NetProfitCentipoints += profit
+		switch {
+		case profit > 0:
+			stats.Wins++
+			streak++
+			if streak > stats.GetLongestWinStreak() {
+				stats.LongestWinStreak = streak
+			}
+			if profit > biggestWin {
+				biggestWin = profit
+				stats.BiggestWin = bet
+			}
+		case profit < 0:
+			stats.Losses++
+			streak = 0
+		}
+		for _, outcome := range market.GetPool().GetOutcomes() {
+			if outcome.GetName() == bet.GetOutcome() {
+				outcomeCounts[outcome.GetTitle()]++
+				break
+			}
+		}
+	}
+	stats.MarketsBetOn = uint32(len(marketsBetOn))
+	if stats.GetWins()+stats.GetLosses() > 0 {
+		stats.WinRate = float64(stats.GetWins()) / float64(stats.GetWins()+stats.GetLosses())
+	}
+	if stats.GetTotalStakedCentipoints() > 0 {
+		stats.Roi = float64(stats.GetNetProfitCentipoints()) / float64(stats.GetTotalStakedCentipoints())
+	}
+
+	for title, count := range outcomeCounts {
+		stats.FavoriteOutcomes = append(stats.FavoriteOutcomes, &api.OutcomeCount{Title: title, BetCount: count})
+	}
+	sort.Slice(stats.FavoriteOutcomes, func(i, j int) bool {
+		a, b := stats.FavoriteOutcomes[i], stats.FavoriteOutcomes[j]
+		if a.GetBetCount() != b.GetBetCount() {
+			return a.GetBetCount() > b.GetBetCount()
+		}
+		return a.GetTitle() < b.GetTitle()
+	})
+	if len(stats.FavoriteOutcomes) > maxFavoriteOutcomes {
+		stats.FavoriteOutcomes = stats.FavoriteOutcomes[:maxFavoriteOutcomes]
+	}
+	return stats
+}
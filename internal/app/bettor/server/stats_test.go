@@ -0,0 +1,250 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetMarketStats(t *testing.T) {
+	marketName := entity.MarketN("guild:1", uuid.NewString())
+	yes, no, maybe := marketName+"/outcomes/1", marketName+"/outcomes/2", marketName+"/outcomes/3"
+	market := func(yesCentipoints, noCentipoints uint64) *api.Market {
+		return &api.Market{
+			Name:   marketName,
+			Status: api.Market_STATUS_OPEN,
+			Type: &api.Market_Pool{Pool: &api.Pool{Outcomes: []*api.Outcome{
+				{Name: yes, Title: "Yes", Centipoints: yesCentipoints},
+				{Name: no, Title: "No", Centipoints: noCentipoints},
+				{Name: maybe, Title: "Maybe"},
+			}}},
+		}
+	}
+	bet := func(id, user, outcome string, centipoints uint64) *api.Bet {
+		return &api.Bet{Name: entity.BetN("guild:1", id), User: user, Market: marketName, Centipoints: centipoints, Type: &api.Bet_Outcome{Outcome: outcome}}
+	}
+	userA, userB := entity.UserN("guild:1", "a"), entity.UserN("guild:1", "b")
+	testCases := []struct {
+		desc      string
+		market    *api.Market
+		bets      []*api.Bet
+		name      string
+		expected  *api.MarketStats
+		expectErr bool
+	}{
+		{
+			desc:   "no bets",
+			market: market(0, 0),
+			name:   marketName,
+			expected: &api.MarketStats{
+				Market: marketName,
+				Outcomes: []*api.OutcomeStats{
+					{Outcome: yes},
+					{Outcome: no},
+					{Outcome: maybe},
+				},
+			},
+		},
+		{
+			desc:   "bets on multiple outcomes",
+			market: market(300, 100),
+			bets: []*api.Bet{
+				bet("a", userA, yes, 100),
+				bet("b", userA, yes, 100),
+				bet("c", userB, yes, 100),
+				bet("d", userB, no, 100),
+			},
+			name: marketName,
+			expected: &api.MarketStats{
+				Market:           marketName,
+				TotalCentipoints: 400,
+				BettorCount:      2,
+				Outcomes: []*api.OutcomeStats{
+					{Outcome: yes, Centipoints: 300, BettorCount: 2, ImpliedProbability: 0.75, PayoutMultiplier: 4.0 / 3},
+					{Outcome: no, Centipoints: 100, BettorCount: 1, ImpliedProbability: 0.25, PayoutMultiplier: 4},
+					{Outcome: maybe},
+				},
+				LargestBet: bet("a", userA, yes, 100),
+			},
+		},
+		{
+			desc:   "all bets on one outcome",
+			market: market(0, 300),
+			bets: []*api.Bet{
+				bet("a", userA, no, 100),
+				bet("b", userB, no, 200),
+			},
+			name: marketName,
+			expected: &api.MarketStats{
+				Market:           marketName,
+				TotalCentipoints: 300,
+				BettorCount:      2,
+				Outcomes: []*api.OutcomeStats{
+					{Outcome: yes},
+					{Outcome: no, Centipoints: 300, BettorCount: 2, ImpliedProbability: 1, PayoutMultiplier: 1},
+					{Outcome: maybe},
+				},
+				LargestBet: bet("b", userB, no, 200),
+			},
+		},
+		{
+			desc:      "fails if market does not exist",
+			market:    market(0, 0),
+			name:      entity.MarketN("guild:1", uuid.NewString()),
+			expectErr: true,
+		},
+		{
+			desc:      "fails if name is empty",
+			market:    market(0, 0),
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			s, err := server.New(server.WithRepo(&mem.Repo{Markets: []*api.Market{tC.market}, Bets: tC.bets}))
+			require.Nil(t, err)
+			out, err := s.GetMarketStats(context.Background(), connect.NewRequest(&api.GetMarketStatsRequest{Name: tC.name}))
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.True(t, proto.Equal(tC.expected, out.Msg.GetStats()), "expected %v, got %v", tC.expected, out.Msg.GetStats())
+		})
+	}
+}
+
+func TestGetUserStats(t *testing.T) {
+	user := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "rusty", Centipoints: 100}
+	other := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "linus", Centipoints: 100}
+	market := func(id string, status api.Market_Status, titles ...string) *api.Market {
+		m := &api.Market{Name: entity.MarketN("guild:1", id), Status: status, Type: &api.Market_Pool{Pool: &api.Pool{}}}
+		for i, title := range titles {
+			m.GetPool().Outcomes = append(m.GetPool().Outcomes, &api.Outcome{Name: fmt.Sprintf("%s/outcomes/%d", m.GetName(), i), Title: title})
+		}
+		return m
+	}
+	marketA := market("a", api.Market_STATUS_SETTLED, "Yes", "No")
+	marketB := market("b", api.Market_STATUS_SETTLED, "Yes", "No")
+	marketC := market("c", api.Market_STATUS_SETTLED, "Red", "Blue")
+	canceled := market("d", api.Market_STATUS_CANCELED, "Yes", "No")
+	open := market("e", api.Market_STATUS_OPEN, "Yes", "No")
+	day := func(d int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC))
+	}
+	bet := func(id string, user *api.User, market *api.Market, outcome int, centipoints, settledCentipoints uint64, settledAt *timestamppb.Timestamp) *api.Bet {
+		return &api.Bet{
+			Name:               entity.BetN("guild:1", id),
+			User:               user.GetName(),
+			Market:             market.GetName(),
+			Centipoints:        centipoints,
+			SettledCentipoints: settledCentipoints,
+			SettledAt:          settledAt,
+			Type:               &api.Bet_Outcome{Outcome: market.GetPool().GetOutcomes()[outcome].GetName()},
+		}
+	}
+	bets := []*api.Bet{
+		bet("1", user, marketA, 0, 100, 300, day(1)), // win +200
+		bet("2", user, marketA, 0, 100, 300, day(1)), // win +200
+		bet("3", user, marketB, 0, 100, 0, day(2)),   // loss -100
+		bet("4", user, marketC, 0, 100, 100, day(3)), // even
+		bet("5", user, marketC, 1, 200, 600, day(3)), // win +400
+		bet("6", user, canceled, 0, 100, 100, day(4)),
+		bet("7", user, open, 0, 100, 0, nil),
+		bet("8", other, marketA, 1, 100, 0, day(1)),
+	}
+	testCases := []struct {
+		desc      string
+		req       *api.GetUserStatsRequest
+		expected  *api.UserStats
+		expectErr bool
+	}{
+		{
+			desc: "all settled bets",
+			req:  &api.GetUserStatsRequest{User: user.GetName()},
+			expected: &api.UserStats{
+				User:                   user.GetName(),
+				MarketsBetOn:           3,
+				SettledBets:            5,
+				Wins:                   3,
+				Losses:                 1,
+				WinRate:                0.75,
+				TotalStakedCentipoints: 600,
+				NetProfitCentipoints:   700,
+				Roi:                    700.0 / 600,
+				BiggestWin:             bets[4],
+				LongestWinStreak:       2,
+				FavoriteOutcomes: []*api.OutcomeCount{
+					{Title: "Yes", BetCount: 3},
+					{Title: "Blue", BetCount: 1},
+					{Title: "Red", BetCount: 1},
+				},
+			},
+		},
+		{
+			desc: "time window",
+			req:  &api.GetUserStatsRequest{User: user.GetName(), StartTime: day(2), EndTime: day(3)},
+			expected: &api.UserStats{
+				User:                   user.GetName(),
+				MarketsBetOn:           1,
+				SettledBets:            1,
+				Losses:                 1,
+				TotalStakedCentipoints: 100,
+				NetProfitCentipoints:   -100,
+				Roi:                    -1,
+				FavoriteOutcomes:       []*api.OutcomeCount{{Title: "Yes", BetCount: 1}},
+			},
+		},
+		{
+			desc:     "no settled bets",
+			req:      &api.GetUserStatsRequest{User: user.GetName(), StartTime: day(10)},
+			expected: &api.UserStats{User: user.GetName()},
+		},
+		{
+			desc:      "fails if user does not exist",
+			req:       &api.GetUserStatsRequest{User: entity.UserN("guild:1", uuid.NewString())},
+			expectErr: true,
+		},
+		{
+			desc:      "fails if start_time is not before end_time",
+			req:       &api.GetUserStatsRequest{User: user.GetName(), StartTime: day(2), EndTime: day(2)},
+			expectErr: true,
+		},
+		{
+			desc:      "fails if user is empty",
+			req:       &api.GetUserStatsRequest{},
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			s, err := server.New(server.WithRepo(&mem.Repo{
+				Users:   []*api.User{user, other},
+				Markets: []*api.Market{marketA, marketB, marketC, canceled, open},
+				Bets:    bets,
+			}))
+			require.Nil(t, err)
+			out, err := s.GetUserStats(context.Background(), connect.NewRequest(tC.req))
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.True(t, proto.Equal(tC.expected, out.Msg.GetStats()), "expected %v, got %v", tC.expected, out.Msg.GetStats())
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/pagination"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TransferPoints sends points from one user to another in the same book.
+func (s *Server) TransferPoints(ctx context.Context, in *connect.Request[api.TransferPointsRequest]) (*connect.Response[api.TransferPointsResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	bookID, _ := entity.UserIDs(in.Msg.GetFromUser())
+	if toBookID, _ := entity.UserIDs(in.Msg.GetToUser()); bookID != toBookID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("users must be members of the same book"))
+	}
+	if in.Msg.GetFromUser() == in.Msg.GetToUser() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot transfer points to self"))
+	}
+	defer s.locks.Lock(in.Msg.GetFromUser(), in.Msg.GetToUser())()
+
+	now := timestamppb.Now()
+	transfer := &api.Transfer{
+		Name:        entity.TransferN(bookID, uuid.NewString()),
+		CreatedAt:   now,
+		FromUser:    in.Msg.GetFromUser(),
+		ToUser:      in.Msg.GetToUser(),
+		Centipoints: in.Msg.GetCentipoints(),
+		Memo:        in.Msg.GetMemo(),
+	}
+	// both balances and the transfer are written together so points are never debited without being credited
+	var fromUser, toUser *api.User
+	err := s.write(ctx, func() (*repo.Batch, error) {
+		var err error
+		if fromUser, err = s.Repo.GetUser(ctx, transfer.GetFromUser()); err != nil {
+			return nil, err
+		}
+		if toUser, err = s.Repo.GetUser(ctx, transfer.GetToUser()); err != nil {
+			return nil, err
+		}
+		if fromUser.GetCentipoints() < transfer.GetCentipoints() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user does not have enough balance"))
+		}
+		fromUser.Centipoints -= transfer.GetCentipoints()
+		fromUser.UpdatedAt = now
+		transfer.DebtRepaidCentipoints = credit(toUser, transfer.GetCentipoints())
+		toUser.UpdatedAt = now
+		amount, repaid := int64(transfer.GetCentipoints()), int64(transfer.GetDebtRepaidCentipoints())
+		return &repo.Batch{
+			Users:     []*api.User{fromUser, toUser},
+			Transfers: []*api.Transfer{transfer},
+			Events: []*api.DomainEvent{
+				userTransferred(fromUser, -amount, 0, transfer.GetName()),
+				userTransferred(toUser, amount-repaid, -repaid, transfer.GetName()),
+			},
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.TransferPointsResponse{
+		Transfer: transfer,
+		FromUser: fromUser,
+		ToUser:   toUser,
+	}), nil
+}
+
+// ListTransfers lists transfers of points between users of a book.
+func (s *Server) ListTransfers(ctx context.Context, in *connect.Request[api.ListTransfersRequest]) (*connect.Response[api.ListTransfersResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := defaultPageSize
+	if in.Msg.GetPageSize() > 0 && in.Msg.GetPageSize() <= maxPageSize {
+		pageSize = int(in.Msg.GetPageSize())
+	}
+
+	var cursor string
+	if in.Msg.GetPageToken() != "" {
+		p, err := s.PageTokens.FromToken(in.Msg.GetPageToken(), api.StripListTransfersPagination(in.Msg))
+		if err != nil {
+			return nil, err
+		}
+		cursor = p.Cursor
+	}
+
+	transfers, hasMore, err := s.Repo.ListTransfers(ctx, &repo.ListTransfersArgs{
+		Book:            in.Msg.GetBook(),
+		GreaterThanName: cursor,
+		User:            in.Msg.GetUser(),
+		Limit:           pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if hasMore {
+		nextPageToken, err = s.PageTokens.ToToken(pagination.Pagination{
+			Cursor:      transfers[len(transfers)-1].GetName(),
+			ListRequest: api.StripListTransfersPagination(in.Msg),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&api.ListTransfersResponse{
+		Transfers:     transfers,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// listTransfers lists all transfers matching args.
+func (s *Server) listTransfers(ctx context.Context, args *repo.ListTransfersArgs) ([]*api.Transfer, error) {
+	args.Limit = 100
+	var transfers []*api.Transfer
+	for {
+		ts, hasMore, err := s.Repo.ListTransfers(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, ts...)
+		if !hasMore {
+			return transfers, nil
+		}
+		args.GreaterThanName = ts[len(ts)-1].GetName()
+	}
+}
@@ -0,0 +1,148 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferPoints(t *testing.T) {
+	from := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000}
+	to := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 500}
+	otherBook := &api.User{Name: entity.UserN("guild:2", "c"), Username: "linus", Centipoints: 500}
+	testCases := []struct {
+		desc          string
+		req           *api.TransferPointsRequest
+		expectErrCode connect.Code
+	}{
+		{
+			desc: "basic case",
+			req:  &api.TransferPointsRequest{FromUser: from.GetName(), ToUser: to.GetName(), Centipoints: 300, Memo: "for the pizza"},
+		},
+		{
+			desc: "transfers entire balance",
+			req:  &api.TransferPointsRequest{FromUser: from.GetName(), ToUser: to.GetName(), Centipoints: 1000},
+		},
+		{
+			desc:          "fails if user does not have enough balance",
+			req:           &api.TransferPointsRequest{FromUser: from.GetName(), ToUser: to.GetName(), Centipoints: 1001},
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if transferring to self",
+			req:           &api.TransferPointsRequest{FromUser: from.GetName(), ToUser: from.GetName(), Centipoints: 100},
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if users are in different books",
+			req:           &api.TransferPointsRequest{FromUser: from.GetName(), ToUser: otherBook.GetName(), Centipoints: 100},
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+		{
+			desc:          "fails if sender does not exist",
+			req:           &api.TransferPointsRequest{FromUser: entity.UserN("guild:1", uuid.NewString()), ToUser: to.GetName(), Centipoints: 100},
+			expectErrCode: connect.CodeNotFound,
+		},
+		{
+			desc:          "fails if recipient does not exist",
+			req:           &api.TransferPointsRequest{FromUser: from.GetName(), ToUser: entity.UserN("guild:1", uuid.NewString()), Centipoints: 100},
+			expectErrCode: connect.CodeNotFound,
+		},
+		{
+			desc:          "fails if centipoints is 0",
+			req:           &api.TransferPointsRequest{FromUser: from.GetName(), ToUser: to.GetName()},
+			expectErrCode: connect.CodeInvalidArgument,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			r := &mem.Repo{Users: []*api.User{from, to, otherBook}}
+			s, err := server.New(server.WithRepo(r))
+			require.Nil(t, err)
+			out, err := s.TransferPoints(context.Background(), connect.NewRequest(tC.req))
+			if tC.expectErrCode != 0 {
+				require.NotNil(t, err)
+				assert.Equal(t, tC.expectErrCode, connect.CodeOf(err))
+
+				// balances are unchanged
+				got, err := r.GetUser(context.Background(), from.GetName())
+				require.Nil(t, err)
+				assert.Equal(t, from.GetCentipoints(), got.GetCentipoints())
+				got, err = r.GetUser(context.Background(), to.GetName())
+				require.Nil(t, err)
+				assert.Equal(t, to.GetCentipoints(), got.GetCentipoints())
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, from.GetCentipoints()-tC.req.GetCentipoints(), out.Msg.GetFromUser().GetCentipoints())
+			assert.Equal(t, to.GetCentipoints()+tC.req.GetCentipoints(), out.Msg.GetToUser().GetCentipoints())
+			assert.Equal(t, tC.req.GetMemo(), out.Msg.GetTransfer().GetMemo())
+
+			transfers, _, err := r.ListTransfers(context.Background(), &repo.ListTransfersArgs{Book: entity.BookN("guild:1"), User: to.GetName(), Limit: 10})
+			require.Nil(t, err)
+			require.Len(t, transfers, 1)
+			assert.Equal(t, out.Msg.GetTransfer().GetName(), transfers[0].GetName())
+		})
+	}
+}
+
+func TestTransferPointsFailedWriteChangesNothing(t *testing.T) {
+	ctx := context.Background()
+	from := &api.User{Name: entity.UserN("guild:1", "a"), Username: "rusty", Centipoints: 1000}
+	to := &api.User{Name: entity.UserN("guild:1", "b"), Username: "danny", Centipoints: 500}
+	r := &failingWriteRepo{Repo: &mem.Repo{Users: []*api.User{from, to}}}
+	s, err := server.New(server.WithRepo(r))
+	require.Nil(t, err)
+	_, err = s.TransferPoints(ctx, connect.NewRequest(&api.TransferPointsRequest{FromUser: from.GetName(), ToUser: to.GetName(), Centipoints: 300}))
+	assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+
+	for name, expected := range map[string]uint64{from.GetName(): 1000, to.GetName(): 500} {
+		got, err := r.GetUser(ctx, name)
+		require.Nil(t, err)
+		assert.Equal(t, expected, got.GetCentipoints())
+	}
+	transfers, _, err := r.ListTransfers(ctx, &repo.ListTransfersArgs{Book: entity.BookN("guild:1"), Limit: 10})
+	require.Nil(t, err)
+	assert.Empty(t, transfers)
+}
+
+func TestListTransfers(t *testing.T) {
+	user := entity.UserN("guild:1", "a")
+	var transfers []*api.Transfer
+	for i := 0; i < 3; i++ {
+		transfers = append(transfers, &api.Transfer{Name: entity.TransferN("guild:1", uuid.NewString()), FromUser: user, ToUser: entity.UserN("guild:1", "b"), Centipoints: 100})
+	}
+	transfers = append(transfers, &api.Transfer{Name: entity.TransferN("guild:1", uuid.NewString()), FromUser: entity.UserN("guild:1", "b"), ToUser: entity.UserN("guild:1", "c"), Centipoints: 100})
+	s, err := server.New(server.WithRepo(&mem.Repo{Transfers: transfers}))
+	require.Nil(t, err)
+
+	var got []*api.Transfer
+	var pageToken string
+	for {
+		out, err := s.ListTransfers(context.Background(), connect.NewRequest(&api.ListTransfersRequest{Book: entity.BookN("guild:1"), User: user, PageSize: 2, PageToken: pageToken}))
+		require.Nil(t, err)
+		got = append(got, out.Msg.GetTransfers()...)
+		if out.Msg.GetNextPageToken() == "" {
+			break
+		}
+		pageToken = out.Msg.GetNextPageToken()
+	}
+	require.Len(t, got, 3)
+	for _, tr := range got {
+		assert.Equal(t, user, tr.GetFromUser())
+	}
+
+	out, err := s.ListTransfers(context.Background(), connect.NewRequest(&api.ListTransfersRequest{Book: entity.BookN("guild:1"), User: entity.UserN("guild:1", "b")}))
+	require.Nil(t, err)
+	assert.Len(t, out.Msg.GetTransfers(), 4, "lists both sent and received transfers")
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+)
+
+// maxUpdateAttempts is the number of times a read-modify-write is attempted before giving up on a resource that
+// keeps being concurrently modified.
+const maxUpdateAttempts = 5
+
+// updateUser reads a user, applies update to it, and writes it back. Writes are conditional on the user's etag and
+// are retried from a fresh read if the user was concurrently modified. Errors returned by update are not retried. event
+// builds the domain event that is persisted with the write from the updated user.
+func (s *Server) updateUser(ctx context.Context, name string, update func(user *api.User) error, event func(user *api.User) *api.DomainEvent) (*api.User, error) {
+	for i := 0; i < maxUpdateAttempts; i++ {
+		user, err := s.Repo.GetUser(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := update(user); err != nil {
+			return nil, err
+		}
+		if err := s.Repo.UpdateUser(ctx, user, event(user)); err != nil {
+			if connect.CodeOf(err) == connect.CodeAborted {
+				continue
+			}
+			return nil, err
+		}
+		return user, nil
+	}
+	return nil, connect.NewError(connect.CodeAborted, errors.New("user was concurrently modified"))
+}
+
+// updateMarket reads a market, applies update to it, and writes it back. Writes are conditional on the market's etag
+// and are retried from a fresh read if the market was concurrently modified. If etag is set, the market must still
+// have that etag and the write is not retried since the caller's view of the market is stale. Errors returned by
+// update are not retried. event builds the domain event that is persisted with the write from the updated market.
+func (s *Server) updateMarket(ctx context.Context, name, etag string, update func(market *api.Market) error, event func(market *api.Market) *api.DomainEvent) (*api.Market, error) {
+	for i := 0; i < maxUpdateAttempts; i++ {
+		market, err := s.Repo.GetMarket(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if etag != "" && market.GetEtag() != etag {
+			return nil, connect.NewError(connect.CodeAborted, errors.New("market has been modified"))
+		}
+		if err := update(market); err != nil {
+			return nil, err
+		}
+		if err := s.Repo.UpdateMarket(ctx, market, event(market)); err != nil {
+			if connect.CodeOf(err) == connect.CodeAborted && etag == "" {
+				continue
+			}
+			return nil, err
+		}
+		return market, nil
+	}
+	return nil, connect.NewError(connect.CodeAborted, errors.New("market was concurrently modified"))
+}
+
+// write builds a batch from fresh reads and writes it atomically. Writes are conditional on the etags of the resources
+// build read and are retried from fresh reads if any of them was concurrently modified. Errors returned by build are
+// not retried.
+func (s *Server) write(ctx context.Context, build func() (*repo.Batch, error)) error {
+	for i := 0; i < maxUpdateAttempts; i++ {
+		batch, err := build()
+		if err != nil {
+			return err
+		}
+		if err := s.Repo.Write(ctx, batch); err != nil {
+			if connect.CodeOf(err) == connect.CodeAborted {
+				continue
+			}
+			return err
+		}
+		return nil
+	}
+	return connect.NewError(connect.CodeAborted, errors.New("resources were concurrently modified"))
+}
@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"encoding/gob"
 	"errors"
 
 	"github.com/bufbuild/connect-go"
@@ -20,10 +19,6 @@ const (
 	maxPageSize     = 100
 )
 
-func init() {
-	gob.Register(&api.ListUsersRequest{})
-}
-
 // CreateUser creates a new user.
 func (s *Server) CreateUser(ctx context.Context, in *connect.Request[api.CreateUserRequest]) (*connect.Response[api.CreateUserResponse], error) {
 	if in.Msg == nil || in.Msg.GetUser() == nil {
@@ -44,7 +39,7 @@ func (s *Server) CreateUser(ctx context.Context, in *connect.Request[api.CreateU
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := s.Repo.CreateUser(ctx, user); err != nil {
+	if err := s.Repo.CreateUser(ctx, user, userCreated(user)); err != nil {
 		return nil, err
 	}
 
@@ -86,7 +81,6 @@ func (s *Server) GetUserByUsername(ctx context.Context, in *connect.Request[api.
 }
 
 // ListUsers lists users by filters.
-// NOTE: "total_centipoints" cannot be paginated at the moment.
 func (s *Server) ListUsers(ctx context.Context, in *connect.Request[api.ListUsersRequest]) (*connect.Response[api.ListUsersResponse], error) {
 	if err := in.Msg.Validate(); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -99,53 +93,47 @@ func (s *Server) ListUsers(ctx context.Context, in *connect.Request[api.ListUser
 
 	var cursor string
 	if in.Msg.GetPageToken() != "" {
-		p, err := pagination.FromToken(in.Msg.GetPageToken())
+		p, err := s.PageTokens.FromToken(in.Msg.GetPageToken(), api.StripListUsersPagination(in.Msg))
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			return nil, err
 		}
 		cursor = p.Cursor
-		fromToken, ok := proto.Clone(p.ListRequest).(*api.ListUsersRequest)
-		if !ok {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
-		}
-		if !proto.Equal(api.StripListUsersPagination(in.Msg), api.StripListUsersPagination(fromToken)) {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page token"))
-		}
 	}
 
-	var users []*api.User
-	var nextPageToken string
+	args := &repo.ListUsersArgs{Book: in.Msg.GetBook(), Users: in.Msg.GetUsers(), Limit: pageSize, OrderBy: in.Msg.GetOrderBy()}
 	switch in.Msg.GetOrderBy() {
 	case "", "name":
-		var hasMore bool
-		var err error
-		users, hasMore, err = s.Repo.ListUsers(ctx, &repo.ListUsersArgs{Book: in.Msg.GetBook(), GreaterThanName: cursor, Users: in.Msg.GetUsers(), Limit: pageSize, OrderBy: in.Msg.GetOrderBy()})
-		if err != nil {
-			return nil, err
-		}
-
-		if hasMore {
-			nextPageToken, err = pagination.ToToken(pagination.Pagination{
-				Cursor:      users[len(users)-1].GetName(),
-				ListRequest: in.Msg,
-			})
+		args.GreaterThanName = cursor
+	case "total_centipoints":
+		if cursor != "" {
+			after, err := parseOrderCursor(cursor)
 			if err != nil {
 				return nil, err
 			}
+			args.After = after
 		}
-	case "total_centipoints":
-		// NOTE: "total_centipoints" cannot be paginated at the moment.
-		if cursor != "" {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("page token is not supported for order by total centipoints"))
-		}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid order by"))
+	}
+	users, hasMore, err := s.Repo.ListUsers(ctx, args)
+	if err != nil {
+		return nil, err
+	}
 
-		var err error
-		users, _, err = s.Repo.ListUsers(ctx, &repo.ListUsersArgs{Book: in.Msg.GetBook(), Users: in.Msg.GetUsers(), Limit: pageSize, OrderBy: in.Msg.GetOrderBy()})
+	var nextPageToken string
+	if hasMore {
+		last := users[len(users)-1]
+		next := last.GetName()
+		if in.Msg.GetOrderBy() == "total_centipoints" {
+			next = orderCursor(last.GetCentipoints()+last.GetUnsettledCentipoints(), last.GetName())
+		}
+		nextPageToken, err = s.PageTokens.ToToken(pagination.Pagination{
+			Cursor:      next,
+			ListRequest: api.StripListUsersPagination(in.Msg),
+		})
 		if err != nil {
 			return nil, err
 		}
-	default:
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid order by"))
 	}
 
 	return connect.NewResponse(&api.ListUsersResponse{
@@ -153,3 +141,26 @@ func (s *Server) ListUsers(ctx context.Context, in *connect.Request[api.ListUser
 		NextPageToken: nextPageToken,
 	}), nil
 }
+
+// credit adds centipoints to a user's balance. Any debt left by resettled markets is repaid first. It returns the
+// centipoints that repaid debt.
+func credit(user *api.User, centipoints uint64) (repaid uint64) {
+	repaid = centipoints
+	if repaid > user.GetDebtCentipoints() {
+		repaid = user.GetDebtCentipoints()
+	}
+	user.DebtCentipoints -= repaid
+	user.Centipoints += centipoints - repaid
+	return repaid
+}
+
+// clawBack removes centipoints from a user's balance. Centipoints beyond the balance are added to the user's debt. It
+// returns the centipoints that were added to the debt.
+func clawBack(user *api.User, centipoints uint64) (owed uint64) {
+	if centipoints > user.GetCentipoints() {
+		owed = centipoints - user.GetCentipoints()
+	}
+	user.Centipoints -= centipoints - owed
+	user.DebtCentipoints += owed
+	return owed
+}
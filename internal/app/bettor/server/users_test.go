@@ -333,10 +333,22 @@ func TestListUsers(t *testing.T) {
 			expectedCalls: 1,
 		},
 		{
-			desc:          "order by total_centipoints limit",
+			desc:          "order by total_centipoints page size 1",
+			req:           &api.ListUsersRequest{Book: entity.BookN(bookID), OrderBy: "total_centipoints", PageSize: 1},
+			expected:      []*api.User{user2, user3Hydrated, user1},
+			expectedCalls: 3,
+		},
+		{
+			desc:          "order by total_centipoints page size 2",
 			req:           &api.ListUsersRequest{Book: entity.BookN(bookID), OrderBy: "total_centipoints", PageSize: 2},
-			expected:      []*api.User{user2, user3Hydrated},
-			expectedCalls: 1,
+			expected:      []*api.User{user2, user3Hydrated, user1},
+			expectedCalls: 2,
+		},
+		{
+			desc:          "order by total_centipoints list by user resource names",
+			req:           &api.ListUsersRequest{Book: entity.BookN(bookID), OrderBy: "total_centipoints", Users: []string{user1.Name, user3.Name}, PageSize: 1},
+			expected:      []*api.User{user3Hydrated, user1},
+			expectedCalls: 2,
 		},
 		{
 			desc:      "order by invalid",
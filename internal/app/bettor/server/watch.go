@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo/pubsub"
+)
+
+// WatchMarket streams changes to a market and its bets. The current market is sent first.
+func (s *Server) WatchMarket(ctx context.Context, in *connect.Request[api.WatchMarketRequest], stream *connect.ServerStream[api.WatchMarketResponse]) error {
+	if err := in.Msg.Validate(); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	name := in.Msg.GetName()
+
+	// subscribe before reading the snapshot so no change is missed
+	sub := s.Hub.Subscribe(func(e *api.Event) bool {
+		return e.GetMarket().GetName() == name || e.GetBet().GetMarket() == name
+	})
+	defer sub.Close()
+	market, err := s.Repo.GetMarket(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&api.WatchMarketResponse{Event: &api.Event{Type: api.Event_TYPE_SNAPSHOT, Resource: &api.Event_Market{Market: market}}}); err != nil {
+		return err
+	}
+
+	snapshotRevision := revision(market.GetEtag())
+	return forward(ctx, sub, func(e *api.Event) error {
+		if m := e.GetMarket(); m != nil && revision(m.GetEtag()) <= snapshotRevision {
+			return nil // already reflected in the snapshot
+		}
+		return stream.Send(&api.WatchMarketResponse{Event: e})
+	})
+}
+
+// WatchBook streams changes to all users, markets, and bets in a book.
+func (s *Server) WatchBook(ctx context.Context, in *connect.Request[api.WatchBookRequest], stream *connect.ServerStream[api.WatchBookResponse]) error {
+	if err := in.Msg.Validate(); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	bookID := entity.BooksIDs(in.Msg.GetBook())
+
+	sub := s.Hub.Subscribe(func(e *api.Event) bool {
+		return eventBookID(e) == bookID
+	})
+	defer sub.Close()
+	return forward(ctx, sub, func(e *api.Event) error {
+		return stream.Send(&api.WatchBookResponse{Event: e})
+	})
+}
+
+// forward sends a subscription's events until the client goes away or the subscription is dropped.
+func forward(ctx context.Context, sub *pubsub.Subscription, send func(e *api.Event) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.Events():
+			if !ok {
+				if err := sub.Err(); err != nil {
+					return connect.NewError(connect.CodeResourceExhausted, err)
+				}
+				return nil
+			}
+			if err := send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func eventBookID(e *api.Event) string {
+	var bookID string
+	switch {
+	case e.GetUser() != nil:
+		bookID, _ = entity.UserIDs(e.GetUser().GetName())
+	case e.GetMarket() != nil:
+		bookID, _ = entity.MarketIDs(e.GetMarket().GetName())
+	case e.GetBet() != nil:
+		bookID, _ = entity.BetIDs(e.GetBet().GetName())
+	}
+	return bookID
+}
+
+// revision is the revision number in an etag.
+func revision(etag string) uint64 {
+	n, _ := strconv.ParseUint(etag, 10, 64)
+	return n
+}
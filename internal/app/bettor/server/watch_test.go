@@ -0,0 +1,132 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/api/bettor/v1alpha/bettorv1alphaconnect"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// serve runs s over HTTP since streaming handlers cannot be called directly.
+func serve(t *testing.T, s *server.Server) bettorv1alphaconnect.BettorServiceClient {
+	mux := http.NewServeMux()
+	mux.Handle(bettorv1alphaconnect.NewBettorServiceHandler(s))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return bettorv1alphaconnect.NewBettorServiceClient(srv.Client(), srv.URL)
+}
+
+func TestWatchMarket(t *testing.T) {
+	user := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "rusty", Centipoints: 1000}
+	market := &api.Market{
+		Name:    entity.MarketN("guild:1", uuid.NewString()),
+		Title:   "Will I PB?",
+		Creator: user.GetName(),
+		Status:  api.Market_STATUS_OPEN,
+		Type: &api.Market_Pool{Pool: &api.Pool{Outcomes: []*api.Outcome{
+			{Name: entity.OutcomeN("guild:1", "a", "1"), Title: "Yes"},
+			{Name: entity.OutcomeN("guild:1", "a", "2"), Title: "No"},
+		}}},
+	}
+	otherMarket := proto.Clone(market).(*api.Market)
+	otherMarket.Name = entity.MarketN("guild:1", uuid.NewString())
+	s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{user}, Markets: []*api.Market{market, otherMarket}}))
+	require.Nil(t, err)
+	client := serve(t, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.WatchMarket(ctx, connect.NewRequest(&api.WatchMarketRequest{Name: market.GetName()}))
+	require.Nil(t, err)
+	defer func() {
+		cancel() // Close waits for the stream to end
+		stream.Close()
+	}()
+	require.True(t, stream.Receive(), stream.Err())
+	assert.Equal(t, api.Event_TYPE_SNAPSHOT, stream.Msg().GetEvent().GetType())
+	assert.Equal(t, market.GetName(), stream.Msg().GetEvent().GetMarket().GetName())
+
+	for _, m := range []*api.Market{otherMarket, market} {
+		_, err = s.CreateBet(ctx, connect.NewRequest(&api.CreateBetRequest{
+			Book: entity.BookN("guild:1"),
+			Bet: &api.Bet{
+				User:        user.GetName(),
+				Market:      m.GetName(),
+				Centipoints: 100,
+				Type:        &api.Bet_Outcome{Outcome: m.GetPool().GetOutcomes()[0].GetName()},
+			},
+		}))
+		require.Nil(t, err)
+	}
+
+	// user and other market changes are not streamed
+	require.True(t, stream.Receive(), stream.Err())
+	e := stream.Msg().GetEvent()
+	assert.Equal(t, api.Event_TYPE_UPDATED, e.GetType())
+	assert.Equal(t, market.GetName(), e.GetMarket().GetName())
+	assert.Equal(t, uint64(100), e.GetMarket().GetPool().GetOutcomes()[0].GetCentipoints())
+	require.True(t, stream.Receive(), stream.Err())
+	e = stream.Msg().GetEvent()
+	assert.Equal(t, api.Event_TYPE_CREATED, e.GetType())
+	assert.Equal(t, market.GetName(), e.GetBet().GetMarket())
+}
+
+func TestWatchMarketNotFound(t *testing.T) {
+	s, err := server.New(server.WithRepo(&mem.Repo{}))
+	require.Nil(t, err)
+	client := serve(t, s)
+	stream, err := client.WatchMarket(context.Background(), connect.NewRequest(&api.WatchMarketRequest{Name: entity.MarketN("guild:1", "a")}))
+	require.Nil(t, err)
+	defer stream.Close()
+	assert.False(t, stream.Receive())
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(stream.Err()))
+}
+
+func TestWatchBook(t *testing.T) {
+	s, err := server.New(server.WithRepo(&mem.Repo{}))
+	require.Nil(t, err)
+	client := serve(t, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.WatchBook(ctx, connect.NewRequest(&api.WatchBookRequest{Book: entity.BookN("guild:1")}))
+	require.Nil(t, err)
+	defer func() {
+		cancel() // Close waits for the stream to end
+		stream.Close()
+	}()
+
+	// the stream has no initial message to signal that it is subscribed so keep writing until events arrive
+	go func() {
+		for i := 0; ctx.Err() == nil; i++ {
+			for _, book := range []string{"guild:2", "guild:1"} {
+				_, _ = s.CreateUser(ctx, connect.NewRequest(&api.CreateUserRequest{
+					Book: entity.BookN(book),
+					User: &api.User{Username: fmt.Sprintf("rusty_%d", i)},
+				}))
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	for i := 0; i < 3; i++ {
+		require.True(t, stream.Receive(), stream.Err())
+		e := stream.Msg().GetEvent()
+		assert.Equal(t, api.Event_TYPE_CREATED, e.GetType())
+		bookID, _ := entity.UserIDs(e.GetUser().GetName())
+		assert.Equal(t, "guild:1", bookID)
+	}
+}
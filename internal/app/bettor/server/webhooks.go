@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/pkg/pagination"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxNumberOfWebhooks is the maximum number of webhooks allowed per book.
+const MaxNumberOfWebhooks = 10
+
+// CreateWebhook subscribes a URL to a book's market and bet events.
+func (s *Server) CreateWebhook(ctx context.Context, in *connect.Request[api.CreateWebhookRequest]) (*connect.Response[api.CreateWebhookResponse], error) {
+	if in.Msg == nil || in.Msg.GetWebhook() == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("webhook is required"))
+	}
+	if in.Msg.GetBook() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("book is required"))
+	}
+	w := proto.Clone(in.Msg.GetWebhook()).(*api.Webhook)
+
+	bookID := entity.BooksIDs(in.Msg.GetBook())
+	w.Name = entity.WebhookN(bookID, uuid.NewString())
+	w.CreatedAt = timestamppb.Now()
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	w.Secret = hex.EncodeToString(secret)
+
+	if err := w.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// serialize webhook creation per book to enforce the webhook limit
+	defer s.locks.Lock(entity.BookN(bookID))()
+
+	webhooks, _, err := s.Repo.ListWebhooks(ctx, &repo.ListWebhooksArgs{Book: in.Msg.GetBook(), Limit: MaxNumberOfWebhooks})
+	if err != nil {
+		return nil, err
+	}
+	if len(webhooks) >= MaxNumberOfWebhooks {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("too many webhooks"))
+	}
+
+	if err := s.Repo.CreateWebhook(ctx, w); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.CreateWebhookResponse{
+		Webhook: w,
+	}), nil
+}
+
+// ListWebhooks lists a book's webhooks. Secrets are not returned.
+func (s *Server) ListWebhooks(ctx context.Context, in *connect.Request[api.ListWebhooksRequest]) (*connect.Response[api.ListWebhooksResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pageSize := defaultPageSize
+	if in.Msg.GetPageSize() > 0 && in.Msg.GetPageSize() <= maxPageSize {
+		pageSize = int(in.Msg.GetPageSize())
+	}
+
+	var cursor string
+	if in.Msg.GetPageToken() != "" {
+		p, err := s.PageTokens.FromToken(in.Msg.GetPageToken(), api.StripListWebhooksPagination(in.Msg))
+		if err != nil {
+			return nil, err
+		}
+		cursor = p.Cursor
+	}
+
+	webhooks, hasMore, err := s.Repo.ListWebhooks(ctx, &repo.ListWebhooksArgs{
+		Book:            in.Msg.GetBook(),
+		GreaterThanName: cursor,
+		Limit:           pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range webhooks {
+		w.Secret = ""
+	}
+
+	var nextPageToken string
+	if hasMore {
+		nextPageToken, err = s.PageTokens.ToToken(pagination.Pagination{
+			Cursor:      webhooks[len(webhooks)-1].GetName(),
+			ListRequest: api.StripListWebhooksPagination(in.Msg),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&api.ListWebhooksResponse{
+		Webhooks:      webhooks,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// DeleteWebhook deletes a webhook. Its pending deliveries are dropped.
+func (s *Server) DeleteWebhook(ctx context.Context, in *connect.Request[api.DeleteWebhookRequest]) (*connect.Response[api.DeleteWebhookResponse], error) {
+	if err := in.Msg.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.Repo.DeleteWebhook(ctx, in.Msg.GetName()); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.DeleteWebhookResponse{}), nil
+}
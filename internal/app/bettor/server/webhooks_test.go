@@ -0,0 +1,199 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/outbox"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/server"
+	bettorwebhook "github.com/elh/bettor/internal/app/bettor/webhook"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	var maxWebhooks []*api.Webhook
+	for i := 0; i < server.MaxNumberOfWebhooks; i++ {
+		maxWebhooks = append(maxWebhooks, &api.Webhook{Name: entity.WebhookN("guild:1", uuid.NewString())})
+	}
+	testCases := []struct {
+		desc             string
+		existingWebhooks []*api.Webhook
+		book             string
+		webhook          *api.Webhook
+		expectErr        bool
+	}{
+		{
+			desc:    "basic case",
+			book:    entity.BookN("guild:1"),
+			webhook: &api.Webhook{Url: "https://example.com/hook"},
+		},
+		{
+			desc:             "other books' webhooks do not count towards the limit",
+			existingWebhooks: maxWebhooks,
+			book:             entity.BookN("guild:2"),
+			webhook:          &api.Webhook{Url: "http://localhost:8081/hook"},
+		},
+		{
+			desc:      "fails if book not set",
+			webhook:   &api.Webhook{Url: "https://example.com/hook"},
+			expectErr: true,
+		},
+		{
+			desc:      "fails if webhook not set",
+			book:      entity.BookN("guild:1"),
+			expectErr: true,
+		},
+		{
+			desc:      "fails if url not set",
+			book:      entity.BookN("guild:1"),
+			webhook:   &api.Webhook{},
+			expectErr: true,
+		},
+		{
+			desc:      "fails if url is not http",
+			book:      entity.BookN("guild:1"),
+			webhook:   &api.Webhook{Url: "ftp://example.com/hook"},
+			expectErr: true,
+		},
+		{
+			desc:             "fails if too many webhooks",
+			existingWebhooks: maxWebhooks,
+			book:             entity.BookN("guild:1"),
+			webhook:          &api.Webhook{Url: "https://example.com/hook"},
+			expectErr:        true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			s, err := server.New(server.WithRepo(&mem.Repo{Webhooks: tC.existingWebhooks}))
+			require.Nil(t, err)
+			out, err := s.CreateWebhook(context.Background(), connect.NewRequest(&api.CreateWebhookRequest{Book: tC.book, Webhook: tC.webhook}))
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.NotEmpty(t, out.Msg.GetWebhook().GetName())
+			assert.NotNil(t, out.Msg.GetWebhook().GetCreatedAt())
+			assert.Equal(t, tC.webhook.GetUrl(), out.Msg.GetWebhook().GetUrl())
+			assert.Len(t, out.Msg.GetWebhook().GetSecret(), 64)
+
+			got, err := s.Repo.GetWebhook(context.Background(), out.Msg.GetWebhook().GetName())
+			require.Nil(t, err)
+			assert.Equal(t, out.Msg.GetWebhook().GetSecret(), got.GetSecret())
+		})
+	}
+}
+
+func TestListWebhooks(t *testing.T) {
+	var webhooks []*api.Webhook
+	for i := 0; i < 3; i++ {
+		webhooks = append(webhooks, &api.Webhook{Name: entity.WebhookN("guild:1", fmt.Sprintf("%d", i)), Url: "https://example.com/hook", Secret: "shh"})
+	}
+	s, err := server.New(server.WithRepo(&mem.Repo{Webhooks: append(webhooks, &api.Webhook{Name: entity.WebhookN("guild:2", "a")})}))
+	require.Nil(t, err)
+
+	var got []*api.Webhook
+	var pageToken string
+	for {
+		out, err := s.ListWebhooks(context.Background(), connect.NewRequest(&api.ListWebhooksRequest{Book: entity.BookN("guild:1"), PageSize: 2, PageToken: pageToken}))
+		require.Nil(t, err)
+		got = append(got, out.Msg.GetWebhooks()...)
+		if out.Msg.GetNextPageToken() == "" {
+			break
+		}
+		pageToken = out.Msg.GetNextPageToken()
+	}
+	require.Len(t, got, len(webhooks))
+	for i, w := range got {
+		assert.Equal(t, webhooks[i].GetName(), w.GetName())
+		assert.Empty(t, w.GetSecret(), "secrets are only returned on creation")
+	}
+
+	_, err = s.ListWebhooks(context.Background(), connect.NewRequest(&api.ListWebhooksRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	webhook := &api.Webhook{Name: entity.WebhookN("guild:1", uuid.NewString())}
+	s, err := server.New(server.WithRepo(&mem.Repo{Webhooks: []*api.Webhook{webhook}}))
+	require.Nil(t, err)
+
+	_, err = s.DeleteWebhook(context.Background(), connect.NewRequest(&api.DeleteWebhookRequest{Name: webhook.GetName()}))
+	require.Nil(t, err)
+	_, err = s.Repo.GetWebhook(context.Background(), webhook.GetName())
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = s.DeleteWebhook(context.Background(), connect.NewRequest(&api.DeleteWebhookRequest{Name: webhook.GetName()}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestWebhookEvents(t *testing.T) {
+	ctx := context.Background()
+	user := &api.User{Name: entity.UserN("guild:1", uuid.NewString()), Username: "rusty", Centipoints: 1000}
+	webhook := &api.Webhook{Name: entity.WebhookN("guild:1", uuid.NewString()), Url: "https://example.com/hook"}
+	otherBookWebhook := &api.Webhook{Name: entity.WebhookN("guild:2", uuid.NewString()), Url: "https://example.com/hook"}
+	s, err := server.New(server.WithRepo(&mem.Repo{Users: []*api.User{user}, Webhooks: []*api.Webhook{webhook, otherBookWebhook}}))
+	require.Nil(t, err)
+
+	created, err := s.CreateMarket(ctx, connect.NewRequest(&api.CreateMarketRequest{
+		Book: entity.BookN("guild:1"),
+		Market: &api.Market{
+			Title:   "Will I PB?",
+			Creator: user.GetName(),
+			Type:    &api.Market_Pool{Pool: &api.Pool{Outcomes: []*api.Outcome{{Title: "Yes"}, {Title: "No"}}}},
+		},
+	}))
+	require.Nil(t, err)
+	market := created.Msg.GetMarket()
+	_, err = s.CreateBet(ctx, connect.NewRequest(&api.CreateBetRequest{
+		Book: entity.BookN("guild:1"),
+		Bet: &api.Bet{
+			User:        user.GetName(),
+			Market:      market.GetName(),
+			Centipoints: 100,
+			Type:        &api.Bet_Outcome{Outcome: market.GetPool().GetOutcomes()[0].GetName()},
+		},
+	}))
+	require.Nil(t, err)
+	_, err = s.LockMarket(ctx, connect.NewRequest(&api.LockMarketRequest{Name: market.GetName()}))
+	require.Nil(t, err)
+	_, err = s.SettleMarket(ctx, connect.NewRequest(&api.SettleMarketRequest{
+		Name: market.GetName(),
+		Type: &api.SettleMarketRequest_Winner{Winner: market.GetPool().GetOutcomes()[0].GetName()},
+	}))
+	require.Nil(t, err)
+
+	dispatcher, err := outbox.New(outbox.WithRepo(s.Repo), outbox.WithConsumer("webhooks", bettorwebhook.Consumer(s.Repo)))
+	require.Nil(t, err)
+	require.Nil(t, dispatcher.DeliverPending(ctx))
+	deliveries, _, err := s.Repo.ListDeliveries(ctx, &repo.ListDeliveriesArgs{Limit: 10})
+	require.Nil(t, err)
+	expected := []api.WebhookEvent_Type{
+		api.WebhookEvent_TYPE_MARKET_CREATED,
+		api.WebhookEvent_TYPE_BET_PLACED,
+		api.WebhookEvent_TYPE_MARKET_LOCKED,
+		api.WebhookEvent_TYPE_MARKET_SETTLED,
+	}
+	require.Len(t, deliveries, len(expected))
+	var got []api.WebhookEvent_Type
+	for _, d := range deliveries {
+		bookID, webhookID, _ := entity.DeliveryIDs(d.GetName())
+		assert.Equal(t, webhook.GetName(), entity.WebhookN(bookID, webhookID))
+		got = append(got, d.GetEvent().GetType())
+	}
+	assert.ElementsMatch(t, expected, got)
+	for _, d := range deliveries {
+		if d.GetEvent().GetType() == api.WebhookEvent_TYPE_MARKET_SETTLED {
+			assert.Equal(t, api.Market_STATUS_SETTLED, d.GetEvent().GetMarket().GetStatus())
+		}
+	}
+}
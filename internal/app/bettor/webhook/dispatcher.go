@@ -0,0 +1,198 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/go-kit/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Dispatcher defaults.
+const (
+	DefaultInterval      = 5 * time.Second
+	DefaultMinBackoff    = 10 * time.Second
+	DefaultMaxBackoff    = time.Hour
+	DefaultMaxAttempts   = 15 // ~6h of retries with the default backoff
+	deliveriesPerPoll    = 100
+	defaultClientTimeout = 10 * time.Second
+)
+
+// Dispatcher POSTs due deliveries to their webhooks. Deliveries that are not accepted with a 2xx response are retried
+// with exponential backoff and dropped after MaxAttempts. Deliveries are at-least-once since a delivery is only deleted
+// after it is accepted.
+type Dispatcher struct {
+	Repo        repo.Repo
+	Client      *http.Client
+	Logger      log.Logger
+	Interval    time.Duration
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+	MaxAttempts uint32
+}
+
+// New initializes a new Dispatcher.
+func New(args ...Arg) (*Dispatcher, error) {
+	dispatcherArgs := &dispatcherArgs{
+		client: &http.Client{Timeout: defaultClientTimeout},
+		logger: log.NewNopLogger(),
+	}
+	for _, arg := range args {
+		arg(dispatcherArgs)
+	}
+	if dispatcherArgs.repo == nil || dispatcherArgs.client == nil || dispatcherArgs.logger == nil {
+		return nil, fmt.Errorf("missing required arguments")
+	}
+	return &Dispatcher{
+		Repo:        dispatcherArgs.repo,
+		Client:      dispatcherArgs.client,
+		Logger:      dispatcherArgs.logger,
+		Interval:    DefaultInterval,
+		MinBackoff:  DefaultMinBackoff,
+		MaxBackoff:  DefaultMaxBackoff,
+		MaxAttempts: DefaultMaxAttempts,
+	}, nil
+}
+
+// Run delivers due deliveries every Interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+	for {
+		if err := d.DeliverDue(ctx); err != nil && ctx.Err() == nil {
+			d.Logger.Log("msg", "failed to deliver webhooks", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue attempts every delivery that is due.
+func (d *Dispatcher) DeliverDue(ctx context.Context) error {
+	now := time.Now()
+	for {
+		deliveries, hasMore, err := d.Repo.ListDeliveries(ctx, &repo.ListDeliveriesArgs{DueBefore: now, Limit: deliveriesPerPoll})
+		if err != nil {
+			return err
+		}
+		for _, delivery := range deliveries {
+			if err := d.attempt(ctx, delivery); err != nil {
+				return err
+			}
+		}
+		if !hasMore {
+			return nil
+		}
+	}
+}
+
+// attempt POSTs a delivery and then deletes or reschedules it. Only repo errors are returned.
+func (d *Dispatcher) attempt(ctx context.Context, delivery *api.WebhookDelivery) error {
+	bookID, webhookID, _ := entity.DeliveryIDs(delivery.GetName())
+	webhook, err := d.Repo.GetWebhook(ctx, entity.WebhookN(bookID, webhookID))
+	if connect.CodeOf(err) == connect.CodeNotFound {
+		return d.Repo.DeleteDelivery(ctx, delivery.GetName()) // webhook was deleted
+	}
+	if err != nil {
+		return err
+	}
+
+	err = d.post(ctx, webhook, delivery.GetEvent())
+	if err == nil {
+		return d.Repo.DeleteDelivery(ctx, delivery.GetName())
+	}
+	if ctx.Err() != nil {
+		return ctx.Err() // shutting down. not the receiver's fault
+	}
+	delivery.Attempts++
+	if delivery.GetAttempts() >= d.MaxAttempts {
+		d.Logger.Log("msg", "dropping webhook delivery after max attempts", "delivery", delivery.GetName(), "err", err)
+		return d.Repo.DeleteDelivery(ctx, delivery.GetName())
+	}
+	d.Logger.Log("msg", "webhook delivery failed", "delivery", delivery.GetName(), "attempts", delivery.GetAttempts(), "err", err)
+	delivery.NextAttemptAt = timestamppb.New(time.Now().Add(d.backoff(delivery.GetAttempts())))
+	return d.Repo.PutDelivery(ctx, delivery)
+}
+
+// backoff returns the delay before the next attempt after attempts failures. It doubles from MinBackoff up to
+// MaxBackoff.
+func (d *Dispatcher) backoff(attempts uint32) time.Duration {
+	delay := d.MinBackoff
+	for i := uint32(1); i < attempts && delay < d.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.MaxBackoff {
+		return d.MaxBackoff
+	}
+	return delay
+}
+
+// post sends a signed event to a webhook, returning an error unless the receiver responds with a 2xx status.
+func (d *Dispatcher) post(ctx context.Context, webhook *api.Webhook, event *api.WebhookEvent) error {
+	body, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.GetUrl(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, event.GetId())
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(webhook.GetSecret(), timestamp, body))
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body) // drain so the connection can be reused
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type dispatcherArgs struct {
+	repo   repo.Repo
+	client *http.Client
+	logger log.Logger
+}
+
+// Arg is an argument for constructing a Dispatcher.
+type Arg func(o *dispatcherArgs)
+
+// WithRepo provides a repo to the Dispatcher.
+func WithRepo(repo repo.Repo) Arg {
+	return Arg(func(a *dispatcherArgs) {
+		a.repo = repo
+	})
+}
+
+// WithHTTPClient provides the HTTP client used to POST deliveries.
+func WithHTTPClient(client *http.Client) Arg {
+	return Arg(func(a *dispatcherArgs) {
+		a.client = client
+	})
+}
+
+// WithLogger provides a logger to the Dispatcher.
+func WithLogger(logger log.Logger) Arg {
+	return Arg(func(a *dispatcherArgs) {
+		a.logger = logger
+	})
+}
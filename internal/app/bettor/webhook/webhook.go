@@ -0,0 +1,81 @@
+// Package webhook delivers book events to webhooks from a durable outbox of deliveries in the repo. Deliveries are
+// enqueued by consuming domain events from the outbox package.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/outbox"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+)
+
+// Request headers of a delivery. Receivers verify the signature with Sign and should reject stale timestamps.
+const (
+	EventIDHeader   = "Bettor-Webhook-Id"
+	TimestampHeader = "Bettor-Webhook-Timestamp"
+	SignatureHeader = "Bettor-Webhook-Signature"
+)
+
+// Sign returns the signature of a payload sent at timestamp, in unix seconds. It is the hex-encoded HMAC-SHA256 of
+// "<timestamp>.<body>" keyed by the webhook's secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Consumer returns an outbox consumer that enqueues webhook events for the domain events that have a webhook event
+// type. Other domain events are skipped.
+func Consumer(r repo.Repo) outbox.Handler {
+	return func(ctx context.Context, e *api.DomainEvent) error {
+		event := &api.WebhookEvent{Id: e.GetId(), CreatedAt: e.GetCreatedAt()}
+		switch p := e.GetPayload().(type) {
+		case *api.DomainEvent_MarketCreated:
+			event.Type, event.Resource = api.WebhookEvent_TYPE_MARKET_CREATED, &api.WebhookEvent_Market{Market: p.MarketCreated.GetMarket()}
+		case *api.DomainEvent_MarketLocked:
+			event.Type, event.Resource = api.WebhookEvent_TYPE_MARKET_LOCKED, &api.WebhookEvent_Market{Market: p.MarketLocked.GetMarket()}
+		case *api.DomainEvent_MarketSettled:
+			event.Type, event.Resource = api.WebhookEvent_TYPE_MARKET_SETTLED, &api.WebhookEvent_Market{Market: p.MarketSettled.GetMarket()}
+		case *api.DomainEvent_MarketCanceled:
+			event.Type, event.Resource = api.WebhookEvent_TYPE_MARKET_CANCELED, &api.WebhookEvent_Market{Market: p.MarketCanceled.GetMarket()}
+		case *api.DomainEvent_BetPlaced:
+			event.Type, event.Resource = api.WebhookEvent_TYPE_BET_PLACED, &api.WebhookEvent_Bet{Bet: p.BetPlaced.GetBet()}
+		default:
+			return nil
+		}
+		return Enqueue(ctx, r, e.GetBook(), event)
+	}
+}
+
+// Enqueue creates a delivery of an event for every webhook of a book. Deliveries are named by the event's id so
+// enqueueing an event again overwrites its pending deliveries.
+func Enqueue(ctx context.Context, r repo.Repo, book string, event *api.WebhookEvent) error {
+	var greaterThanName string
+	for {
+		webhooks, hasMore, err := r.ListWebhooks(ctx, &repo.ListWebhooksArgs{Book: book, GreaterThanName: greaterThanName, Limit: 100})
+		if err != nil {
+			return err
+		}
+		for _, w := range webhooks {
+			bookID, webhookID := entity.WebhookIDs(w.GetName())
+			if err := r.PutDelivery(ctx, &api.WebhookDelivery{
+				Name:          entity.DeliveryN(bookID, webhookID, event.GetId()),
+				Event:         event,
+				NextAttemptAt: event.GetCreatedAt(),
+			}); err != nil {
+				return err
+			}
+		}
+		if !hasMore {
+			return nil
+		}
+		greaterThanName = webhooks[len(webhooks)-1].GetName()
+	}
+}
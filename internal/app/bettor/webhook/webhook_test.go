@@ -0,0 +1,248 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/app/bettor/entity"
+	"github.com/elh/bettor/internal/app/bettor/repo"
+	"github.com/elh/bettor/internal/app/bettor/repo/mem"
+	"github.com/elh/bettor/internal/app/bettor/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// receiver is a webhook receiver that verifies signatures and responds with queued statuses, then 200s.
+type receiver struct {
+	t        *testing.T
+	secret   string
+	mtx      sync.Mutex
+	statuses []int
+	events   []*api.WebhookEvent
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	require.Nil(rc.t, err)
+	timestamp, err := strconv.ParseInt(r.Header.Get(webhook.TimestampHeader), 10, 64)
+	require.Nil(rc.t, err)
+	assert.Equal(rc.t, webhook.Sign(rc.secret, timestamp, body), r.Header.Get(webhook.SignatureHeader))
+	event := &api.WebhookEvent{}
+	require.Nil(rc.t, protojson.Unmarshal(body, event))
+	assert.Equal(rc.t, event.GetId(), r.Header.Get(webhook.EventIDHeader))
+
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	rc.events = append(rc.events, event)
+	status := http.StatusOK
+	if len(rc.statuses) > 0 {
+		status, rc.statuses = rc.statuses[0], rc.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func TestDeliverDue(t *testing.T) {
+	ctx := context.Background()
+	market := &api.Market{Name: entity.MarketN("guild:1", "a"), Title: "Will I PB?"}
+	event := &api.WebhookEvent{Id: "a", Type: api.WebhookEvent_TYPE_MARKET_CREATED, Resource: &api.WebhookEvent_Market{Market: market}}
+	delivery := &api.WebhookDelivery{Name: entity.DeliveryN("guild:1", "a", "a"), Event: event, NextAttemptAt: timestamppb.Now()}
+	testCases := []struct {
+		desc             string
+		deleteWebhook    bool
+		statuses         []int
+		maxAttempts      uint32
+		expectReceived   int
+		expectAttempts   uint32 // of the remaining delivery. 0 if it was deleted
+		expectNextWithin time.Duration
+	}{
+		{
+			desc:           "delivers and deletes delivery",
+			expectReceived: 1,
+		},
+		{
+			desc:           "accepts any 2xx",
+			statuses:       []int{http.StatusNoContent},
+			expectReceived: 1,
+		},
+		{
+			desc:             "reschedules failed delivery with backoff",
+			statuses:         []int{http.StatusInternalServerError},
+			expectReceived:   1,
+			expectAttempts:   1,
+			expectNextWithin: time.Minute,
+		},
+		{
+			desc:           "drops delivery after max attempts",
+			statuses:       []int{http.StatusInternalServerError},
+			maxAttempts:    1,
+			expectReceived: 1,
+		},
+		{
+			desc:          "drops delivery of deleted webhook",
+			deleteWebhook: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			rc := &receiver{t: t, secret: "shh", statuses: tC.statuses}
+			srv := httptest.NewServer(rc)
+			defer srv.Close()
+			r := &mem.Repo{Deliveries: []*api.WebhookDelivery{proto.Clone(delivery).(*api.WebhookDelivery)}}
+			if !tC.deleteWebhook {
+				r.Webhooks = []*api.Webhook{{Name: entity.WebhookN("guild:1", "a"), Url: srv.URL, Secret: rc.secret}}
+			}
+			d, err := webhook.New(webhook.WithRepo(r))
+			require.Nil(t, err)
+			d.MinBackoff = time.Minute
+			if tC.maxAttempts > 0 {
+				d.MaxAttempts = tC.maxAttempts
+			}
+
+			require.Nil(t, d.DeliverDue(ctx))
+			require.Len(t, rc.events, tC.expectReceived)
+			if tC.expectReceived > 0 {
+				assert.True(t, proto.Equal(event, rc.events[0]))
+			}
+			deliveries, _, err := r.ListDeliveries(ctx, &repo.ListDeliveriesArgs{Limit: 10})
+			require.Nil(t, err)
+			if tC.expectAttempts == 0 {
+				assert.Empty(t, deliveries)
+				return
+			}
+			require.Len(t, deliveries, 1)
+			assert.Equal(t, tC.expectAttempts, deliveries[0].GetAttempts())
+			assert.WithinDuration(t, time.Now().Add(tC.expectNextWithin), deliveries[0].GetNextAttemptAt().AsTime(), 5*time.Second)
+
+			// not due until the backoff has passed
+			require.Nil(t, d.DeliverDue(ctx))
+			assert.Len(t, rc.events, tC.expectReceived)
+		})
+	}
+}
+
+func TestRetryUntilAccepted(t *testing.T) {
+	ctx := context.Background()
+	rc := &receiver{t: t, secret: "shh", statuses: []int{http.StatusInternalServerError, http.StatusBadGateway}}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+	r := &mem.Repo{Webhooks: []*api.Webhook{{Name: entity.WebhookN("guild:1", "a"), Url: srv.URL, Secret: rc.secret}}}
+	require.Nil(t, webhook.Enqueue(ctx, r, entity.BookN("guild:1"), &api.WebhookEvent{Type: api.WebhookEvent_TYPE_BET_PLACED}))
+	d, err := webhook.New(webhook.WithRepo(r))
+	require.Nil(t, err)
+	d.MinBackoff = 0
+
+	for i := 0; i < 3; i++ {
+		require.Nil(t, d.DeliverDue(ctx))
+	}
+	require.Len(t, rc.events, 3)
+	assert.Equal(t, rc.events[0].GetId(), rc.events[2].GetId())
+	deliveries, _, err := r.ListDeliveries(ctx, &repo.ListDeliveriesArgs{Limit: 10})
+	require.Nil(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestEnqueue(t *testing.T) {
+	ctx := context.Background()
+	r := &mem.Repo{Webhooks: []*api.Webhook{
+		{Name: entity.WebhookN("guild:1", "a")},
+		{Name: entity.WebhookN("guild:1", "b")},
+		{Name: entity.WebhookN("guild:2", "a")},
+	}}
+	event := &api.WebhookEvent{Id: "a", Type: api.WebhookEvent_TYPE_MARKET_LOCKED, CreatedAt: timestamppb.Now()}
+	require.Nil(t, webhook.Enqueue(ctx, r, entity.BookN("guild:1"), event))
+
+	deliveries, _, err := r.ListDeliveries(ctx, &repo.ListDeliveriesArgs{Limit: 10})
+	require.Nil(t, err)
+	require.Len(t, deliveries, 2)
+	for i, webhookID := range []string{"a", "b"} {
+		assert.Equal(t, entity.DeliveryN("guild:1", webhookID, event.GetId()), deliveries[i].GetName())
+		assert.True(t, proto.Equal(event, deliveries[i].GetEvent()))
+	}
+}
+
+func TestConsumer(t *testing.T) {
+	market := &api.Market{Name: entity.MarketN("guild:1", "a")}
+	bet := &api.Bet{Name: entity.BetN("guild:1", "a")}
+	testCases := []struct {
+		desc     string
+		event    *api.DomainEvent
+		expected *api.WebhookEvent
+	}{
+		{
+			desc:     "market created",
+			event:    &api.DomainEvent{Payload: &api.DomainEvent_MarketCreated{MarketCreated: &api.MarketCreated{Market: market}}},
+			expected: &api.WebhookEvent{Type: api.WebhookEvent_TYPE_MARKET_CREATED, Resource: &api.WebhookEvent_Market{Market: market}},
+		},
+		{
+			desc:     "market settled",
+			event:    &api.DomainEvent{Payload: &api.DomainEvent_MarketSettled{MarketSettled: &api.MarketSettled{Market: market}}},
+			expected: &api.WebhookEvent{Type: api.WebhookEvent_TYPE_MARKET_SETTLED, Resource: &api.WebhookEvent_Market{Market: market}},
+		},
+		{
+			desc:     "bet placed",
+			event:    &api.DomainEvent{Payload: &api.DomainEvent_BetPlaced{BetPlaced: &api.BetPlaced{Bet: bet}}},
+			expected: &api.WebhookEvent{Type: api.WebhookEvent_TYPE_BET_PLACED, Resource: &api.WebhookEvent_Bet{Bet: bet}},
+		},
+		{
+			desc:  "skips events without a webhook event type",
+			event: &api.DomainEvent{Payload: &api.DomainEvent_BetSettled{BetSettled: &api.BetSettled{Bet: bet}}},
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			ctx := context.Background()
+			r := &mem.Repo{Webhooks: []*api.Webhook{{Name: entity.WebhookN("guild:1", "a")}}}
+			tC.event.Id = "a"
+			tC.event.Book = entity.BookN("guild:1")
+			tC.event.CreatedAt = timestamppb.Now()
+			require.Nil(t, webhook.Consumer(r)(ctx, tC.event))
+			// handling an event again is idempotent
+			require.Nil(t, webhook.Consumer(r)(ctx, tC.event))
+
+			deliveries, _, err := r.ListDeliveries(ctx, &repo.ListDeliveriesArgs{Limit: 10})
+			require.Nil(t, err)
+			if tC.expected == nil {
+				assert.Empty(t, deliveries)
+				return
+			}
+			tC.expected.Id = "a"
+			tC.expected.CreatedAt = tC.event.GetCreatedAt()
+			require.Len(t, deliveries, 1)
+			assert.Equal(t, entity.DeliveryN("guild:1", "a", "a"), deliveries[0].GetName())
+			assert.True(t, proto.Equal(tC.expected, deliveries[0].GetEvent()))
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d, err := webhook.New(webhook.WithRepo(&mem.Repo{}))
+	require.Nil(t, err)
+	d.MinBackoff, d.MaxBackoff = time.Second, 5*time.Second
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	require.Nil(t, d.Repo.CreateWebhook(context.Background(), &api.Webhook{Name: entity.WebhookN("guild:1", "a"), Url: srv.URL}))
+
+	name := entity.DeliveryN("guild:1", "a", "a")
+	for attempts, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		require.Nil(t, d.Repo.PutDelivery(context.Background(), &api.WebhookDelivery{Name: name, Attempts: uint32(attempts), NextAttemptAt: timestamppb.Now()}))
+		require.Nil(t, d.DeliverDue(context.Background()))
+		got, _, err := d.Repo.ListDeliveries(context.Background(), &repo.ListDeliveriesArgs{Limit: 1})
+		require.Nil(t, err)
+		require.Len(t, got, 1)
+		assert.WithinDuration(t, time.Now().Add(expected), got[0].GetNextAttemptAt().AsTime(), 500*time.Millisecond)
+	}
+}
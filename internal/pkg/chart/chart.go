@@ -0,0 +1,316 @@
+// Package chart renders line charts of values over time as PNG images. It is pure Go and draws text with a built-in
+// bitmap font.
+package chart
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"strconv"
+	"time"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// ErrNoData is returned when rendering a chart without any points.
+var ErrNoData = errors.New("chart has no data")
+
+const (
+	defaultWidth  = 800
+	defaultHeight = 400
+	padding       = 12
+	lineWidth     = 2
+	legendSwatch  = 10
+	yTicks        = 5
+	xTicks        = 4
+)
+
+var (
+	face       = basicfont.Face7x13
+	background = color.RGBA{0x31, 0x33, 0x38, 0xff} // Discord dark theme
+	foreground = color.RGBA{0xdb, 0xde, 0xe1, 0xff}
+	gridColor  = color.RGBA{0x4e, 0x50, 0x58, 0xff}
+
+	// Palette is the colors of series in order. Series beyond its length reuse colors.
+	Palette = []color.RGBA{
+		{0x58, 0x65, 0xf2, 0xff}, // blurple
+		{0xed, 0x42, 0x45, 0xff}, // red
+		{0x57, 0xf2, 0x87, 0xff}, // green
+		{0xfe, 0xe7, 0x5c, 0xff}, // yellow
+		{0xeb, 0x45, 0x9e, 0xff}, // fuchsia
+		{0xf4, 0x8c, 0x36, 0xff}, // orange
+		{0x3b, 0xc4, 0xe0, 0xff}, // cyan
+		{0xb7, 0x8c, 0xf2, 0xff}, // purple
+	}
+)
+
+// Point is a value at a time.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series is a named line of points sorted by time.
+type Series struct {
+	Name   string
+	Points []Point
+}
+
+// Chart is a line chart of series over time. Values change discretely so series are drawn as steps that hold each
+// value until the next point.
+type Chart struct {
+	Title  string
+	Series []Series
+	// End extends the last value of every series to End if it is after their last point.
+	End time.Time
+	// YMin and YMax fix the range of the y axis, e.g. 0 and 1 for probabilities. If they are equal, the range fits
+	// the data.
+	YMin, YMax float64
+	// FormatValue formats y axis labels. Defaults to the shortest decimal representation.
+	FormatValue func(float64) string
+	// Width and Height are the size of the image in pixels. Default to 800x400.
+	Width, Height int
+}
+
+// PNG renders the chart as a PNG image.
+func (c *Chart) PNG() ([]byte, error) {
+	img, err := c.Render()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Render renders the chart as an image.
+func (c *Chart) Render() (*image.RGBA, error) {
+	start, end, ok := c.timeRange()
+	if !ok {
+		return nil, ErrNoData
+	}
+	yMin, yMax := c.valueRange()
+	ticks := niceTicks(yMin, yMax, yTicks)
+	if c.YMin == c.YMax {
+		yMin, yMax = math.Min(yMin, ticks[0]), math.Max(yMax, ticks[len(ticks)-1])
+	}
+	formatValue := c.FormatValue
+	if formatValue == nil {
+		formatValue = func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	}
+
+	width, height := c.Width, c.Height
+	if width <= 0 || height <= 0 {
+		width, height = defaultWidth, defaultHeight
+	}
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+
+	lineHeight := face.Metrics().Height.Ceil()
+	top := padding
+	if c.Title != "" {
+		drawText(img, c.Title, padding, top, foreground)
+		top += lineHeight + padding/2
+	}
+	top = c.drawLegend(img, padding, top, width-padding) + padding
+
+	var labelWidth int
+	for _, tick := range ticks {
+		if w := textWidth(formatValue(tick)); w > labelWidth {
+			labelWidth = w
+		}
+	}
+	// y axis labels are centered on their ticks so leave room for half of one above the plot
+	plot := image.Rectangle{
+		Min: image.Pt(padding+labelWidth+padding/2, top+lineHeight/2),
+		Max: image.Pt(width-padding, height-padding-lineHeight-padding/2),
+	}
+	if plot.Empty() {
+		return nil, errors.New("chart is too small")
+	}
+	x := func(t time.Time) int {
+		if !end.After(start) {
+			return plot.Min.X
+		}
+		return plot.Min.X + int(math.Round(float64(t.Sub(start))/float64(end.Sub(start))*float64(plot.Dx()-1)))
+	}
+	y := func(v float64) int {
+		if yMax == yMin {
+			return plot.Min.Y + plot.Dy()/2
+		}
+		v = math.Max(yMin, math.Min(yMax, v))
+		return plot.Max.Y - 1 - int(math.Round((v-yMin)/(yMax-yMin)*float64(plot.Dy()-1)))
+	}
+
+	// y axis grid and labels
+	for _, tick := range ticks {
+		if tick < yMin || tick > yMax {
+			continue
+		}
+		ty := y(tick)
+		fill(img, image.Rect(plot.Min.X, ty, plot.Max.X, ty+1), gridColor)
+		label := formatValue(tick)
+		drawText(img, label, plot.Min.X-padding/2-textWidth(label), ty-lineHeight/2, foreground)
+	}
+	// x axis labels
+	layout := "Jan 2"
+	if end.Sub(start) < 48*time.Hour {
+		layout = "Jan 2 15:04"
+	}
+	n := xTicks
+	if !end.After(start) {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		t := start
+		if n > 1 {
+			t = start.Add(time.Duration(float64(end.Sub(start)) * float64(i) / float64(n-1)))
+		}
+		label := t.UTC().Format(layout)
+		lx := max(plot.Min.X, min(x(t)-textWidth(label)/2, plot.Max.X-textWidth(label)))
+		drawText(img, label, lx, plot.Max.Y+padding/2, foreground)
+	}
+	fill(img, image.Rect(plot.Min.X, plot.Max.Y-1, plot.Max.X, plot.Max.Y), foreground)
+
+	for i, s := range c.Series {
+		col := Palette[i%len(Palette)]
+		for j, p := range s.Points {
+			px, py := x(p.Time), y(p.Value)
+			next := px + lineWidth
+			if j+1 < len(s.Points) {
+				next = x(s.Points[j+1].Time)
+				// rise or fall to the next value
+				ny := y(s.Points[j+1].Value)
+				fill(img, image.Rect(next, min(py, ny), next+lineWidth, max(py, ny)+lineWidth), col)
+			} else if c.End.After(p.Time) {
+				next = x(c.End)
+			}
+			fill(img, image.Rect(px, py, next+lineWidth, py+lineWidth), col)
+		}
+	}
+	return img, nil
+}
+
+// timeRange returns the earliest and latest times of the chart.
+func (c *Chart) timeRange() (start, end time.Time, ok bool) {
+	for _, s := range c.Series {
+		for _, p := range s.Points {
+			if !ok || p.Time.Before(start) {
+				start = p.Time
+			}
+			if !ok || p.Time.After(end) {
+				end = p.Time
+			}
+			ok = true
+		}
+	}
+	if ok && c.End.After(end) {
+		end = c.End
+	}
+	return start, end, ok
+}
+
+// valueRange returns the range of the y axis.
+func (c *Chart) valueRange() (yMin, yMax float64) {
+	if c.YMin != c.YMax {
+		return c.YMin, c.YMax
+	}
+	yMin, yMax = math.Inf(1), math.Inf(-1)
+	for _, s := range c.Series {
+		for _, p := range s.Points {
+			yMin, yMax = math.Min(yMin, p.Value), math.Max(yMax, p.Value)
+		}
+	}
+	return yMin, yMax
+}
+
+// drawLegend draws a swatch and name for each named series, wrapping at right. It returns the y below the legend.
+func (c *Chart) drawLegend(img *image.RGBA, left, top, right int) int {
+	lineHeight := face.Metrics().Height.Ceil()
+	lx, drawn := left, false
+	for i, s := range c.Series {
+		if s.Name == "" {
+			continue
+		}
+		w := legendSwatch + padding/2 + textWidth(s.Name)
+		if drawn && lx+w > right {
+			lx, top = left, top+lineHeight+padding/2
+		}
+		swatchTop := top + (lineHeight-legendSwatch)/2
+		fill(img, image.Rect(lx, swatchTop, lx+legendSwatch, swatchTop+legendSwatch), Palette[i%len(Palette)])
+		drawText(img, s.Name, lx+legendSwatch+padding/2, top, foreground)
+		lx += w + padding
+		drawn = true
+	}
+	if !drawn {
+		return top - padding
+	}
+	return top + lineHeight
+}
+
+// niceTicks returns about n evenly spaced round numbers covering [lo, hi].
+func niceTicks(lo, hi float64, n int) []float64 {
+	if hi <= lo {
+		if lo == 0 {
+			return []float64{0, 1}
+		}
+		lo, hi = lo-math.Abs(lo)/2, hi+math.Abs(hi)/2
+	}
+	raw := (hi - lo) / float64(n-1)
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	step := magnitude * 10
+	for _, m := range []float64{1, 2, 2.5, 5, 10} {
+		if raw <= m*magnitude {
+			step = m * magnitude
+			break
+		}
+	}
+	var ticks []float64
+	for i := math.Floor(lo / step); ; i++ {
+		ticks = append(ticks, i*step)
+		if i*step >= hi {
+			return ticks
+		}
+	}
+}
+
+func fill(img *image.RGBA, r image.Rectangle, c color.Color) {
+	draw.Draw(img, r, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+// drawText draws text with its top left corner at x, y.
+func drawText(img *image.RGBA, text string, x, y int, c color.Color) {
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y+face.Metrics().Ascent.Ceil()),
+	}
+	d.DrawString(text)
+}
+
+func textWidth(text string) int {
+	return font.MeasureString(face, text).Ceil()
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,94 @@
+package chart_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/elh/bettor/internal/pkg/chart"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPNG(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	odds := []chart.Series{
+		{Name: "Yes", Points: []chart.Point{{Time: t0, Value: 1}, {Time: t0.Add(time.Hour), Value: 0.25}}},
+		{Name: "No", Points: []chart.Point{{Time: t0, Value: 0}, {Time: t0.Add(time.Hour), Value: 0.75}}},
+	}
+	testCases := []struct {
+		desc         string
+		chart        *chart.Chart
+		expectSize   image.Point
+		expectColors []color.RGBA
+		expectErr    bool
+	}{
+		{
+			desc:         "fixed range",
+			chart:        &chart.Chart{Title: "Will I PB?", Series: odds, YMin: 0, YMax: 1, End: t0.Add(2 * time.Hour)},
+			expectSize:   image.Pt(800, 400),
+			expectColors: []color.RGBA{chart.Palette[0], chart.Palette[1]},
+		},
+		{
+			desc: "fitted range",
+			chart: &chart.Chart{Series: []chart.Series{
+				{Points: []chart.Point{{Time: t0, Value: 1000}, {Time: t0.Add(48 * time.Hour), Value: 1300}, {Time: t0.Add(96 * time.Hour), Value: -50}}},
+			}},
+			expectSize:   image.Pt(800, 400),
+			expectColors: []color.RGBA{chart.Palette[0]},
+		},
+		{
+			desc:         "single point",
+			chart:        &chart.Chart{Series: []chart.Series{{Points: []chart.Point{{Time: t0, Value: 100}}}}},
+			expectSize:   image.Pt(800, 400),
+			expectColors: []color.RGBA{chart.Palette[0]},
+		},
+		{
+			desc:         "custom size",
+			chart:        &chart.Chart{Series: odds, Width: 300, Height: 200},
+			expectSize:   image.Pt(300, 200),
+			expectColors: []color.RGBA{chart.Palette[0], chart.Palette[1]},
+		},
+		{
+			desc:      "fails if there are no points",
+			chart:     &chart.Chart{Series: []chart.Series{{Name: "Yes"}}},
+			expectErr: true,
+		},
+		{
+			desc:      "fails if too small",
+			chart:     &chart.Chart{Series: odds, Width: 10, Height: 10},
+			expectErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			b, err := tC.chart.PNG()
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			img, err := png.Decode(bytes.NewReader(b))
+			require.Nil(t, err)
+			assert.Equal(t, tC.expectSize, img.Bounds().Size())
+			for _, c := range tC.expectColors {
+				assert.True(t, hasColor(img, c), "expected color %v to be drawn", c)
+			}
+		})
+	}
+}
+
+func hasColor(img image.Image, c color.RGBA) bool {
+	for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+		for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+			if color.RGBAModel.Convert(img.At(x, y)) == c {
+				return true
+			}
+		}
+	}
+	return false
+}
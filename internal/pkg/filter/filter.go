@@ -0,0 +1,304 @@
+// Package filter implements a subset of AIP-160 filter expressions (https://google.aip.dev/160) over proto messages.
+//
+// Supported are AND, OR, NOT (or "-"), parentheses, and restrictions of the form `field op value` where op is one of
+// = != < <= > >= :. Fields are proto field names and may traverse singular message fields with ".". Timestamp fields
+// may also be named as AIP-142 names them (create_time for created_at), as order_by does. Values are bare
+// words or quoted strings. Enum values may omit their type prefix (status = OPEN), and timestamps are RFC 3339 or
+// dates (created_at > "2026-01-01"). `field:*` matches if the field is set. Filters can also be translated to SQL
+// predicates over columns that hold their fields.
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Filter is a parsed filter expression. A nil Filter matches everything.
+type Filter struct {
+	text string
+	root node
+}
+
+// Parse parses a filter expression for messages described by md. Unknown fields and values of the wrong type are
+// errors. An empty filter parses to nil.
+func Parse(filter string, md protoreflect.MessageDescriptor) (*Filter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	tokens, err := lex(filter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	p := &parser{tokens: tokens, compile: func(field, op string, value token) (node, error) {
+		return compile(md, field, op, value)
+	}}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	if t := p.next(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("invalid filter: unexpected %v", t)
+	}
+	return &Filter{text: filter, root: root}, nil
+}
+
+// Match returns whether msg matches the filter.
+func (f *Filter) Match(msg proto.Message) bool {
+	if f == nil {
+		return true
+	}
+	return f.root.match(msg.ProtoReflect())
+}
+
+// String returns the filter expression.
+func (f *Filter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.text
+}
+
+type node interface {
+	match(m protoreflect.Message) bool
+	sql(columns map[string]string) (expr sqlExpr, ok bool)
+}
+
+type andNode []node
+
+func (n andNode) match(m protoreflect.Message) bool {
+	for _, c := range n {
+		if !c.match(m) {
+			return false
+		}
+	}
+	return true
+}
+
+type orNode []node
+
+func (n orNode) match(m protoreflect.Message) bool {
+	for _, c := range n {
+		if c.match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+type notNode struct {
+	n node
+}
+
+func (n notNode) match(m protoreflect.Message) bool {
+	return !n.n.match(m)
+}
+
+// restriction compares the field at path to a value. If present is set, it instead checks that the field is set.
+// field is the path as proto field names and value is the compared value as stored in an SQL column.
+type restriction struct {
+	field   string
+	path    []protoreflect.FieldDescriptor
+	present bool
+	op      string
+	compare func(v protoreflect.Value) int
+	value   any
+}
+
+func (r restriction) match(m protoreflect.Message) bool {
+	leaf := r.path[len(r.path)-1]
+	for _, fd := range r.path[:len(r.path)-1] {
+		if r.present && !m.Has(fd) {
+			return false
+		}
+		m = m.Get(fd).Message()
+	}
+	if r.present {
+		return m.Has(leaf)
+	}
+	if leaf.Kind() == protoreflect.MessageKind && !m.Has(leaf) {
+		return r.op == "!=" // unset timestamps only match !=
+	}
+	c := r.compare(m.Get(leaf))
+	switch r.op {
+	case "=", ":":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+// aipNames maps AIP-142 names of timestamp fields to the proto field names they refer to.
+var aipNames = map[string]protoreflect.Name{
+	"create_time":  "created_at",
+	"update_time":  "updated_at",
+	"settle_time":  "settled_at",
+	"settled_time": "settled_at",
+}
+
+func compile(md protoreflect.MessageDescriptor, field, op string, value token) (node, error) {
+	var path []protoreflect.FieldDescriptor
+	segments := strings.Split(field, ".")
+	for i, seg := range segments {
+		if md == nil {
+			return nil, fmt.Errorf("field %q is not a message", strings.Join(segments[:i], "."))
+		}
+		fd := md.Fields().ByName(protoreflect.Name(seg))
+		if name, ok := aipNames[seg]; fd == nil && ok {
+			fd = md.Fields().ByName(name)
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %q", field)
+		}
+		segments[i] = string(fd.Name())
+		path = append(path, fd)
+		md = nil
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			md = fd.Message()
+		}
+	}
+	name := strings.Join(segments, ".") // field as proto field names
+	if op == ":" && value.kind == tokenWord && value.text == "*" {
+		return restriction{field: name, path: path, present: true}, nil
+	}
+
+	fd := path[len(path)-1]
+	if fd.IsList() || fd.IsMap() {
+		return nil, fmt.Errorf("field %q is repeated and only supports \":*\"", field)
+	}
+	invalid := func() error {
+		return fmt.Errorf("invalid value %q for field %q", value.text, field)
+	}
+	ordered := true
+	var compare func(v protoreflect.Value) int
+	var sqlValue any
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		compare = func(v protoreflect.Value) int { return strings.Compare(v.String(), value.text) }
+		sqlValue = value.text
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value.text)
+		if err != nil {
+			return nil, invalid()
+		}
+		ordered = false
+		compare = func(v protoreflect.Value) int { return cmp(boolInt(v.Bool()), boolInt(b)) }
+		sqlValue = boolInt(b)
+	case protoreflect.EnumKind:
+		n, ok := enumNumber(fd.Enum(), value.text)
+		if !ok {
+			return nil, invalid()
+		}
+		ordered = false
+		compare = func(v protoreflect.Value) int { return cmp(v.Enum(), n) }
+		sqlValue = int32(n)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(value.text, 10, 64)
+		if err != nil {
+			return nil, invalid()
+		}
+		compare = func(v protoreflect.Value) int { return cmp(v.Int(), n) }
+		sqlValue = n
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(value.text, 10, 64)
+		if err != nil {
+			return nil, invalid()
+		}
+		compare = func(v protoreflect.Value) int { return cmp(v.Uint(), n) }
+		sqlValue = int64(n)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		n, err := strconv.ParseFloat(value.text, 64)
+		if err != nil {
+			return nil, invalid()
+		}
+		compare = func(v protoreflect.Value) int { return cmp(v.Float(), n) }
+		sqlValue = n
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() != "google.protobuf.Timestamp" {
+			return nil, fmt.Errorf("field %q is a message and only supports \":*\"", field)
+		}
+		t, err := parseTime(value.text)
+		if err != nil {
+			return nil, invalid()
+		}
+		seconds, nanos := fd.Message().Fields().ByName("seconds"), fd.Message().Fields().ByName("nanos")
+		compare = func(v protoreflect.Value) int {
+			m := v.Message()
+			ts := time.Unix(m.Get(seconds).Int(), m.Get(nanos).Int())
+			switch {
+			case ts.Before(t):
+				return -1
+			case ts.After(t):
+				return 1
+			}
+			return 0
+		}
+		sqlValue = t
+	default:
+		return nil, fmt.Errorf("field %q cannot be filtered", field)
+	}
+	if !ordered && op != "=" && op != "!=" && op != ":" {
+		return nil, fmt.Errorf("field %q does not support %q", field, op)
+	}
+	return restriction{field: name, path: path, op: op, compare: compare, value: sqlValue}, nil
+}
+
+// enumNumber resolves an enum value by its full name or by its name without the type prefix, e.g. STATUS_OPEN or
+// OPEN.
+func enumNumber(ed protoreflect.EnumDescriptor, name string) (protoreflect.EnumNumber, bool) {
+	if v := ed.Values().ByName(protoreflect.Name(name)); v != nil {
+		return v.Number(), true
+	}
+	var found protoreflect.EnumValueDescriptor
+	for i := 0; i < ed.Values().Len(); i++ {
+		v := ed.Values().Get(i)
+		if strings.HasSuffix(string(v.Name()), "_"+name) {
+			if found != nil {
+				return 0, false // ambiguous
+			}
+			found = v
+		}
+	}
+	if found == nil {
+		return 0, false
+	}
+	return found.Number(), true
+}
+
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", s)
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func cmp[T int | int64 | uint64 | float64 | protoreflect.EnumNumber](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
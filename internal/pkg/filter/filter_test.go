@@ -0,0 +1,193 @@
+package filter_test
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/pkg/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFilter(t *testing.T) {
+	market := &api.Market{
+		Name:      "books/guild:1/markets/a",
+		CreatedAt: timestamppb.New(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)),
+		Title:     "Will it rain?",
+		Creator:   "books/guild:1/users/a",
+		Status:    api.Market_STATUS_OPEN,
+		Type: &api.Market_Pool{Pool: &api.Pool{
+			Outcomes: []*api.Outcome{{Name: "books/guild:1/markets/a/outcomes/1", Title: "Yes", Centipoints: 100}},
+		}},
+	}
+	testCases := []struct {
+		desc      string
+		filter    string
+		expected  bool
+		expectErr bool
+	}{
+		{desc: "empty", filter: "", expected: true},
+		{desc: "enum without prefix", filter: "status = OPEN", expected: true},
+		{desc: "enum with prefix", filter: "status = STATUS_OPEN", expected: true},
+		{desc: "enum not equal", filter: "status != OPEN", expected: false},
+		{desc: "enum other value", filter: "status = SETTLED", expected: false},
+		{desc: "quoted string", filter: `creator = "books/guild:1/users/a"`, expected: true},
+		{desc: "single quoted string", filter: `creator = 'books/guild:1/users/b'`, expected: false},
+		{desc: "escaped quote", filter: `title = "Will it \"rain\"?"`, expected: false},
+		{desc: "date comparison", filter: `created_at > "2026-01-01"`, expected: true},
+		{desc: "rfc3339 comparison", filter: `created_at < "2026-02-01T00:00:01Z"`, expected: true},
+		{desc: "unset timestamp does not match comparison", filter: `settled_at < "2030-01-01"`, expected: false},
+		{desc: "unset timestamp matches not equal", filter: `settled_at != "2030-01-01"`, expected: true},
+		{desc: "presence", filter: "created_at:*", expected: true},
+		{desc: "absence", filter: "NOT settled_at:*", expected: true},
+		{desc: "absence with minus", filter: "-settled_at:*", expected: true},
+		{desc: "repeated presence", filter: "pool.outcomes:*", expected: true},
+		{desc: "nested field", filter: `pool.winner = ""`, expected: true},
+		{desc: "and", filter: `status = OPEN AND creator = "books/guild:1/users/a" AND created_at > "2026-01-01"`, expected: true},
+		{desc: "and fails", filter: `status = OPEN AND creator = "books/guild:1/users/b"`, expected: false},
+		{desc: "implicit and", filter: `status = OPEN creator = "books/guild:1/users/b"`, expected: false},
+		{desc: "or", filter: "status = SETTLED OR status = OPEN", expected: true},
+		{desc: "or binds tighter than and", filter: `creator = "x" OR status = OPEN AND status = OPEN`, expected: true},
+		{desc: "parentheses", filter: `NOT (status = SETTLED OR status = CANCELED)`, expected: true},
+		{desc: "string ordering", filter: `title >= "Will"`, expected: true},
+		{desc: "fails on unknown field", filter: "foo = 1", expectErr: true},
+		{desc: "fails on unknown nested field", filter: "pool.foo = 1", expectErr: true},
+		{desc: "fails on traversing a scalar", filter: "title.foo = 1", expectErr: true},
+		{desc: "fails on invalid enum", filter: "status = BAD", expectErr: true},
+		{desc: "fails on invalid timestamp", filter: `created_at > "yesterday"`, expectErr: true},
+		{desc: "fails on ordering enums", filter: "status > OPEN", expectErr: true},
+		{desc: "fails on repeated comparison", filter: "pool.outcomes = 1", expectErr: true},
+		{desc: "fails on missing comparator", filter: "status", expectErr: true},
+		{desc: "fails on missing value", filter: "status =", expectErr: true},
+		{desc: "fails on unbalanced parentheses", filter: "(status = OPEN", expectErr: true},
+		{desc: "fails on trailing parenthesis", filter: "status = OPEN)", expectErr: true},
+		{desc: "fails on unterminated string", filter: `title = "abc`, expectErr: true},
+		{desc: "fails on dangling and", filter: "status = OPEN AND", expectErr: true},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			f, err := filter.Parse(tC.filter, market.ProtoReflect().Descriptor())
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tC.expected, f.Match(market))
+		})
+	}
+}
+
+func TestFilterNumbers(t *testing.T) {
+	bet := &api.Bet{Name: "books/guild:1/bets/a", Centipoints: 500}
+	testCases := []struct {
+		filter    string
+		expected  bool
+		expectErr bool
+	}{
+		{filter: "centipoints = 500", expected: true},
+		{filter: "centipoints > 100 AND centipoints <= 500", expected: true},
+		{filter: "centipoints < 500", expected: false},
+		{filter: "settled_centipoints = 0", expected: true},
+		{filter: "centipoints = -1", expectErr: true},
+		{filter: "centipoints = abc", expectErr: true},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.filter, func(t *testing.T) {
+			f, err := filter.Parse(tC.filter, bet.ProtoReflect().Descriptor())
+			if tC.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tC.expected, f.Match(bet))
+		})
+	}
+}
+
+func TestFilterSQL(t *testing.T) {
+	columns := map[string]string{"status": "status", "creator": "creator", "created_at": "created_at"}
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()
+	testCases := []struct {
+		desc           string
+		filter         string
+		expectedWhere  string
+		expectedParams []any
+		expectedExact  bool
+	}{
+		{desc: "empty", filter: "", expectedExact: true},
+		{desc: "enum", filter: "status = OPEN", expectedWhere: "status = ?", expectedParams: []any{int32(api.Market_STATUS_OPEN)}, expectedExact: true},
+		{desc: "has", filter: `creator:"a"`, expectedWhere: "creator = ?", expectedParams: []any{"a"}, expectedExact: true},
+		{desc: "presence", filter: "created_at:*", expectedWhere: "created_at != 0", expectedExact: true},
+		{desc: "timestamp comparison skips unset", filter: `created_at > "2026-01-01"`, expectedWhere: "(created_at != 0 AND created_at > ?)", expectedParams: []any{day}, expectedExact: true},
+		{desc: "timestamp not equal matches unset", filter: `created_at != "2026-01-01"`, expectedWhere: "(created_at = 0 OR created_at != ?)", expectedParams: []any{day}, expectedExact: true},
+		{
+			desc:           "and",
+			filter:         `status = OPEN AND creator = "a"`,
+			expectedWhere:  "(status = ? AND creator = ?)",
+			expectedParams: []any{int32(api.Market_STATUS_OPEN), "a"},
+			expectedExact:  true,
+		},
+		{
+			desc:           "or",
+			filter:         `status = OPEN OR creator = "a"`,
+			expectedWhere:  "(status = ? OR creator = ?)",
+			expectedParams: []any{int32(api.Market_STATUS_OPEN), "a"},
+			expectedExact:  true,
+		},
+		{desc: "not", filter: `NOT creator = "a"`, expectedWhere: "NOT creator = ?", expectedParams: []any{"a"}, expectedExact: true},
+		{desc: "and drops fields without columns", filter: `status = OPEN AND title = "a"`, expectedWhere: "(status = ?)", expectedParams: []any{int32(api.Market_STATUS_OPEN)}},
+		{desc: "or with a field without a column", filter: `status = OPEN OR title = "a"`},
+		{desc: "not of a loose predicate", filter: `NOT (status = OPEN AND title = "a")`},
+		{desc: "field without a column", filter: `title = "a"`},
+		{desc: "timestamp before the epoch", filter: `created_at > "1969-01-01"`},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			f, err := filter.Parse(tC.filter, (&api.Market{}).ProtoReflect().Descriptor())
+			require.Nil(t, err)
+			where, params, exact := f.SQL(columns)
+			assert.Equal(t, tC.expectedWhere, where)
+			assert.Equal(t, tC.expectedParams, params)
+			assert.Equal(t, tC.expectedExact, exact)
+		})
+	}
+}
+
+func TestFilterAIPNames(t *testing.T) {
+	market := &api.Market{
+		Name:      "books/x/markets/a",
+		CreatedAt: timestamppb.New(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt: timestamppb.New(time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)),
+		Creator:   "books/x/users/y",
+		Status:    api.Market_STATUS_OPEN,
+	}
+	testCases := []struct {
+		filter   string
+		expected bool
+	}{
+		{filter: `status = OPEN AND creator = "books/x/users/y" AND create_time > "2026-01-01"`, expected: true},
+		{filter: `update_time > "2026-02-01T12:00:00Z"`, expected: true},
+		{filter: "settle_time:*", expected: false},
+		{filter: `settled_time < "2030-01-01"`, expected: false},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.filter, func(t *testing.T) {
+			f, err := filter.Parse(tC.filter, market.ProtoReflect().Descriptor())
+			require.Nil(t, err)
+			assert.Equal(t, tC.expected, f.Match(market))
+		})
+	}
+	t.Run("translates to columns by proto field name", func(t *testing.T) {
+		f, err := filter.Parse(`create_time > "2026-01-01"`, market.ProtoReflect().Descriptor())
+		require.Nil(t, err)
+		where, _, exact := f.SQL(map[string]string{"created_at": "created_at"})
+		assert.Equal(t, "(created_at != 0 AND created_at > ?)", where)
+		assert.True(t, exact)
+	})
+}
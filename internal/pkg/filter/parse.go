@@ -0,0 +1,227 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenComparator
+	tokenLParen
+	tokenRParen
+	tokenMinus
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of filter"
+	}
+	return fmt.Sprintf("%q at position %d", t.text, t.pos)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.*-", r)
+}
+
+func lex(filter string) ([]token, error) {
+	var tokens []token
+	rs := []rune(filter)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case r == '=' || r == ':':
+			tokens = append(tokens, token{kind: tokenComparator, text: string(r), pos: i})
+			i++
+		case r == '<' || r == '>' || r == '!':
+			text := string(r)
+			if i+1 < len(rs) && rs[i+1] == '=' {
+				text += "="
+			}
+			if text == "!" {
+				return nil, fmt.Errorf("unexpected %q at position %d", "!", i)
+			}
+			tokens = append(tokens, token{kind: tokenComparator, text: text, pos: i})
+			i += len(text)
+		case r == '-' && (i+1 >= len(rs) || !unicode.IsDigit(rs[i+1])):
+			tokens = append(tokens, token{kind: tokenMinus, text: "-", pos: i})
+			i++
+		case r == '"' || r == '\'':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(rs) && rs[j] != r; j++ {
+				if rs[j] == '\\' && j+1 < len(rs) {
+					j++
+				}
+				sb.WriteRune(rs[j])
+			}
+			if j >= len(rs) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: sb.String(), pos: i})
+			i = j + 1
+		case isWordRune(r):
+			j := i
+			for j < len(rs) && isWordRune(rs[j]) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: string(rs[i:j]), pos: i})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", r, i)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(rs)}), nil
+}
+
+// parser is a recursive descent parser for the AIP-160 grammar. Note that OR binds tighter than AND:
+//
+//	expression  = sequence {"AND" sequence}
+//	sequence    = factor {factor}
+//	factor      = term {"OR" term}
+//	term        = ["NOT" | "-"] simple
+//	simple      = restriction | "(" expression ")"
+//	restriction = field comparator value
+type parser struct {
+	tokens  []token
+	pos     int
+	compile func(field, op string, value token) (node, error)
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenWord && t.text == keyword
+}
+
+func (p *parser) parseExpression() (node, error) {
+	n, err := p.parseSequence()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []node{n}
+	for p.isKeyword("AND") {
+		p.next()
+		n, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return andNode(nodes), nil
+}
+
+func (p *parser) parseSequence() (node, error) {
+	n, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []node{n}
+	for {
+		t := p.peek()
+		if t.kind == tokenEOF || t.kind == tokenRParen || p.isKeyword("AND") {
+			break
+		}
+		n, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return andNode(nodes), nil
+}
+
+func (p *parser) parseFactor() (node, error) {
+	n, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []node{n}
+	for p.isKeyword("OR") {
+		p.next()
+		n, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return orNode(nodes), nil
+}
+
+func (p *parser) parseTerm() (node, error) {
+	if p.isKeyword("NOT") || p.peek().kind == tokenMinus {
+		p.next()
+		n, err := p.parseSimple()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{n}, nil
+	}
+	return p.parseSimple()
+}
+
+func (p *parser) parseSimple() (node, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokenLParen:
+		n, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokenRParen {
+			return nil, fmt.Errorf("expected \")\" but got %v", t)
+		}
+		return n, nil
+	case t.kind == tokenWord && t.text != "AND" && t.text != "OR" && t.text != "NOT":
+		op := p.next()
+		if op.kind != tokenComparator {
+			return nil, fmt.Errorf("expected comparator after %q but got %v", t.text, op)
+		}
+		value := p.next()
+		if value.kind != tokenWord && value.kind != tokenString {
+			return nil, fmt.Errorf("expected value after %q but got %v", t.text+" "+op.text, value)
+		}
+		return p.compile(t.text, op.text, value)
+	default:
+		return nil, fmt.Errorf("expected field or \"(\" but got %v", t)
+	}
+}
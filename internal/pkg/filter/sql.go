@@ -0,0 +1,109 @@
+package filter
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// sqlExpr is a filter translated to an SQL predicate. It is exact if it matches the same rows as the filter, else it
+// matches a superset of them.
+type sqlExpr struct {
+	text   string
+	params []any
+	exact  bool
+}
+
+// SQL translates the filter to an SQL predicate for a WHERE clause. columns maps field paths to the columns that hold
+// them: strings and numbers as is, enums as their numbers, bools as 0 or 1, and timestamps as unix nanos with 0 if
+// unset. Timestamps before the epoch are not supported. Restrictions on other fields are left out so the predicate may
+// match more rows than the filter. If exact is false, rows must still be matched with Match. A nil filter or one with
+// no translatable restrictions returns "".
+func (f *Filter) SQL(columns map[string]string) (where string, params []any, exact bool) {
+	if f == nil {
+		return "", nil, true
+	}
+	expr, ok := f.root.sql(columns)
+	if !ok {
+		return "", nil, false
+	}
+	return expr.text, expr.params, expr.exact
+}
+
+func (n andNode) sql(columns map[string]string) (sqlExpr, bool) {
+	var texts []string
+	out := sqlExpr{exact: true}
+	for _, c := range n {
+		expr, ok := c.sql(columns)
+		if !ok {
+			// dropping a conjunct loosens the predicate
+			out.exact = false
+			continue
+		}
+		texts = append(texts, expr.text)
+		out.params = append(out.params, expr.params...)
+		out.exact = out.exact && expr.exact
+	}
+	if len(texts) == 0 {
+		return sqlExpr{}, false
+	}
+	out.text = "(" + strings.Join(texts, " AND ") + ")"
+	return out, true
+}
+
+func (n orNode) sql(columns map[string]string) (sqlExpr, bool) {
+	var texts []string
+	out := sqlExpr{exact: true}
+	for _, c := range n {
+		expr, ok := c.sql(columns)
+		if !ok {
+			return sqlExpr{}, false
+		}
+		texts = append(texts, expr.text)
+		out.params = append(out.params, expr.params...)
+		out.exact = out.exact && expr.exact
+	}
+	out.text = "(" + strings.Join(texts, " OR ") + ")"
+	return out, true
+}
+
+func (n notNode) sql(columns map[string]string) (sqlExpr, bool) {
+	// the negation of a looser predicate is stricter, so only exact predicates can be negated
+	expr, ok := n.n.sql(columns)
+	if !ok || !expr.exact {
+		return sqlExpr{}, false
+	}
+	return sqlExpr{text: "NOT " + expr.text, params: expr.params, exact: true}, true
+}
+
+func (r restriction) sql(columns map[string]string) (sqlExpr, bool) {
+	column, ok := columns[r.field]
+	if !ok {
+		return sqlExpr{}, false
+	}
+	leaf := r.path[len(r.path)-1]
+	if r.present {
+		if leaf.Kind() == protoreflect.StringKind {
+			return sqlExpr{text: column + " != ''", exact: true}, true
+		}
+		return sqlExpr{text: column + " != 0", exact: true}, true
+	}
+	op := r.op
+	if op == ":" {
+		op = "="
+	}
+	t, ok := r.value.(time.Time)
+	if !ok {
+		return sqlExpr{text: column + " " + op + " ?", params: []any{r.value}, exact: true}, true
+	}
+	// unset timestamps are stored as 0 and only match !=. times before the epoch cannot be told apart from unset
+	nanos := t.UnixNano()
+	if nanos <= 0 {
+		return sqlExpr{}, false
+	}
+	if op == "!=" {
+		return sqlExpr{text: "(" + column + " = 0 OR " + column + " != ?)", params: []any{nanos}, exact: true}, true
+	}
+	return sqlExpr{text: "(" + column + " != 0 AND " + column + " " + op + " ?)", params: []any{nanos}, exact: true}, true
+}
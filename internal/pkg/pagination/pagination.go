@@ -2,31 +2,122 @@ package pagination
 
 import (
 	"bytes"
-	"encoding/gob"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"time"
 
+	"github.com/bufbuild/connect-go"
 	"google.golang.org/protobuf/proto"
 )
 
+// DefaultTTL is how long page tokens are valid for by default.
+const DefaultTTL = 24 * time.Hour
+
+// token layout: version, expiry as unix seconds, request digest, cursor, and an HMAC-SHA256 over all of it.
+const (
+	tokenVersion = 1
+	versionSize  = 1
+	expirySize   = 8
+	digestSize   = sha256.Size
+	macSize      = sha256.Size
+	headerSize   = versionSize + expirySize + digestSize
+)
+
+var (
+	// ErrInvalidToken is returned for page tokens that are malformed, tampered with, or signed with another key.
+	ErrInvalidToken = errors.New("invalid page token")
+	// ErrExpiredToken is returned for page tokens past their expiry.
+	ErrExpiredToken = errors.New("page token has expired")
+	// ErrMismatchedToken is returned for page tokens used with a different list request than they were issued for.
+	ErrMismatchedToken = errors.New("page token does not match request")
+)
+
 // Pagination is a helper struct for implementing cursor-based pagination.
 type Pagination struct {
-	Cursor      string
+	Cursor string
+	// ListRequest is the list request with page size and page token cleared. Tokens only carry a digest of it and are
+	// only valid for the same request.
 	ListRequest proto.Message
 }
 
+// Codec encodes and decodes page tokens. Tokens are opaque, URL-safe, versioned, signed with Key, and expire after
+// TTL.
+type Codec struct {
+	Key []byte
+	TTL time.Duration
+	Now func() time.Time // defaults to time.Now
+}
+
+// NewCodec initializes a Codec with the default TTL.
+func NewCodec(key []byte) *Codec {
+	return &Codec{Key: key, TTL: DefaultTTL}
+}
+
 // ToToken returns a next page token.
-func ToToken(p Pagination) (string, error) {
-	var buf bytes.Buffer
-	if err := gob.NewEncoder(&buf).Encode(p); err != nil {
+func (c *Codec) ToToken(p Pagination) (string, error) {
+	digest, err := requestDigest(p.ListRequest)
+	if err != nil {
 		return "", err
 	}
-	return buf.String(), nil
+	buf := make([]byte, headerSize, headerSize+len(p.Cursor)+macSize)
+	buf[0] = tokenVersion
+	binary.BigEndian.PutUint64(buf[versionSize:], uint64(c.now().Add(c.TTL).Unix()))
+	copy(buf[versionSize+expirySize:], digest)
+	buf = append(buf, p.Cursor...)
+	buf = append(buf, c.mac(buf)...)
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// FromToken returns a Pagination struct from a next page token. listRequest is the list request with page size and
+// page token cleared and must match the one the token was issued for. Errors are connect InvalidArgument errors.
+func (c *Codec) FromToken(token string, listRequest proto.Message) (Pagination, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) < headerSize+macSize || buf[0] != tokenVersion {
+		return Pagination{}, connect.NewError(connect.CodeInvalidArgument, ErrInvalidToken)
+	}
+	body, mac := buf[:len(buf)-macSize], buf[len(buf)-macSize:]
+	if !hmac.Equal(mac, c.mac(body)) {
+		return Pagination{}, connect.NewError(connect.CodeInvalidArgument, ErrInvalidToken)
+	}
+	expiry := time.Unix(int64(binary.BigEndian.Uint64(body[versionSize:])), 0)
+	if !c.now().Before(expiry) {
+		return Pagination{}, connect.NewError(connect.CodeInvalidArgument, ErrExpiredToken)
+	}
+	digest, err := requestDigest(listRequest)
+	if err != nil {
+		return Pagination{}, connect.NewError(connect.CodeInternal, err)
+	}
+	if !bytes.Equal(digest, body[versionSize+expirySize:headerSize]) {
+		return Pagination{}, connect.NewError(connect.CodeInvalidArgument, ErrMismatchedToken)
+	}
+	return Pagination{Cursor: string(body[headerSize:]), ListRequest: listRequest}, nil
+}
+
+func (c *Codec) mac(body []byte) []byte {
+	h := hmac.New(sha256.New, c.Key)
+	h.Write(body)
+	return h.Sum(nil)
+}
+
+func (c *Codec) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
 }
 
-// FromToken returns a Pagination struct from a next page token.
-func FromToken(token string) (Pagination, error) {
-	var p Pagination
-	if err := gob.NewDecoder(bytes.NewBufferString(token)).Decode(&p); err != nil {
-		return Pagination{}, err
+// requestDigest identifies a list request by its type and contents.
+func requestDigest(listRequest proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(listRequest)
+	if err != nil {
+		return nil, err
 	}
-	return p, nil
+	h := sha256.New()
+	h.Write([]byte(listRequest.ProtoReflect().Descriptor().FullName()))
+	h.Write([]byte{0})
+	h.Write(data)
+	return h.Sum(nil), nil
 }
@@ -0,0 +1,117 @@
+package pagination_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	api "github.com/elh/bettor/api/bettor/v1alpha"
+	"github.com/elh/bettor/internal/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTokens(t *testing.T) {
+	now := time.Unix(1680000000, 0)
+	codec := &pagination.Codec{Key: []byte("secret"), TTL: time.Hour, Now: func() time.Time { return now }}
+	req := &api.ListBetsRequest{Book: "books/guild:1", User: "books/guild:1/users/a"}
+	token, err := codec.ToToken(pagination.Pagination{Cursor: "books/guild:1/bets/b", ListRequest: req})
+	require.Nil(t, err)
+
+	tamper := func(token string, i int) string {
+		b, err := base64.RawURLEncoding.DecodeString(token)
+		require.Nil(t, err)
+		b[i] ^= 0x01
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	testCases := []struct {
+		desc        string
+		token       string
+		codec       *pagination.Codec
+		req         proto.Message
+		expectedErr error
+	}{
+		{
+			desc:  "basic case",
+			token: token,
+		},
+		{
+			desc:  "valid until expiry",
+			token: token,
+			codec: &pagination.Codec{Key: []byte("secret"), Now: func() time.Time { return now.Add(time.Hour - time.Second) }},
+		},
+		{
+			desc:        "fails if expired",
+			token:       token,
+			codec:       &pagination.Codec{Key: []byte("secret"), Now: func() time.Time { return now.Add(time.Hour) }},
+			expectedErr: pagination.ErrExpiredToken,
+		},
+		{
+			desc:        "fails if signed with another key",
+			token:       token,
+			codec:       &pagination.Codec{Key: []byte("other"), Now: func() time.Time { return now }},
+			expectedErr: pagination.ErrInvalidToken,
+		},
+		{
+			desc:        "fails if cursor is tampered with",
+			token:       tamper(token, 1+8+32),
+			expectedErr: pagination.ErrInvalidToken,
+		},
+		{
+			desc:        "fails if expiry is tampered with",
+			token:       tamper(token, 1),
+			expectedErr: pagination.ErrInvalidToken,
+		},
+		{
+			desc:        "fails if version is unknown",
+			token:       tamper(token, 0),
+			expectedErr: pagination.ErrInvalidToken,
+		},
+		{
+			desc:        "fails if not base64url",
+			token:       token + "!",
+			expectedErr: pagination.ErrInvalidToken,
+		},
+		{
+			desc:        "fails if truncated",
+			token:       token[:20],
+			expectedErr: pagination.ErrInvalidToken,
+		},
+		{
+			desc:        "fails if request does not match",
+			token:       token,
+			req:         &api.ListBetsRequest{Book: "books/guild:1", User: "books/guild:1/users/b"},
+			expectedErr: pagination.ErrMismatchedToken,
+		},
+		{
+			desc:        "fails if request type does not match",
+			token:       token,
+			req:         &api.ListMarketsRequest{Book: "books/guild:1"},
+			expectedErr: pagination.ErrMismatchedToken,
+		},
+	}
+	for _, tC := range testCases {
+		tC := tC
+		t.Run(tC.desc, func(t *testing.T) {
+			c := codec
+			if tC.codec != nil {
+				c = tC.codec
+			}
+			r := proto.Message(req)
+			if tC.req != nil {
+				r = tC.req
+			}
+			p, err := c.FromToken(tC.token, r)
+			if tC.expectedErr != nil {
+				require.NotNil(t, err)
+				assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				assert.ErrorIs(t, err, tC.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, "books/guild:1/bets/b", p.Cursor)
+		})
+	}
+}